@@ -59,6 +59,12 @@ func (m *GetKubeConfigRequest) validate(all bool) error {
 
 	// no validation rules for ClusterId
 
+	// no validation rules for ProjectName
+
+	// no validation rules for Namespace
+
+	// no validation rules for TtlSeconds
+
 	if len(errors) > 0 {
 		return GetKubeConfigRequestMultiError(errors)
 	}
@@ -294,6 +300,35 @@ func (m *KubeConfigInfo) validate(all bool) error {
 
 	// no validation rules for KubeConfig
 
+	if all {
+		switch v := interface{}(m.GetExpireTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KubeConfigInfoValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KubeConfigInfoValidationError{
+					field:  "ExpireTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpireTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KubeConfigInfoValidationError{
+				field:  "ExpireTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return KubeConfigInfoMultiError(errors)
 	}
@@ -402,6 +437,8 @@ func (m *ListClustersRequest) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return ListClustersRequestMultiError(errors)
 	}
@@ -644,6 +681,8 @@ func (m *GetClusterRequest) validate(all bool) error {
 
 	// no validation rules for ClusterId
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return GetClusterRequestMultiError(errors)
 	}
@@ -855,76 +894,152 @@ var _ interface {
 	ErrorName() string
 } = GetClusterResponseValidationError{}
 
-// Validate checks the field values on ClusterInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *ClusterInfo) Validate() error {
+// Validate checks the field values on CordonClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CordonClusterRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ClusterInfo with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ClusterInfoMultiError, or
-// nil if none found.
-func (m *ClusterInfo) ValidateAll() error {
+// ValidateAll checks the field values on CordonClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CordonClusterRequestMultiError, or nil if none found.
+func (m *CordonClusterRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ClusterInfo) validate(all bool) error {
+func (m *CordonClusterRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for ClusterId
 
-	// no validation rules for Labels
+	// no validation rules for ProjectName
 
-	if all {
-		switch v := interface{}(m.GetCreateTime()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ClusterInfoValidationError{
-					field:  "CreateTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, ClusterInfoValidationError{
-					field:  "CreateTime",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return ClusterInfoValidationError{
-				field:  "CreateTime",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return CordonClusterRequestMultiError(errors)
 	}
 
-	// no validation rules for Name
+	return nil
+}
+
+// CordonClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by CordonClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CordonClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CordonClusterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CordonClusterRequestMultiError) AllErrors() []error { return m }
+
+// CordonClusterRequestValidationError is the validation error returned by
+// CordonClusterRequest.Validate if the designated constraints aren't met.
+type CordonClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CordonClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CordonClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CordonClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CordonClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CordonClusterRequestValidationError) ErrorName() string {
+	return "CordonClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CordonClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCordonClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CordonClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CordonClusterRequestValidationError{}
+
+// Validate checks the field values on UncordonClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UncordonClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UncordonClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UncordonClusterRequestMultiError, or nil if none found.
+func (m *UncordonClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UncordonClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ProjectName
 
 	if len(errors) > 0 {
-		return ClusterInfoMultiError(errors)
+		return UncordonClusterRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterInfoMultiError is an error wrapping multiple validation errors
-// returned by ClusterInfo.ValidateAll() if the designated constraints aren't met.
-type ClusterInfoMultiError []error
+// UncordonClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by UncordonClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UncordonClusterRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterInfoMultiError) Error() string {
+func (m UncordonClusterRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -933,11 +1048,11 @@ func (m ClusterInfoMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterInfoMultiError) AllErrors() []error { return m }
+func (m UncordonClusterRequestMultiError) AllErrors() []error { return m }
 
-// ClusterInfoValidationError is the validation error returned by
-// ClusterInfo.Validate if the designated constraints aren't met.
-type ClusterInfoValidationError struct {
+// UncordonClusterRequestValidationError is the validation error returned by
+// UncordonClusterRequest.Validate if the designated constraints aren't met.
+type UncordonClusterRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -945,22 +1060,24 @@ type ClusterInfoValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterInfoValidationError) Field() string { return e.field }
+func (e UncordonClusterRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterInfoValidationError) Reason() string { return e.reason }
+func (e UncordonClusterRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterInfoValidationError) Cause() error { return e.cause }
+func (e UncordonClusterRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterInfoValidationError) Key() bool { return e.key }
+func (e UncordonClusterRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterInfoValidationError) ErrorName() string { return "ClusterInfoValidationError" }
+func (e UncordonClusterRequestValidationError) ErrorName() string {
+	return "UncordonClusterRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ClusterInfoValidationError) Error() string {
+func (e UncordonClusterRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -972,14 +1089,14 @@ func (e ClusterInfoValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sClusterInfo.%s: %s%s",
+		"invalid %sUncordonClusterRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterInfoValidationError{}
+var _ error = UncordonClusterRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -987,4 +1104,2761 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterInfoValidationError{}
+} = UncordonClusterRequestValidationError{}
+
+// Validate checks the field values on DrainClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DrainClusterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DrainClusterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DrainClusterRequestMultiError, or nil if none found.
+func (m *DrainClusterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DrainClusterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return DrainClusterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DrainClusterRequestMultiError is an error wrapping multiple validation
+// errors returned by DrainClusterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DrainClusterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DrainClusterRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DrainClusterRequestMultiError) AllErrors() []error { return m }
+
+// DrainClusterRequestValidationError is the validation error returned by
+// DrainClusterRequest.Validate if the designated constraints aren't met.
+type DrainClusterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DrainClusterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DrainClusterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DrainClusterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DrainClusterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DrainClusterRequestValidationError) ErrorName() string {
+	return "DrainClusterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DrainClusterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDrainClusterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DrainClusterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DrainClusterRequestValidationError{}
+
+// Validate checks the field values on GetClusterInventoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterInventoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterInventoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterInventoryRequestMultiError, or nil if none found.
+func (m *GetClusterInventoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterInventoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return GetClusterInventoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterInventoryRequestMultiError is an error wrapping multiple
+// validation errors returned by GetClusterInventoryRequest.ValidateAll() if
+// the designated constraints aren't met.
+type GetClusterInventoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterInventoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterInventoryRequestMultiError) AllErrors() []error { return m }
+
+// GetClusterInventoryRequestValidationError is the validation error returned
+// by GetClusterInventoryRequest.Validate if the designated constraints aren't met.
+type GetClusterInventoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterInventoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterInventoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterInventoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterInventoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterInventoryRequestValidationError) ErrorName() string {
+	return "GetClusterInventoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterInventoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterInventoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterInventoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterInventoryRequestValidationError{}
+
+// Validate checks the field values on GetClusterInventoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterInventoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterInventoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterInventoryResponseMultiError, or nil if none found.
+func (m *GetClusterInventoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterInventoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetClusterInventoryResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetClusterInventoryResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetClusterInventoryResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	if len(errors) > 0 {
+		return GetClusterInventoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterInventoryResponseMultiError is an error wrapping multiple
+// validation errors returned by GetClusterInventoryResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetClusterInventoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterInventoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterInventoryResponseMultiError) AllErrors() []error { return m }
+
+// GetClusterInventoryResponseValidationError is the validation error returned
+// by GetClusterInventoryResponse.Validate if the designated constraints
+// aren't met.
+type GetClusterInventoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterInventoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterInventoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterInventoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterInventoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterInventoryResponseValidationError) ErrorName() string {
+	return "GetClusterInventoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterInventoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterInventoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterInventoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterInventoryResponseValidationError{}
+
+// Validate checks the field values on ExportInventoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportInventoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportInventoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportInventoryRequestMultiError, or nil if none found.
+func (m *ExportInventoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportInventoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return ExportInventoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportInventoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ExportInventoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ExportInventoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportInventoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportInventoryRequestMultiError) AllErrors() []error { return m }
+
+// ExportInventoryRequestValidationError is the validation error returned by
+// ExportInventoryRequest.Validate if the designated constraints aren't met.
+type ExportInventoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportInventoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportInventoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportInventoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportInventoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportInventoryRequestValidationError) ErrorName() string {
+	return "ExportInventoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportInventoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportInventoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportInventoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportInventoryRequestValidationError{}
+
+// Validate checks the field values on ExportInventoryResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportInventoryResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportInventoryResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportInventoryResponseMultiError, or nil if none found.
+func (m *ExportInventoryResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportInventoryResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Content
+
+	// no validation rules for FileName
+
+	if len(errors) > 0 {
+		return ExportInventoryResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportInventoryResponseMultiError is an error wrapping multiple validation
+// errors returned by ExportInventoryResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ExportInventoryResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportInventoryResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportInventoryResponseMultiError) AllErrors() []error { return m }
+
+// ExportInventoryResponseValidationError is the validation error returned by
+// ExportInventoryResponse.Validate if the designated constraints aren't met.
+type ExportInventoryResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportInventoryResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportInventoryResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportInventoryResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportInventoryResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportInventoryResponseValidationError) ErrorName() string {
+	return "ExportInventoryResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportInventoryResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportInventoryResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportInventoryResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportInventoryResponseValidationError{}
+
+// Validate checks the field values on CreateClusterGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClusterGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClusterGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClusterGroupRequestMultiError, or nil if none found.
+func (m *CreateClusterGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClusterGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterGroupRequestValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return CreateClusterGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClusterGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateClusterGroupRequest.ValidateAll() if the
+// designated constraints aren't met.
+type CreateClusterGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClusterGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClusterGroupRequestMultiError) AllErrors() []error { return m }
+
+// CreateClusterGroupRequestValidationError is the validation error returned by
+// CreateClusterGroupRequest.Validate if the designated constraints aren't met.
+type CreateClusterGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClusterGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClusterGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClusterGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClusterGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClusterGroupRequestValidationError) ErrorName() string {
+	return "CreateClusterGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClusterGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClusterGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClusterGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClusterGroupRequestValidationError{}
+
+// Validate checks the field values on CreateClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateClusterGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateClusterGroupResponseMultiError, or nil if none found.
+func (m *CreateClusterGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateClusterGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateClusterGroupResponseValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateClusterGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateClusterGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by CreateClusterGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type CreateClusterGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateClusterGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateClusterGroupResponseMultiError) AllErrors() []error { return m }
+
+// CreateClusterGroupResponseValidationError is the validation error returned
+// by CreateClusterGroupResponse.Validate if the designated constraints aren't met.
+type CreateClusterGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateClusterGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateClusterGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateClusterGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateClusterGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateClusterGroupResponseValidationError) ErrorName() string {
+	return "CreateClusterGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateClusterGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateClusterGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateClusterGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateClusterGroupResponseValidationError{}
+
+// Validate checks the field values on ListClusterGroupsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterGroupsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterGroupsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterGroupsRequestMultiError, or nil if none found.
+func (m *ListClusterGroupsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterGroupsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return ListClusterGroupsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterGroupsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListClusterGroupsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListClusterGroupsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterGroupsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterGroupsRequestMultiError) AllErrors() []error { return m }
+
+// ListClusterGroupsRequestValidationError is the validation error returned by
+// ListClusterGroupsRequest.Validate if the designated constraints aren't met.
+type ListClusterGroupsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterGroupsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterGroupsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterGroupsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterGroupsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterGroupsRequestValidationError) ErrorName() string {
+	return "ListClusterGroupsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterGroupsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterGroupsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterGroupsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterGroupsRequestValidationError{}
+
+// Validate checks the field values on ListClusterGroupsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListClusterGroupsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListClusterGroupsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListClusterGroupsResponseMultiError, or nil if none found.
+func (m *ListClusterGroupsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListClusterGroupsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClusterGroups() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListClusterGroupsResponseValidationError{
+						field:  fmt.Sprintf("ClusterGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListClusterGroupsResponseValidationError{
+						field:  fmt.Sprintf("ClusterGroups[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListClusterGroupsResponseValidationError{
+					field:  fmt.Sprintf("ClusterGroups[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	if len(errors) > 0 {
+		return ListClusterGroupsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListClusterGroupsResponseMultiError is an error wrapping multiple validation
+// errors returned by ListClusterGroupsResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ListClusterGroupsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListClusterGroupsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListClusterGroupsResponseMultiError) AllErrors() []error { return m }
+
+// ListClusterGroupsResponseValidationError is the validation error returned by
+// ListClusterGroupsResponse.Validate if the designated constraints aren't met.
+type ListClusterGroupsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListClusterGroupsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListClusterGroupsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListClusterGroupsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListClusterGroupsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListClusterGroupsResponseValidationError) ErrorName() string {
+	return "ListClusterGroupsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListClusterGroupsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListClusterGroupsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListClusterGroupsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListClusterGroupsResponseValidationError{}
+
+// Validate checks the field values on GetClusterGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterGroupRequestMultiError, or nil if none found.
+func (m *GetClusterGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return GetClusterGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by GetClusterGroupRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetClusterGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterGroupRequestMultiError) AllErrors() []error { return m }
+
+// GetClusterGroupRequestValidationError is the validation error returned by
+// GetClusterGroupRequest.Validate if the designated constraints aren't met.
+type GetClusterGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterGroupRequestValidationError) ErrorName() string {
+	return "GetClusterGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterGroupRequestValidationError{}
+
+// Validate checks the field values on GetClusterGroupResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetClusterGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetClusterGroupResponseMultiError, or nil if none found.
+func (m *GetClusterGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetClusterGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetClusterGroupResponseValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return GetClusterGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetClusterGroupResponseMultiError is an error wrapping multiple validation
+// errors returned by GetClusterGroupResponse.ValidateAll() if the designated
+// constraints aren't met.
+type GetClusterGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetClusterGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetClusterGroupResponseMultiError) AllErrors() []error { return m }
+
+// GetClusterGroupResponseValidationError is the validation error returned by
+// GetClusterGroupResponse.Validate if the designated constraints aren't met.
+type GetClusterGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetClusterGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetClusterGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetClusterGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetClusterGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetClusterGroupResponseValidationError) ErrorName() string {
+	return "GetClusterGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetClusterGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetClusterGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetClusterGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetClusterGroupResponseValidationError{}
+
+// Validate checks the field values on UpdateClusterGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateClusterGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateClusterGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateClusterGroupRequestMultiError, or nil if none found.
+func (m *UpdateClusterGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateClusterGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterGroupRequestValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return UpdateClusterGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateClusterGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateClusterGroupRequest.ValidateAll() if the
+// designated constraints aren't met.
+type UpdateClusterGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateClusterGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateClusterGroupRequestMultiError) AllErrors() []error { return m }
+
+// UpdateClusterGroupRequestValidationError is the validation error returned by
+// UpdateClusterGroupRequest.Validate if the designated constraints aren't met.
+type UpdateClusterGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateClusterGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateClusterGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateClusterGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateClusterGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateClusterGroupRequestValidationError) ErrorName() string {
+	return "UpdateClusterGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateClusterGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateClusterGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateClusterGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateClusterGroupRequestValidationError{}
+
+// Validate checks the field values on UpdateClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateClusterGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateClusterGroupResponseMultiError, or nil if none found.
+func (m *UpdateClusterGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateClusterGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateClusterGroupResponseValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateClusterGroupResponseValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateClusterGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateClusterGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by UpdateClusterGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type UpdateClusterGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateClusterGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateClusterGroupResponseMultiError) AllErrors() []error { return m }
+
+// UpdateClusterGroupResponseValidationError is the validation error returned
+// by UpdateClusterGroupResponse.Validate if the designated constraints aren't met.
+type UpdateClusterGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateClusterGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateClusterGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateClusterGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateClusterGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateClusterGroupResponseValidationError) ErrorName() string {
+	return "UpdateClusterGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateClusterGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateClusterGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateClusterGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateClusterGroupResponseValidationError{}
+
+// Validate checks the field values on DeleteClusterGroupRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteClusterGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteClusterGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteClusterGroupRequestMultiError, or nil if none found.
+func (m *DeleteClusterGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteClusterGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return DeleteClusterGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteClusterGroupRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteClusterGroupRequest.ValidateAll() if the
+// designated constraints aren't met.
+type DeleteClusterGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteClusterGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteClusterGroupRequestMultiError) AllErrors() []error { return m }
+
+// DeleteClusterGroupRequestValidationError is the validation error returned by
+// DeleteClusterGroupRequest.Validate if the designated constraints aren't met.
+type DeleteClusterGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteClusterGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteClusterGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteClusterGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteClusterGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteClusterGroupRequestValidationError) ErrorName() string {
+	return "DeleteClusterGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteClusterGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteClusterGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteClusterGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteClusterGroupRequestValidationError{}
+
+// Validate checks the field values on PreviewClusterGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewClusterGroupRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewClusterGroupRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewClusterGroupRequestMultiError, or nil if none found.
+func (m *PreviewClusterGroupRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewClusterGroupRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetClusterGroup()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewClusterGroupRequestValidationError{
+					field:  "ClusterGroup",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetClusterGroup()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewClusterGroupRequestValidationError{
+				field:  "ClusterGroup",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return PreviewClusterGroupRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewClusterGroupRequestMultiError is an error wrapping multiple
+// validation errors returned by PreviewClusterGroupRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewClusterGroupRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewClusterGroupRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewClusterGroupRequestMultiError) AllErrors() []error { return m }
+
+// PreviewClusterGroupRequestValidationError is the validation error returned
+// by PreviewClusterGroupRequest.Validate if the designated constraints aren't met.
+type PreviewClusterGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewClusterGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewClusterGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewClusterGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewClusterGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewClusterGroupRequestValidationError) ErrorName() string {
+	return "PreviewClusterGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewClusterGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewClusterGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewClusterGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewClusterGroupRequestValidationError{}
+
+// Validate checks the field values on PreviewClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewClusterGroupResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewClusterGroupResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewClusterGroupResponseMultiError, or nil if none found.
+func (m *PreviewClusterGroupResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewClusterGroupResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewClusterGroupResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewClusterGroupResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewClusterGroupResponseValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	if len(errors) > 0 {
+		return PreviewClusterGroupResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewClusterGroupResponseMultiError is an error wrapping multiple
+// validation errors returned by PreviewClusterGroupResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PreviewClusterGroupResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewClusterGroupResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewClusterGroupResponseMultiError) AllErrors() []error { return m }
+
+// PreviewClusterGroupResponseValidationError is the validation error returned
+// by PreviewClusterGroupResponse.Validate if the designated constraints
+// aren't met.
+type PreviewClusterGroupResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewClusterGroupResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewClusterGroupResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewClusterGroupResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewClusterGroupResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewClusterGroupResponseValidationError) ErrorName() string {
+	return "PreviewClusterGroupResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewClusterGroupResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewClusterGroupResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewClusterGroupResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewClusterGroupResponseValidationError{}
+
+// Validate checks the field values on PreviewTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewTargetsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewTargetsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewTargetsRequestMultiError, or nil if none found.
+func (m *PreviewTargetsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewTargetsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for AppVersion
+
+	// no validation rules for DeploymentType
+
+	for idx, item := range m.GetTargetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewTargetsRequestValidationError{
+						field:  fmt.Sprintf("TargetClusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewTargetsRequestValidationError{
+						field:  fmt.Sprintf("TargetClusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewTargetsRequestValidationError{
+					field:  fmt.Sprintf("TargetClusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetAllAppTargetClusters()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PreviewTargetsRequestValidationError{
+					field:  "AllAppTargetClusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PreviewTargetsRequestValidationError{
+					field:  "AllAppTargetClusters",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAllAppTargetClusters()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PreviewTargetsRequestValidationError{
+				field:  "AllAppTargetClusters",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return PreviewTargetsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewTargetsRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewTargetsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewTargetsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewTargetsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewTargetsRequestMultiError) AllErrors() []error { return m }
+
+// PreviewTargetsRequestValidationError is the validation error returned by
+// PreviewTargetsRequest.Validate if the designated constraints aren't met.
+type PreviewTargetsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewTargetsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewTargetsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewTargetsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewTargetsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewTargetsRequestValidationError) ErrorName() string {
+	return "PreviewTargetsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewTargetsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewTargetsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewTargetsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewTargetsRequestValidationError{}
+
+// Validate checks the field values on PreviewTargetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewTargetsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewTargetsResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewTargetsResponseMultiError, or nil if none found.
+func (m *PreviewTargetsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewTargetsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetApps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewTargetsResponseValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewTargetsResponseValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewTargetsResponseValidationError{
+					field:  fmt.Sprintf("Apps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetPartialClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreviewTargetsResponseValidationError{
+						field:  fmt.Sprintf("PartialClusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreviewTargetsResponseValidationError{
+						field:  fmt.Sprintf("PartialClusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreviewTargetsResponseValidationError{
+					field:  fmt.Sprintf("PartialClusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	if len(errors) > 0 {
+		return PreviewTargetsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewTargetsResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewTargetsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewTargetsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewTargetsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewTargetsResponseMultiError) AllErrors() []error { return m }
+
+// PreviewTargetsResponseValidationError is the validation error returned by
+// PreviewTargetsResponse.Validate if the designated constraints aren't met.
+type PreviewTargetsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewTargetsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewTargetsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewTargetsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewTargetsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewTargetsResponseValidationError) ErrorName() string {
+	return "PreviewTargetsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewTargetsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewTargetsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewTargetsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewTargetsResponseValidationError{}
+
+// Validate checks the field values on AppTargetClusters with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AppTargetClusters) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppTargetClusters with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AppTargetClustersMultiError, or nil if none found.
+func (m *AppTargetClusters) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppTargetClusters) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppTargetClustersValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppTargetClustersValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppTargetClustersValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for TotalElements
+
+	if len(errors) > 0 {
+		return AppTargetClustersMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppTargetClustersMultiError is an error wrapping multiple validation errors
+// returned by AppTargetClusters.ValidateAll() if the designated constraints
+// aren't met.
+type AppTargetClustersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppTargetClustersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppTargetClustersMultiError) AllErrors() []error { return m }
+
+// AppTargetClustersValidationError is the validation error returned by
+// AppTargetClusters.Validate if the designated constraints aren't met.
+type AppTargetClustersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppTargetClustersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppTargetClustersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppTargetClustersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppTargetClustersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppTargetClustersValidationError) ErrorName() string {
+	return "AppTargetClustersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AppTargetClustersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppTargetClusters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppTargetClustersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppTargetClustersValidationError{}
+
+// Validate checks the field values on ClusterInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterInfo) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClusterInfo with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterInfoMultiError, or
+// nil if none found.
+func (m *ClusterInfo) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClusterInfo) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Labels
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterInfoValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterInfoValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterInfoValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Name
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterInfoValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterInfoValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterInfoValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Cordon
+
+	if len(errors) > 0 {
+		return ClusterInfoMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClusterInfoMultiError is an error wrapping multiple validation errors
+// returned by ClusterInfo.ValidateAll() if the designated constraints aren't met.
+type ClusterInfoMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClusterInfoMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClusterInfoMultiError) AllErrors() []error { return m }
+
+// ClusterInfoValidationError is the validation error returned by
+// ClusterInfo.Validate if the designated constraints aren't met.
+type ClusterInfoValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClusterInfoValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClusterInfoValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClusterInfoValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClusterInfoValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClusterInfoValidationError) ErrorName() string { return "ClusterInfoValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClusterInfoValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClusterInfo.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClusterInfoValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClusterInfoValidationError{}
+
+// Validate checks the field values on InventoryItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *InventoryItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on InventoryItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in InventoryItemMultiError, or
+// nil if none found.
+func (m *InventoryItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *InventoryItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ClusterName
+
+	// no validation rules for DeploymentUid
+
+	// no validation rules for DeploymentName
+
+	// no validation rules for DeploymentDisplayName
+
+	// no validation rules for AppName
+
+	// no validation rules for AppVersion
+
+	// no validation rules for App
+
+	// no validation rules for ChartName
+
+	// no validation rules for ChartVersion
+
+	// no validation rules for Namespace
+
+	// no validation rules for BundleName
+
+	// no validation rules for BundleState
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, InventoryItemValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, InventoryItemValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return InventoryItemValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return InventoryItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// InventoryItemMultiError is an error wrapping multiple validation errors
+// returned by InventoryItem.ValidateAll() if the designated constraints
+// aren't met.
+type InventoryItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m InventoryItemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m InventoryItemMultiError) AllErrors() []error { return m }
+
+// InventoryItemValidationError is the validation error returned by
+// InventoryItem.Validate if the designated constraints aren't met.
+type InventoryItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e InventoryItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e InventoryItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e InventoryItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e InventoryItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e InventoryItemValidationError) ErrorName() string { return "InventoryItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e InventoryItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sInventoryItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = InventoryItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = InventoryItemValidationError{}
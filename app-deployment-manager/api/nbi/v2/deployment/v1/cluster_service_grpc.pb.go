@@ -11,6 +11,7 @@ import (
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -22,12 +23,36 @@ const _ = grpc.SupportPackageIsVersion7
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type ClusterServiceClient interface {
-	// GetKubeConfig gets kubeConfig based on a given cluster id
+	// GetKubeConfig issues a short-lived scoped kubeConfig based on a given cluster id
 	GetKubeConfig(ctx context.Context, in *GetKubeConfigRequest, opts ...grpc.CallOption) (*GetKubeConfigResponse, error)
 	// Gets a list of all cluster objects.
 	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
 	// Gets a cluster object.
 	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*GetClusterResponse, error)
+	// Cordons a cluster, excluding it from new deployment targets and rollouts while its applications keep running.
+	CordonCluster(ctx context.Context, in *CordonClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Uncordons a cordoned or drained cluster, making it a deployment target again.
+	UncordonCluster(ctx context.Context, in *UncordonClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Drains a cluster, excluding it from deployment targets and removing all its applications.
+	DrainCluster(ctx context.Context, in *DrainClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Gets the deployments, applications, chart versions and namespaces placed on a cluster.
+	GetClusterInventory(ctx context.Context, in *GetClusterInventoryRequest, opts ...grpc.CallOption) (*GetClusterInventoryResponse, error)
+	// Exports the inventory of all the clusters of the project as a JSON or CSV file.
+	ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (*ExportInventoryResponse, error)
+	// Creates a cluster group.
+	CreateClusterGroup(ctx context.Context, in *CreateClusterGroupRequest, opts ...grpc.CallOption) (*CreateClusterGroupResponse, error)
+	// Gets a list of all cluster group objects.
+	ListClusterGroups(ctx context.Context, in *ListClusterGroupsRequest, opts ...grpc.CallOption) (*ListClusterGroupsResponse, error)
+	// Gets a cluster group object with its resolved member clusters.
+	GetClusterGroup(ctx context.Context, in *GetClusterGroupRequest, opts ...grpc.CallOption) (*GetClusterGroupResponse, error)
+	// Updates a cluster group, the deployments targeting the group follow its new members.
+	UpdateClusterGroup(ctx context.Context, in *UpdateClusterGroupRequest, opts ...grpc.CallOption) (*UpdateClusterGroupResponse, error)
+	// Deletes a cluster group, which must not be targeted by a deployment nor referred to by another group.
+	DeleteClusterGroup(ctx context.Context, in *DeleteClusterGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Previews the member clusters of a cluster group without storing it.
+	PreviewClusterGroup(ctx context.Context, in *PreviewClusterGroupRequest, opts ...grpc.CallOption) (*PreviewClusterGroupResponse, error)
+	// Previews the clusters each application of a deployment would be deployed to, without creating it.
+	PreviewTargets(ctx context.Context, in *PreviewTargetsRequest, opts ...grpc.CallOption) (*PreviewTargetsResponse, error)
 }
 
 type clusterServiceClient struct {
@@ -65,16 +90,148 @@ func (c *clusterServiceClient) GetCluster(ctx context.Context, in *GetClusterReq
 	return out, nil
 }
 
+func (c *clusterServiceClient) CordonCluster(ctx context.Context, in *CordonClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/CordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UncordonCluster(ctx context.Context, in *UncordonClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/UncordonCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) DrainCluster(ctx context.Context, in *DrainClusterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/DrainCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetClusterInventory(ctx context.Context, in *GetClusterInventoryRequest, opts ...grpc.CallOption) (*GetClusterInventoryResponse, error) {
+	out := new(GetClusterInventoryResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/GetClusterInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ExportInventory(ctx context.Context, in *ExportInventoryRequest, opts ...grpc.CallOption) (*ExportInventoryResponse, error) {
+	out := new(ExportInventoryResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/ExportInventory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) CreateClusterGroup(ctx context.Context, in *CreateClusterGroupRequest, opts ...grpc.CallOption) (*CreateClusterGroupResponse, error) {
+	out := new(CreateClusterGroupResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/CreateClusterGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) ListClusterGroups(ctx context.Context, in *ListClusterGroupsRequest, opts ...grpc.CallOption) (*ListClusterGroupsResponse, error) {
+	out := new(ListClusterGroupsResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/ListClusterGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetClusterGroup(ctx context.Context, in *GetClusterGroupRequest, opts ...grpc.CallOption) (*GetClusterGroupResponse, error) {
+	out := new(GetClusterGroupResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/GetClusterGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) UpdateClusterGroup(ctx context.Context, in *UpdateClusterGroupRequest, opts ...grpc.CallOption) (*UpdateClusterGroupResponse, error) {
+	out := new(UpdateClusterGroupResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/UpdateClusterGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) DeleteClusterGroup(ctx context.Context, in *DeleteClusterGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/DeleteClusterGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) PreviewClusterGroup(ctx context.Context, in *PreviewClusterGroupRequest, opts ...grpc.CallOption) (*PreviewClusterGroupResponse, error) {
+	out := new(PreviewClusterGroupResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/PreviewClusterGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) PreviewTargets(ctx context.Context, in *PreviewTargetsRequest, opts ...grpc.CallOption) (*PreviewTargetsResponse, error) {
+	out := new(PreviewTargetsResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.ClusterService/PreviewTargets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 // All implementations should embed UnimplementedClusterServiceServer
 // for forward compatibility
 type ClusterServiceServer interface {
-	// GetKubeConfig gets kubeConfig based on a given cluster id
+	// GetKubeConfig issues a short-lived scoped kubeConfig based on a given cluster id
 	GetKubeConfig(context.Context, *GetKubeConfigRequest) (*GetKubeConfigResponse, error)
 	// Gets a list of all cluster objects.
 	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
 	// Gets a cluster object.
 	GetCluster(context.Context, *GetClusterRequest) (*GetClusterResponse, error)
+	// Cordons a cluster, excluding it from new deployment targets and rollouts while its applications keep running.
+	CordonCluster(context.Context, *CordonClusterRequest) (*emptypb.Empty, error)
+	// Uncordons a cordoned or drained cluster, making it a deployment target again.
+	UncordonCluster(context.Context, *UncordonClusterRequest) (*emptypb.Empty, error)
+	// Drains a cluster, excluding it from deployment targets and removing all its applications.
+	DrainCluster(context.Context, *DrainClusterRequest) (*emptypb.Empty, error)
+	// Gets the deployments, applications, chart versions and namespaces placed on a cluster.
+	GetClusterInventory(context.Context, *GetClusterInventoryRequest) (*GetClusterInventoryResponse, error)
+	// Exports the inventory of all the clusters of the project as a JSON or CSV file.
+	ExportInventory(context.Context, *ExportInventoryRequest) (*ExportInventoryResponse, error)
+	// Creates a cluster group.
+	CreateClusterGroup(context.Context, *CreateClusterGroupRequest) (*CreateClusterGroupResponse, error)
+	// Gets a list of all cluster group objects.
+	ListClusterGroups(context.Context, *ListClusterGroupsRequest) (*ListClusterGroupsResponse, error)
+	// Gets a cluster group object with its resolved member clusters.
+	GetClusterGroup(context.Context, *GetClusterGroupRequest) (*GetClusterGroupResponse, error)
+	// Updates a cluster group, the deployments targeting the group follow its new members.
+	UpdateClusterGroup(context.Context, *UpdateClusterGroupRequest) (*UpdateClusterGroupResponse, error)
+	// Deletes a cluster group, which must not be targeted by a deployment nor referred to by another group.
+	DeleteClusterGroup(context.Context, *DeleteClusterGroupRequest) (*emptypb.Empty, error)
+	// Previews the member clusters of a cluster group without storing it.
+	PreviewClusterGroup(context.Context, *PreviewClusterGroupRequest) (*PreviewClusterGroupResponse, error)
+	// Previews the clusters each application of a deployment would be deployed to, without creating it.
+	PreviewTargets(context.Context, *PreviewTargetsRequest) (*PreviewTargetsResponse, error)
 }
 
 // UnimplementedClusterServiceServer should be embedded to have forward compatible implementations.
@@ -90,6 +247,42 @@ func (UnimplementedClusterServiceServer) ListClusters(context.Context, *ListClus
 func (UnimplementedClusterServiceServer) GetCluster(context.Context, *GetClusterRequest) (*GetClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
 }
+func (UnimplementedClusterServiceServer) CordonCluster(context.Context, *CordonClusterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CordonCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) UncordonCluster(context.Context, *UncordonClusterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UncordonCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) DrainCluster(context.Context, *DrainClusterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainCluster not implemented")
+}
+func (UnimplementedClusterServiceServer) GetClusterInventory(context.Context, *GetClusterInventoryRequest) (*GetClusterInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterInventory not implemented")
+}
+func (UnimplementedClusterServiceServer) ExportInventory(context.Context, *ExportInventoryRequest) (*ExportInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportInventory not implemented")
+}
+func (UnimplementedClusterServiceServer) CreateClusterGroup(context.Context, *CreateClusterGroupRequest) (*CreateClusterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClusterGroup not implemented")
+}
+func (UnimplementedClusterServiceServer) ListClusterGroups(context.Context, *ListClusterGroupsRequest) (*ListClusterGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusterGroups not implemented")
+}
+func (UnimplementedClusterServiceServer) GetClusterGroup(context.Context, *GetClusterGroupRequest) (*GetClusterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClusterGroup not implemented")
+}
+func (UnimplementedClusterServiceServer) UpdateClusterGroup(context.Context, *UpdateClusterGroupRequest) (*UpdateClusterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateClusterGroup not implemented")
+}
+func (UnimplementedClusterServiceServer) DeleteClusterGroup(context.Context, *DeleteClusterGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClusterGroup not implemented")
+}
+func (UnimplementedClusterServiceServer) PreviewClusterGroup(context.Context, *PreviewClusterGroupRequest) (*PreviewClusterGroupResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewClusterGroup not implemented")
+}
+func (UnimplementedClusterServiceServer) PreviewTargets(context.Context, *PreviewTargetsRequest) (*PreviewTargetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTargets not implemented")
+}
 
 // UnsafeClusterServiceServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to ClusterServiceServer will
@@ -156,6 +349,222 @@ func _ClusterService_GetCluster_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_CordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CordonClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).CordonCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/CordonCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).CordonCluster(ctx, req.(*CordonClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UncordonCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UncordonClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UncordonCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/UncordonCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UncordonCluster(ctx, req.(*UncordonClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_DrainCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DrainCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/DrainCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DrainCluster(ctx, req.(*DrainClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetClusterInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetClusterInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/GetClusterInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetClusterInventory(ctx, req.(*GetClusterInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ExportInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportInventoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ExportInventory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/ExportInventory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ExportInventory(ctx, req.(*ExportInventoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_CreateClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateClusterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).CreateClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/CreateClusterGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).CreateClusterGroup(ctx, req.(*CreateClusterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_ListClusterGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClusterGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListClusterGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/ListClusterGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListClusterGroups(ctx, req.(*ListClusterGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/GetClusterGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetClusterGroup(ctx, req.(*GetClusterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_UpdateClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateClusterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).UpdateClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/UpdateClusterGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).UpdateClusterGroup(ctx, req.(*UpdateClusterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_DeleteClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteClusterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DeleteClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/DeleteClusterGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DeleteClusterGroup(ctx, req.(*DeleteClusterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_PreviewClusterGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewClusterGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).PreviewClusterGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/PreviewClusterGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).PreviewClusterGroup(ctx, req.(*PreviewClusterGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_PreviewTargets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTargetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).PreviewTargets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.ClusterService/PreviewTargets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).PreviewTargets(ctx, req.(*PreviewTargetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ClusterService_ServiceDesc is the grpc.ServiceDesc for ClusterService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -175,6 +584,54 @@ var ClusterService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCluster",
 			Handler:    _ClusterService_GetCluster_Handler,
 		},
+		{
+			MethodName: "CordonCluster",
+			Handler:    _ClusterService_CordonCluster_Handler,
+		},
+		{
+			MethodName: "UncordonCluster",
+			Handler:    _ClusterService_UncordonCluster_Handler,
+		},
+		{
+			MethodName: "DrainCluster",
+			Handler:    _ClusterService_DrainCluster_Handler,
+		},
+		{
+			MethodName: "GetClusterInventory",
+			Handler:    _ClusterService_GetClusterInventory_Handler,
+		},
+		{
+			MethodName: "ExportInventory",
+			Handler:    _ClusterService_ExportInventory_Handler,
+		},
+		{
+			MethodName: "CreateClusterGroup",
+			Handler:    _ClusterService_CreateClusterGroup_Handler,
+		},
+		{
+			MethodName: "ListClusterGroups",
+			Handler:    _ClusterService_ListClusterGroups_Handler,
+		},
+		{
+			MethodName: "GetClusterGroup",
+			Handler:    _ClusterService_GetClusterGroup_Handler,
+		},
+		{
+			MethodName: "UpdateClusterGroup",
+			Handler:    _ClusterService_UpdateClusterGroup_Handler,
+		},
+		{
+			MethodName: "DeleteClusterGroup",
+			Handler:    _ClusterService_DeleteClusterGroup_Handler,
+		},
+		{
+			MethodName: "PreviewClusterGroup",
+			Handler:    _ClusterService_PreviewClusterGroup_Handler,
+		},
+		{
+			MethodName: "PreviewTargets",
+			Handler:    _ClusterService_PreviewTargets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "deployment/v1/cluster_service.proto",
@@ -296,19 +296,4235 @@ func (m *Deployment) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for DriftPolicy
+
+	for idx, item := range m.GetHealthProbes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("HealthProbes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("HealthProbes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("HealthProbes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetTestHooks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("TestHooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("TestHooks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("TestHooks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Priority
+
+	for idx, item := range m.GetBlueGreenServices() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("BlueGreenServices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("BlueGreenServices[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("BlueGreenServices[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetBlueGreenStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "BlueGreenStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "BlueGreenStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetBlueGreenStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "BlueGreenStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCanary()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "Canary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "Canary",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCanary()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "Canary",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetCanaryStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "CanaryStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "CanaryStatus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCanaryStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "CanaryStatus",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetResourceRequests()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "ResourceRequests",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentValidationError{
+					field:  "ResourceRequests",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResourceRequests()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentValidationError{
+				field:  "ResourceRequests",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetNamespacePolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("NamespacePolicies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("NamespacePolicies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("NamespacePolicies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetKustomizePatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("KustomizePatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("KustomizePatches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("KustomizePatches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetManifestApps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("ManifestApps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentValidationError{
+						field:  fmt.Sprintf("ManifestApps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentValidationError{
+					field:  fmt.Sprintf("ManifestApps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DeploymentMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeploymentMultiError is an error wrapping multiple validation errors
+// returned by Deployment.ValidateAll() if the designated constraints aren't met.
+type DeploymentMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeploymentMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeploymentMultiError) AllErrors() []error { return m }
+
+// DeploymentValidationError is the validation error returned by
+// Deployment.Validate if the designated constraints aren't met.
+type DeploymentValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeploymentValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeploymentValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeploymentValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeploymentValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeploymentValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeployment.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeploymentValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeploymentValidationError{}
+
+// Validate checks the field values on HealthProbe with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HealthProbe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HealthProbe with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HealthProbeMultiError, or
+// nil if none found.
+func (m *HealthProbe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HealthProbe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Name
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for Retries
+
+	switch v := m.Probe.(type) {
+	case *HealthProbe_Http:
+		if v == nil {
+			err := HealthProbeValidationError{
+				field:  "Probe",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetHttp()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Http",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetHttp()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HealthProbeValidationError{
+					field:  "Http",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *HealthProbe_Job:
+		if v == nil {
+			err := HealthProbeValidationError{
+				field:  "Probe",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJob()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HealthProbeValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *HealthProbe_Prometheus:
+		if v == nil {
+			err := HealthProbeValidationError{
+				field:  "Probe",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetPrometheus()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Prometheus",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, HealthProbeValidationError{
+						field:  "Prometheus",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetPrometheus()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return HealthProbeValidationError{
+					field:  "Prometheus",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return HealthProbeMultiError(errors)
+	}
+
+	return nil
+}
+
+// HealthProbeMultiError is an error wrapping multiple validation errors
+// returned by HealthProbe.ValidateAll() if the designated constraints aren't met.
+type HealthProbeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HealthProbeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HealthProbeMultiError) AllErrors() []error { return m }
+
+// HealthProbeValidationError is the validation error returned by
+// HealthProbe.Validate if the designated constraints aren't met.
+type HealthProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HealthProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HealthProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HealthProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HealthProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HealthProbeValidationError) ErrorName() string { return "HealthProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HealthProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHealthProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HealthProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HealthProbeValidationError{}
+
+// Validate checks the field values on HTTPProbe with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *HTTPProbe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on HTTPProbe with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in HTTPProbeMultiError, or nil
+// if none found.
+func (m *HTTPProbe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *HTTPProbe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Service
+
+	// no validation rules for Namespace
+
+	// no validation rules for Port
+
+	// no validation rules for Path
+
+	// no validation rules for Scheme
+
+	// no validation rules for ExpectedStatus
+
+	if len(errors) > 0 {
+		return HTTPProbeMultiError(errors)
+	}
+
+	return nil
+}
+
+// HTTPProbeMultiError is an error wrapping multiple validation errors returned
+// by HTTPProbe.ValidateAll() if the designated constraints aren't met.
+type HTTPProbeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m HTTPProbeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m HTTPProbeMultiError) AllErrors() []error { return m }
+
+// HTTPProbeValidationError is the validation error returned by
+// HTTPProbe.Validate if the designated constraints aren't met.
+type HTTPProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e HTTPProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e HTTPProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e HTTPProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e HTTPProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e HTTPProbeValidationError) ErrorName() string { return "HTTPProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e HTTPProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sHTTPProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = HTTPProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = HTTPProbeValidationError{}
+
+// Validate checks the field values on JobProbe with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JobProbe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JobProbe with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JobProbeMultiError, or nil
+// if none found.
+func (m *JobProbe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JobProbe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Image
+
+	if len(errors) > 0 {
+		return JobProbeMultiError(errors)
+	}
+
+	return nil
+}
+
+// JobProbeMultiError is an error wrapping multiple validation errors returned
+// by JobProbe.ValidateAll() if the designated constraints aren't met.
+type JobProbeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JobProbeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JobProbeMultiError) AllErrors() []error { return m }
+
+// JobProbeValidationError is the validation error returned by
+// JobProbe.Validate if the designated constraints aren't met.
+type JobProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JobProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JobProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JobProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JobProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JobProbeValidationError) ErrorName() string { return "JobProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JobProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJobProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JobProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JobProbeValidationError{}
+
+// Validate checks the field values on TestHook with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestHook) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestHook with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestHookMultiError, or nil
+// if none found.
+func (m *TestHook) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestHook) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Name
+
+	// no validation rules for TimeoutSeconds
+
+	// no validation rules for BackoffLimit
+
+	switch v := m.Test.(type) {
+	case *TestHook_Job:
+		if v == nil {
+			err := TestHookValidationError{
+				field:  "Test",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetJob()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TestHookValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TestHookValidationError{
+						field:  "Job",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetJob()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TestHookValidationError{
+					field:  "Job",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *TestHook_Artifact:
+		if v == nil {
+			err := TestHookValidationError{
+				field:  "Test",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Artifact
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return TestHookMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestHookMultiError is an error wrapping multiple validation errors returned
+// by TestHook.ValidateAll() if the designated constraints aren't met.
+type TestHookMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestHookMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestHookMultiError) AllErrors() []error { return m }
+
+// TestHookValidationError is the validation error returned by
+// TestHook.Validate if the designated constraints aren't met.
+type TestHookValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestHookValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestHookValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestHookValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestHookValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestHookValidationError) ErrorName() string { return "TestHookValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestHookValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestHook.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestHookValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestHookValidationError{}
+
+// Validate checks the field values on PrometheusProbe with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PrometheusProbe) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PrometheusProbe with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PrometheusProbeMultiError, or nil if none found.
+func (m *PrometheusProbe) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PrometheusProbe) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Query
+
+	// no validation rules for Operator
+
+	// no validation rules for Threshold
+
+	if len(errors) > 0 {
+		return PrometheusProbeMultiError(errors)
+	}
+
+	return nil
+}
+
+// PrometheusProbeMultiError is an error wrapping multiple validation errors
+// returned by PrometheusProbe.ValidateAll() if the designated constraints
+// aren't met.
+type PrometheusProbeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PrometheusProbeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PrometheusProbeMultiError) AllErrors() []error { return m }
+
+// PrometheusProbeValidationError is the validation error returned by
+// PrometheusProbe.Validate if the designated constraints aren't met.
+type PrometheusProbeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PrometheusProbeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PrometheusProbeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PrometheusProbeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PrometheusProbeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PrometheusProbeValidationError) ErrorName() string { return "PrometheusProbeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PrometheusProbeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPrometheusProbe.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PrometheusProbeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PrometheusProbeValidationError{}
+
+// Validate checks the field values on BlueGreenService with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlueGreenService) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlueGreenService with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlueGreenServiceMultiError, or nil if none found.
+func (m *BlueGreenService) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlueGreenService) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Name
+
+	// no validation rules for Selector
+
+	for idx, item := range m.GetPorts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BlueGreenServiceValidationError{
+						field:  fmt.Sprintf("Ports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BlueGreenServiceValidationError{
+						field:  fmt.Sprintf("Ports[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BlueGreenServiceValidationError{
+					field:  fmt.Sprintf("Ports[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BlueGreenServiceMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlueGreenServiceMultiError is an error wrapping multiple validation errors
+// returned by BlueGreenService.ValidateAll() if the designated constraints
+// aren't met.
+type BlueGreenServiceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlueGreenServiceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlueGreenServiceMultiError) AllErrors() []error { return m }
+
+// BlueGreenServiceValidationError is the validation error returned by
+// BlueGreenService.Validate if the designated constraints aren't met.
+type BlueGreenServiceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlueGreenServiceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlueGreenServiceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlueGreenServiceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlueGreenServiceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlueGreenServiceValidationError) ErrorName() string { return "BlueGreenServiceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlueGreenServiceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlueGreenService.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlueGreenServiceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlueGreenServiceValidationError{}
+
+// Validate checks the field values on BlueGreenServicePort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BlueGreenServicePort) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlueGreenServicePort with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlueGreenServicePortMultiError, or nil if none found.
+func (m *BlueGreenServicePort) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlueGreenServicePort) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Port
+
+	// no validation rules for TargetPort
+
+	// no validation rules for Protocol
+
+	if len(errors) > 0 {
+		return BlueGreenServicePortMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlueGreenServicePortMultiError is an error wrapping multiple validation
+// errors returned by BlueGreenServicePort.ValidateAll() if the designated
+// constraints aren't met.
+type BlueGreenServicePortMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlueGreenServicePortMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlueGreenServicePortMultiError) AllErrors() []error { return m }
+
+// BlueGreenServicePortValidationError is the validation error returned by
+// BlueGreenServicePort.Validate if the designated constraints aren't met.
+type BlueGreenServicePortValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlueGreenServicePortValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlueGreenServicePortValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlueGreenServicePortValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlueGreenServicePortValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlueGreenServicePortValidationError) ErrorName() string {
+	return "BlueGreenServicePortValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BlueGreenServicePortValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlueGreenServicePort.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlueGreenServicePortValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlueGreenServicePortValidationError{}
+
+// Validate checks the field values on BlueGreenStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *BlueGreenStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BlueGreenStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BlueGreenStatusMultiError, or nil if none found.
+func (m *BlueGreenStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BlueGreenStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ActiveSlot
+
+	// no validation rules for PreviewSlot
+
+	// no validation rules for Phase
+
+	if len(errors) > 0 {
+		return BlueGreenStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// BlueGreenStatusMultiError is an error wrapping multiple validation errors
+// returned by BlueGreenStatus.ValidateAll() if the designated constraints
+// aren't met.
+type BlueGreenStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BlueGreenStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BlueGreenStatusMultiError) AllErrors() []error { return m }
+
+// BlueGreenStatusValidationError is the validation error returned by
+// BlueGreenStatus.Validate if the designated constraints aren't met.
+type BlueGreenStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BlueGreenStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BlueGreenStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BlueGreenStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BlueGreenStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BlueGreenStatusValidationError) ErrorName() string { return "BlueGreenStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BlueGreenStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBlueGreenStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BlueGreenStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BlueGreenStatusValidationError{}
+
+// Validate checks the field values on Canary with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Canary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Canary with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in CanaryMultiError, or nil if none found.
+func (m *Canary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Canary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ClusterCount
+
+	// no validation rules for Selector
+
+	// no validation rules for ObservationSeconds
+
+	if len(errors) > 0 {
+		return CanaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// CanaryMultiError is an error wrapping multiple validation errors returned by
+// Canary.ValidateAll() if the designated constraints aren't met.
+type CanaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CanaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CanaryMultiError) AllErrors() []error { return m }
+
+// CanaryValidationError is the validation error returned by Canary.Validate if
+// the designated constraints aren't met.
+type CanaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CanaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CanaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CanaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CanaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CanaryValidationError) ErrorName() string { return "CanaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CanaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCanary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CanaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CanaryValidationError{}
+
+// Validate checks the field values on CanaryStatus with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CanaryStatus) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CanaryStatus with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CanaryStatusMultiError, or
+// nil if none found.
+func (m *CanaryStatus) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CanaryStatus) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Phase
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return CanaryStatusMultiError(errors)
+	}
+
+	return nil
+}
+
+// CanaryStatusMultiError is an error wrapping multiple validation errors
+// returned by CanaryStatus.ValidateAll() if the designated constraints aren't met.
+type CanaryStatusMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CanaryStatusMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CanaryStatusMultiError) AllErrors() []error { return m }
+
+// CanaryStatusValidationError is the validation error returned by
+// CanaryStatus.Validate if the designated constraints aren't met.
+type CanaryStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CanaryStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CanaryStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CanaryStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CanaryStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CanaryStatusValidationError) ErrorName() string { return "CanaryStatusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CanaryStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCanaryStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CanaryStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CanaryStatusValidationError{}
+
+// Validate checks the field values on ResourceRequests with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ResourceRequests) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResourceRequests with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResourceRequestsMultiError, or nil if none found.
+func (m *ResourceRequests) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResourceRequests) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Cpu
+
+	// no validation rules for Memory
+
+	// no validation rules for Enforce
+
+	if len(errors) > 0 {
+		return ResourceRequestsMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResourceRequestsMultiError is an error wrapping multiple validation errors
+// returned by ResourceRequests.ValidateAll() if the designated constraints
+// aren't met.
+type ResourceRequestsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResourceRequestsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResourceRequestsMultiError) AllErrors() []error { return m }
+
+// ResourceRequestsValidationError is the validation error returned by
+// ResourceRequests.Validate if the designated constraints aren't met.
+type ResourceRequestsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResourceRequestsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResourceRequestsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResourceRequestsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResourceRequestsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResourceRequestsValidationError) ErrorName() string { return "ResourceRequestsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ResourceRequestsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResourceRequests.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResourceRequestsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResourceRequestsValidationError{}
+
+// Validate checks the field values on NamespacePolicy with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *NamespacePolicy) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on NamespacePolicy with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// NamespacePolicyMultiError, or nil if none found.
+func (m *NamespacePolicy) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *NamespacePolicy) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for ResourceQuota
+
+	// no validation rules for DefaultLimits
+
+	// no validation rules for DefaultRequests
+
+	// no validation rules for MaxLimits
+
+	// no validation rules for PodSecurityLevel
+
+	if len(errors) > 0 {
+		return NamespacePolicyMultiError(errors)
+	}
+
+	return nil
+}
+
+// NamespacePolicyMultiError is an error wrapping multiple validation errors
+// returned by NamespacePolicy.ValidateAll() if the designated constraints
+// aren't met.
+type NamespacePolicyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m NamespacePolicyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m NamespacePolicyMultiError) AllErrors() []error { return m }
+
+// NamespacePolicyValidationError is the validation error returned by
+// NamespacePolicy.Validate if the designated constraints aren't met.
+type NamespacePolicyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e NamespacePolicyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e NamespacePolicyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e NamespacePolicyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e NamespacePolicyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e NamespacePolicyValidationError) ErrorName() string { return "NamespacePolicyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e NamespacePolicyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sNamespacePolicy.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = NamespacePolicyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = NamespacePolicyValidationError{}
+
+// Validate checks the field values on KustomizePatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *KustomizePatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on KustomizePatch with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in KustomizePatchMultiError,
+// or nil if none found.
+func (m *KustomizePatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *KustomizePatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Type
+
+	// no validation rules for Patch
+
+	if all {
+		switch v := interface{}(m.GetTarget()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, KustomizePatchValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, KustomizePatchValidationError{
+					field:  "Target",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTarget()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return KustomizePatchValidationError{
+				field:  "Target",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return KustomizePatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// KustomizePatchMultiError is an error wrapping multiple validation errors
+// returned by KustomizePatch.ValidateAll() if the designated constraints
+// aren't met.
+type KustomizePatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m KustomizePatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m KustomizePatchMultiError) AllErrors() []error { return m }
+
+// KustomizePatchValidationError is the validation error returned by
+// KustomizePatch.Validate if the designated constraints aren't met.
+type KustomizePatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e KustomizePatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e KustomizePatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e KustomizePatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e KustomizePatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e KustomizePatchValidationError) ErrorName() string { return "KustomizePatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e KustomizePatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sKustomizePatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = KustomizePatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = KustomizePatchValidationError{}
+
+// Validate checks the field values on PatchTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PatchTarget) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PatchTarget with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PatchTargetMultiError, or
+// nil if none found.
+func (m *PatchTarget) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PatchTarget) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Group
+
+	// no validation rules for Version
+
+	// no validation rules for Kind
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	// no validation rules for LabelSelector
+
+	if len(errors) > 0 {
+		return PatchTargetMultiError(errors)
+	}
+
+	return nil
+}
+
+// PatchTargetMultiError is an error wrapping multiple validation errors
+// returned by PatchTarget.ValidateAll() if the designated constraints aren't met.
+type PatchTargetMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PatchTargetMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PatchTargetMultiError) AllErrors() []error { return m }
+
+// PatchTargetValidationError is the validation error returned by
+// PatchTarget.Validate if the designated constraints aren't met.
+type PatchTargetValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PatchTargetValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PatchTargetValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PatchTargetValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PatchTargetValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PatchTargetValidationError) ErrorName() string { return "PatchTargetValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PatchTargetValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPatchTarget.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PatchTargetValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PatchTargetValidationError{}
+
+// Validate checks the field values on ManifestApp with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ManifestApp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ManifestApp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ManifestAppMultiError, or
+// nil if none found.
+func (m *ManifestApp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ManifestApp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Namespace
+
+	switch v := m.Source.(type) {
+	case *ManifestApp_Artifact:
+		if v == nil {
+			err := ManifestAppValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		// no validation rules for Artifact
+	case *ManifestApp_Git:
+		if v == nil {
+			err := ManifestAppValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetGit()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ManifestAppValidationError{
+						field:  "Git",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ManifestAppValidationError{
+						field:  "Git",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetGit()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ManifestAppValidationError{
+					field:  "Git",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *ManifestApp_Oci:
+		if v == nil {
+			err := ManifestAppValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetOci()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ManifestAppValidationError{
+						field:  "Oci",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ManifestAppValidationError{
+						field:  "Oci",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetOci()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ManifestAppValidationError{
+					field:  "Oci",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return ManifestAppMultiError(errors)
+	}
+
+	return nil
+}
+
+// ManifestAppMultiError is an error wrapping multiple validation errors
+// returned by ManifestApp.ValidateAll() if the designated constraints aren't met.
+type ManifestAppMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ManifestAppMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ManifestAppMultiError) AllErrors() []error { return m }
+
+// ManifestAppValidationError is the validation error returned by
+// ManifestApp.Validate if the designated constraints aren't met.
+type ManifestAppValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ManifestAppValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ManifestAppValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ManifestAppValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ManifestAppValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ManifestAppValidationError) ErrorName() string { return "ManifestAppValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ManifestAppValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sManifestApp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ManifestAppValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ManifestAppValidationError{}
+
+// Validate checks the field values on GitManifestSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GitManifestSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GitManifestSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GitManifestSourceMultiError, or nil if none found.
+func (m *GitManifestSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GitManifestSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Path
+
+	// no validation rules for Revision
+
+	if len(errors) > 0 {
+		return GitManifestSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// GitManifestSourceMultiError is an error wrapping multiple validation errors
+// returned by GitManifestSource.ValidateAll() if the designated constraints
+// aren't met.
+type GitManifestSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GitManifestSourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GitManifestSourceMultiError) AllErrors() []error { return m }
+
+// GitManifestSourceValidationError is the validation error returned by
+// GitManifestSource.Validate if the designated constraints aren't met.
+type GitManifestSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GitManifestSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GitManifestSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GitManifestSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GitManifestSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GitManifestSourceValidationError) ErrorName() string {
+	return "GitManifestSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GitManifestSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGitManifestSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GitManifestSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GitManifestSourceValidationError{}
+
+// Validate checks the field values on OCIManifestSource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OCIManifestSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OCIManifestSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OCIManifestSourceMultiError, or nil if none found.
+func (m *OCIManifestSource) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OCIManifestSource) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Url
+
+	// no validation rules for Path
+
+	if len(errors) > 0 {
+		return OCIManifestSourceMultiError(errors)
+	}
+
+	return nil
+}
+
+// OCIManifestSourceMultiError is an error wrapping multiple validation errors
+// returned by OCIManifestSource.ValidateAll() if the designated constraints
+// aren't met.
+type OCIManifestSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OCIManifestSourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OCIManifestSourceMultiError) AllErrors() []error { return m }
+
+// OCIManifestSourceValidationError is the validation error returned by
+// OCIManifestSource.Validate if the designated constraints aren't met.
+type OCIManifestSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OCIManifestSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OCIManifestSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OCIManifestSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OCIManifestSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OCIManifestSourceValidationError) ErrorName() string {
+	return "OCIManifestSourceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OCIManifestSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOCIManifestSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OCIManifestSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OCIManifestSourceValidationError{}
+
+// Validate checks the field values on ServiceExport with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ServiceExport) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ServiceExport with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ServiceExportMultiError, or
+// nil if none found.
+func (m *ServiceExport) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ServiceExport) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return ServiceExportMultiError(errors)
+	}
+
+	return nil
+}
+
+// ServiceExportMultiError is an error wrapping multiple validation errors
+// returned by ServiceExport.ValidateAll() if the designated constraints
+// aren't met.
+type ServiceExportMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ServiceExportMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ServiceExportMultiError) AllErrors() []error { return m }
+
+// ServiceExportValidationError is the validation error returned by
+// ServiceExport.Validate if the designated constraints aren't met.
+type ServiceExportValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ServiceExportValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ServiceExportValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ServiceExportValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ServiceExportValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ServiceExportValidationError) ErrorName() string { return "ServiceExportValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ServiceExportValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sServiceExport.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ServiceExportValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ServiceExportValidationError{}
+
+// Validate checks the field values on OverrideValues with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OverrideValues) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OverrideValues with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OverrideValuesMultiError,
+// or nil if none found.
+func (m *OverrideValues) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OverrideValues) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for TargetNamespace
+
+	if all {
+		switch v := interface{}(m.GetValues()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OverrideValuesValidationError{
+					field:  "Values",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OverrideValuesValidationError{
+					field:  "Values",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValues()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OverrideValuesValidationError{
+				field:  "Values",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetSecretRefs() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, OverrideValuesValidationError{
+						field:  fmt.Sprintf("SecretRefs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, OverrideValuesValidationError{
+						field:  fmt.Sprintf("SecretRefs[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return OverrideValuesValidationError{
+					field:  fmt.Sprintf("SecretRefs[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return OverrideValuesMultiError(errors)
+	}
+
+	return nil
+}
+
+// OverrideValuesMultiError is an error wrapping multiple validation errors
+// returned by OverrideValues.ValidateAll() if the designated constraints
+// aren't met.
+type OverrideValuesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OverrideValuesMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OverrideValuesMultiError) AllErrors() []error { return m }
+
+// OverrideValuesValidationError is the validation error returned by
+// OverrideValues.Validate if the designated constraints aren't met.
+type OverrideValuesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OverrideValuesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OverrideValuesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OverrideValuesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OverrideValuesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OverrideValuesValidationError) ErrorName() string { return "OverrideValuesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e OverrideValuesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOverrideValues.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OverrideValuesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OverrideValuesValidationError{}
+
+// Validate checks the field values on SecretRef with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SecretRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SecretRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SecretRefMultiError, or nil
+// if none found.
+func (m *SecretRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SecretRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Key
+
+	switch v := m.Source.(type) {
+	case *SecretRef_Vault:
+		if v == nil {
+			err := SecretRefValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetVault()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretRefValidationError{
+						field:  "Vault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretRefValidationError{
+						field:  "Vault",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetVault()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretRefValidationError{
+					field:  "Vault",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	case *SecretRef_External:
+		if v == nil {
+			err := SecretRefValidationError{
+				field:  "Source",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+		if all {
+			switch v := interface{}(m.GetExternal()).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SecretRefValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SecretRefValidationError{
+						field:  "External",
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(m.GetExternal()).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SecretRefValidationError{
+					field:  "External",
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+
+	if len(errors) > 0 {
+		return SecretRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// SecretRefMultiError is an error wrapping multiple validation errors returned
+// by SecretRef.ValidateAll() if the designated constraints aren't met.
+type SecretRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SecretRefMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SecretRefMultiError) AllErrors() []error { return m }
+
+// SecretRefValidationError is the validation error returned by
+// SecretRef.Validate if the designated constraints aren't met.
+type SecretRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SecretRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SecretRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SecretRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SecretRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SecretRefValidationError) ErrorName() string { return "SecretRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SecretRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSecretRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SecretRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SecretRefValidationError{}
+
+// Validate checks the field values on VaultSecretRef with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *VaultSecretRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VaultSecretRef with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in VaultSecretRefMultiError,
+// or nil if none found.
+func (m *VaultSecretRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VaultSecretRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Path
+
+	// no validation rules for Key
+
+	if len(errors) > 0 {
+		return VaultSecretRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// VaultSecretRefMultiError is an error wrapping multiple validation errors
+// returned by VaultSecretRef.ValidateAll() if the designated constraints
+// aren't met.
+type VaultSecretRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VaultSecretRefMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VaultSecretRefMultiError) AllErrors() []error { return m }
+
+// VaultSecretRefValidationError is the validation error returned by
+// VaultSecretRef.Validate if the designated constraints aren't met.
+type VaultSecretRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VaultSecretRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VaultSecretRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VaultSecretRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VaultSecretRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VaultSecretRefValidationError) ErrorName() string { return "VaultSecretRefValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VaultSecretRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVaultSecretRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VaultSecretRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VaultSecretRefValidationError{}
+
+// Validate checks the field values on ExternalSecretRef with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExternalSecretRef) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExternalSecretRef with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExternalSecretRefMultiError, or nil if none found.
+func (m *ExternalSecretRef) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExternalSecretRef) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Store
+
+	// no validation rules for StoreKind
+
+	// no validation rules for Key
+
+	// no validation rules for Property
+
+	if len(errors) > 0 {
+		return ExternalSecretRefMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExternalSecretRefMultiError is an error wrapping multiple validation errors
+// returned by ExternalSecretRef.ValidateAll() if the designated constraints
+// aren't met.
+type ExternalSecretRefMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExternalSecretRefMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExternalSecretRefMultiError) AllErrors() []error { return m }
+
+// ExternalSecretRefValidationError is the validation error returned by
+// ExternalSecretRef.Validate if the designated constraints aren't met.
+type ExternalSecretRefValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExternalSecretRefValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExternalSecretRefValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExternalSecretRefValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExternalSecretRefValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExternalSecretRefValidationError) ErrorName() string {
+	return "ExternalSecretRefValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExternalSecretRefValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExternalSecretRef.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExternalSecretRefValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExternalSecretRefValidationError{}
+
+// Validate checks the field values on TargetClusters with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TargetClusters) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TargetClusters with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TargetClustersMultiError,
+// or nil if none found.
+func (m *TargetClusters) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TargetClusters) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AppName
+
+	// no validation rules for Labels
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ClusterGroup
+
+	if len(errors) > 0 {
+		return TargetClustersMultiError(errors)
+	}
+
+	return nil
+}
+
+// TargetClustersMultiError is an error wrapping multiple validation errors
+// returned by TargetClusters.ValidateAll() if the designated constraints
+// aren't met.
+type TargetClustersMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TargetClustersMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TargetClustersMultiError) AllErrors() []error { return m }
+
+// TargetClustersValidationError is the validation error returned by
+// TargetClusters.Validate if the designated constraints aren't met.
+type TargetClustersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TargetClustersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TargetClustersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TargetClustersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TargetClustersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TargetClustersValidationError) ErrorName() string { return "TargetClustersValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TargetClustersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTargetClusters.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TargetClustersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TargetClustersValidationError{}
+
+// Validate checks the field values on Summary with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Summary) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Summary with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in SummaryMultiError, or nil if none found.
+func (m *Summary) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Summary) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Running
+
+	// no validation rules for Down
+
+	// no validation rules for Type
+
+	// no validation rules for Unknown
+
+	if len(errors) > 0 {
+		return SummaryMultiError(errors)
+	}
+
+	return nil
+}
+
+// SummaryMultiError is an error wrapping multiple validation errors returned
+// by Summary.ValidateAll() if the designated constraints aren't met.
+type SummaryMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SummaryMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SummaryMultiError) AllErrors() []error { return m }
+
+// SummaryValidationError is the validation error returned by Summary.Validate
+// if the designated constraints aren't met.
+type SummaryValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SummaryValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SummaryValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SummaryValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SummaryValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SummaryValidationError) ErrorName() string { return "SummaryValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SummaryValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSummary.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SummaryValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SummaryValidationError{}
+
+// Validate checks the field values on App with the rules defined in the proto
+// definition for this message. If any rules are violated, the first error
+// encountered is returned, or nil if there are no violations.
+func (m *App) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on App with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in AppMultiError, or nil if none found.
+func (m *App) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *App) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTests()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Tests",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AppValidationError{
+					field:  "Tests",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTests()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AppValidationError{
+				field:  "Tests",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AppMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppMultiError is an error wrapping multiple validation errors returned by
+// App.ValidateAll() if the designated constraints aren't met.
+type AppMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppMultiError) AllErrors() []error { return m }
+
+// AppValidationError is the validation error returned by App.Validate if the
+// designated constraints aren't met.
+type AppValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppValidationError) ErrorName() string { return "AppValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppValidationError{}
+
+// Validate checks the field values on AppHealth with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppHealth) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppHealth with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppHealthMultiError, or nil
+// if none found.
+func (m *AppHealth) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppHealth) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	for idx, item := range m.GetProbes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppHealthValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppHealthValidationError{
+						field:  fmt.Sprintf("Probes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppHealthValidationError{
+					field:  fmt.Sprintf("Probes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AppHealthMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppHealthMultiError is an error wrapping multiple validation errors returned
+// by AppHealth.ValidateAll() if the designated constraints aren't met.
+type AppHealthMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppHealthMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppHealthMultiError) AllErrors() []error { return m }
+
+// AppHealthValidationError is the validation error returned by
+// AppHealth.Validate if the designated constraints aren't met.
+type AppHealthValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppHealthValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppHealthValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppHealthValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppHealthValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppHealthValidationError) ErrorName() string { return "AppHealthValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppHealthValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppHealth.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppHealthValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppHealthValidationError{}
+
+// Validate checks the field values on ProbeResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ProbeResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ProbeResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ProbeResultMultiError, or
+// nil if none found.
+func (m *ProbeResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ProbeResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for State
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return ProbeResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// ProbeResultMultiError is an error wrapping multiple validation errors
+// returned by ProbeResult.ValidateAll() if the designated constraints aren't met.
+type ProbeResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ProbeResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ProbeResultMultiError) AllErrors() []error { return m }
+
+// ProbeResultValidationError is the validation error returned by
+// ProbeResult.Validate if the designated constraints aren't met.
+type ProbeResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ProbeResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ProbeResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ProbeResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ProbeResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ProbeResultValidationError) ErrorName() string { return "ProbeResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ProbeResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sProbeResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ProbeResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ProbeResultValidationError{}
+
+// Validate checks the field values on AppTests with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppTests) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AppTests with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppTestsMultiError, or nil
+// if none found.
+func (m *AppTests) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AppTests) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for State
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppTestsValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppTestsValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AppTestsValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return AppTestsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AppTestsMultiError is an error wrapping multiple validation errors returned
+// by AppTests.ValidateAll() if the designated constraints aren't met.
+type AppTestsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AppTestsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AppTestsMultiError) AllErrors() []error { return m }
+
+// AppTestsValidationError is the validation error returned by
+// AppTests.Validate if the designated constraints aren't met.
+type AppTestsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AppTestsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AppTestsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AppTestsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AppTestsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AppTestsValidationError) ErrorName() string { return "AppTestsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AppTestsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAppTests.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AppTestsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AppTestsValidationError{}
+
+// Validate checks the field values on TestResult with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TestResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TestResult with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TestResultMultiError, or
+// nil if none found.
+func (m *TestResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TestResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for State
+
+	// no validation rules for Message
+
+	// no validation rules for Logs
+
+	if len(errors) > 0 {
+		return TestResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// TestResultMultiError is an error wrapping multiple validation errors
+// returned by TestResult.ValidateAll() if the designated constraints aren't met.
+type TestResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TestResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TestResultMultiError) AllErrors() []error { return m }
+
+// TestResultValidationError is the validation error returned by
+// TestResult.Validate if the designated constraints aren't met.
+type TestResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TestResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TestResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TestResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TestResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TestResultValidationError) ErrorName() string { return "TestResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TestResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTestResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TestResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TestResultValidationError{}
+
+// Validate checks the field values on DeploymentInstancesCluster with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeploymentInstancesCluster) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeploymentInstancesCluster with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeploymentInstancesClusterMultiError, or nil if none found.
+func (m *DeploymentInstancesCluster) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeploymentInstancesCluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeploymentUid
+
+	// no validation rules for DeploymentName
+
+	// no validation rules for DeploymentDisplayName
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeploymentInstancesClusterValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeploymentInstancesClusterValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeploymentInstancesClusterValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetApps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DeploymentInstancesClusterValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DeploymentInstancesClusterValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DeploymentInstancesClusterValidationError{
+					field:  fmt.Sprintf("Apps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return DeploymentMultiError(errors)
+		return DeploymentInstancesClusterMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentMultiError is an error wrapping multiple validation errors
-// returned by Deployment.ValidateAll() if the designated constraints aren't met.
-type DeploymentMultiError []error
+// DeploymentInstancesClusterMultiError is an error wrapping multiple
+// validation errors returned by DeploymentInstancesCluster.ValidateAll() if
+// the designated constraints aren't met.
+type DeploymentInstancesClusterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentMultiError) Error() string {
+func (m DeploymentInstancesClusterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -317,11 +4533,11 @@ func (m DeploymentMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentMultiError) AllErrors() []error { return m }
+func (m DeploymentInstancesClusterMultiError) AllErrors() []error { return m }
 
-// DeploymentValidationError is the validation error returned by
-// Deployment.Validate if the designated constraints aren't met.
-type DeploymentValidationError struct {
+// DeploymentInstancesClusterValidationError is the validation error returned
+// by DeploymentInstancesCluster.Validate if the designated constraints aren't met.
+type DeploymentInstancesClusterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -329,22 +4545,24 @@ type DeploymentValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentValidationError) Field() string { return e.field }
+func (e DeploymentInstancesClusterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentValidationError) Reason() string { return e.reason }
+func (e DeploymentInstancesClusterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentValidationError) Cause() error { return e.cause }
+func (e DeploymentInstancesClusterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentValidationError) Key() bool { return e.key }
+func (e DeploymentInstancesClusterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentValidationError) ErrorName() string { return "DeploymentValidationError" }
+func (e DeploymentInstancesClusterValidationError) ErrorName() string {
+	return "DeploymentInstancesClusterValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e DeploymentValidationError) Error() string {
+func (e DeploymentInstancesClusterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -356,14 +4574,14 @@ func (e DeploymentValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeployment.%s: %s%s",
+		"invalid %sDeploymentInstancesCluster.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentValidationError{}
+var _ error = DeploymentInstancesClusterValidationError{}
 
 var _ interface {
 	Field() string
@@ -371,48 +4589,138 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentValidationError{}
+} = DeploymentInstancesClusterValidationError{}
 
-// Validate checks the field values on ServiceExport with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on Cluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *ServiceExport) Validate() error {
+func (m *Cluster) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ServiceExport with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in ServiceExportMultiError, or
-// nil if none found.
-func (m *ServiceExport) ValidateAll() error {
+// ValidateAll checks the field values on Cluster with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in ClusterMultiError, or nil if none found.
+func (m *Cluster) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ServiceExport) validate(all bool) error {
-	if m == nil {
-		return nil
+func (m *Cluster) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Name
+
+	// no validation rules for Id
+
+	if all {
+		switch v := interface{}(m.GetStatus()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Status",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterValidationError{
+				field:  "Status",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetApps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ClusterValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ClusterValidationError{
+						field:  fmt.Sprintf("Apps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ClusterValidationError{
+					field:  fmt.Sprintf("Apps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
 	}
 
-	var errors []error
-
-	// no validation rules for AppName
-
-	// no validation rules for Enabled
+	if all {
+		switch v := interface{}(m.GetHealth()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterValidationError{
+					field:  "Health",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetHealth()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterValidationError{
+				field:  "Health",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return ServiceExportMultiError(errors)
+		return ClusterMultiError(errors)
 	}
 
 	return nil
 }
 
-// ServiceExportMultiError is an error wrapping multiple validation errors
-// returned by ServiceExport.ValidateAll() if the designated constraints
-// aren't met.
-type ServiceExportMultiError []error
+// ClusterMultiError is an error wrapping multiple validation errors returned
+// by Cluster.ValidateAll() if the designated constraints aren't met.
+type ClusterMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ServiceExportMultiError) Error() string {
+func (m ClusterMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -421,11 +4729,11 @@ func (m ServiceExportMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ServiceExportMultiError) AllErrors() []error { return m }
+func (m ClusterMultiError) AllErrors() []error { return m }
 
-// ServiceExportValidationError is the validation error returned by
-// ServiceExport.Validate if the designated constraints aren't met.
-type ServiceExportValidationError struct {
+// ClusterValidationError is the validation error returned by Cluster.Validate
+// if the designated constraints aren't met.
+type ClusterValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -433,22 +4741,22 @@ type ServiceExportValidationError struct {
 }
 
 // Field function returns field value.
-func (e ServiceExportValidationError) Field() string { return e.field }
+func (e ClusterValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ServiceExportValidationError) Reason() string { return e.reason }
+func (e ClusterValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ServiceExportValidationError) Cause() error { return e.cause }
+func (e ClusterValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ServiceExportValidationError) Key() bool { return e.key }
+func (e ClusterValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ServiceExportValidationError) ErrorName() string { return "ServiceExportValidationError" }
+func (e ClusterValidationError) ErrorName() string { return "ClusterValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ServiceExportValidationError) Error() string {
+func (e ClusterValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -460,14 +4768,14 @@ func (e ServiceExportValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sServiceExport.%s: %s%s",
+		"invalid %sCluster.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ServiceExportValidationError{}
+var _ error = ClusterValidationError{}
 
 var _ interface {
 	Field() string
@@ -475,77 +4783,85 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ServiceExportValidationError{}
+} = ClusterValidationError{}
 
-// Validate checks the field values on OverrideValues with the rules defined in
+// Validate checks the field values on ClusterHealth with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *OverrideValues) Validate() error {
+func (m *ClusterHealth) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OverrideValues with the rules defined
+// ValidateAll checks the field values on ClusterHealth with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in OverrideValuesMultiError,
-// or nil if none found.
-func (m *OverrideValues) ValidateAll() error {
+// result is a list of violation errors wrapped in ClusterHealthMultiError, or
+// nil if none found.
+func (m *ClusterHealth) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OverrideValues) validate(all bool) error {
+func (m *ClusterHealth) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppName
+	// no validation rules for State
 
-	// no validation rules for TargetNamespace
+	// no validation rules for ReadyNodes
+
+	// no validation rules for TotalNodes
+
+	// no validation rules for KubernetesVersion
+
+	// no validation rules for AgentVersion
 
 	if all {
-		switch v := interface{}(m.GetValues()).(type) {
+		switch v := interface{}(m.GetLastSeen()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, OverrideValuesValidationError{
-					field:  "Values",
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastSeen",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, OverrideValuesValidationError{
-					field:  "Values",
+				errors = append(errors, ClusterHealthValidationError{
+					field:  "LastSeen",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValues()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetLastSeen()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return OverrideValuesValidationError{
-				field:  "Values",
+			return ClusterHealthValidationError{
+				field:  "LastSeen",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
 		}
 	}
 
+	// no validation rules for LastSeenAgeSeconds
+
 	if len(errors) > 0 {
-		return OverrideValuesMultiError(errors)
+		return ClusterHealthMultiError(errors)
 	}
 
 	return nil
 }
 
-// OverrideValuesMultiError is an error wrapping multiple validation errors
-// returned by OverrideValues.ValidateAll() if the designated constraints
+// ClusterHealthMultiError is an error wrapping multiple validation errors
+// returned by ClusterHealth.ValidateAll() if the designated constraints
 // aren't met.
-type OverrideValuesMultiError []error
+type ClusterHealthMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OverrideValuesMultiError) Error() string {
+func (m ClusterHealthMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -554,11 +4870,11 @@ func (m OverrideValuesMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OverrideValuesMultiError) AllErrors() []error { return m }
+func (m ClusterHealthMultiError) AllErrors() []error { return m }
 
-// OverrideValuesValidationError is the validation error returned by
-// OverrideValues.Validate if the designated constraints aren't met.
-type OverrideValuesValidationError struct {
+// ClusterHealthValidationError is the validation error returned by
+// ClusterHealth.Validate if the designated constraints aren't met.
+type ClusterHealthValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -566,22 +4882,22 @@ type OverrideValuesValidationError struct {
 }
 
 // Field function returns field value.
-func (e OverrideValuesValidationError) Field() string { return e.field }
+func (e ClusterHealthValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OverrideValuesValidationError) Reason() string { return e.reason }
+func (e ClusterHealthValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OverrideValuesValidationError) Cause() error { return e.cause }
+func (e ClusterHealthValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OverrideValuesValidationError) Key() bool { return e.key }
+func (e ClusterHealthValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OverrideValuesValidationError) ErrorName() string { return "OverrideValuesValidationError" }
+func (e ClusterHealthValidationError) ErrorName() string { return "ClusterHealthValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OverrideValuesValidationError) Error() string {
+func (e ClusterHealthValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -593,14 +4909,14 @@ func (e OverrideValuesValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOverrideValues.%s: %s%s",
+		"invalid %sClusterHealth.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OverrideValuesValidationError{}
+var _ error = ClusterHealthValidationError{}
 
 var _ interface {
 	Field() string
@@ -608,50 +4924,82 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OverrideValuesValidationError{}
+} = ClusterHealthValidationError{}
 
-// Validate checks the field values on TargetClusters with the rules defined in
+// Validate checks the field values on ClusterGroup with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *TargetClusters) Validate() error {
+func (m *ClusterGroup) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on TargetClusters with the rules defined
+// ValidateAll checks the field values on ClusterGroup with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in TargetClustersMultiError,
-// or nil if none found.
-func (m *TargetClusters) ValidateAll() error {
+// result is a list of violation errors wrapped in ClusterGroupMultiError, or
+// nil if none found.
+func (m *ClusterGroup) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *TargetClusters) validate(all bool) error {
+func (m *ClusterGroup) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for AppName
+	// no validation rules for Name
 
-	// no validation rules for Labels
+	// no validation rules for DisplayName
 
-	// no validation rules for ClusterId
+	// no validation rules for Description
+
+	// no validation rules for Selector
+
+	// no validation rules for Message
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ClusterGroupValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ClusterGroupValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ClusterGroupValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return TargetClustersMultiError(errors)
+		return ClusterGroupMultiError(errors)
 	}
 
 	return nil
 }
 
-// TargetClustersMultiError is an error wrapping multiple validation errors
-// returned by TargetClusters.ValidateAll() if the designated constraints
-// aren't met.
-type TargetClustersMultiError []error
+// ClusterGroupMultiError is an error wrapping multiple validation errors
+// returned by ClusterGroup.ValidateAll() if the designated constraints aren't met.
+type ClusterGroupMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m TargetClustersMultiError) Error() string {
+func (m ClusterGroupMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -660,11 +5008,11 @@ func (m TargetClustersMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m TargetClustersMultiError) AllErrors() []error { return m }
+func (m ClusterGroupMultiError) AllErrors() []error { return m }
 
-// TargetClustersValidationError is the validation error returned by
-// TargetClusters.Validate if the designated constraints aren't met.
-type TargetClustersValidationError struct {
+// ClusterGroupValidationError is the validation error returned by
+// ClusterGroup.Validate if the designated constraints aren't met.
+type ClusterGroupValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -672,22 +5020,22 @@ type TargetClustersValidationError struct {
 }
 
 // Field function returns field value.
-func (e TargetClustersValidationError) Field() string { return e.field }
+func (e ClusterGroupValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e TargetClustersValidationError) Reason() string { return e.reason }
+func (e ClusterGroupValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e TargetClustersValidationError) Cause() error { return e.cause }
+func (e ClusterGroupValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e TargetClustersValidationError) Key() bool { return e.key }
+func (e ClusterGroupValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e TargetClustersValidationError) ErrorName() string { return "TargetClustersValidationError" }
+func (e ClusterGroupValidationError) ErrorName() string { return "ClusterGroupValidationError" }
 
 // Error satisfies the builtin error interface
-func (e TargetClustersValidationError) Error() string {
+func (e ClusterGroupValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -699,14 +5047,14 @@ func (e TargetClustersValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sTargetClusters.%s: %s%s",
+		"invalid %sClusterGroup.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = TargetClustersValidationError{}
+var _ error = ClusterGroupValidationError{}
 
 var _ interface {
 	Field() string
@@ -714,52 +5062,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = TargetClustersValidationError{}
+} = ClusterGroupValidationError{}
 
-// Validate checks the field values on Summary with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Summary) Validate() error {
+// Validate checks the field values on DriftedResource with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DriftedResource) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Summary with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in SummaryMultiError, or nil if none found.
-func (m *Summary) ValidateAll() error {
+// ValidateAll checks the field values on DriftedResource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DriftedResourceMultiError, or nil if none found.
+func (m *DriftedResource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Summary) validate(all bool) error {
+func (m *DriftedResource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Total
+	// no validation rules for Kind
 
-	// no validation rules for Running
+	// no validation rules for ApiVersion
 
-	// no validation rules for Down
+	// no validation rules for Namespace
+
+	// no validation rules for Name
 
 	// no validation rules for Type
 
-	// no validation rules for Unknown
+	// no validation rules for Patch
 
 	if len(errors) > 0 {
-		return SummaryMultiError(errors)
+		return DriftedResourceMultiError(errors)
 	}
 
 	return nil
 }
 
-// SummaryMultiError is an error wrapping multiple validation errors returned
-// by Summary.ValidateAll() if the designated constraints aren't met.
-type SummaryMultiError []error
+// DriftedResourceMultiError is an error wrapping multiple validation errors
+// returned by DriftedResource.ValidateAll() if the designated constraints
+// aren't met.
+type DriftedResourceMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m SummaryMultiError) Error() string {
+func (m DriftedResourceMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -768,11 +5120,11 @@ func (m SummaryMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m SummaryMultiError) AllErrors() []error { return m }
+func (m DriftedResourceMultiError) AllErrors() []error { return m }
 
-// SummaryValidationError is the validation error returned by Summary.Validate
-// if the designated constraints aren't met.
-type SummaryValidationError struct {
+// DriftedResourceValidationError is the validation error returned by
+// DriftedResource.Validate if the designated constraints aren't met.
+type DriftedResourceValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -780,22 +5132,22 @@ type SummaryValidationError struct {
 }
 
 // Field function returns field value.
-func (e SummaryValidationError) Field() string { return e.field }
+func (e DriftedResourceValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SummaryValidationError) Reason() string { return e.reason }
+func (e DriftedResourceValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SummaryValidationError) Cause() error { return e.cause }
+func (e DriftedResourceValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SummaryValidationError) Key() bool { return e.key }
+func (e DriftedResourceValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SummaryValidationError) ErrorName() string { return "SummaryValidationError" }
+func (e DriftedResourceValidationError) ErrorName() string { return "DriftedResourceValidationError" }
 
 // Error satisfies the builtin error interface
-func (e SummaryValidationError) Error() string {
+func (e DriftedResourceValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -807,14 +5159,14 @@ func (e SummaryValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSummary.%s: %s%s",
+		"invalid %sDriftedResource.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SummaryValidationError{}
+var _ error = DriftedResourceValidationError{}
 
 var _ interface {
 	Field() string
@@ -822,23 +5174,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SummaryValidationError{}
+} = DriftedResourceValidationError{}
 
-// Validate checks the field values on App with the rules defined in the proto
-// definition for this message. If any rules are violated, the first error
-// encountered is returned, or nil if there are no violations.
-func (m *App) Validate() error {
+// Validate checks the field values on AppDrift with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AppDrift) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on App with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in AppMultiError, or nil if none found.
-func (m *App) ValidateAll() error {
+// ValidateAll checks the field values on AppDrift with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AppDriftMultiError, or nil
+// if none found.
+func (m *AppDrift) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *App) validate(all bool) error {
+func (m *AppDrift) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -849,48 +5202,59 @@ func (m *App) validate(all bool) error {
 
 	// no validation rules for Id
 
-	if all {
-		switch v := interface{}(m.GetStatus()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AppValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for Modified
+
+	// no validation rules for Missing
+
+	// no validation rules for Orphaned
+
+	for idx, item := range m.GetResources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AppDriftValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AppDriftValidationError{
+						field:  fmt.Sprintf("Resources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AppValidationError{
-					field:  "Status",
+				return AppDriftValidationError{
+					field:  fmt.Sprintf("Resources[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AppValidationError{
-				field:  "Status",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return AppMultiError(errors)
+		return AppDriftMultiError(errors)
 	}
 
 	return nil
 }
 
-// AppMultiError is an error wrapping multiple validation errors returned by
-// App.ValidateAll() if the designated constraints aren't met.
-type AppMultiError []error
+// AppDriftMultiError is an error wrapping multiple validation errors returned
+// by AppDrift.ValidateAll() if the designated constraints aren't met.
+type AppDriftMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AppMultiError) Error() string {
+func (m AppDriftMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -899,11 +5263,11 @@ func (m AppMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AppMultiError) AllErrors() []error { return m }
+func (m AppDriftMultiError) AllErrors() []error { return m }
 
-// AppValidationError is the validation error returned by App.Validate if the
-// designated constraints aren't met.
-type AppValidationError struct {
+// AppDriftValidationError is the validation error returned by
+// AppDrift.Validate if the designated constraints aren't met.
+type AppDriftValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -911,22 +5275,22 @@ type AppValidationError struct {
 }
 
 // Field function returns field value.
-func (e AppValidationError) Field() string { return e.field }
+func (e AppDriftValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AppValidationError) Reason() string { return e.reason }
+func (e AppDriftValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AppValidationError) Cause() error { return e.cause }
+func (e AppDriftValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AppValidationError) Key() bool { return e.key }
+func (e AppDriftValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AppValidationError) ErrorName() string { return "AppValidationError" }
+func (e AppDriftValidationError) ErrorName() string { return "AppDriftValidationError" }
 
 // Error satisfies the builtin error interface
-func (e AppValidationError) Error() string {
+func (e AppDriftValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -938,14 +5302,14 @@ func (e AppValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sApp.%s: %s%s",
+		"invalid %sAppDrift.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AppValidationError{}
+var _ error = AppDriftValidationError{}
 
 var _ interface {
 	Field() string
@@ -953,64 +5317,35 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AppValidationError{}
+} = AppDriftValidationError{}
 
-// Validate checks the field values on DeploymentInstancesCluster with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *DeploymentInstancesCluster) Validate() error {
+// Validate checks the field values on ClusterDrift with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ClusterDrift) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on DeploymentInstancesCluster with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// DeploymentInstancesClusterMultiError, or nil if none found.
-func (m *DeploymentInstancesCluster) ValidateAll() error {
+// ValidateAll checks the field values on ClusterDrift with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ClusterDriftMultiError, or
+// nil if none found.
+func (m *ClusterDrift) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *DeploymentInstancesCluster) validate(all bool) error {
+func (m *ClusterDrift) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for DeploymentUid
-
-	// no validation rules for DeploymentName
+	// no validation rules for Name
 
-	// no validation rules for DeploymentDisplayName
+	// no validation rules for Id
 
-	if all {
-		switch v := interface{}(m.GetStatus()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, DeploymentInstancesClusterValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, DeploymentInstancesClusterValidationError{
-					field:  "Status",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return DeploymentInstancesClusterValidationError{
-				field:  "Status",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Drifted
 
 	for idx, item := range m.GetApps() {
 		_, _ = idx, item
@@ -1019,7 +5354,7 @@ func (m *DeploymentInstancesCluster) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, DeploymentInstancesClusterValidationError{
+					errors = append(errors, ClusterDriftValidationError{
 						field:  fmt.Sprintf("Apps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1027,7 +5362,7 @@ func (m *DeploymentInstancesCluster) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, DeploymentInstancesClusterValidationError{
+					errors = append(errors, ClusterDriftValidationError{
 						field:  fmt.Sprintf("Apps[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1036,7 +5371,7 @@ func (m *DeploymentInstancesCluster) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return DeploymentInstancesClusterValidationError{
+				return ClusterDriftValidationError{
 					field:  fmt.Sprintf("Apps[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1047,19 +5382,18 @@ func (m *DeploymentInstancesCluster) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return DeploymentInstancesClusterMultiError(errors)
+		return ClusterDriftMultiError(errors)
 	}
 
 	return nil
 }
 
-// DeploymentInstancesClusterMultiError is an error wrapping multiple
-// validation errors returned by DeploymentInstancesCluster.ValidateAll() if
-// the designated constraints aren't met.
-type DeploymentInstancesClusterMultiError []error
+// ClusterDriftMultiError is an error wrapping multiple validation errors
+// returned by ClusterDrift.ValidateAll() if the designated constraints aren't met.
+type ClusterDriftMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m DeploymentInstancesClusterMultiError) Error() string {
+func (m ClusterDriftMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1068,11 +5402,11 @@ func (m DeploymentInstancesClusterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m DeploymentInstancesClusterMultiError) AllErrors() []error { return m }
+func (m ClusterDriftMultiError) AllErrors() []error { return m }
 
-// DeploymentInstancesClusterValidationError is the validation error returned
-// by DeploymentInstancesCluster.Validate if the designated constraints aren't met.
-type DeploymentInstancesClusterValidationError struct {
+// ClusterDriftValidationError is the validation error returned by
+// ClusterDrift.Validate if the designated constraints aren't met.
+type ClusterDriftValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1080,24 +5414,22 @@ type DeploymentInstancesClusterValidationError struct {
 }
 
 // Field function returns field value.
-func (e DeploymentInstancesClusterValidationError) Field() string { return e.field }
+func (e ClusterDriftValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e DeploymentInstancesClusterValidationError) Reason() string { return e.reason }
+func (e ClusterDriftValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e DeploymentInstancesClusterValidationError) Cause() error { return e.cause }
+func (e ClusterDriftValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e DeploymentInstancesClusterValidationError) Key() bool { return e.key }
+func (e ClusterDriftValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e DeploymentInstancesClusterValidationError) ErrorName() string {
-	return "DeploymentInstancesClusterValidationError"
-}
+func (e ClusterDriftValidationError) ErrorName() string { return "ClusterDriftValidationError" }
 
 // Error satisfies the builtin error interface
-func (e DeploymentInstancesClusterValidationError) Error() string {
+func (e ClusterDriftValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1109,14 +5441,14 @@ func (e DeploymentInstancesClusterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sDeploymentInstancesCluster.%s: %s%s",
+		"invalid %sClusterDrift.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = DeploymentInstancesClusterValidationError{}
+var _ error = ClusterDriftValidationError{}
 
 var _ interface {
 	Field() string
@@ -1124,38 +5456,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = DeploymentInstancesClusterValidationError{}
+} = ClusterDriftValidationError{}
 
-// Validate checks the field values on Cluster with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
+// Validate checks the field values on DependencyNode with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *Cluster) Validate() error {
+func (m *DependencyNode) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Cluster with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in ClusterMultiError, or nil if none found.
-func (m *Cluster) ValidateAll() error {
+// ValidateAll checks the field values on DependencyNode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DependencyNodeMultiError,
+// or nil if none found.
+func (m *DependencyNode) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Cluster) validate(all bool) error {
+func (m *DependencyNode) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for DeployId
+
 	// no validation rules for Name
 
-	// no validation rules for Id
+	// no validation rules for DisplayName
+
+	// no validation rules for AppName
+
+	// no validation rules for AppVersion
+
+	// no validation rules for ProfileName
 
 	if all {
 		switch v := interface{}(m.GetStatus()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, ClusterValidationError{
+				errors = append(errors, DependencyNodeValidationError{
 					field:  "Status",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1163,7 +5504,7 @@ func (m *Cluster) validate(all bool) error {
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, ClusterValidationError{
+				errors = append(errors, DependencyNodeValidationError{
 					field:  "Status",
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -1172,7 +5513,7 @@ func (m *Cluster) validate(all bool) error {
 		}
 	} else if v, ok := interface{}(m.GetStatus()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return ClusterValidationError{
+			return DependencyNodeValidationError{
 				field:  "Status",
 				reason: "embedded message failed validation",
 				cause:  err,
@@ -1180,53 +5521,124 @@ func (m *Cluster) validate(all bool) error {
 		}
 	}
 
-	for idx, item := range m.GetApps() {
-		_, _ = idx, item
+	if len(errors) > 0 {
+		return DependencyNodeMultiError(errors)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ClusterValidationError{
-						field:  fmt.Sprintf("Apps[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, ClusterValidationError{
-						field:  fmt.Sprintf("Apps[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ClusterValidationError{
-					field:  fmt.Sprintf("Apps[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
+	return nil
+}
+
+// DependencyNodeMultiError is an error wrapping multiple validation errors
+// returned by DependencyNode.ValidateAll() if the designated constraints
+// aren't met.
+type DependencyNodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DependencyNodeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DependencyNodeMultiError) AllErrors() []error { return m }
+
+// DependencyNodeValidationError is the validation error returned by
+// DependencyNode.Validate if the designated constraints aren't met.
+type DependencyNodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DependencyNodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DependencyNodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DependencyNodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DependencyNodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DependencyNodeValidationError) ErrorName() string { return "DependencyNodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DependencyNodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDependencyNode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DependencyNodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DependencyNodeValidationError{}
+
+// Validate checks the field values on DependencyEdge with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DependencyEdge) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DependencyEdge with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DependencyEdgeMultiError,
+// or nil if none found.
+func (m *DependencyEdge) ValidateAll() error {
+	return m.validate(true)
+}
 
+func (m *DependencyEdge) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
+	// no validation rules for From
+
+	// no validation rules for To
+
 	if len(errors) > 0 {
-		return ClusterMultiError(errors)
+		return DependencyEdgeMultiError(errors)
 	}
 
 	return nil
 }
 
-// ClusterMultiError is an error wrapping multiple validation errors returned
-// by Cluster.ValidateAll() if the designated constraints aren't met.
-type ClusterMultiError []error
+// DependencyEdgeMultiError is an error wrapping multiple validation errors
+// returned by DependencyEdge.ValidateAll() if the designated constraints
+// aren't met.
+type DependencyEdgeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ClusterMultiError) Error() string {
+func (m DependencyEdgeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1235,11 +5647,11 @@ func (m ClusterMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ClusterMultiError) AllErrors() []error { return m }
+func (m DependencyEdgeMultiError) AllErrors() []error { return m }
 
-// ClusterValidationError is the validation error returned by Cluster.Validate
-// if the designated constraints aren't met.
-type ClusterValidationError struct {
+// DependencyEdgeValidationError is the validation error returned by
+// DependencyEdge.Validate if the designated constraints aren't met.
+type DependencyEdgeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1247,22 +5659,22 @@ type ClusterValidationError struct {
 }
 
 // Field function returns field value.
-func (e ClusterValidationError) Field() string { return e.field }
+func (e DependencyEdgeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ClusterValidationError) Reason() string { return e.reason }
+func (e DependencyEdgeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ClusterValidationError) Cause() error { return e.cause }
+func (e DependencyEdgeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ClusterValidationError) Key() bool { return e.key }
+func (e DependencyEdgeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ClusterValidationError) ErrorName() string { return "ClusterValidationError" }
+func (e DependencyEdgeValidationError) ErrorName() string { return "DependencyEdgeValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ClusterValidationError) Error() string {
+func (e DependencyEdgeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1274,14 +5686,14 @@ func (e ClusterValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCluster.%s: %s%s",
+		"invalid %sDependencyEdge.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ClusterValidationError{}
+var _ error = DependencyEdgeValidationError{}
 
 var _ interface {
 	Field() string
@@ -1289,7 +5701,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ClusterValidationError{}
+} = DependencyEdgeValidationError{}
 
 // Validate checks the field values on Deployment_Status with the rules defined
 // in the proto definition for this message. If any rules are violated, the
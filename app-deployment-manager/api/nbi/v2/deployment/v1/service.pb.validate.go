@@ -86,6 +86,8 @@ func (m *CreateDeploymentRequest) validate(all bool) error {
 		}
 	}
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return CreateDeploymentRequestMultiError(errors)
 	}
@@ -300,6 +302,8 @@ func (m *ListDeploymentsRequest) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return ListDeploymentsRequestMultiError(errors)
 	}
@@ -551,6 +555,8 @@ func (m *ListDeploymentsPerClusterRequest) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return ListDeploymentsPerClusterRequestMultiError(errors)
 	}
@@ -798,6 +804,8 @@ func (m *GetDeploymentRequest) validate(all bool) error {
 
 	// no validation rules for DeplId
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return GetDeploymentRequestMultiError(errors)
 	}
@@ -1062,6 +1070,8 @@ func (m *UpdateDeploymentRequest) validate(all bool) error {
 
 	// no validation rules for DeplId
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return UpdateDeploymentRequestMultiError(errors)
 	}
@@ -1299,6 +1309,8 @@ func (m *DeleteDeploymentRequest) validate(all bool) error {
 
 	// no validation rules for DeleteType
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return DeleteDeploymentRequestMultiError(errors)
 	}
@@ -1401,6 +1413,8 @@ func (m *GetDeploymentsStatusRequest) validate(all bool) error {
 
 	var errors []error
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return GetDeploymentsStatusRequestMultiError(errors)
 	}
@@ -1625,6 +1639,8 @@ func (m *GetAppNamespaceRequest) validate(all bool) error {
 
 	// no validation rules for AppId
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return GetAppNamespaceRequestMultiError(errors)
 	}
@@ -1841,6 +1857,8 @@ func (m *ListDeploymentClustersRequest) validate(all bool) error {
 
 	// no validation rules for Offset
 
+	// no validation rules for ProjectName
+
 	if len(errors) > 0 {
 		return ListDeploymentClustersRequestMultiError(errors)
 	}
@@ -2060,3 +2078,956 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = ListDeploymentClustersResponseValidationError{}
+
+// Validate checks the field values on GetDeploymentDriftRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentDriftRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentDriftRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentDriftRequestMultiError, or nil if none found.
+func (m *GetDeploymentDriftRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentDriftRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeplId
+
+	// no validation rules for ClusterId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return GetDeploymentDriftRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentDriftRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDeploymentDriftRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDeploymentDriftRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentDriftRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentDriftRequestMultiError) AllErrors() []error { return m }
+
+// GetDeploymentDriftRequestValidationError is the validation error returned by
+// GetDeploymentDriftRequest.Validate if the designated constraints aren't met.
+type GetDeploymentDriftRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentDriftRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentDriftRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentDriftRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentDriftRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentDriftRequestValidationError) ErrorName() string {
+	return "GetDeploymentDriftRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentDriftRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentDriftRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentDriftRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentDriftRequestValidationError{}
+
+// Validate checks the field values on GetDeploymentDriftResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDeploymentDriftResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDeploymentDriftResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDeploymentDriftResponseMultiError, or nil if none found.
+func (m *GetDeploymentDriftResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDeploymentDriftResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DriftPolicy
+
+	for idx, item := range m.GetClusters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDeploymentDriftResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDeploymentDriftResponseValidationError{
+						field:  fmt.Sprintf("Clusters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDeploymentDriftResponseValidationError{
+					field:  fmt.Sprintf("Clusters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDeploymentDriftResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDeploymentDriftResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDeploymentDriftResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDeploymentDriftResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDeploymentDriftResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDeploymentDriftResponseMultiError) AllErrors() []error { return m }
+
+// GetDeploymentDriftResponseValidationError is the validation error returned
+// by GetDeploymentDriftResponse.Validate if the designated constraints aren't met.
+type GetDeploymentDriftResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDeploymentDriftResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDeploymentDriftResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDeploymentDriftResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDeploymentDriftResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDeploymentDriftResponseValidationError) ErrorName() string {
+	return "GetDeploymentDriftResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDeploymentDriftResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDeploymentDriftResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDeploymentDriftResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDeploymentDriftResponseValidationError{}
+
+// Validate checks the field values on GetDependencyGraphRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDependencyGraphRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDependencyGraphRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDependencyGraphRequestMultiError, or nil if none found.
+func (m *GetDependencyGraphRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDependencyGraphRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeplId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return GetDependencyGraphRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDependencyGraphRequestMultiError is an error wrapping multiple validation
+// errors returned by GetDependencyGraphRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetDependencyGraphRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDependencyGraphRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDependencyGraphRequestMultiError) AllErrors() []error { return m }
+
+// GetDependencyGraphRequestValidationError is the validation error returned by
+// GetDependencyGraphRequest.Validate if the designated constraints aren't met.
+type GetDependencyGraphRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDependencyGraphRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDependencyGraphRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDependencyGraphRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDependencyGraphRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDependencyGraphRequestValidationError) ErrorName() string {
+	return "GetDependencyGraphRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDependencyGraphRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDependencyGraphRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDependencyGraphRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDependencyGraphRequestValidationError{}
+
+// Validate checks the field values on GetDependencyGraphResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetDependencyGraphResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetDependencyGraphResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetDependencyGraphResponseMultiError, or nil if none found.
+func (m *GetDependencyGraphResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetDependencyGraphResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetNodes() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDependencyGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDependencyGraphResponseValidationError{
+						field:  fmt.Sprintf("Nodes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDependencyGraphResponseValidationError{
+					field:  fmt.Sprintf("Nodes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetEdges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetDependencyGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetDependencyGraphResponseValidationError{
+						field:  fmt.Sprintf("Edges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetDependencyGraphResponseValidationError{
+					field:  fmt.Sprintf("Edges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetDependencyGraphResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetDependencyGraphResponseMultiError is an error wrapping multiple
+// validation errors returned by GetDependencyGraphResponse.ValidateAll() if
+// the designated constraints aren't met.
+type GetDependencyGraphResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetDependencyGraphResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetDependencyGraphResponseMultiError) AllErrors() []error { return m }
+
+// GetDependencyGraphResponseValidationError is the validation error returned
+// by GetDependencyGraphResponse.Validate if the designated constraints aren't met.
+type GetDependencyGraphResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetDependencyGraphResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetDependencyGraphResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetDependencyGraphResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetDependencyGraphResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetDependencyGraphResponseValidationError) ErrorName() string {
+	return "GetDependencyGraphResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetDependencyGraphResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetDependencyGraphResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetDependencyGraphResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetDependencyGraphResponseValidationError{}
+
+// Validate checks the field values on PromoteDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PromoteDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromoteDeploymentRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PromoteDeploymentRequestMultiError, or nil if none found.
+func (m *PromoteDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromoteDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeplId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return PromoteDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromoteDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by PromoteDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PromoteDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromoteDeploymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromoteDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// PromoteDeploymentRequestValidationError is the validation error returned by
+// PromoteDeploymentRequest.Validate if the designated constraints aren't met.
+type PromoteDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoteDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoteDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoteDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoteDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoteDeploymentRequestValidationError) ErrorName() string {
+	return "PromoteDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PromoteDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoteDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoteDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoteDeploymentRequestValidationError{}
+
+// Validate checks the field values on AbortDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AbortDeploymentRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AbortDeploymentRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AbortDeploymentRequestMultiError, or nil if none found.
+func (m *AbortDeploymentRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AbortDeploymentRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeplId
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return AbortDeploymentRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AbortDeploymentRequestMultiError is an error wrapping multiple validation
+// errors returned by AbortDeploymentRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AbortDeploymentRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AbortDeploymentRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AbortDeploymentRequestMultiError) AllErrors() []error { return m }
+
+// AbortDeploymentRequestValidationError is the validation error returned by
+// AbortDeploymentRequest.Validate if the designated constraints aren't met.
+type AbortDeploymentRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AbortDeploymentRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AbortDeploymentRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AbortDeploymentRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AbortDeploymentRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AbortDeploymentRequestValidationError) ErrorName() string {
+	return "AbortDeploymentRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AbortDeploymentRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAbortDeploymentRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AbortDeploymentRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AbortDeploymentRequestValidationError{}
+
+// Validate checks the field values on ExportOfflineBundleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportOfflineBundleRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportOfflineBundleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportOfflineBundleRequestMultiError, or nil if none found.
+func (m *ExportOfflineBundleRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportOfflineBundleRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeplId
+
+	// no validation rules for ClusterId
+
+	// no validation rules for IncludeImages
+
+	// no validation rules for ProjectName
+
+	if len(errors) > 0 {
+		return ExportOfflineBundleRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportOfflineBundleRequestMultiError is an error wrapping multiple
+// validation errors returned by ExportOfflineBundleRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ExportOfflineBundleRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportOfflineBundleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportOfflineBundleRequestMultiError) AllErrors() []error { return m }
+
+// ExportOfflineBundleRequestValidationError is the validation error returned
+// by ExportOfflineBundleRequest.Validate if the designated constraints aren't met.
+type ExportOfflineBundleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOfflineBundleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOfflineBundleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOfflineBundleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOfflineBundleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOfflineBundleRequestValidationError) ErrorName() string {
+	return "ExportOfflineBundleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOfflineBundleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOfflineBundleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOfflineBundleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOfflineBundleRequestValidationError{}
+
+// Validate checks the field values on ExportOfflineBundleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ExportOfflineBundleResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportOfflineBundleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportOfflineBundleResponseMultiError, or nil if none found.
+func (m *ExportOfflineBundleResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportOfflineBundleResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Archive
+
+	// no validation rules for FileName
+
+	// no validation rules for Generation
+
+	if len(errors) > 0 {
+		return ExportOfflineBundleResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportOfflineBundleResponseMultiError is an error wrapping multiple
+// validation errors returned by ExportOfflineBundleResponse.ValidateAll() if
+// the designated constraints aren't met.
+type ExportOfflineBundleResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportOfflineBundleResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportOfflineBundleResponseMultiError) AllErrors() []error { return m }
+
+// ExportOfflineBundleResponseValidationError is the validation error returned
+// by ExportOfflineBundleResponse.Validate if the designated constraints
+// aren't met.
+type ExportOfflineBundleResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportOfflineBundleResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportOfflineBundleResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportOfflineBundleResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportOfflineBundleResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportOfflineBundleResponseValidationError) ErrorName() string {
+	return "ExportOfflineBundleResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportOfflineBundleResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportOfflineBundleResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportOfflineBundleResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportOfflineBundleResponseValidationError{}
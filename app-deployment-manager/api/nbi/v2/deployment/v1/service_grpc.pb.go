@@ -38,6 +38,18 @@ type DeploymentServiceClient interface {
 	GetDeploymentsStatus(ctx context.Context, in *GetDeploymentsStatusRequest, opts ...grpc.CallOption) (*GetDeploymentsStatusResponse, error)
 	// Gets a list of all deployment cluster objects.
 	ListDeploymentClusters(ctx context.Context, in *ListDeploymentClustersRequest, opts ...grpc.CallOption) (*ListDeploymentClustersResponse, error)
+	// Gets the drift of the deployed resources from the desired state, per cluster.
+	GetDeploymentDrift(ctx context.Context, in *GetDeploymentDriftRequest, opts ...grpc.CallOption) (*GetDeploymentDriftResponse, error)
+	// Gets the dependency graph of a deployment with the deployments it requires and the deployments requiring it.
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error)
+	// Promotes the canary stage of a deployment, rolling out the new version to all target clusters.
+	PromoteDeployment(ctx context.Context, in *PromoteDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Aborts the canary stage of a deployment, rolling back the canary clusters to the stable version.
+	AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Exports an archive to deploy a deployment on a disconnected cluster, with the rendered values, the Helm charts and
+	// optionally the container images. The archive is streamed in chunks to be concatenated in order. The exported
+	// generation is recorded on the deployment.
+	ExportOfflineBundle(ctx context.Context, in *ExportOfflineBundleRequest, opts ...grpc.CallOption) (DeploymentService_ExportOfflineBundleClient, error)
 	GetAppNamespace(ctx context.Context, in *GetAppNamespaceRequest, opts ...grpc.CallOption) (*GetAppNamespaceResponse, error)
 }
 
@@ -121,6 +133,74 @@ func (c *deploymentServiceClient) ListDeploymentClusters(ctx context.Context, in
 	return out, nil
 }
 
+func (c *deploymentServiceClient) GetDeploymentDrift(ctx context.Context, in *GetDeploymentDriftRequest, opts ...grpc.CallOption) (*GetDeploymentDriftResponse, error) {
+	out := new(GetDeploymentDriftResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.DeploymentService/GetDeploymentDrift", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentServiceClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error) {
+	out := new(GetDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, "/deployment.v1.DeploymentService/GetDependencyGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentServiceClient) PromoteDeployment(ctx context.Context, in *PromoteDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.DeploymentService/PromoteDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentServiceClient) AbortDeployment(ctx context.Context, in *AbortDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/deployment.v1.DeploymentService/AbortDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deploymentServiceClient) ExportOfflineBundle(ctx context.Context, in *ExportOfflineBundleRequest, opts ...grpc.CallOption) (DeploymentService_ExportOfflineBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DeploymentService_ServiceDesc.Streams[0], "/deployment.v1.DeploymentService/ExportOfflineBundle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &deploymentServiceExportOfflineBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DeploymentService_ExportOfflineBundleClient interface {
+	Recv() (*ExportOfflineBundleResponse, error)
+	grpc.ClientStream
+}
+
+type deploymentServiceExportOfflineBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *deploymentServiceExportOfflineBundleClient) Recv() (*ExportOfflineBundleResponse, error) {
+	m := new(ExportOfflineBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *deploymentServiceClient) GetAppNamespace(ctx context.Context, in *GetAppNamespaceRequest, opts ...grpc.CallOption) (*GetAppNamespaceResponse, error) {
 	out := new(GetAppNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/deployment.v1.DeploymentService/GetAppNamespace", in, out, opts...)
@@ -149,6 +229,18 @@ type DeploymentServiceServer interface {
 	GetDeploymentsStatus(context.Context, *GetDeploymentsStatusRequest) (*GetDeploymentsStatusResponse, error)
 	// Gets a list of all deployment cluster objects.
 	ListDeploymentClusters(context.Context, *ListDeploymentClustersRequest) (*ListDeploymentClustersResponse, error)
+	// Gets the drift of the deployed resources from the desired state, per cluster.
+	GetDeploymentDrift(context.Context, *GetDeploymentDriftRequest) (*GetDeploymentDriftResponse, error)
+	// Gets the dependency graph of a deployment with the deployments it requires and the deployments requiring it.
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error)
+	// Promotes the canary stage of a deployment, rolling out the new version to all target clusters.
+	PromoteDeployment(context.Context, *PromoteDeploymentRequest) (*emptypb.Empty, error)
+	// Aborts the canary stage of a deployment, rolling back the canary clusters to the stable version.
+	AbortDeployment(context.Context, *AbortDeploymentRequest) (*emptypb.Empty, error)
+	// Exports an archive to deploy a deployment on a disconnected cluster, with the rendered values, the Helm charts and
+	// optionally the container images. The archive is streamed in chunks to be concatenated in order. The exported
+	// generation is recorded on the deployment.
+	ExportOfflineBundle(*ExportOfflineBundleRequest, DeploymentService_ExportOfflineBundleServer) error
 	GetAppNamespace(context.Context, *GetAppNamespaceRequest) (*GetAppNamespaceResponse, error)
 }
 
@@ -180,6 +272,21 @@ func (UnimplementedDeploymentServiceServer) GetDeploymentsStatus(context.Context
 func (UnimplementedDeploymentServiceServer) ListDeploymentClusters(context.Context, *ListDeploymentClustersRequest) (*ListDeploymentClustersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListDeploymentClusters not implemented")
 }
+func (UnimplementedDeploymentServiceServer) GetDeploymentDrift(context.Context, *GetDeploymentDriftRequest) (*GetDeploymentDriftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeploymentDrift not implemented")
+}
+func (UnimplementedDeploymentServiceServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedDeploymentServiceServer) PromoteDeployment(context.Context, *PromoteDeploymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteDeployment not implemented")
+}
+func (UnimplementedDeploymentServiceServer) AbortDeployment(context.Context, *AbortDeploymentRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AbortDeployment not implemented")
+}
+func (UnimplementedDeploymentServiceServer) ExportOfflineBundle(*ExportOfflineBundleRequest, DeploymentService_ExportOfflineBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportOfflineBundle not implemented")
+}
 func (UnimplementedDeploymentServiceServer) GetAppNamespace(context.Context, *GetAppNamespaceRequest) (*GetAppNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAppNamespace not implemented")
 }
@@ -339,6 +446,99 @@ func _DeploymentService_ListDeploymentClusters_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DeploymentService_GetDeploymentDrift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeploymentDriftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).GetDeploymentDrift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.DeploymentService/GetDeploymentDrift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).GetDeploymentDrift(ctx, req.(*GetDeploymentDriftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentService_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).GetDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.DeploymentService/GetDependencyGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentService_PromoteDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).PromoteDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.DeploymentService/PromoteDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).PromoteDeployment(ctx, req.(*PromoteDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentService_AbortDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AbortDeploymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeploymentServiceServer).AbortDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/deployment.v1.DeploymentService/AbortDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeploymentServiceServer).AbortDeployment(ctx, req.(*AbortDeploymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeploymentService_ExportOfflineBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportOfflineBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DeploymentServiceServer).ExportOfflineBundle(m, &deploymentServiceExportOfflineBundleServer{stream})
+}
+
+type DeploymentService_ExportOfflineBundleServer interface {
+	Send(*ExportOfflineBundleResponse) error
+	grpc.ServerStream
+}
+
+type deploymentServiceExportOfflineBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *deploymentServiceExportOfflineBundleServer) Send(m *ExportOfflineBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _DeploymentService_GetAppNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAppNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -396,11 +596,33 @@ var DeploymentService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListDeploymentClusters",
 			Handler:    _DeploymentService_ListDeploymentClusters_Handler,
 		},
+		{
+			MethodName: "GetDeploymentDrift",
+			Handler:    _DeploymentService_GetDeploymentDrift_Handler,
+		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _DeploymentService_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "PromoteDeployment",
+			Handler:    _DeploymentService_PromoteDeployment_Handler,
+		},
+		{
+			MethodName: "AbortDeployment",
+			Handler:    _DeploymentService_AbortDeployment_Handler,
+		},
 		{
 			MethodName: "GetAppNamespace",
 			Handler:    _DeploymentService_GetAppNamespace_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportOfflineBundle",
+			Handler:       _DeploymentService_ExportOfflineBundle_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "deployment/v1/service.proto",
 }
@@ -89,12 +89,48 @@ func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
 
 // The interface specification for the client above.
 type ClientInterface interface {
+	// DeploymentV1ClusterServiceListClusterGroups2 request
+	DeploymentV1ClusterServiceListClusterGroups2(ctx context.Context, params *DeploymentV1ClusterServiceListClusterGroups2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceCreateClusterGroup2WithBody request with any body
+	DeploymentV1ClusterServiceCreateClusterGroup2WithBody(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServiceCreateClusterGroup2(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, body DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServicePreviewClusterGroup2WithBody request with any body
+	DeploymentV1ClusterServicePreviewClusterGroup2WithBody(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServicePreviewClusterGroup2(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, body DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceDeleteClusterGroup2 request
+	DeploymentV1ClusterServiceDeleteClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceDeleteClusterGroup2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterGroup2 request
+	DeploymentV1ClusterServiceGetClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceGetClusterGroup2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceUpdateClusterGroup2WithBody request with any body
+	DeploymentV1ClusterServiceUpdateClusterGroup2WithBody(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServiceUpdateClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, body DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1ClusterServiceListClusters2 request
 	DeploymentV1ClusterServiceListClusters2(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeploymentV1ClusterServiceGetCluster2 request
 	DeploymentV1ClusterServiceGetCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1ClusterServiceCordonCluster2 request
+	DeploymentV1ClusterServiceCordonCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceCordonCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceDrainCluster2 request
+	DeploymentV1ClusterServiceDrainCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceDrainCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterInventory2 request
+	DeploymentV1ClusterServiceGetClusterInventory2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetClusterInventory2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceUncordonCluster2 request
+	DeploymentV1ClusterServiceUncordonCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceUncordonCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceListDeployments2 request
 	DeploymentV1DeploymentServiceListDeployments2(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -117,12 +153,35 @@ type ClientInterface interface {
 
 	DeploymentV1DeploymentServiceUpdateDeployment2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1DeploymentServiceAbortDeployment2 request
+	DeploymentV1DeploymentServiceAbortDeployment2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceAbortDeployment2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceListDeploymentClusters2 request
 	DeploymentV1DeploymentServiceListDeploymentClusters2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1DeploymentServiceExportOfflineBundle2 request
+	DeploymentV1DeploymentServiceExportOfflineBundle2(ctx context.Context, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundle2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServiceGetDependencyGraph2 request
+	DeploymentV1DeploymentServiceGetDependencyGraph2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDependencyGraph2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentDrift2 request
+	DeploymentV1DeploymentServiceGetDeploymentDrift2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDrift2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServicePromoteDeployment2 request
+	DeploymentV1DeploymentServicePromoteDeployment2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServicePromoteDeployment2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceExportInventory2 request
+	DeploymentV1ClusterServiceExportInventory2(ctx context.Context, params *DeploymentV1ClusterServiceExportInventory2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceGetDeploymentsStatus2 request
 	DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1ClusterServicePreviewTargets2WithBody request with any body
+	DeploymentV1ClusterServicePreviewTargets2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServicePreviewTargets2(ctx context.Context, body DeploymentV1ClusterServicePreviewTargets2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1ClusterServiceGetKubeConfigWithBody request with any body
 	DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -133,12 +192,48 @@ type ClientInterface interface {
 
 	DeploymentV1DeploymentServiceGetAppNamespace(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1ClusterServiceListClusterGroups request
+	DeploymentV1ClusterServiceListClusterGroups(ctx context.Context, projectName string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceCreateClusterGroupWithBody request with any body
+	DeploymentV1ClusterServiceCreateClusterGroupWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServiceCreateClusterGroup(ctx context.Context, projectName string, body DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServicePreviewClusterGroupWithBody request with any body
+	DeploymentV1ClusterServicePreviewClusterGroupWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServicePreviewClusterGroup(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceDeleteClusterGroup request
+	DeploymentV1ClusterServiceDeleteClusterGroup(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterGroup request
+	DeploymentV1ClusterServiceGetClusterGroup(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceUpdateClusterGroupWithBody request with any body
+	DeploymentV1ClusterServiceUpdateClusterGroupWithBody(ctx context.Context, projectName string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServiceUpdateClusterGroup(ctx context.Context, projectName string, name string, body DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1ClusterServiceListClusters request
 	DeploymentV1ClusterServiceListClusters(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
 	// DeploymentV1ClusterServiceGetCluster request
 	DeploymentV1ClusterServiceGetCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1ClusterServiceCordonCluster request
+	DeploymentV1ClusterServiceCordonCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceDrainCluster request
+	DeploymentV1ClusterServiceDrainCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterInventory request
+	DeploymentV1ClusterServiceGetClusterInventory(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceUncordonCluster request
+	DeploymentV1ClusterServiceUncordonCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceListDeployments request
 	DeploymentV1DeploymentServiceListDeployments(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -161,11 +256,142 @@ type ClientInterface interface {
 
 	DeploymentV1DeploymentServiceUpdateDeployment(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1DeploymentServiceAbortDeployment request
+	DeploymentV1DeploymentServiceAbortDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceListDeploymentClusters request
 	DeploymentV1DeploymentServiceListDeploymentClusters(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// DeploymentV1DeploymentServiceExportOfflineBundle request
+	DeploymentV1DeploymentServiceExportOfflineBundle(ctx context.Context, projectName string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundleParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServiceGetDependencyGraph request
+	DeploymentV1DeploymentServiceGetDependencyGraph(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentDrift request
+	DeploymentV1DeploymentServiceGetDeploymentDrift(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDriftParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1DeploymentServicePromoteDeployment request
+	DeploymentV1DeploymentServicePromoteDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServiceExportInventory request
+	DeploymentV1ClusterServiceExportInventory(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceExportInventoryParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// DeploymentV1DeploymentServiceGetDeploymentsStatus request
 	DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeploymentV1ClusterServicePreviewTargetsWithBody request with any body
+	DeploymentV1ClusterServicePreviewTargetsWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	DeploymentV1ClusterServicePreviewTargets(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) DeploymentV1ClusterServiceListClusterGroups2(ctx context.Context, params *DeploymentV1ClusterServiceListClusterGroups2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceListClusterGroups2Request(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceCreateClusterGroup2WithBody(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCreateClusterGroup2RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceCreateClusterGroup2(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, body DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCreateClusterGroup2Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServicePreviewClusterGroup2WithBody(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewClusterGroup2RequestWithBody(c.Server, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServicePreviewClusterGroup2(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, body DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewClusterGroup2Request(c.Server, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceDeleteClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceDeleteClusterGroup2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceDeleteClusterGroup2Request(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceGetClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceGetClusterGroup2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetClusterGroup2Request(c.Server, name, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceUpdateClusterGroup2WithBody(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUpdateClusterGroup2RequestWithBody(c.Server, name, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceUpdateClusterGroup2(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, body DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUpdateClusterGroup2Request(c.Server, name, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
 func (c *Client) DeploymentV1ClusterServiceListClusters2(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -192,6 +418,54 @@ func (c *Client) DeploymentV1ClusterServiceGetCluster2(ctx context.Context, clus
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeploymentV1ClusterServiceCordonCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceCordonCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCordonCluster2Request(c.Server, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceDrainCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceDrainCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceDrainCluster2Request(c.Server, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceGetClusterInventory2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetClusterInventory2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetClusterInventory2Request(c.Server, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeploymentV1ClusterServiceUncordonCluster2(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceUncordonCluster2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUncordonCluster2Request(c.Server, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeploymentV1DeploymentServiceListDeployments2(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeploymentV1DeploymentServiceListDeployments2Request(c.Server, params)
 	if err != nil {
@@ -288,6 +562,18 @@ func (c *Client) DeploymentV1DeploymentServiceUpdateDeployment2(ctx context.Cont
 	return c.Client.Do(req)
 }
 
+func (c *Client) DeploymentV1DeploymentServiceAbortDeployment2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceAbortDeployment2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceAbortDeployment2Request(c.Server, deplId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) DeploymentV1DeploymentServiceListDeploymentClusters2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewDeploymentV1DeploymentServiceListDeploymentClusters2Request(c.Server, deplId, params)
 	if err != nil {
@@ -300,8 +586,8 @@ func (c *Client) DeploymentV1DeploymentServiceListDeploymentClusters2(ctx contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(c.Server, params)
+func (c *Client) DeploymentV1DeploymentServiceExportOfflineBundle2(ctx context.Context, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundle2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceExportOfflineBundle2Request(c.Server, deplId, clusterId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -312,8 +598,8 @@ func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx context.
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) DeploymentV1DeploymentServiceGetDependencyGraph2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDependencyGraph2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDependencyGraph2Request(c.Server, deplId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -324,8 +610,8 @@ func (c *Client) DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx context.Con
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1ClusterServiceGetKubeConfig(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1ClusterServiceGetKubeConfigRequest(c.Server, params, body)
+func (c *Client) DeploymentV1DeploymentServiceGetDeploymentDrift2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDrift2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDeploymentDrift2Request(c.Server, deplId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -336,8 +622,8 @@ func (c *Client) DeploymentV1ClusterServiceGetKubeConfig(ctx context.Context, pa
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceGetAppNamespaceWithBody(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(c.Server, params, contentType, body)
+func (c *Client) DeploymentV1DeploymentServicePromoteDeployment2(ctx context.Context, deplId string, params *DeploymentV1DeploymentServicePromoteDeployment2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServicePromoteDeployment2Request(c.Server, deplId, params)
 	if err != nil {
 		return nil, err
 	}
@@ -348,8 +634,8 @@ func (c *Client) DeploymentV1DeploymentServiceGetAppNamespaceWithBody(ctx contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceGetAppNamespace(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceGetAppNamespaceRequest(c.Server, params, body)
+func (c *Client) DeploymentV1ClusterServiceExportInventory2(ctx context.Context, params *DeploymentV1ClusterServiceExportInventory2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceExportInventory2Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -360,8 +646,8 @@ func (c *Client) DeploymentV1DeploymentServiceGetAppNamespace(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1ClusterServiceListClusters(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1ClusterServiceListClustersRequest(c.Server, projectName, params)
+func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(c.Server, params)
 	if err != nil {
 		return nil, err
 	}
@@ -372,8 +658,8 @@ func (c *Client) DeploymentV1ClusterServiceListClusters(ctx context.Context, pro
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1ClusterServiceGetCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1ClusterServiceGetClusterRequest(c.Server, projectName, clusterId)
+func (c *Client) DeploymentV1ClusterServicePreviewTargets2WithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewTargets2RequestWithBody(c.Server, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -384,8 +670,8 @@ func (c *Client) DeploymentV1ClusterServiceGetCluster(ctx context.Context, proje
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceListDeployments(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceListDeploymentsRequest(c.Server, projectName, params)
+func (c *Client) DeploymentV1ClusterServicePreviewTargets2(ctx context.Context, body DeploymentV1ClusterServicePreviewTargets2JSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewTargets2Request(c.Server, body)
 	if err != nil {
 		return nil, err
 	}
@@ -396,8 +682,8 @@ func (c *Client) DeploymentV1DeploymentServiceListDeployments(ctx context.Contex
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceCreateDeploymentWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(c.Server, projectName, contentType, body)
+func (c *Client) DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -408,8 +694,8 @@ func (c *Client) DeploymentV1DeploymentServiceCreateDeploymentWithBody(ctx conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceCreateDeployment(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceCreateDeploymentRequest(c.Server, projectName, body)
+func (c *Client) DeploymentV1ClusterServiceGetKubeConfig(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetKubeConfigRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -420,8 +706,8 @@ func (c *Client) DeploymentV1DeploymentServiceCreateDeployment(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceListDeploymentsPerCluster(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest(c.Server, projectName, clusterId, params)
+func (c *Client) DeploymentV1DeploymentServiceGetAppNamespaceWithBody(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(c.Server, params, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -432,8 +718,8 @@ func (c *Client) DeploymentV1DeploymentServiceListDeploymentsPerCluster(ctx cont
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceDeleteDeployment(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceDeleteDeploymentRequest(c.Server, projectName, deplId, params)
+func (c *Client) DeploymentV1DeploymentServiceGetAppNamespace(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetAppNamespaceRequest(c.Server, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -444,8 +730,8 @@ func (c *Client) DeploymentV1DeploymentServiceDeleteDeployment(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceGetDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceGetDeploymentRequest(c.Server, projectName, deplId)
+func (c *Client) DeploymentV1ClusterServiceListClusterGroups(ctx context.Context, projectName string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceListClusterGroupsRequest(c.Server, projectName)
 	if err != nil {
 		return nil, err
 	}
@@ -456,8 +742,8 @@ func (c *Client) DeploymentV1DeploymentServiceGetDeployment(ctx context.Context,
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceUpdateDeploymentWithBody(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(c.Server, projectName, deplId, contentType, body)
+func (c *Client) DeploymentV1ClusterServiceCreateClusterGroupWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCreateClusterGroupRequestWithBody(c.Server, projectName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -468,8 +754,8 @@ func (c *Client) DeploymentV1DeploymentServiceUpdateDeploymentWithBody(ctx conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceUpdateDeployment(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceUpdateDeploymentRequest(c.Server, projectName, deplId, body)
+func (c *Client) DeploymentV1ClusterServiceCreateClusterGroup(ctx context.Context, projectName string, body DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCreateClusterGroupRequest(c.Server, projectName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -480,8 +766,8 @@ func (c *Client) DeploymentV1DeploymentServiceUpdateDeployment(ctx context.Conte
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceListDeploymentClusters(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceListDeploymentClustersRequest(c.Server, projectName, deplId, params)
+func (c *Client) DeploymentV1ClusterServicePreviewClusterGroupWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewClusterGroupRequestWithBody(c.Server, projectName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
@@ -492,8 +778,8 @@ func (c *Client) DeploymentV1DeploymentServiceListDeploymentClusters(ctx context
 	return c.Client.Do(req)
 }
 
-func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
-	req, err := NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest(c.Server, projectName, params)
+func (c *Client) DeploymentV1ClusterServicePreviewClusterGroup(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewClusterGroupRequest(c.Server, projectName, body)
 	if err != nil {
 		return nil, err
 	}
@@ -504,280 +790,367 @@ func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx context.C
 	return c.Client.Do(req)
 }
 
-// NewDeploymentV1ClusterServiceListClusters2Request generates requests for DeploymentV1ClusterServiceListClusters2
-func NewDeploymentV1ClusterServiceListClusters2Request(server string, params *DeploymentV1ClusterServiceListClusters2Params) (*http.Request, error) {
-	var err error
-
-	serverURL, err := url.Parse(server)
+func (c *Client) DeploymentV1ClusterServiceDeleteClusterGroup(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceDeleteClusterGroupRequest(c.Server, projectName, name)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DeploymentV1ClusterServiceGetClusterGroup(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetClusterGroupRequest(c.Server, projectName, name)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeploymentV1ClusterServiceUpdateClusterGroupWithBody(ctx context.Context, projectName string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUpdateClusterGroupRequestWithBody(c.Server, projectName, name, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Labels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ProjectName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *Client) DeploymentV1ClusterServiceUpdateClusterGroup(ctx context.Context, projectName string, name string, body DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUpdateClusterGroupRequest(c.Server, projectName, name, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeploymentV1ClusterServiceListClusters(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceListClustersRequest(c.Server, projectName, params)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeploymentV1ClusterServiceGetCluster2Request generates requests for DeploymentV1ClusterServiceGetCluster2
-func NewDeploymentV1ClusterServiceGetCluster2Request(server string, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+func (c *Client) DeploymentV1ClusterServiceGetCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetClusterRequest(c.Server, projectName, clusterId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeploymentV1ClusterServiceCordonCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceCordonClusterRequest(c.Server, projectName, clusterId)
 	if err != nil {
 		return nil, err
 	}
-
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeploymentV1ClusterServiceDrainCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceDrainClusterRequest(c.Server, projectName, clusterId)
 	if err != nil {
 		return nil, err
 	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.ProjectName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+func (c *Client) DeploymentV1ClusterServiceGetClusterInventory(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceGetClusterInventoryRequest(c.Server, projectName, clusterId)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	return req, nil
+func (c *Client) DeploymentV1ClusterServiceUncordonCluster(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceUncordonClusterRequest(c.Server, projectName, clusterId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
 }
 
-// NewDeploymentV1DeploymentServiceListDeployments2Request generates requests for DeploymentV1DeploymentServiceListDeployments2
-func NewDeploymentV1DeploymentServiceListDeployments2Request(server string, params *DeploymentV1DeploymentServiceListDeployments2Params) (*http.Request, error) {
-	var err error
+func (c *Client) DeploymentV1DeploymentServiceListDeployments(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceListDeploymentsRequest(c.Server, projectName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *Client) DeploymentV1DeploymentServiceCreateDeploymentWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(c.Server, projectName, contentType, body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments")
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *Client) DeploymentV1DeploymentServiceCreateDeployment(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceCreateDeploymentRequest(c.Server, projectName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
 	}
+	return c.Client.Do(req)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *Client) DeploymentV1DeploymentServiceListDeploymentsPerCluster(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest(c.Server, projectName, clusterId, params)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+func (c *Client) DeploymentV1DeploymentServiceDeleteDeployment(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceDeleteDeploymentRequest(c.Server, projectName, deplId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Labels != nil {
+func (c *Client) DeploymentV1DeploymentServiceGetDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDeploymentRequest(c.Server, projectName, deplId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) DeploymentV1DeploymentServiceUpdateDeploymentWithBody(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(c.Server, projectName, deplId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeploymentV1DeploymentServiceUpdateDeployment(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceUpdateDeploymentRequest(c.Server, projectName, deplId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.OrderBy != nil {
+func (c *Client) DeploymentV1DeploymentServiceAbortDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceAbortDeploymentRequest(c.Server, projectName, deplId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) DeploymentV1DeploymentServiceListDeploymentClusters(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceListDeploymentClustersRequest(c.Server, projectName, deplId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeploymentV1DeploymentServiceExportOfflineBundle(ctx context.Context, projectName string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundleParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceExportOfflineBundleRequest(c.Server, projectName, deplId, clusterId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Filter != nil {
+func (c *Client) DeploymentV1DeploymentServiceGetDependencyGraph(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDependencyGraphRequest(c.Server, projectName, deplId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) DeploymentV1DeploymentServiceGetDeploymentDrift(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDriftParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDeploymentDriftRequest(c.Server, projectName, deplId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeploymentV1DeploymentServicePromoteDeployment(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServicePromoteDeploymentRequest(c.Server, projectName, deplId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.PageSize != nil {
+func (c *Client) DeploymentV1ClusterServiceExportInventory(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceExportInventoryParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServiceExportInventoryRequest(c.Server, projectName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *Client) DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest(c.Server, projectName, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		}
+func (c *Client) DeploymentV1ClusterServicePreviewTargetsWithBody(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewTargetsRequestWithBody(c.Server, projectName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-		if params.Offset != nil {
+func (c *Client) DeploymentV1ClusterServicePreviewTargets(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeploymentV1ClusterServicePreviewTargetsRequest(c.Server, projectName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+// NewDeploymentV1ClusterServiceListClusterGroups2Request generates requests for DeploymentV1ClusterServiceListClusterGroups2
+func NewDeploymentV1ClusterServiceListClusterGroups2Request(server string, params *DeploymentV1ClusterServiceListClusterGroups2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -791,6 +1164,50 @@ func NewDeploymentV1DeploymentServiceListDeployments2Request(server string, para
 
 		}
 
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceCreateClusterGroup2Request calls the generic DeploymentV1ClusterServiceCreateClusterGroup2 builder with application/json body
+func NewDeploymentV1ClusterServiceCreateClusterGroup2Request(server string, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, body DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServiceCreateClusterGroup2RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServiceCreateClusterGroup2RequestWithBody generates requests for DeploymentV1ClusterServiceCreateClusterGroup2 with any type of body
+func NewDeploymentV1ClusterServiceCreateClusterGroup2RequestWithBody(server string, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
 		if params.ProjectName != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
@@ -810,27 +1227,29 @@ func NewDeploymentV1DeploymentServiceListDeployments2Request(server string, para
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceCreateDeployment2Request calls the generic DeploymentV1DeploymentServiceCreateDeployment2 builder with application/json body
-func NewDeploymentV1DeploymentServiceCreateDeployment2Request(server string, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody) (*http.Request, error) {
+// NewDeploymentV1ClusterServicePreviewClusterGroup2Request calls the generic DeploymentV1ClusterServicePreviewClusterGroup2 builder with application/json body
+func NewDeploymentV1ClusterServicePreviewClusterGroup2Request(server string, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, body DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server, params, "application/json", bodyReader)
+	return NewDeploymentV1ClusterServicePreviewClusterGroup2RequestWithBody(server, params, "application/json", bodyReader)
 }
 
-// NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody generates requests for DeploymentV1DeploymentServiceCreateDeployment2 with any type of body
-func NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server string, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeploymentV1ClusterServicePreviewClusterGroup2RequestWithBody generates requests for DeploymentV1ClusterServicePreviewClusterGroup2 with any type of body
+func NewDeploymentV1ClusterServicePreviewClusterGroup2RequestWithBody(server string, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	serverURL, err := url.Parse(server)
@@ -838,7 +1257,7 @@ func NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server str
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments")
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups/preview")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -880,13 +1299,13 @@ func NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server str
 	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request generates requests for DeploymentV1DeploymentServiceListDeploymentsPerCluster2
-func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceDeleteClusterGroup2Request generates requests for DeploymentV1ClusterServiceDeleteClusterGroup2
+func NewDeploymentV1ClusterServiceDeleteClusterGroup2Request(server string, name string, params *DeploymentV1ClusterServiceDeleteClusterGroup2Params) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -896,7 +1315,7 @@ func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server st
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/clusters/%s", pathParam0)
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -909,9 +1328,9 @@ func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server st
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Labels != nil {
+		if params.ProjectName != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -925,90 +1344,10 @@ func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server st
 
 		}
 
-		if params.OrderBy != nil {
+		queryURL.RawQuery = queryValues.Encode()
+	}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.ProjectName != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1016,13 +1355,13 @@ func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server st
 	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceDeleteDeployment2Request generates requests for DeploymentV1DeploymentServiceDeleteDeployment2
-func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceGetClusterGroup2Request generates requests for DeploymentV1ClusterServiceGetClusterGroup2
+func NewDeploymentV1ClusterServiceGetClusterGroup2Request(server string, name string, params *DeploymentV1ClusterServiceGetClusterGroup2Params) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1032,7 +1371,7 @@ func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, dep
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1045,18 +1384,6 @@ func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, dep
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleteType", runtime.ParamLocationQuery, params.DeleteType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
-
 		if params.ProjectName != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
@@ -1076,7 +1403,7 @@ func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, dep
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1084,13 +1411,24 @@ func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, dep
 	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceGetDeployment2Request generates requests for DeploymentV1DeploymentServiceGetDeployment2
-func NewDeploymentV1DeploymentServiceGetDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceUpdateClusterGroup2Request calls the generic DeploymentV1ClusterServiceUpdateClusterGroup2 builder with application/json body
+func NewDeploymentV1ClusterServiceUpdateClusterGroup2Request(server string, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, body DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServiceUpdateClusterGroup2RequestWithBody(server, name, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServiceUpdateClusterGroup2RequestWithBody generates requests for DeploymentV1ClusterServiceUpdateClusterGroup2 with any type of body
+func NewDeploymentV1ClusterServiceUpdateClusterGroup2RequestWithBody(server string, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
 	if err != nil {
 		return nil, err
 	}
@@ -1100,7 +1438,7 @@ func NewDeploymentV1DeploymentServiceGetDeployment2Request(server string, deplId
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/cluster-groups/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1132,42 +1470,26 @@ func NewDeploymentV1DeploymentServiceGetDeployment2Request(server string, deplId
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
+	req.Header.Add("Content-Type", contentType)
 
-// NewDeploymentV1DeploymentServiceUpdateDeployment2Request calls the generic DeploymentV1DeploymentServiceUpdateDeployment2 builder with application/json body
-func NewDeploymentV1DeploymentServiceUpdateDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
-	if err != nil {
-		return nil, err
-	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server, deplId, params, "application/json", bodyReader)
+	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody generates requests for DeploymentV1DeploymentServiceUpdateDeployment2 with any type of body
-func NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server string, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceListClusters2Request generates requests for DeploymentV1ClusterServiceListClusters2
+func NewDeploymentV1ClusterServiceListClusters2Request(server string, params *DeploymentV1ClusterServiceListClusters2Params) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1180,9 +1502,9 @@ func NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server str
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.ProjectName != nil {
+		if params.Labels != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1196,48 +1518,6 @@ func NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server str
 
 		}
 
-		queryURL.RawQuery = queryValues.Encode()
-	}
-
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
-}
-
-// NewDeploymentV1DeploymentServiceListDeploymentClusters2Request generates requests for DeploymentV1DeploymentServiceListDeploymentClusters2
-func NewDeploymentV1DeploymentServiceListDeploymentClusters2Request(server string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
-	if err != nil {
-		return nil, err
-	}
-
-	serverURL, err := url.Parse(server)
-	if err != nil {
-		return nil, err
-	}
-
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/clusters", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
-	}
-
-	queryURL, err := serverURL.Parse(operationPath)
-	if err != nil {
-		return nil, err
-	}
-
-	if params != nil {
-		queryValues := queryURL.Query()
-
 		if params.OrderBy != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
@@ -1329,16 +1609,23 @@ func NewDeploymentV1DeploymentServiceListDeploymentClusters2Request(server strin
 	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request generates requests for DeploymentV1DeploymentServiceGetDeploymentsStatus2
-func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceGetCluster2Request generates requests for DeploymentV1ClusterServiceGetCluster2
+func NewDeploymentV1ClusterServiceGetCluster2Request(server string, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/summary/deployments_status")
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1351,9 +1638,9 @@ func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string,
 	if params != nil {
 		queryValues := queryURL.Query()
 
-		if params.Labels != nil {
+		if params.ProjectName != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
 				return nil, err
 			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
@@ -1367,6 +1654,46 @@ func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string,
 
 		}
 
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceCordonCluster2Request generates requests for DeploymentV1ClusterServiceCordonCluster2
+func NewDeploymentV1ClusterServiceCordonCluster2Request(server string, clusterId string, params *DeploymentV1ClusterServiceCordonCluster2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s/cordon", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
 		if params.ProjectName != nil {
 
 			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
@@ -1386,7 +1713,7 @@ func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string,
 		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1394,27 +1721,23 @@ func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string,
 	return req, nil
 }
 
-// NewDeploymentV1ClusterServiceGetKubeConfigRequest calls the generic DeploymentV1ClusterServiceGetKubeConfig builder with application/json body
-func NewDeploymentV1ClusterServiceGetKubeConfigRequest(server string, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewDeploymentV1ClusterServiceDrainCluster2Request generates requests for DeploymentV1ClusterServiceDrainCluster2
+func NewDeploymentV1ClusterServiceDrainCluster2Request(server string, clusterId string, params *DeploymentV1ClusterServiceDrainCluster2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(server, params, "application/json", bodyReader)
-}
-
-// NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody generates requests for DeploymentV1ClusterServiceGetKubeConfig with any type of body
-func NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(server string, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
 
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.v1.ClusterService/GetKubeConfig")
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s/drain", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1424,61 +1747,53 @@ func NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(server string, pa
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
-	if err != nil {
-		return nil, err
-	}
-
-	req.Header.Add("Content-Type", contentType)
-
 	if params != nil {
+		queryValues := queryURL.Query()
 
-		var headerParam0 string
-
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Connect-Protocol-Version", runtime.ParamLocationHeader, params.ConnectProtocolVersion)
-		if err != nil {
-			return nil, err
-		}
-
-		req.Header.Set("Connect-Protocol-Version", headerParam0)
-
-		if params.ConnectTimeoutMs != nil {
-			var headerParam1 string
+		if params.ProjectName != nil {
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Connect-Timeout-Ms", runtime.ParamLocationHeader, *params.ConnectTimeoutMs)
-			if err != nil {
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
 				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
 			}
 
-			req.Header.Set("Connect-Timeout-Ms", headerParam1)
 		}
 
+		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	return req, nil
-}
-
-// NewDeploymentV1DeploymentServiceGetAppNamespaceRequest calls the generic DeploymentV1DeploymentServiceGetAppNamespace builder with application/json body
-func NewDeploymentV1DeploymentServiceGetAppNamespaceRequest(server string, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(server, params, "application/json", bodyReader)
+
+	return req, nil
 }
 
-// NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody generates requests for DeploymentV1DeploymentServiceGetAppNamespace with any type of body
-func NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(server string, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader) (*http.Request, error) {
+// NewDeploymentV1ClusterServiceGetClusterInventory2Request generates requests for DeploymentV1ClusterServiceGetClusterInventory2
+func NewDeploymentV1ClusterServiceGetClusterInventory2Request(server string, clusterId string, params *DeploymentV1ClusterServiceGetClusterInventory2Params) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/deployment.v1.DeploymentService/GetAppNamespace")
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s/inventory", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1488,57 +1803,102 @@ func NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(server strin
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", contentType)
+	return req, nil
+}
 
-	if params != nil {
+// NewDeploymentV1ClusterServiceUncordonCluster2Request generates requests for DeploymentV1ClusterServiceUncordonCluster2
+func NewDeploymentV1ClusterServiceUncordonCluster2Request(server string, clusterId string, params *DeploymentV1ClusterServiceUncordonCluster2Params) (*http.Request, error) {
+	var err error
 
-		var headerParam0 string
+	var pathParam0 string
 
-		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Connect-Protocol-Version", runtime.ParamLocationHeader, params.ConnectProtocolVersion)
-		if err != nil {
-			return nil, err
-		}
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
 
-		req.Header.Set("Connect-Protocol-Version", headerParam0)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-		if params.ConnectTimeoutMs != nil {
-			var headerParam1 string
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/clusters/%s/uncordon", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Connect-Timeout-Ms", runtime.ParamLocationHeader, *params.ConnectTimeoutMs)
-			if err != nil {
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
 				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
 			}
 
-			req.Header.Set("Connect-Timeout-Ms", headerParam1)
 		}
 
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
 	}
 
 	return req, nil
 }
 
-// NewDeploymentV1ClusterServiceListClustersRequest generates requests for DeploymentV1ClusterServiceListClusters
-func NewDeploymentV1ClusterServiceListClustersRequest(server string, projectName string, params *DeploymentV1ClusterServiceListClustersParams) (*http.Request, error) {
+// NewDeploymentV1DeploymentServiceListDeployments2Request generates requests for DeploymentV1DeploymentServiceListDeployments2
+func NewDeploymentV1DeploymentServiceListDeployments2Request(server string, params *DeploymentV1DeploymentServiceListDeployments2Params) (*http.Request, error) {
 	var err error
 
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters", pathParam0)
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments")
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -1601,1573 +1961,5732 @@ func NewDeploymentV1ClusterServiceListClustersRequest(server string, projectName
 
 		if params.PageSize != nil {
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceCreateDeployment2Request calls the generic DeploymentV1DeploymentServiceCreateDeployment2 builder with application/json body
+func NewDeploymentV1DeploymentServiceCreateDeployment2Request(server string, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody generates requests for DeploymentV1DeploymentServiceCreateDeployment2 with any type of body
+func NewDeploymentV1DeploymentServiceCreateDeployment2RequestWithBody(server string, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request generates requests for DeploymentV1DeploymentServiceListDeploymentsPerCluster2
+func NewDeploymentV1DeploymentServiceListDeploymentsPerCluster2Request(server string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/clusters/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceDeleteDeployment2Request generates requests for DeploymentV1DeploymentServiceDeleteDeployment2
+func NewDeploymentV1DeploymentServiceDeleteDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleteType", runtime.ParamLocationQuery, params.DeleteType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeployment2Request generates requests for DeploymentV1DeploymentServiceGetDeployment2
+func NewDeploymentV1DeploymentServiceGetDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceUpdateDeployment2Request calls the generic DeploymentV1DeploymentServiceUpdateDeployment2 builder with application/json body
+func NewDeploymentV1DeploymentServiceUpdateDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server, deplId, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody generates requests for DeploymentV1DeploymentServiceUpdateDeployment2 with any type of body
+func NewDeploymentV1DeploymentServiceUpdateDeployment2RequestWithBody(server string, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceAbortDeployment2Request generates requests for DeploymentV1DeploymentServiceAbortDeployment2
+func NewDeploymentV1DeploymentServiceAbortDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServiceAbortDeployment2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/abort", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceListDeploymentClusters2Request generates requests for DeploymentV1DeploymentServiceListDeploymentClusters2
+func NewDeploymentV1DeploymentServiceListDeploymentClusters2Request(server string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceExportOfflineBundle2Request generates requests for DeploymentV1DeploymentServiceExportOfflineBundle2
+func NewDeploymentV1DeploymentServiceExportOfflineBundle2Request(server string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundle2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/clusters/%s/offline-bundle", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeImages != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeImages", runtime.ParamLocationQuery, *params.IncludeImages); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDependencyGraph2Request generates requests for DeploymentV1DeploymentServiceGetDependencyGraph2
+func NewDeploymentV1DeploymentServiceGetDependencyGraph2Request(server string, deplId string, params *DeploymentV1DeploymentServiceGetDependencyGraph2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/dependencies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeploymentDrift2Request generates requests for DeploymentV1DeploymentServiceGetDeploymentDrift2
+func NewDeploymentV1DeploymentServiceGetDeploymentDrift2Request(server string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDrift2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/drift", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, *params.ClusterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServicePromoteDeployment2Request generates requests for DeploymentV1DeploymentServicePromoteDeployment2
+func NewDeploymentV1DeploymentServicePromoteDeployment2Request(server string, deplId string, params *DeploymentV1DeploymentServicePromoteDeployment2Params) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/deployments/%s/promote", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceExportInventory2Request generates requests for DeploymentV1ClusterServiceExportInventory2
+func NewDeploymentV1ClusterServiceExportInventory2Request(server string, params *DeploymentV1ClusterServiceExportInventory2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/inventory/export")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request generates requests for DeploymentV1DeploymentServiceGetDeploymentsStatus2
+func NewDeploymentV1DeploymentServiceGetDeploymentsStatus2Request(server string, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/summary/deployments_status")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ProjectName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "projectName", runtime.ParamLocationQuery, *params.ProjectName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServicePreviewTargets2Request calls the generic DeploymentV1ClusterServicePreviewTargets2 builder with application/json body
+func NewDeploymentV1ClusterServicePreviewTargets2Request(server string, body DeploymentV1ClusterServicePreviewTargets2JSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServicePreviewTargets2RequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServicePreviewTargets2RequestWithBody generates requests for DeploymentV1ClusterServicePreviewTargets2 with any type of body
+func NewDeploymentV1ClusterServicePreviewTargets2RequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.orchestrator.apis/v1/targets/preview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceGetKubeConfigRequest calls the generic DeploymentV1ClusterServiceGetKubeConfig builder with application/json body
+func NewDeploymentV1ClusterServiceGetKubeConfigRequest(server string, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody generates requests for DeploymentV1ClusterServiceGetKubeConfig with any type of body
+func NewDeploymentV1ClusterServiceGetKubeConfigRequestWithBody(server string, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.v1.ClusterService/GetKubeConfig")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Connect-Protocol-Version", runtime.ParamLocationHeader, params.ConnectProtocolVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Connect-Protocol-Version", headerParam0)
+
+		if params.ConnectTimeoutMs != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Connect-Timeout-Ms", runtime.ParamLocationHeader, *params.ConnectTimeoutMs)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Connect-Timeout-Ms", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetAppNamespaceRequest calls the generic DeploymentV1DeploymentServiceGetAppNamespace builder with application/json body
+func NewDeploymentV1DeploymentServiceGetAppNamespaceRequest(server string, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(server, params, "application/json", bodyReader)
+}
+
+// NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody generates requests for DeploymentV1DeploymentServiceGetAppNamespace with any type of body
+func NewDeploymentV1DeploymentServiceGetAppNamespaceRequestWithBody(server string, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/deployment.v1.DeploymentService/GetAppNamespace")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		var headerParam0 string
+
+		headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Connect-Protocol-Version", runtime.ParamLocationHeader, params.ConnectProtocolVersion)
+		if err != nil {
+			return nil, err
+		}
+
+		req.Header.Set("Connect-Protocol-Version", headerParam0)
+
+		if params.ConnectTimeoutMs != nil {
+			var headerParam1 string
+
+			headerParam1, err = runtime.StyleParamWithLocation("simple", false, "Connect-Timeout-Ms", runtime.ParamLocationHeader, *params.ConnectTimeoutMs)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Connect-Timeout-Ms", headerParam1)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceListClusterGroupsRequest generates requests for DeploymentV1ClusterServiceListClusterGroups
+func NewDeploymentV1ClusterServiceListClusterGroupsRequest(server string, projectName string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceCreateClusterGroupRequest calls the generic DeploymentV1ClusterServiceCreateClusterGroup builder with application/json body
+func NewDeploymentV1ClusterServiceCreateClusterGroupRequest(server string, projectName string, body DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServiceCreateClusterGroupRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServiceCreateClusterGroupRequestWithBody generates requests for DeploymentV1ClusterServiceCreateClusterGroup with any type of body
+func NewDeploymentV1ClusterServiceCreateClusterGroupRequestWithBody(server string, projectName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServicePreviewClusterGroupRequest calls the generic DeploymentV1ClusterServicePreviewClusterGroup builder with application/json body
+func NewDeploymentV1ClusterServicePreviewClusterGroupRequest(server string, projectName string, body DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServicePreviewClusterGroupRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServicePreviewClusterGroupRequestWithBody generates requests for DeploymentV1ClusterServicePreviewClusterGroup with any type of body
+func NewDeploymentV1ClusterServicePreviewClusterGroupRequestWithBody(server string, projectName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceDeleteClusterGroupRequest generates requests for DeploymentV1ClusterServiceDeleteClusterGroup
+func NewDeploymentV1ClusterServiceDeleteClusterGroupRequest(server string, projectName string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceGetClusterGroupRequest generates requests for DeploymentV1ClusterServiceGetClusterGroup
+func NewDeploymentV1ClusterServiceGetClusterGroupRequest(server string, projectName string, name string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceUpdateClusterGroupRequest calls the generic DeploymentV1ClusterServiceUpdateClusterGroup builder with application/json body
+func NewDeploymentV1ClusterServiceUpdateClusterGroupRequest(server string, projectName string, name string, body DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServiceUpdateClusterGroupRequestWithBody(server, projectName, name, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServiceUpdateClusterGroupRequestWithBody generates requests for DeploymentV1ClusterServiceUpdateClusterGroup with any type of body
+func NewDeploymentV1ClusterServiceUpdateClusterGroupRequestWithBody(server string, projectName string, name string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "name", runtime.ParamLocationPath, name)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/cluster-groups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceListClustersRequest generates requests for DeploymentV1ClusterServiceListClusters
+func NewDeploymentV1ClusterServiceListClustersRequest(server string, projectName string, params *DeploymentV1ClusterServiceListClustersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceGetClusterRequest generates requests for DeploymentV1ClusterServiceGetCluster
+func NewDeploymentV1ClusterServiceGetClusterRequest(server string, projectName string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceCordonClusterRequest generates requests for DeploymentV1ClusterServiceCordonCluster
+func NewDeploymentV1ClusterServiceCordonClusterRequest(server string, projectName string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s/cordon", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceDrainClusterRequest generates requests for DeploymentV1ClusterServiceDrainCluster
+func NewDeploymentV1ClusterServiceDrainClusterRequest(server string, projectName string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s/drain", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceGetClusterInventoryRequest generates requests for DeploymentV1ClusterServiceGetClusterInventory
+func NewDeploymentV1ClusterServiceGetClusterInventoryRequest(server string, projectName string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s/inventory", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceUncordonClusterRequest generates requests for DeploymentV1ClusterServiceUncordonCluster
+func NewDeploymentV1ClusterServiceUncordonClusterRequest(server string, projectName string, clusterId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s/uncordon", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceListDeploymentsRequest generates requests for DeploymentV1DeploymentServiceListDeployments
+func NewDeploymentV1DeploymentServiceListDeploymentsRequest(server string, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceCreateDeploymentRequest calls the generic DeploymentV1DeploymentServiceCreateDeployment builder with application/json body
+func NewDeploymentV1DeploymentServiceCreateDeploymentRequest(server string, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody generates requests for DeploymentV1DeploymentServiceCreateDeployment with any type of body
+func NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(server string, projectName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest generates requests for DeploymentV1DeploymentServiceListDeploymentsPerCluster
+func NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest(server string, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/clusters/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceDeleteDeploymentRequest generates requests for DeploymentV1DeploymentServiceDeleteDeployment
+func NewDeploymentV1DeploymentServiceDeleteDeploymentRequest(server string, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleteType", runtime.ParamLocationQuery, params.DeleteType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeploymentRequest generates requests for DeploymentV1DeploymentServiceGetDeployment
+func NewDeploymentV1DeploymentServiceGetDeploymentRequest(server string, projectName string, deplId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceUpdateDeploymentRequest calls the generic DeploymentV1DeploymentServiceUpdateDeployment builder with application/json body
+func NewDeploymentV1DeploymentServiceUpdateDeploymentRequest(server string, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(server, projectName, deplId, "application/json", bodyReader)
+}
+
+// NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody generates requests for DeploymentV1DeploymentServiceUpdateDeployment with any type of body
+func NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(server string, projectName string, deplId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceAbortDeploymentRequest generates requests for DeploymentV1DeploymentServiceAbortDeployment
+func NewDeploymentV1DeploymentServiceAbortDeploymentRequest(server string, projectName string, deplId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/abort", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceListDeploymentClustersRequest generates requests for DeploymentV1DeploymentServiceListDeploymentClusters
+func NewDeploymentV1DeploymentServiceListDeploymentClustersRequest(server string, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/clusters", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.OrderBy != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Filter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PageSize != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Offset != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceExportOfflineBundleRequest generates requests for DeploymentV1DeploymentServiceExportOfflineBundle
+func NewDeploymentV1DeploymentServiceExportOfflineBundleRequest(server string, projectName string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundleParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/clusters/%s/offline-bundle", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.IncludeImages != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "includeImages", runtime.ParamLocationQuery, *params.IncludeImages); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDependencyGraphRequest generates requests for DeploymentV1DeploymentServiceGetDependencyGraph
+func NewDeploymentV1DeploymentServiceGetDependencyGraphRequest(server string, projectName string, deplId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/dependencies", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeploymentDriftRequest generates requests for DeploymentV1DeploymentServiceGetDeploymentDrift
+func NewDeploymentV1DeploymentServiceGetDeploymentDriftRequest(server string, projectName string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDriftParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/drift", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ClusterId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "clusterId", runtime.ParamLocationQuery, *params.ClusterId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServicePromoteDeploymentRequest generates requests for DeploymentV1DeploymentServicePromoteDeployment
+func NewDeploymentV1DeploymentServicePromoteDeploymentRequest(server string, projectName string, deplId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/promote", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServiceExportInventoryRequest generates requests for DeploymentV1ClusterServiceExportInventory
+func NewDeploymentV1ClusterServiceExportInventoryRequest(server string, projectName string, params *DeploymentV1ClusterServiceExportInventoryParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/inventory/export", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Format != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "format", runtime.ParamLocationQuery, *params.Format); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest generates requests for DeploymentV1DeploymentServiceGetDeploymentsStatus
+func NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest(server string, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/summary/deployments_status", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Labels != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeploymentV1ClusterServicePreviewTargetsRequest calls the generic DeploymentV1ClusterServicePreviewTargets builder with application/json body
+func NewDeploymentV1ClusterServicePreviewTargetsRequest(server string, projectName string, body DeploymentV1ClusterServicePreviewTargetsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewDeploymentV1ClusterServicePreviewTargetsRequestWithBody(server, projectName, "application/json", bodyReader)
+}
+
+// NewDeploymentV1ClusterServicePreviewTargetsRequestWithBody generates requests for DeploymentV1ClusterServicePreviewTargets with any type of body
+func NewDeploymentV1ClusterServicePreviewTargetsRequestWithBody(server string, projectName string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/targets/preview", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// DeploymentV1ClusterServiceListClusterGroups2WithResponse request
+	DeploymentV1ClusterServiceListClusterGroups2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusterGroups2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusterGroups2Response, error)
+
+	// DeploymentV1ClusterServiceCreateClusterGroup2WithBodyWithResponse request with any body
+	DeploymentV1ClusterServiceCreateClusterGroup2WithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroup2Response, error)
+
+	DeploymentV1ClusterServiceCreateClusterGroup2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, body DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroup2Response, error)
+
+	// DeploymentV1ClusterServicePreviewClusterGroup2WithBodyWithResponse request with any body
+	DeploymentV1ClusterServicePreviewClusterGroup2WithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroup2Response, error)
+
+	DeploymentV1ClusterServicePreviewClusterGroup2WithResponse(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, body DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroup2Response, error)
+
+	// DeploymentV1ClusterServiceDeleteClusterGroup2WithResponse request
+	DeploymentV1ClusterServiceDeleteClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceDeleteClusterGroup2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDeleteClusterGroup2Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterGroup2WithResponse request
+	DeploymentV1ClusterServiceGetClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceGetClusterGroup2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterGroup2Response, error)
+
+	// DeploymentV1ClusterServiceUpdateClusterGroup2WithBodyWithResponse request with any body
+	DeploymentV1ClusterServiceUpdateClusterGroup2WithBodyWithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroup2Response, error)
+
+	DeploymentV1ClusterServiceUpdateClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, body DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroup2Response, error)
+
+	// DeploymentV1ClusterServiceListClusters2WithResponse request
+	DeploymentV1ClusterServiceListClusters2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusters2Response, error)
+
+	// DeploymentV1ClusterServiceGetCluster2WithResponse request
+	DeploymentV1ClusterServiceGetCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetCluster2Response, error)
+
+	// DeploymentV1ClusterServiceCordonCluster2WithResponse request
+	DeploymentV1ClusterServiceCordonCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceCordonCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCordonCluster2Response, error)
+
+	// DeploymentV1ClusterServiceDrainCluster2WithResponse request
+	DeploymentV1ClusterServiceDrainCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceDrainCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDrainCluster2Response, error)
+
+	// DeploymentV1ClusterServiceGetClusterInventory2WithResponse request
+	DeploymentV1ClusterServiceGetClusterInventory2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetClusterInventory2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterInventory2Response, error)
+
+	// DeploymentV1ClusterServiceUncordonCluster2WithResponse request
+	DeploymentV1ClusterServiceUncordonCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceUncordonCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUncordonCluster2Response, error)
+
+	// DeploymentV1DeploymentServiceListDeployments2WithResponse request
+	DeploymentV1DeploymentServiceListDeployments2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeployments2Response, error)
+
+	// DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse request with any body
+	DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error)
+
+	DeploymentV1DeploymentServiceCreateDeployment2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error)
+
+	// DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse request
+	DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error)
+
+	// DeploymentV1DeploymentServiceDeleteDeployment2WithResponse request
+	DeploymentV1DeploymentServiceDeleteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error)
+
+	// DeploymentV1DeploymentServiceGetDeployment2WithResponse request
+	DeploymentV1DeploymentServiceGetDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeployment2Response, error)
+
+	// DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse request with any body
+	DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error)
+
+	DeploymentV1DeploymentServiceUpdateDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error)
+
+	// DeploymentV1DeploymentServiceAbortDeployment2WithResponse request
+	DeploymentV1DeploymentServiceAbortDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceAbortDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceAbortDeployment2Response, error)
+
+	// DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse request
+	DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error)
+
+	// DeploymentV1DeploymentServiceExportOfflineBundle2WithResponse request
+	DeploymentV1DeploymentServiceExportOfflineBundle2WithResponse(ctx context.Context, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundle2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceExportOfflineBundle2Response, error)
+
+	// DeploymentV1DeploymentServiceGetDependencyGraph2WithResponse request
+	DeploymentV1DeploymentServiceGetDependencyGraph2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDependencyGraph2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDependencyGraph2Response, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentDrift2WithResponse request
+	DeploymentV1DeploymentServiceGetDeploymentDrift2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDrift2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentDrift2Response, error)
+
+	// DeploymentV1DeploymentServicePromoteDeployment2WithResponse request
+	DeploymentV1DeploymentServicePromoteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServicePromoteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServicePromoteDeployment2Response, error)
+
+	// DeploymentV1ClusterServiceExportInventory2WithResponse request
+	DeploymentV1ClusterServiceExportInventory2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceExportInventory2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceExportInventory2Response, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse request
+	DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error)
+
+	// DeploymentV1ClusterServicePreviewTargets2WithBodyWithResponse request with any body
+	DeploymentV1ClusterServicePreviewTargets2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargets2Response, error)
+
+	DeploymentV1ClusterServicePreviewTargets2WithResponse(ctx context.Context, body DeploymentV1ClusterServicePreviewTargets2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargets2Response, error)
+
+	// DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse request with any body
+	DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error)
+
+	DeploymentV1ClusterServiceGetKubeConfigWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error)
+
+	// DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse request with any body
+	DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error)
+
+	DeploymentV1DeploymentServiceGetAppNamespaceWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error)
+
+	// DeploymentV1ClusterServiceListClusterGroupsWithResponse request
+	DeploymentV1ClusterServiceListClusterGroupsWithResponse(ctx context.Context, projectName string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusterGroupsResponse, error)
+
+	// DeploymentV1ClusterServiceCreateClusterGroupWithBodyWithResponse request with any body
+	DeploymentV1ClusterServiceCreateClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroupResponse, error)
+
+	DeploymentV1ClusterServiceCreateClusterGroupWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroupResponse, error)
+
+	// DeploymentV1ClusterServicePreviewClusterGroupWithBodyWithResponse request with any body
+	DeploymentV1ClusterServicePreviewClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroupResponse, error)
+
+	DeploymentV1ClusterServicePreviewClusterGroupWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroupResponse, error)
+
+	// DeploymentV1ClusterServiceDeleteClusterGroupWithResponse request
+	DeploymentV1ClusterServiceDeleteClusterGroupWithResponse(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDeleteClusterGroupResponse, error)
+
+	// DeploymentV1ClusterServiceGetClusterGroupWithResponse request
+	DeploymentV1ClusterServiceGetClusterGroupWithResponse(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterGroupResponse, error)
+
+	// DeploymentV1ClusterServiceUpdateClusterGroupWithBodyWithResponse request with any body
+	DeploymentV1ClusterServiceUpdateClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroupResponse, error)
+
+	DeploymentV1ClusterServiceUpdateClusterGroupWithResponse(ctx context.Context, projectName string, name string, body DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroupResponse, error)
+
+	// DeploymentV1ClusterServiceListClustersWithResponse request
+	DeploymentV1ClusterServiceListClustersWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClustersResponse, error)
+
+	// DeploymentV1ClusterServiceGetClusterWithResponse request
+	DeploymentV1ClusterServiceGetClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterResponse, error)
+
+	// DeploymentV1ClusterServiceCordonClusterWithResponse request
+	DeploymentV1ClusterServiceCordonClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCordonClusterResponse, error)
+
+	// DeploymentV1ClusterServiceDrainClusterWithResponse request
+	DeploymentV1ClusterServiceDrainClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDrainClusterResponse, error)
+
+	// DeploymentV1ClusterServiceGetClusterInventoryWithResponse request
+	DeploymentV1ClusterServiceGetClusterInventoryWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterInventoryResponse, error)
+
+	// DeploymentV1ClusterServiceUncordonClusterWithResponse request
+	DeploymentV1ClusterServiceUncordonClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUncordonClusterResponse, error)
+
+	// DeploymentV1DeploymentServiceListDeploymentsWithResponse request
+	DeploymentV1DeploymentServiceListDeploymentsWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error)
+
+	// DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse request with any body
+	DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error)
+
+	DeploymentV1DeploymentServiceCreateDeploymentWithResponse(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error)
+
+	// DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse request
+	DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error)
+
+	// DeploymentV1DeploymentServiceDeleteDeploymentWithResponse request
+	DeploymentV1DeploymentServiceDeleteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentWithResponse request
+	DeploymentV1DeploymentServiceGetDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error)
+
+	// DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse request with any body
+	DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error)
+
+	DeploymentV1DeploymentServiceUpdateDeploymentWithResponse(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error)
+
+	// DeploymentV1DeploymentServiceAbortDeploymentWithResponse request
+	DeploymentV1DeploymentServiceAbortDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceAbortDeploymentResponse, error)
+
+	// DeploymentV1DeploymentServiceListDeploymentClustersWithResponse request
+	DeploymentV1DeploymentServiceListDeploymentClustersWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error)
+
+	// DeploymentV1DeploymentServiceExportOfflineBundleWithResponse request
+	DeploymentV1DeploymentServiceExportOfflineBundleWithResponse(ctx context.Context, projectName string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundleParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceExportOfflineBundleResponse, error)
+
+	// DeploymentV1DeploymentServiceGetDependencyGraphWithResponse request
+	DeploymentV1DeploymentServiceGetDependencyGraphWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDependencyGraphResponse, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentDriftWithResponse request
+	DeploymentV1DeploymentServiceGetDeploymentDriftWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDriftParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentDriftResponse, error)
+
+	// DeploymentV1DeploymentServicePromoteDeploymentWithResponse request
+	DeploymentV1DeploymentServicePromoteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServicePromoteDeploymentResponse, error)
+
+	// DeploymentV1ClusterServiceExportInventoryWithResponse request
+	DeploymentV1ClusterServiceExportInventoryWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceExportInventoryParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceExportInventoryResponse, error)
+
+	// DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse request
+	DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatusResponse, error)
+
+	// DeploymentV1ClusterServicePreviewTargetsWithBodyWithResponse request with any body
+	DeploymentV1ClusterServicePreviewTargetsWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargetsResponse, error)
+
+	DeploymentV1ClusterServicePreviewTargetsWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargetsResponse, error)
+}
+
+type DeploymentV1ClusterServiceListClusterGroups2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListClusterGroupsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceListClusterGroups2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceListClusterGroups2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceCreateClusterGroup2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1CreateClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceCreateClusterGroup2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceCreateClusterGroup2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServicePreviewClusterGroup2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1PreviewClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServicePreviewClusterGroup2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServicePreviewClusterGroup2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceDeleteClusterGroup2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceDeleteClusterGroup2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceDeleteClusterGroup2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetClusterGroup2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetClusterGroup2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetClusterGroup2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceUpdateClusterGroup2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1UpdateClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceUpdateClusterGroup2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceUpdateClusterGroup2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceListClusters2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListClustersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceListClusters2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceListClusters2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetCluster2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetCluster2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetCluster2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceCordonCluster2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceCordonCluster2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceCordonCluster2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceDrainCluster2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceDrainCluster2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceDrainCluster2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetClusterInventory2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterInventoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetClusterInventory2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetClusterInventory2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceUncordonCluster2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceUncordonCluster2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceUncordonCluster2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeployments2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeployments2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeployments2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceCreateDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1CreateDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceCreateDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceCreateDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentsPerClusterResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceDeleteDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceDeleteDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceDeleteDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceUpdateDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1UpdateDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceUpdateDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceUpdateDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceAbortDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceAbortDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceAbortDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeploymentClusters2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentClustersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeploymentClusters2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeploymentClusters2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceExportOfflineBundle2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ExportOfflineBundleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceExportOfflineBundle2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceExportOfflineBundle2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDependencyGraph2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDependencyGraphResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDependencyGraph2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDependencyGraph2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDeploymentDrift2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentDriftResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeploymentDrift2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeploymentDrift2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServicePromoteDeployment2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServicePromoteDeployment2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServicePromoteDeployment2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceExportInventory2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ExportInventoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceExportInventory2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceExportInventory2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDeploymentsStatus2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentsStatusResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeploymentsStatus2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeploymentsStatus2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServicePreviewTargets2Response struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1PreviewTargetsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServicePreviewTargets2Response) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServicePreviewTargets2Response) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetKubeConfigResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetKubeConfigResponse
+	JSONDefault  *ConnectError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetKubeConfigResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetKubeConfigResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetAppNamespaceResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetAppNamespaceResponse
+	JSONDefault  *ConnectError
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetAppNamespaceResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetAppNamespaceResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceListClusterGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListClusterGroupsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceListClusterGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceListClusterGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceCreateClusterGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1CreateClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceCreateClusterGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceCreateClusterGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServicePreviewClusterGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1PreviewClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServicePreviewClusterGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServicePreviewClusterGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceDeleteClusterGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceDeleteClusterGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceDeleteClusterGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetClusterGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetClusterGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetClusterGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceUpdateClusterGroupResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1UpdateClusterGroupResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceUpdateClusterGroupResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceUpdateClusterGroupResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceListClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListClustersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceListClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceListClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceCordonClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceCordonClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceCordonClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceDrainClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceDrainClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceDrainClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceGetClusterInventoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetClusterInventoryResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceGetClusterInventoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceGetClusterInventoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1ClusterServiceUncordonClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceUncordonClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceUncordonClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeploymentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentsResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeploymentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeploymentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceCreateDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1CreateDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceCreateDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceCreateDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentsPerClusterResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceDeleteDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceDeleteDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceDeleteDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceUpdateDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1UpdateDeploymentResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceUpdateDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceUpdateDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceAbortDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceAbortDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceAbortDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceListDeploymentClustersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ListDeploymentClustersResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceListDeploymentClustersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceListDeploymentClustersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceExportOfflineBundleResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ExportOfflineBundleResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceExportOfflineBundleResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceExportOfflineBundleResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDependencyGraphResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDependencyGraphResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDependencyGraphResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDependencyGraphResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServiceGetDeploymentDriftResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentDriftResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeploymentDriftResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeploymentDriftResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeploymentV1DeploymentServicePromoteDeploymentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GoogleProtobufEmpty
+}
+
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServicePromoteDeploymentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServicePromoteDeploymentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeploymentV1ClusterServiceExportInventoryResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1ExportInventoryResponse
+}
 
-		if params.Offset != nil {
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServiceExportInventoryResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServiceExportInventoryResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-		}
+type DeploymentV1DeploymentServiceGetDeploymentsStatusResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1GetDeploymentsStatusResponse
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// Status returns HTTPResponse.Status
+func (r DeploymentV1DeploymentServiceGetDeploymentsStatusResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
 	}
+	return http.StatusText(0)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
-	if err != nil {
-		return nil, err
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1DeploymentServiceGetDeploymentsStatusResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
 	}
+	return 0
+}
 
-	return req, nil
+type DeploymentV1ClusterServicePreviewTargetsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *DeploymentV1PreviewTargetsResponse
 }
 
-// NewDeploymentV1ClusterServiceGetClusterRequest generates requests for DeploymentV1ClusterServiceGetCluster
-func NewDeploymentV1ClusterServiceGetClusterRequest(server string, projectName string, clusterId string) (*http.Request, error) {
-	var err error
+// Status returns HTTPResponse.Status
+func (r DeploymentV1ClusterServicePreviewTargetsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
 
-	var pathParam0 string
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeploymentV1ClusterServicePreviewTargetsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1ClusterServiceListClusterGroups2WithResponse request returning *DeploymentV1ClusterServiceListClusterGroups2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceListClusterGroups2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusterGroups2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusterGroups2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceListClusterGroups2(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceListClusterGroups2Response(rsp)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+// DeploymentV1ClusterServiceCreateClusterGroup2WithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceCreateClusterGroup2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCreateClusterGroup2WithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCreateClusterGroup2WithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceCreateClusterGroup2Response(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCreateClusterGroup2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceCreateClusterGroup2Params, body DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCreateClusterGroup2(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceCreateClusterGroup2Response(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1ClusterServicePreviewClusterGroup2WithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServicePreviewClusterGroup2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewClusterGroup2WithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewClusterGroup2WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewClusterGroup2Response(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewClusterGroup2WithResponse(ctx context.Context, params *DeploymentV1ClusterServicePreviewClusterGroup2Params, body DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewClusterGroup2(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewClusterGroup2Response(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// DeploymentV1ClusterServiceDeleteClusterGroup2WithResponse request returning *DeploymentV1ClusterServiceDeleteClusterGroup2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceDeleteClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceDeleteClusterGroup2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDeleteClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceDeleteClusterGroup2(ctx, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseDeploymentV1ClusterServiceDeleteClusterGroup2Response(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceListDeploymentsRequest generates requests for DeploymentV1DeploymentServiceListDeployments
-func NewDeploymentV1DeploymentServiceListDeploymentsRequest(server string, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1ClusterServiceGetClusterGroup2WithResponse request returning *DeploymentV1ClusterServiceGetClusterGroup2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceGetClusterGroup2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetClusterGroup2(ctx, name, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceGetClusterGroup2Response(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1ClusterServiceUpdateClusterGroup2WithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceUpdateClusterGroup2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUpdateClusterGroup2WithBodyWithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUpdateClusterGroup2WithBody(ctx, name, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceUpdateClusterGroup2Response(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUpdateClusterGroup2WithResponse(ctx context.Context, name string, params *DeploymentV1ClusterServiceUpdateClusterGroup2Params, body DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroup2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUpdateClusterGroup2(ctx, name, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceUpdateClusterGroup2Response(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1ClusterServiceListClusters2WithResponse request returning *DeploymentV1ClusterServiceListClusters2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceListClusters2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusters2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceListClusters2(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceListClusters2Response(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Labels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
+// DeploymentV1ClusterServiceGetCluster2WithResponse request returning *DeploymentV1ClusterServiceGetCluster2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetCluster2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetCluster2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceGetCluster2Response(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// DeploymentV1ClusterServiceCordonCluster2WithResponse request returning *DeploymentV1ClusterServiceCordonCluster2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCordonCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceCordonCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCordonCluster2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCordonCluster2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceCordonCluster2Response(rsp)
+}
 
-		}
+// DeploymentV1ClusterServiceDrainCluster2WithResponse request returning *DeploymentV1ClusterServiceDrainCluster2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceDrainCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceDrainCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDrainCluster2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceDrainCluster2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceDrainCluster2Response(rsp)
+}
 
-		if params.PageSize != nil {
+// DeploymentV1ClusterServiceGetClusterInventory2WithResponse request returning *DeploymentV1ClusterServiceGetClusterInventory2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterInventory2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetClusterInventory2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterInventory2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetClusterInventory2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceGetClusterInventory2Response(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+// DeploymentV1ClusterServiceUncordonCluster2WithResponse request returning *DeploymentV1ClusterServiceUncordonCluster2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUncordonCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceUncordonCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUncordonCluster2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUncordonCluster2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceUncordonCluster2Response(rsp)
+}
 
-		}
+// DeploymentV1DeploymentServiceListDeployments2WithResponse request returning *DeploymentV1DeploymentServiceListDeployments2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeployments2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeployments2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeployments2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1DeploymentServiceListDeployments2Response(rsp)
+}
 
-		if params.Offset != nil {
+// DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceCreateDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment2WithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp)
+}
 
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeployment2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment2(ctx, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp)
+}
 
-		}
+// DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentsPerCluster2(ctx, clusterId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response(rsp)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// DeploymentV1DeploymentServiceDeleteDeployment2WithResponse request returning *DeploymentV1DeploymentServiceDeleteDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceDeleteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceDeleteDeployment2(ctx, deplId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceDeleteDeployment2Response(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// DeploymentV1DeploymentServiceGetDeployment2WithResponse request returning *DeploymentV1DeploymentServiceGetDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeployment2(ctx, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseDeploymentV1DeploymentServiceGetDeployment2Response(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceCreateDeploymentRequest calls the generic DeploymentV1DeploymentServiceCreateDeployment builder with application/json body
-func NewDeploymentV1DeploymentServiceCreateDeploymentRequest(server string, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceUpdateDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment2WithBody(ctx, deplId, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(server, projectName, "application/json", bodyReader)
+	return ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody generates requests for DeploymentV1DeploymentServiceCreateDeployment with any type of body
-func NewDeploymentV1DeploymentServiceCreateDeploymentRequestWithBody(server string, projectName string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment2(ctx, deplId, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1DeploymentServiceAbortDeployment2WithResponse request returning *DeploymentV1DeploymentServiceAbortDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceAbortDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceAbortDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceAbortDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceAbortDeployment2(ctx, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceAbortDeployment2Response(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse request returning *DeploymentV1DeploymentServiceListDeploymentClusters2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentClusters2(ctx, deplId, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1DeploymentServiceExportOfflineBundle2WithResponse request returning *DeploymentV1DeploymentServiceExportOfflineBundle2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceExportOfflineBundle2WithResponse(ctx context.Context, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundle2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceExportOfflineBundle2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceExportOfflineBundle2(ctx, deplId, clusterId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceExportOfflineBundle2Response(rsp)
+}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+// DeploymentV1DeploymentServiceGetDependencyGraph2WithResponse request returning *DeploymentV1DeploymentServiceGetDependencyGraph2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDependencyGraph2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDependencyGraph2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDependencyGraph2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDependencyGraph2(ctx, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return ParseDeploymentV1DeploymentServiceGetDependencyGraph2Response(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest generates requests for DeploymentV1DeploymentServiceListDeploymentsPerCluster
-func NewDeploymentV1DeploymentServiceListDeploymentsPerClusterRequest(server string, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1DeploymentServiceGetDeploymentDrift2WithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentDrift2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentDrift2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDrift2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentDrift2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentDrift2(ctx, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDeploymentDrift2Response(rsp)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "cluster_id", runtime.ParamLocationPath, clusterId)
+// DeploymentV1DeploymentServicePromoteDeployment2WithResponse request returning *DeploymentV1DeploymentServicePromoteDeployment2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServicePromoteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServicePromoteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServicePromoteDeployment2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServicePromoteDeployment2(ctx, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServicePromoteDeployment2Response(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1ClusterServiceExportInventory2WithResponse request returning *DeploymentV1ClusterServiceExportInventory2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServiceExportInventory2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceExportInventory2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceExportInventory2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServiceExportInventory2(ctx, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceExportInventory2Response(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/clusters/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentsStatus2Response
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1ClusterServicePreviewTargets2WithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServicePreviewTargets2Response
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewTargets2WithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargets2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewTargets2WithBody(ctx, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewTargets2Response(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Labels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewTargets2WithResponse(ctx context.Context, body DeploymentV1ClusterServicePreviewTargets2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargets2Response, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewTargets2(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewTargets2Response(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceGetKubeConfigResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx, params, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceDeleteDeploymentRequest generates requests for DeploymentV1DeploymentServiceDeleteDeployment
-func NewDeploymentV1DeploymentServiceDeleteDeploymentRequest(server string, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetKubeConfigWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetKubeConfig(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp)
+}
 
-	var pathParam1 string
+// DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceGetAppNamespaceResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetAppNamespaceWithBody(ctx, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp)
+}
 
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetAppNamespaceWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetAppNamespace(ctx, params, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1ClusterServiceListClusterGroupsWithResponse request returning *DeploymentV1ClusterServiceListClusterGroupsResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceListClusterGroupsWithResponse(ctx context.Context, projectName string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusterGroupsResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceListClusterGroups(ctx, projectName, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceListClusterGroupsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1ClusterServiceCreateClusterGroupWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceCreateClusterGroupResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCreateClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCreateClusterGroupWithBody(ctx, projectName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceCreateClusterGroupResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCreateClusterGroupWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCreateClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCreateClusterGroup(ctx, projectName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceCreateClusterGroupResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
+// DeploymentV1ClusterServicePreviewClusterGroupWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServicePreviewClusterGroupResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewClusterGroupWithBody(ctx, projectName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServicePreviewClusterGroupResponse(rsp)
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "deleteType", runtime.ParamLocationQuery, params.DeleteType); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewClusterGroupWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewClusterGroup(ctx, projectName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServicePreviewClusterGroupResponse(rsp)
+}
 
-		queryURL.RawQuery = queryValues.Encode()
+// DeploymentV1ClusterServiceDeleteClusterGroupWithResponse request returning *DeploymentV1ClusterServiceDeleteClusterGroupResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceDeleteClusterGroupWithResponse(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDeleteClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceDeleteClusterGroup(ctx, projectName, name, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceDeleteClusterGroupResponse(rsp)
+}
 
-	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+// DeploymentV1ClusterServiceGetClusterGroupWithResponse request returning *DeploymentV1ClusterServiceGetClusterGroupResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterGroupWithResponse(ctx context.Context, projectName string, name string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetClusterGroup(ctx, projectName, name, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceGetClusterGroupResponse(rsp)
+}
 
-	return req, nil
+// DeploymentV1ClusterServiceUpdateClusterGroupWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceUpdateClusterGroupResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUpdateClusterGroupWithBodyWithResponse(ctx context.Context, projectName string, name string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUpdateClusterGroupWithBody(ctx, projectName, name, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceUpdateClusterGroupResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceGetDeploymentRequest generates requests for DeploymentV1DeploymentServiceGetDeployment
-func NewDeploymentV1DeploymentServiceGetDeploymentRequest(server string, projectName string, deplId string) (*http.Request, error) {
-	var err error
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUpdateClusterGroupWithResponse(ctx context.Context, projectName string, name string, body DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUpdateClusterGroupResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUpdateClusterGroup(ctx, projectName, name, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceUpdateClusterGroupResponse(rsp)
+}
 
-	var pathParam0 string
+// DeploymentV1ClusterServiceListClustersWithResponse request returning *DeploymentV1ClusterServiceListClustersResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceListClustersWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClustersResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceListClusters(ctx, projectName, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeploymentV1ClusterServiceListClustersResponse(rsp)
+}
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1ClusterServiceGetClusterWithResponse request returning *DeploymentV1ClusterServiceGetClusterResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetCluster(ctx, projectName, clusterId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceGetClusterResponse(rsp)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+// DeploymentV1ClusterServiceCordonClusterWithResponse request returning *DeploymentV1ClusterServiceCordonClusterResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceCordonClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceCordonClusterResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceCordonCluster(ctx, projectName, clusterId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceCordonClusterResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1ClusterServiceDrainClusterWithResponse request returning *DeploymentV1ClusterServiceDrainClusterResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceDrainClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceDrainClusterResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceDrainCluster(ctx, projectName, clusterId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceDrainClusterResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1ClusterServiceGetClusterInventoryWithResponse request returning *DeploymentV1ClusterServiceGetClusterInventoryResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterInventoryWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterInventoryResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceGetClusterInventory(ctx, projectName, clusterId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceGetClusterInventoryResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1ClusterServiceUncordonClusterWithResponse request returning *DeploymentV1ClusterServiceUncordonClusterResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceUncordonClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceUncordonClusterResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceUncordonCluster(ctx, projectName, clusterId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServiceUncordonClusterResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// DeploymentV1DeploymentServiceListDeploymentsWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeployments(ctx, projectName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseDeploymentV1DeploymentServiceListDeploymentsResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceUpdateDeploymentRequest calls the generic DeploymentV1DeploymentServiceUpdateDeployment builder with application/json body
-func NewDeploymentV1DeploymentServiceUpdateDeploymentRequest(server string, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceCreateDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceCreateDeploymentWithBody(ctx, projectName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-	bodyReader = bytes.NewReader(buf)
-	return NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(server, projectName, deplId, "application/json", bodyReader)
+	return ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody generates requests for DeploymentV1DeploymentServiceUpdateDeployment with any type of body
-func NewDeploymentV1DeploymentServiceUpdateDeploymentRequestWithBody(server string, projectName string, deplId string, contentType string, body io.Reader) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeploymentWithResponse(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment(ctx, projectName, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+// DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentsPerCluster(ctx, projectName, clusterId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1DeploymentServiceDeleteDeploymentWithResponse request returning *DeploymentV1DeploymentServiceDeleteDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceDeleteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceDeleteDeployment(ctx, projectName, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1DeploymentServiceGetDeploymentWithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeployment(ctx, projectName, deplId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDeploymentResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceUpdateDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeploymentWithBody(ctx, projectName, deplId, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp)
+}
 
-	req, err := http.NewRequest("PUT", queryURL.String(), body)
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeploymentWithResponse(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment(ctx, projectName, deplId, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	req.Header.Add("Content-Type", contentType)
-
-	return req, nil
+	return ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceListDeploymentClustersRequest generates requests for DeploymentV1DeploymentServiceListDeploymentClusters
-func NewDeploymentV1DeploymentServiceListDeploymentClustersRequest(server string, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1DeploymentServiceAbortDeploymentWithResponse request returning *DeploymentV1DeploymentServiceAbortDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceAbortDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceAbortDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceAbortDeployment(ctx, projectName, deplId, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceAbortDeploymentResponse(rsp)
+}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "depl_id", runtime.ParamLocationPath, deplId)
+// DeploymentV1DeploymentServiceListDeploymentClustersWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentClustersResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentClustersWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentClusters(ctx, projectName, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1DeploymentServiceExportOfflineBundleWithResponse request returning *DeploymentV1DeploymentServiceExportOfflineBundleResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceExportOfflineBundleWithResponse(ctx context.Context, projectName string, deplId string, clusterId string, params *DeploymentV1DeploymentServiceExportOfflineBundleParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceExportOfflineBundleResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceExportOfflineBundle(ctx, projectName, deplId, clusterId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceExportOfflineBundleResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/deployments/%s/clusters", pathParam0, pathParam1)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+// DeploymentV1DeploymentServiceGetDependencyGraphWithResponse request returning *DeploymentV1DeploymentServiceGetDependencyGraphResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDependencyGraphWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDependencyGraphResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDependencyGraph(ctx, projectName, deplId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDependencyGraphResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// DeploymentV1DeploymentServiceGetDeploymentDriftWithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentDriftResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentDriftWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceGetDeploymentDriftParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentDriftResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentDrift(ctx, projectName, deplId, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDeploymentDriftResponse(rsp)
+}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.OrderBy != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "orderBy", runtime.ParamLocationQuery, *params.OrderBy); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Filter != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "filter", runtime.ParamLocationQuery, *params.Filter); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.PageSize != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pageSize", runtime.ParamLocationQuery, *params.PageSize); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		if params.Offset != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "offset", runtime.ParamLocationQuery, *params.Offset); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
-
-		}
-
-		queryURL.RawQuery = queryValues.Encode()
+// DeploymentV1DeploymentServicePromoteDeploymentWithResponse request returning *DeploymentV1DeploymentServicePromoteDeploymentResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServicePromoteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServicePromoteDeploymentResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServicePromoteDeployment(ctx, projectName, deplId, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServicePromoteDeploymentResponse(rsp)
+}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+// DeploymentV1ClusterServiceExportInventoryWithResponse request returning *DeploymentV1ClusterServiceExportInventoryResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServiceExportInventoryWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceExportInventoryParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceExportInventoryResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServiceExportInventory(ctx, projectName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
-
-	return req, nil
+	return ParseDeploymentV1ClusterServiceExportInventoryResponse(rsp)
 }
 
-// NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest generates requests for DeploymentV1DeploymentServiceGetDeploymentsStatus
-func NewDeploymentV1DeploymentServiceGetDeploymentsStatusRequest(server string, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams) (*http.Request, error) {
-	var err error
-
-	var pathParam0 string
-
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "projectName", runtime.ParamLocationPath, projectName)
+// DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentsStatusResponse
+func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatusResponse, error) {
+	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx, projectName, params, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1DeploymentServiceGetDeploymentsStatusResponse(rsp)
+}
 
-	serverURL, err := url.Parse(server)
+// DeploymentV1ClusterServicePreviewTargetsWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServicePreviewTargetsResponse
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewTargetsWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargetsResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewTargetsWithBody(ctx, projectName, contentType, body, reqEditors...)
 	if err != nil {
 		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewTargetsResponse(rsp)
+}
 
-	operationPath := fmt.Sprintf("/v1/projects/%s/appdeployment/summary/deployments_status", pathParam0)
-	if operationPath[0] == '/' {
-		operationPath = "." + operationPath
+func (c *ClientWithResponses) DeploymentV1ClusterServicePreviewTargetsWithResponse(ctx context.Context, projectName string, body DeploymentV1ClusterServicePreviewTargetsJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServicePreviewTargetsResponse, error) {
+	rsp, err := c.DeploymentV1ClusterServicePreviewTargets(ctx, projectName, body, reqEditors...)
+	if err != nil {
+		return nil, err
 	}
+	return ParseDeploymentV1ClusterServicePreviewTargetsResponse(rsp)
+}
 
-	queryURL, err := serverURL.Parse(operationPath)
+// ParseDeploymentV1ClusterServiceListClusterGroups2Response parses an HTTP response from a DeploymentV1ClusterServiceListClusterGroups2WithResponse call
+func ParseDeploymentV1ClusterServiceListClusterGroups2Response(rsp *http.Response) (*DeploymentV1ClusterServiceListClusterGroups2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	if params != nil {
-		queryValues := queryURL.Query()
-
-		if params.Labels != nil {
-
-			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "labels", runtime.ParamLocationQuery, *params.Labels); err != nil {
-				return nil, err
-			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-				return nil, err
-			} else {
-				for k, v := range parsed {
-					for _, v2 := range v {
-						queryValues.Add(k, v2)
-					}
-				}
-			}
+	response := &DeploymentV1ClusterServiceListClusterGroups2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListClusterGroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
 
-		queryURL.RawQuery = queryValues.Encode()
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	return response, nil
+}
+
+// ParseDeploymentV1ClusterServiceCreateClusterGroup2Response parses an HTTP response from a DeploymentV1ClusterServiceCreateClusterGroup2WithResponse call
+func ParseDeploymentV1ClusterServiceCreateClusterGroup2Response(rsp *http.Response) (*DeploymentV1ClusterServiceCreateClusterGroup2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	return req, nil
-}
-
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+	response := &DeploymentV1ClusterServiceCreateClusterGroup2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1CreateClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
 		}
+		response.JSON200 = &dest
+
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
+	return response, nil
 }
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+// ParseDeploymentV1ClusterServicePreviewClusterGroup2Response parses an HTTP response from a DeploymentV1ClusterServicePreviewClusterGroup2WithResponse call
+func ParseDeploymentV1ClusterServicePreviewClusterGroup2Response(rsp *http.Response) (*DeploymentV1ClusterServicePreviewClusterGroup2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
-}
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+	response := &DeploymentV1ClusterServicePreviewClusterGroup2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-}
-
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// DeploymentV1ClusterServiceListClusters2WithResponse request
-	DeploymentV1ClusterServiceListClusters2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusters2Response, error)
 
-	// DeploymentV1ClusterServiceGetCluster2WithResponse request
-	DeploymentV1ClusterServiceGetCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetCluster2Response, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1PreviewClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1DeploymentServiceListDeployments2WithResponse request
-	DeploymentV1DeploymentServiceListDeployments2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeployments2Response, error)
+	}
 
-	// DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse request with any body
-	DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error)
+	return response, nil
+}
 
-	DeploymentV1DeploymentServiceCreateDeployment2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error)
+// ParseDeploymentV1ClusterServiceDeleteClusterGroup2Response parses an HTTP response from a DeploymentV1ClusterServiceDeleteClusterGroup2WithResponse call
+func ParseDeploymentV1ClusterServiceDeleteClusterGroup2Response(rsp *http.Response) (*DeploymentV1ClusterServiceDeleteClusterGroup2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse request
-	DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error)
+	response := &DeploymentV1ClusterServiceDeleteClusterGroup2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// DeploymentV1DeploymentServiceDeleteDeployment2WithResponse request
-	DeploymentV1DeploymentServiceDeleteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1DeploymentServiceGetDeployment2WithResponse request
-	DeploymentV1DeploymentServiceGetDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeployment2Response, error)
+	}
 
-	// DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse request with any body
-	DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error)
+	return response, nil
+}
 
-	DeploymentV1DeploymentServiceUpdateDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error)
+// ParseDeploymentV1ClusterServiceGetClusterGroup2Response parses an HTTP response from a DeploymentV1ClusterServiceGetClusterGroup2WithResponse call
+func ParseDeploymentV1ClusterServiceGetClusterGroup2Response(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterGroup2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	// DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse request
-	DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error)
+	response := &DeploymentV1ClusterServiceGetClusterGroup2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse request
-	DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse request with any body
-	DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error)
+	}
 
-	DeploymentV1ClusterServiceGetKubeConfigWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error)
+	return response, nil
+}
 
-	// DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse request with any body
-	DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error)
+// ParseDeploymentV1ClusterServiceUpdateClusterGroup2Response parses an HTTP response from a DeploymentV1ClusterServiceUpdateClusterGroup2WithResponse call
+func ParseDeploymentV1ClusterServiceUpdateClusterGroup2Response(rsp *http.Response) (*DeploymentV1ClusterServiceUpdateClusterGroup2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	DeploymentV1DeploymentServiceGetAppNamespaceWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error)
+	response := &DeploymentV1ClusterServiceUpdateClusterGroup2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// DeploymentV1ClusterServiceListClustersWithResponse request
-	DeploymentV1ClusterServiceListClustersWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClustersResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1UpdateClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1ClusterServiceGetClusterWithResponse request
-	DeploymentV1ClusterServiceGetClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterResponse, error)
+	}
 
-	// DeploymentV1DeploymentServiceListDeploymentsWithResponse request
-	DeploymentV1DeploymentServiceListDeploymentsWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error)
+	return response, nil
+}
 
-	// DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse request with any body
-	DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error)
+// ParseDeploymentV1ClusterServiceListClusters2Response parses an HTTP response from a DeploymentV1ClusterServiceListClusters2WithResponse call
+func ParseDeploymentV1ClusterServiceListClusters2Response(rsp *http.Response) (*DeploymentV1ClusterServiceListClusters2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	DeploymentV1DeploymentServiceCreateDeploymentWithResponse(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error)
+	response := &DeploymentV1ClusterServiceListClusters2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse request
-	DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListClustersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1DeploymentServiceDeleteDeploymentWithResponse request
-	DeploymentV1DeploymentServiceDeleteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error)
+	}
 
-	// DeploymentV1DeploymentServiceGetDeploymentWithResponse request
-	DeploymentV1DeploymentServiceGetDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error)
+	return response, nil
+}
 
-	// DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse request with any body
-	DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error)
+// ParseDeploymentV1ClusterServiceGetCluster2Response parses an HTTP response from a DeploymentV1ClusterServiceGetCluster2WithResponse call
+func ParseDeploymentV1ClusterServiceGetCluster2Response(rsp *http.Response) (*DeploymentV1ClusterServiceGetCluster2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-	DeploymentV1DeploymentServiceUpdateDeploymentWithResponse(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error)
+	response := &DeploymentV1ClusterServiceGetCluster2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
 
-	// DeploymentV1DeploymentServiceListDeploymentClustersWithResponse request
-	DeploymentV1DeploymentServiceListDeploymentClustersWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error)
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetClusterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-	// DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse request
-	DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatusResponse, error)
-}
+	}
 
-type DeploymentV1ClusterServiceListClusters2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListClustersResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1ClusterServiceListClusters2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1ClusterServiceCordonCluster2Response parses an HTTP response from a DeploymentV1ClusterServiceCordonCluster2WithResponse call
+func ParseDeploymentV1ClusterServiceCordonCluster2Response(rsp *http.Response) (*DeploymentV1ClusterServiceCordonCluster2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1ClusterServiceListClusters2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1ClusterServiceCordonCluster2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1ClusterServiceGetCluster2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetClusterResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1ClusterServiceGetCluster2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1ClusterServiceGetCluster2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceListDeployments2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentsResponse
-}
+// ParseDeploymentV1ClusterServiceDrainCluster2Response parses an HTTP response from a DeploymentV1ClusterServiceDrainCluster2WithResponse call
+func ParseDeploymentV1ClusterServiceDrainCluster2Response(rsp *http.Response) (*DeploymentV1ClusterServiceDrainCluster2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeployments2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1ClusterServiceDrainCluster2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeployments2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceCreateDeployment2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1CreateDeploymentResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceCreateDeployment2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1ClusterServiceGetClusterInventory2Response parses an HTTP response from a DeploymentV1ClusterServiceGetClusterInventory2WithResponse call
+func ParseDeploymentV1ClusterServiceGetClusterInventory2Response(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterInventory2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceCreateDeployment2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1ClusterServiceGetClusterInventory2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentsPerClusterResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetClusterInventoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceDeleteDeployment2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GoogleProtobufEmpty
-}
+// ParseDeploymentV1ClusterServiceUncordonCluster2Response parses an HTTP response from a DeploymentV1ClusterServiceUncordonCluster2WithResponse call
+func ParseDeploymentV1ClusterServiceUncordonCluster2Response(rsp *http.Response) (*DeploymentV1ClusterServiceUncordonCluster2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceDeleteDeployment2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1ClusterServiceUncordonCluster2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceDeleteDeployment2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceGetDeployment2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetDeploymentResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceGetDeployment2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceListDeployments2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeployments2WithResponse call
+func ParseDeploymentV1DeploymentServiceListDeployments2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeployments2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceGetDeployment2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceListDeployments2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceUpdateDeployment2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1UpdateDeploymentResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListDeploymentsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceUpdateDeployment2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceUpdateDeployment2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceListDeploymentClusters2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentClustersResponse
-}
+// ParseDeploymentV1DeploymentServiceCreateDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceCreateDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeploymentClusters2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1DeploymentServiceCreateDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeploymentClusters2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1CreateDeploymentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceGetDeploymentsStatus2Response struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetDeploymentsStatusResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceGetDeploymentsStatus2Response) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse call
+func ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceGetDeploymentsStatus2Response) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1ClusterServiceGetKubeConfigResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetKubeConfigResponse
-	JSONDefault  *ConnectError
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListDeploymentsPerClusterResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1ClusterServiceGetKubeConfigResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1ClusterServiceGetKubeConfigResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceGetAppNamespaceResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetAppNamespaceResponse
-	JSONDefault  *ConnectError
-}
+// ParseDeploymentV1DeploymentServiceDeleteDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceDeleteDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServiceDeleteDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceGetAppNamespaceResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1DeploymentServiceDeleteDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceGetAppNamespaceResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1ClusterServiceListClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListClustersResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1ClusterServiceListClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceGetDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServiceGetDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1ClusterServiceListClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceGetDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
-
-type DeploymentV1ClusterServiceGetClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetClusterResponse
-}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1ClusterServiceGetClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
-	}
-	return http.StatusText(0)
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetDeploymentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1ClusterServiceGetClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceListDeploymentsResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentsResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeploymentsResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceUpdateDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceUpdateDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeploymentsResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceUpdateDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceCreateDeploymentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1CreateDeploymentResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1UpdateDeploymentResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceCreateDeploymentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceCreateDeploymentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentsPerClusterResponse
-}
+// ParseDeploymentV1DeploymentServiceAbortDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceAbortDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServiceAbortDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceAbortDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1DeploymentServiceAbortDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceDeleteDeploymentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *GoogleProtobufEmpty
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceDeleteDeploymentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse call
+func ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceDeleteDeploymentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceListDeploymentClusters2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceGetDeploymentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetDeploymentResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListDeploymentClustersResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceGetDeploymentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceGetDeploymentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-type DeploymentV1DeploymentServiceUpdateDeploymentResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1UpdateDeploymentResponse
-}
+// ParseDeploymentV1DeploymentServiceExportOfflineBundle2Response parses an HTTP response from a DeploymentV1DeploymentServiceExportOfflineBundle2WithResponse call
+func ParseDeploymentV1DeploymentServiceExportOfflineBundle2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceExportOfflineBundle2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceUpdateDeploymentResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+	response := &DeploymentV1DeploymentServiceExportOfflineBundle2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceUpdateDeploymentResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ExportOfflineBundleResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceListDeploymentClustersResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1ListDeploymentClustersResponse
+	return response, nil
 }
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceListDeploymentClustersResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
+// ParseDeploymentV1DeploymentServiceGetDependencyGraph2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDependencyGraph2WithResponse call
+func ParseDeploymentV1DeploymentServiceGetDependencyGraph2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDependencyGraph2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceListDeploymentClustersResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
+	response := &DeploymentV1DeploymentServiceGetDependencyGraph2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return 0
-}
 
-type DeploymentV1DeploymentServiceGetDeploymentsStatusResponse struct {
-	Body         []byte
-	HTTPResponse *http.Response
-	JSON200      *DeploymentV1GetDeploymentsStatusResponse
-}
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetDependencyGraphResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
 
-// Status returns HTTPResponse.Status
-func (r DeploymentV1DeploymentServiceGetDeploymentsStatusResponse) Status() string {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.Status
 	}
-	return http.StatusText(0)
-}
 
-// StatusCode returns HTTPResponse.StatusCode
-func (r DeploymentV1DeploymentServiceGetDeploymentsStatusResponse) StatusCode() int {
-	if r.HTTPResponse != nil {
-		return r.HTTPResponse.StatusCode
-	}
-	return 0
+	return response, nil
 }
 
-// DeploymentV1ClusterServiceListClusters2WithResponse request returning *DeploymentV1ClusterServiceListClusters2Response
-func (c *ClientWithResponses) DeploymentV1ClusterServiceListClusters2WithResponse(ctx context.Context, params *DeploymentV1ClusterServiceListClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClusters2Response, error) {
-	rsp, err := c.DeploymentV1ClusterServiceListClusters2(ctx, params, reqEditors...)
+// ParseDeploymentV1DeploymentServiceGetDeploymentDrift2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentDrift2WithResponse call
+func ParseDeploymentV1DeploymentServiceGetDeploymentDrift2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentDrift2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1ClusterServiceListClusters2Response(rsp)
-}
 
-// DeploymentV1ClusterServiceGetCluster2WithResponse request returning *DeploymentV1ClusterServiceGetCluster2Response
-func (c *ClientWithResponses) DeploymentV1ClusterServiceGetCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1ClusterServiceGetCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetCluster2Response, error) {
-	rsp, err := c.DeploymentV1ClusterServiceGetCluster2(ctx, clusterId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1DeploymentServiceGetDeploymentDrift2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1ClusterServiceGetCluster2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceListDeployments2WithResponse request returning *DeploymentV1DeploymentServiceListDeployments2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeployments2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceListDeployments2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeployments2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeployments2(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetDeploymentDriftResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceListDeployments2Response(rsp)
+
+	return response, nil
 }
 
-// DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceCreateDeployment2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeployment2WithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment2WithBody(ctx, params, contentType, body, reqEditors...)
+// ParseDeploymentV1DeploymentServicePromoteDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServicePromoteDeployment2WithResponse call
+func ParseDeploymentV1DeploymentServicePromoteDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServicePromoteDeployment2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp)
-}
 
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeployment2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceCreateDeployment2Params, body DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment2(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1DeploymentServicePromoteDeployment2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse(ctx context.Context, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerCluster2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentsPerCluster2(ctx, clusterId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response(rsp)
+
+	return response, nil
 }
 
-// DeploymentV1DeploymentServiceDeleteDeployment2WithResponse request returning *DeploymentV1DeploymentServiceDeleteDeployment2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceDeleteDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceDeleteDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceDeleteDeployment2(ctx, deplId, params, reqEditors...)
+// ParseDeploymentV1ClusterServiceExportInventory2Response parses an HTTP response from a DeploymentV1ClusterServiceExportInventory2WithResponse call
+func ParseDeploymentV1ClusterServiceExportInventory2Response(rsp *http.Response) (*DeploymentV1ClusterServiceExportInventory2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceDeleteDeployment2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceGetDeployment2WithResponse request returning *DeploymentV1DeploymentServiceGetDeployment2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceGetDeployment2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetDeployment2(ctx, deplId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServiceExportInventory2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceGetDeployment2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceUpdateDeployment2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeployment2WithBodyWithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment2WithBody(ctx, deplId, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ExportInventoryResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeployment2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceUpdateDeployment2Params, body DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment2(ctx, deplId, params, body, reqEditors...)
+// ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse call
+func ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse request returning *DeploymentV1DeploymentServiceListDeploymentClusters2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse(ctx context.Context, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClusters2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentClusters2(ctx, deplId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1DeploymentServiceGetDeploymentsStatus2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response(rsp)
-}
 
-// DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentsStatus2Response
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetDeploymentsStatus2Params, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentsStatus2(ctx, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetDeploymentsStatusResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response(rsp)
+
+	return response, nil
 }
 
-// DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse request with arbitrary body returning *DeploymentV1ClusterServiceGetKubeConfigResponse
-func (c *ClientWithResponses) DeploymentV1ClusterServiceGetKubeConfigWithBodyWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
-	rsp, err := c.DeploymentV1ClusterServiceGetKubeConfigWithBody(ctx, params, contentType, body, reqEditors...)
+// ParseDeploymentV1ClusterServicePreviewTargets2Response parses an HTTP response from a DeploymentV1ClusterServicePreviewTargets2WithResponse call
+func ParseDeploymentV1ClusterServicePreviewTargets2Response(rsp *http.Response) (*DeploymentV1ClusterServicePreviewTargets2Response, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp)
-}
 
-func (c *ClientWithResponses) DeploymentV1ClusterServiceGetKubeConfigWithResponse(ctx context.Context, params *DeploymentV1ClusterServiceGetKubeConfigParams, body DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
-	rsp, err := c.DeploymentV1ClusterServiceGetKubeConfig(ctx, params, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServicePreviewTargets2Response{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceGetAppNamespaceResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetAppNamespaceWithBodyWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetAppNamespaceWithBody(ctx, params, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1PreviewTargetsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetAppNamespaceWithResponse(ctx context.Context, params *DeploymentV1DeploymentServiceGetAppNamespaceParams, body DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetAppNamespace(ctx, params, body, reqEditors...)
+// ParseDeploymentV1ClusterServiceGetKubeConfigResponse parses an HTTP response from a DeploymentV1ClusterServiceGetKubeConfigWithResponse call
+func ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp)
-}
 
-// DeploymentV1ClusterServiceListClustersWithResponse request returning *DeploymentV1ClusterServiceListClustersResponse
-func (c *ClientWithResponses) DeploymentV1ClusterServiceListClustersWithResponse(ctx context.Context, projectName string, params *DeploymentV1ClusterServiceListClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceListClustersResponse, error) {
-	rsp, err := c.DeploymentV1ClusterServiceListClusters(ctx, projectName, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServiceGetKubeConfigResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1ClusterServiceListClustersResponse(rsp)
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetKubeConfigResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ConnectError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeploymentV1ClusterServiceGetClusterWithResponse request returning *DeploymentV1ClusterServiceGetClusterResponse
-func (c *ClientWithResponses) DeploymentV1ClusterServiceGetClusterWithResponse(ctx context.Context, projectName string, clusterId string, reqEditors ...RequestEditorFn) (*DeploymentV1ClusterServiceGetClusterResponse, error) {
-	rsp, err := c.DeploymentV1ClusterServiceGetCluster(ctx, projectName, clusterId, reqEditors...)
+// ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetAppNamespaceWithResponse call
+func ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1ClusterServiceGetClusterResponse(rsp)
+
+	response := &DeploymentV1DeploymentServiceGetAppNamespaceResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1GetAppNamespaceResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest ConnectError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeploymentV1DeploymentServiceListDeploymentsWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceListDeploymentsParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeployments(ctx, projectName, params, reqEditors...)
+// ParseDeploymentV1ClusterServiceListClusterGroupsResponse parses an HTTP response from a DeploymentV1ClusterServiceListClusterGroupsWithResponse call
+func ParseDeploymentV1ClusterServiceListClusterGroupsResponse(rsp *http.Response) (*DeploymentV1ClusterServiceListClusterGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceListDeploymentsResponse(rsp)
+
+	response := &DeploymentV1ClusterServiceListClusterGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1ListClusterGroupsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
 }
 
-// DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceCreateDeploymentResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceCreateDeploymentWithBody(ctx, projectName, contentType, body, reqEditors...)
+// ParseDeploymentV1ClusterServiceCreateClusterGroupResponse parses an HTTP response from a DeploymentV1ClusterServiceCreateClusterGroupWithResponse call
+func ParseDeploymentV1ClusterServiceCreateClusterGroupResponse(rsp *http.Response) (*DeploymentV1ClusterServiceCreateClusterGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp)
-}
 
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceCreateDeploymentWithResponse(ctx context.Context, projectName string, body DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceCreateDeployment(ctx, projectName, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServiceCreateClusterGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse(ctx context.Context, projectName string, clusterId string, params *DeploymentV1DeploymentServiceListDeploymentsPerClusterParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentsPerCluster(ctx, projectName, clusterId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1CreateClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse(rsp)
+
+	return response, nil
 }
 
-// DeploymentV1DeploymentServiceDeleteDeploymentWithResponse request returning *DeploymentV1DeploymentServiceDeleteDeploymentResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceDeleteDeploymentWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceDeleteDeploymentParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceDeleteDeployment(ctx, projectName, deplId, params, reqEditors...)
+// ParseDeploymentV1ClusterServicePreviewClusterGroupResponse parses an HTTP response from a DeploymentV1ClusterServicePreviewClusterGroupWithResponse call
+func ParseDeploymentV1ClusterServicePreviewClusterGroupResponse(rsp *http.Response) (*DeploymentV1ClusterServicePreviewClusterGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceGetDeploymentWithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentWithResponse(ctx context.Context, projectName string, deplId string, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetDeployment(ctx, projectName, deplId, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServicePreviewClusterGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceGetDeploymentResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse request with arbitrary body returning *DeploymentV1DeploymentServiceUpdateDeploymentResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeploymentWithBodyWithResponse(ctx context.Context, projectName string, deplId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeploymentWithBody(ctx, projectName, deplId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1PreviewClusterGroupResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp)
+
+	return response, nil
 }
 
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceUpdateDeploymentWithResponse(ctx context.Context, projectName string, deplId string, body DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceUpdateDeployment(ctx, projectName, deplId, body, reqEditors...)
+// ParseDeploymentV1ClusterServiceDeleteClusterGroupResponse parses an HTTP response from a DeploymentV1ClusterServiceDeleteClusterGroupWithResponse call
+func ParseDeploymentV1ClusterServiceDeleteClusterGroupResponse(rsp *http.Response) (*DeploymentV1ClusterServiceDeleteClusterGroupResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
-	return ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceListDeploymentClustersWithResponse request returning *DeploymentV1DeploymentServiceListDeploymentClustersResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceListDeploymentClustersWithResponse(ctx context.Context, projectName string, deplId string, params *DeploymentV1DeploymentServiceListDeploymentClustersParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceListDeploymentClusters(ctx, projectName, deplId, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	response := &DeploymentV1ClusterServiceDeleteClusterGroupResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
 	}
-	return ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse(rsp)
-}
 
-// DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse request returning *DeploymentV1DeploymentServiceGetDeploymentsStatusResponse
-func (c *ClientWithResponses) DeploymentV1DeploymentServiceGetDeploymentsStatusWithResponse(ctx context.Context, projectName string, params *DeploymentV1DeploymentServiceGetDeploymentsStatusParams, reqEditors ...RequestEditorFn) (*DeploymentV1DeploymentServiceGetDeploymentsStatusResponse, error) {
-	rsp, err := c.DeploymentV1DeploymentServiceGetDeploymentsStatus(ctx, projectName, params, reqEditors...)
-	if err != nil {
-		return nil, err
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GoogleProtobufEmpty
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
 	}
-	return ParseDeploymentV1DeploymentServiceGetDeploymentsStatusResponse(rsp)
+
+	return response, nil
 }
 
-// ParseDeploymentV1ClusterServiceListClusters2Response parses an HTTP response from a DeploymentV1ClusterServiceListClusters2WithResponse call
-func ParseDeploymentV1ClusterServiceListClusters2Response(rsp *http.Response) (*DeploymentV1ClusterServiceListClusters2Response, error) {
+// ParseDeploymentV1ClusterServiceGetClusterGroupResponse parses an HTTP response from a DeploymentV1ClusterServiceGetClusterGroupWithResponse call
+func ParseDeploymentV1ClusterServiceGetClusterGroupResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1ClusterServiceListClusters2Response{
+	response := &DeploymentV1ClusterServiceGetClusterGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListClustersResponse
+		var dest DeploymentV1GetClusterGroupResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3178,22 +7697,22 @@ func ParseDeploymentV1ClusterServiceListClusters2Response(rsp *http.Response) (*
 	return response, nil
 }
 
-// ParseDeploymentV1ClusterServiceGetCluster2Response parses an HTTP response from a DeploymentV1ClusterServiceGetCluster2WithResponse call
-func ParseDeploymentV1ClusterServiceGetCluster2Response(rsp *http.Response) (*DeploymentV1ClusterServiceGetCluster2Response, error) {
+// ParseDeploymentV1ClusterServiceUpdateClusterGroupResponse parses an HTTP response from a DeploymentV1ClusterServiceUpdateClusterGroupWithResponse call
+func ParseDeploymentV1ClusterServiceUpdateClusterGroupResponse(rsp *http.Response) (*DeploymentV1ClusterServiceUpdateClusterGroupResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1ClusterServiceGetCluster2Response{
+	response := &DeploymentV1ClusterServiceUpdateClusterGroupResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetClusterResponse
+		var dest DeploymentV1UpdateClusterGroupResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3204,22 +7723,22 @@ func ParseDeploymentV1ClusterServiceGetCluster2Response(rsp *http.Response) (*De
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeployments2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeployments2WithResponse call
-func ParseDeploymentV1DeploymentServiceListDeployments2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeployments2Response, error) {
+// ParseDeploymentV1ClusterServiceListClustersResponse parses an HTTP response from a DeploymentV1ClusterServiceListClustersWithResponse call
+func ParseDeploymentV1ClusterServiceListClustersResponse(rsp *http.Response) (*DeploymentV1ClusterServiceListClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeployments2Response{
+	response := &DeploymentV1ClusterServiceListClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentsResponse
+		var dest DeploymentV1ListClustersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3230,22 +7749,22 @@ func ParseDeploymentV1DeploymentServiceListDeployments2Response(rsp *http.Respon
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceCreateDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceCreateDeployment2WithResponse call
-func ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceCreateDeployment2Response, error) {
+// ParseDeploymentV1ClusterServiceGetClusterResponse parses an HTTP response from a DeploymentV1ClusterServiceGetClusterWithResponse call
+func ParseDeploymentV1ClusterServiceGetClusterResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceCreateDeployment2Response{
+	response := &DeploymentV1ClusterServiceGetClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1CreateDeploymentResponse
+		var dest DeploymentV1GetClusterResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3256,22 +7775,22 @@ func ParseDeploymentV1DeploymentServiceCreateDeployment2Response(rsp *http.Respo
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsPerCluster2WithResponse call
-func ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response, error) {
+// ParseDeploymentV1ClusterServiceCordonClusterResponse parses an HTTP response from a DeploymentV1ClusterServiceCordonClusterWithResponse call
+func ParseDeploymentV1ClusterServiceCordonClusterResponse(rsp *http.Response) (*DeploymentV1ClusterServiceCordonClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeploymentsPerCluster2Response{
+	response := &DeploymentV1ClusterServiceCordonClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentsPerClusterResponse
+		var dest GoogleProtobufEmpty
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3282,15 +7801,15 @@ func ParseDeploymentV1DeploymentServiceListDeploymentsPerCluster2Response(rsp *h
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceDeleteDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceDeleteDeployment2WithResponse call
-func ParseDeploymentV1DeploymentServiceDeleteDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceDeleteDeployment2Response, error) {
+// ParseDeploymentV1ClusterServiceDrainClusterResponse parses an HTTP response from a DeploymentV1ClusterServiceDrainClusterWithResponse call
+func ParseDeploymentV1ClusterServiceDrainClusterResponse(rsp *http.Response) (*DeploymentV1ClusterServiceDrainClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceDeleteDeployment2Response{
+	response := &DeploymentV1ClusterServiceDrainClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -3308,22 +7827,22 @@ func ParseDeploymentV1DeploymentServiceDeleteDeployment2Response(rsp *http.Respo
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceGetDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDeployment2WithResponse call
-func ParseDeploymentV1DeploymentServiceGetDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeployment2Response, error) {
+// ParseDeploymentV1ClusterServiceGetClusterInventoryResponse parses an HTTP response from a DeploymentV1ClusterServiceGetClusterInventoryWithResponse call
+func ParseDeploymentV1ClusterServiceGetClusterInventoryResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceGetDeployment2Response{
+	response := &DeploymentV1ClusterServiceGetClusterInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetDeploymentResponse
+		var dest DeploymentV1GetClusterInventoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3334,22 +7853,22 @@ func ParseDeploymentV1DeploymentServiceGetDeployment2Response(rsp *http.Response
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceUpdateDeployment2Response parses an HTTP response from a DeploymentV1DeploymentServiceUpdateDeployment2WithResponse call
-func ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceUpdateDeployment2Response, error) {
+// ParseDeploymentV1ClusterServiceUncordonClusterResponse parses an HTTP response from a DeploymentV1ClusterServiceUncordonClusterWithResponse call
+func ParseDeploymentV1ClusterServiceUncordonClusterResponse(rsp *http.Response) (*DeploymentV1ClusterServiceUncordonClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceUpdateDeployment2Response{
+	response := &DeploymentV1ClusterServiceUncordonClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1UpdateDeploymentResponse
+		var dest GoogleProtobufEmpty
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3360,22 +7879,22 @@ func ParseDeploymentV1DeploymentServiceUpdateDeployment2Response(rsp *http.Respo
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentClusters2WithResponse call
-func ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentClusters2Response, error) {
+// ParseDeploymentV1DeploymentServiceListDeploymentsResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsWithResponse call
+func ParseDeploymentV1DeploymentServiceListDeploymentsResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeploymentClusters2Response{
+	response := &DeploymentV1DeploymentServiceListDeploymentsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentClustersResponse
+		var dest DeploymentV1ListDeploymentsResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3386,22 +7905,22 @@ func ParseDeploymentV1DeploymentServiceListDeploymentClusters2Response(rsp *http
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentsStatus2WithResponse call
-func ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentsStatus2Response, error) {
+// ParseDeploymentV1DeploymentServiceCreateDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceCreateDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceGetDeploymentsStatus2Response{
+	response := &DeploymentV1DeploymentServiceCreateDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetDeploymentsStatusResponse
+		var dest DeploymentV1CreateDeploymentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3412,88 +7931,74 @@ func ParseDeploymentV1DeploymentServiceGetDeploymentsStatus2Response(rsp *http.R
 	return response, nil
 }
 
-// ParseDeploymentV1ClusterServiceGetKubeConfigResponse parses an HTTP response from a DeploymentV1ClusterServiceGetKubeConfigWithResponse call
-func ParseDeploymentV1ClusterServiceGetKubeConfigResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetKubeConfigResponse, error) {
+// ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse call
+func ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1ClusterServiceGetKubeConfigResponse{
+	response := &DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetKubeConfigResponse
+		var dest DeploymentV1ListDeploymentsPerClusterResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest ConnectError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetAppNamespaceWithResponse call
-func ParseDeploymentV1DeploymentServiceGetAppNamespaceResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetAppNamespaceResponse, error) {
+// ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceDeleteDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceGetAppNamespaceResponse{
+	response := &DeploymentV1DeploymentServiceDeleteDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetAppNamespaceResponse
+		var dest GoogleProtobufEmpty
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest ConnectError
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseDeploymentV1ClusterServiceListClustersResponse parses an HTTP response from a DeploymentV1ClusterServiceListClustersWithResponse call
-func ParseDeploymentV1ClusterServiceListClustersResponse(rsp *http.Response) (*DeploymentV1ClusterServiceListClustersResponse, error) {
+// ParseDeploymentV1DeploymentServiceGetDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServiceGetDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1ClusterServiceListClustersResponse{
+	response := &DeploymentV1DeploymentServiceGetDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListClustersResponse
+		var dest DeploymentV1GetDeploymentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3504,22 +8009,22 @@ func ParseDeploymentV1ClusterServiceListClustersResponse(rsp *http.Response) (*D
 	return response, nil
 }
 
-// ParseDeploymentV1ClusterServiceGetClusterResponse parses an HTTP response from a DeploymentV1ClusterServiceGetClusterWithResponse call
-func ParseDeploymentV1ClusterServiceGetClusterResponse(rsp *http.Response) (*DeploymentV1ClusterServiceGetClusterResponse, error) {
+// ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceUpdateDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1ClusterServiceGetClusterResponse{
+	response := &DeploymentV1DeploymentServiceUpdateDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetClusterResponse
+		var dest DeploymentV1UpdateDeploymentResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3530,22 +8035,22 @@ func ParseDeploymentV1ClusterServiceGetClusterResponse(rsp *http.Response) (*Dep
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeploymentsResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsWithResponse call
-func ParseDeploymentV1DeploymentServiceListDeploymentsResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsResponse, error) {
+// ParseDeploymentV1DeploymentServiceAbortDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceAbortDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServiceAbortDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceAbortDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeploymentsResponse{
+	response := &DeploymentV1DeploymentServiceAbortDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentsResponse
+		var dest GoogleProtobufEmpty
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3556,22 +8061,22 @@ func ParseDeploymentV1DeploymentServiceListDeploymentsResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceCreateDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceCreateDeploymentWithResponse call
-func ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceCreateDeploymentResponse, error) {
+// ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentClustersWithResponse call
+func ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceCreateDeploymentResponse{
+	response := &DeploymentV1DeploymentServiceListDeploymentClustersResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1CreateDeploymentResponse
+		var dest DeploymentV1ListDeploymentClustersResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3582,22 +8087,22 @@ func ParseDeploymentV1DeploymentServiceCreateDeploymentResponse(rsp *http.Respon
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentsPerClusterWithResponse call
-func ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse, error) {
+// ParseDeploymentV1DeploymentServiceExportOfflineBundleResponse parses an HTTP response from a DeploymentV1DeploymentServiceExportOfflineBundleWithResponse call
+func ParseDeploymentV1DeploymentServiceExportOfflineBundleResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceExportOfflineBundleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeploymentsPerClusterResponse{
+	response := &DeploymentV1DeploymentServiceExportOfflineBundleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentsPerClusterResponse
+		var dest DeploymentV1ExportOfflineBundleResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3608,22 +8113,22 @@ func ParseDeploymentV1DeploymentServiceListDeploymentsPerClusterResponse(rsp *ht
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceDeleteDeploymentWithResponse call
-func ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceDeleteDeploymentResponse, error) {
+// ParseDeploymentV1DeploymentServiceGetDependencyGraphResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetDependencyGraphWithResponse call
+func ParseDeploymentV1DeploymentServiceGetDependencyGraphResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDependencyGraphResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceDeleteDeploymentResponse{
+	response := &DeploymentV1DeploymentServiceGetDependencyGraphResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest GoogleProtobufEmpty
+		var dest DeploymentV1GetDependencyGraphResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3634,22 +8139,22 @@ func ParseDeploymentV1DeploymentServiceDeleteDeploymentResponse(rsp *http.Respon
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceGetDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentWithResponse call
-func ParseDeploymentV1DeploymentServiceGetDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentResponse, error) {
+// ParseDeploymentV1DeploymentServiceGetDeploymentDriftResponse parses an HTTP response from a DeploymentV1DeploymentServiceGetDeploymentDriftWithResponse call
+func ParseDeploymentV1DeploymentServiceGetDeploymentDriftResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceGetDeploymentDriftResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceGetDeploymentResponse{
+	response := &DeploymentV1DeploymentServiceGetDeploymentDriftResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1GetDeploymentResponse
+		var dest DeploymentV1GetDeploymentDriftResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3660,22 +8165,22 @@ func ParseDeploymentV1DeploymentServiceGetDeploymentResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServiceUpdateDeploymentWithResponse call
-func ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceUpdateDeploymentResponse, error) {
+// ParseDeploymentV1DeploymentServicePromoteDeploymentResponse parses an HTTP response from a DeploymentV1DeploymentServicePromoteDeploymentWithResponse call
+func ParseDeploymentV1DeploymentServicePromoteDeploymentResponse(rsp *http.Response) (*DeploymentV1DeploymentServicePromoteDeploymentResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceUpdateDeploymentResponse{
+	response := &DeploymentV1DeploymentServicePromoteDeploymentResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1UpdateDeploymentResponse
+		var dest GoogleProtobufEmpty
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3686,22 +8191,22 @@ func ParseDeploymentV1DeploymentServiceUpdateDeploymentResponse(rsp *http.Respon
 	return response, nil
 }
 
-// ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse parses an HTTP response from a DeploymentV1DeploymentServiceListDeploymentClustersWithResponse call
-func ParseDeploymentV1DeploymentServiceListDeploymentClustersResponse(rsp *http.Response) (*DeploymentV1DeploymentServiceListDeploymentClustersResponse, error) {
+// ParseDeploymentV1ClusterServiceExportInventoryResponse parses an HTTP response from a DeploymentV1ClusterServiceExportInventoryWithResponse call
+func ParseDeploymentV1ClusterServiceExportInventoryResponse(rsp *http.Response) (*DeploymentV1ClusterServiceExportInventoryResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeploymentV1DeploymentServiceListDeploymentClustersResponse{
+	response := &DeploymentV1ClusterServiceExportInventoryResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest DeploymentV1ListDeploymentClustersResponse
+		var dest DeploymentV1ExportInventoryResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -3737,3 +8242,29 @@ func ParseDeploymentV1DeploymentServiceGetDeploymentsStatusResponse(rsp *http.Re
 
 	return response, nil
 }
+
+// ParseDeploymentV1ClusterServicePreviewTargetsResponse parses an HTTP response from a DeploymentV1ClusterServicePreviewTargetsWithResponse call
+func ParseDeploymentV1ClusterServicePreviewTargetsResponse(rsp *http.Response) (*DeploymentV1ClusterServicePreviewTargetsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeploymentV1ClusterServicePreviewTargetsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest DeploymentV1PreviewTargetsResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
@@ -92,6 +92,9 @@ type ConnectErrorDetailsAny struct {
 
 // DeploymentV1App Details of application.
 type DeploymentV1App struct {
+	// Health Health probe results of an app on a cluster.
+	Health *DeploymentV1AppHealth `json:"health,omitempty"`
+
 	// Id Id of the app (same as Fleet bundle name) which is,
 	//  concatenated from name and deploy_id (uid which comes from k8s).
 	Id *string `json:"id,omitempty"`
@@ -101,6 +104,124 @@ type DeploymentV1App struct {
 
 	// Status Status has details of the deployment.
 	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
+
+	// Tests Test hook results of an app on a cluster for the current generation of the deployment.
+	Tests *DeploymentV1AppTests `json:"tests,omitempty"`
+}
+
+// DeploymentV1AppDrift Drift details of an app on a cluster.
+type DeploymentV1AppDrift struct {
+	// Id Id of the app (same as Fleet bundle name) which is, concatenated from name and deploy_id (uid which comes from k8s).
+	Id *string `json:"id,omitempty"`
+
+	// Missing Number of desired resources missing on the cluster.
+	Missing *int32 `json:"missing,omitempty"`
+
+	// Modified Number of resources modified outside of the orchestrator.
+	Modified *int32 `json:"modified,omitempty"`
+
+	// Name The deployment package app name.
+	Name *string `json:"name,omitempty"`
+
+	// Orphaned Number of resources on the cluster that are no longer desired.
+	Orphaned *int32 `json:"orphaned,omitempty"`
+
+	// Resources Resources which differ from the desired state. The list may be truncated, the counts are always complete.
+	Resources *[]DeploymentV1DriftedResource `json:"resources,omitempty"`
+}
+
+// DeploymentV1AppHealth Health probe results of an app on a cluster.
+type DeploymentV1AppHealth struct {
+	// Probes Results of the individual probes.
+	Probes *[]DeploymentV1ProbeResult `json:"probes,omitempty"`
+
+	// State State of the health probes, can be Pending, Passed or Failed. Passed only when all probes passed.
+	State *string `json:"state,omitempty"`
+}
+
+// DeploymentV1AppTargetClusters The clusters an application of a deployment would be deployed to.
+type DeploymentV1AppTargetClusters struct {
+	// AppName The application name.
+	AppName *string `json:"appName,omitempty"`
+
+	// Clusters The clusters matched by the target clusters of the application.
+	Clusters      *[]DeploymentV1ClusterInfo `json:"clusters,omitempty"`
+	TotalElements *int32                     `json:"totalElements,omitempty"`
+}
+
+// DeploymentV1AppTests Test hook results of an app on a cluster for the current generation of the deployment.
+type DeploymentV1AppTests struct {
+	// Results Results of the individual tests.
+	Results *[]DeploymentV1TestResult `json:"results,omitempty"`
+
+	// State State of the tests, can be Pending, Passed or Failed. Passed only when all tests passed.
+	State *string `json:"state,omitempty"`
+}
+
+// DeploymentV1BlueGreenService Service exposing an application of a blue-green deployment.
+type DeploymentV1BlueGreenService struct {
+	// AppName The deployment package app name the service belongs to.
+	AppName string `json:"appName"`
+
+	// Name Name of the service, it must not clash with the resources of the app.
+	Name string `json:"name"`
+
+	// Ports Ports of the service.
+	Ports []DeploymentV1BlueGreenServicePort `json:"ports"`
+
+	// Selector (OPTIONAL) Pod labels selecting the pods of the app, the release of the active slot is added to them.
+	Selector *map[string]string `json:"selector,omitempty"`
+}
+
+// DeploymentV1BlueGreenServicePort Port of a blue-green service.
+type DeploymentV1BlueGreenServicePort struct {
+	// Name (OPTIONAL) Name of the port, required if the service has several ports.
+	Name *string `json:"name,omitempty"`
+
+	// Port Port of the service.
+	Port int32 `json:"port"`
+
+	// Protocol (OPTIONAL) Protocol of the port, can be either TCP (default), UDP or SCTP.
+	Protocol *string `json:"protocol,omitempty"`
+
+	// TargetPort (OPTIONAL) Port of the pods, defaults to the port of the service.
+	TargetPort *int32 `json:"targetPort,omitempty"`
+}
+
+// DeploymentV1BlueGreenStatus Progress of the rollout of a blue-green deployment.
+type DeploymentV1BlueGreenStatus struct {
+	// ActiveSlot Slot of the release serving the traffic, either blue or green.
+	ActiveSlot *string `json:"activeSlot,omitempty"`
+
+	// Phase Phase of the rollout, can be Deploying, Switching or empty if no rollout is in progress.
+	Phase *string `json:"phase,omitempty"`
+
+	// PreviewSlot Slot of the release being rolled out, empty if no rollout is in progress.
+	PreviewSlot *string `json:"previewSlot,omitempty"`
+}
+
+// DeploymentV1Canary Canary stage of a deployment.
+type DeploymentV1Canary struct {
+	// ClusterCount Number of target clusters the new version is rolled out to first.
+	ClusterCount int32 `json:"clusterCount"`
+
+	// ObservationSeconds (OPTIONAL) Observation period in seconds before the new version is promoted, defaults to 600.
+	ObservationSeconds *int32 `json:"observationSeconds,omitempty"`
+
+	// Selector (OPTIONAL) Cluster labels selecting the canary clusters among the target clusters, chosen at random if empty.
+	Selector *map[string]string `json:"selector,omitempty"`
+}
+
+// DeploymentV1CanaryStatus Progress of the canary stage of a deployment.
+type DeploymentV1CanaryStatus struct {
+	// Clusters Ids of the clusters the new version is rolled out to.
+	Clusters *[]string `json:"clusters,omitempty"`
+
+	// Message Reason of the last phase transition.
+	Message *string `json:"message,omitempty"`
+
+	// Phase Phase of the canary stage, can be Deploying, Observing, Promoted or Aborted.
+	Phase *string `json:"phase,omitempty"`
 }
 
 // DeploymentV1Cluster Details of cluster.
@@ -108,6 +229,9 @@ type DeploymentV1Cluster struct {
 	// Apps Apps has per-app details.
 	Apps *[]DeploymentV1App `json:"apps,omitempty"`
 
+	// Health Health of a cluster observed through its nodes and Fleet agent.
+	Health *DeploymentV1ClusterHealth `json:"health,omitempty"`
+
 	// Id ID is the cluster id which ECM generates and assigns to the Rancher cluster name.
 	Id *string `json:"id,omitempty"`
 
@@ -118,8 +242,29 @@ type DeploymentV1Cluster struct {
 	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
 }
 
-// DeploymentV1ClusterInfo Cluster defines the message for the Cluster object.
-type DeploymentV1ClusterInfo struct {
+// DeploymentV1ClusterDrift Drift details of a deployment on a cluster.
+type DeploymentV1ClusterDrift struct {
+	// Apps Apps has per-app drift details, only apps which drifted are listed.
+	Apps *[]DeploymentV1AppDrift `json:"apps,omitempty"`
+
+	// Drifted Drifted is true if any app of the deployment drifted from the desired state on the cluster.
+	Drifted *bool `json:"drifted,omitempty"`
+
+	// Id ID is the cluster id which ECM generates and assigns to the Rancher cluster name.
+	Id *string `json:"id,omitempty"`
+
+	// Name Name is the display name which user provides and ECM creates and assigns clustername label to Fleet cluster object.
+	Name *string `json:"name,omitempty"`
+}
+
+// DeploymentV1ClusterGroup ClusterGroup defines a named set of clusters deployments can target. The members are the static clusters, the
+//
+//	clusters matching the selector and the members of the union groups, restricted to the members of each intersection
+//	group and minus the members of each exclude group.
+type DeploymentV1ClusterGroup struct {
+	// Clusters (OPTIONAL) Ids of the static member clusters.
+	Clusters *[]string `json:"clusters,omitempty"`
+
 	// CreateTime A Timestamp represents a point in time independent of any time zone or local
 	//  calendar, encoded as a count of seconds and fractions of seconds at
 	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
@@ -211,40 +356,153 @@ type DeploymentV1ClusterInfo struct {
 	//  ) to obtain a formatter capable of generating timestamps in this format.
 	CreateTime *GoogleProtobufTimestamp `json:"createTime,omitempty"`
 
-	// Id ID is the cluster id which ECM generates and assigns to the Rancher cluster name.
-	Id *string `json:"id,omitempty"`
+	// Description (OPTIONAL) Description of the cluster group.
+	Description *string `json:"description,omitempty"`
 
-	// Labels List of cluster labels retrieved from Fleet cluster object.
-	Labels *map[string]string `json:"labels,omitempty"`
+	// DisplayName (OPTIONAL) Display name of the cluster group.
+	DisplayName *string `json:"displayName,omitempty"`
 
-	// Name Name is the display name which user provides and ECM creates and assigns clustername label to Fleet cluster object.
-	Name *string `json:"name,omitempty"`
-}
+	// Exclude (OPTIONAL) Names of the groups whose members are removed from the group.
+	Exclude *[]string `json:"exclude,omitempty"`
 
-// DeploymentV1CreateDeploymentResponse Response message for the CreateDeployment method.
-type DeploymentV1CreateDeploymentResponse struct {
-	// DeploymentId Returns the new Deployment Id.
-	DeploymentId string `json:"deploymentId"`
+	// Intersection (OPTIONAL) Names of the groups the members of the group must belong to.
+	Intersection *[]string `json:"intersection,omitempty"`
+
+	// Members Ids of the resolved member clusters.
+	Members *[]string `json:"members,omitempty"`
+
+	// Message Error resolving the members of the group, the last resolved members are kept.
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the cluster group, unique in the project.
+	Name string `json:"name"`
+
+	// Selector (OPTIONAL) Cluster labels selecting the clusters having all of them.
+	Selector *map[string]string `json:"selector,omitempty"`
+
+	// Union (OPTIONAL) Names of the groups whose members are added to the group.
+	Union *[]string `json:"union,omitempty"`
 }
 
-// DeploymentV1DeleteType Different delete types to handle parent and child
-//
-//	lists, for dependency support. Available options: PARENT_ONLY, ALL.
-type DeploymentV1DeleteType string
+// DeploymentV1ClusterHealth Health of a cluster observed through its nodes and Fleet agent.
+type DeploymentV1ClusterHealth struct {
+	// AgentVersion Version of the Fleet agent running on the cluster.
+	AgentVersion *string `json:"agentVersion,omitempty"`
 
-// DeploymentV1Deployment Deployment defines the specification to deploy a Deployment Package onto a set of clusters.
-type DeploymentV1Deployment struct {
-	// AllAppTargetClusters Set target clusters based on labels.
-	AllAppTargetClusters *DeploymentV1TargetClusters `json:"allAppTargetClusters,omitempty"`
+	// KubernetesVersion Kubernetes version reported by the cluster API server.
+	KubernetesVersion *string `json:"kubernetesVersion,omitempty"`
 
-	// AppName The deployment package name to deploy from the catalog.
-	AppName string `json:"appName"`
+	// LastSeen A Timestamp represents a point in time independent of any time zone or local
+	//  calendar, encoded as a count of seconds and fractions of seconds at
+	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
+	//  January 1, 1970, in the proleptic Gregorian calendar which extends the
+	//  Gregorian calendar backwards to year one.
+	//
+	//  All minutes are 60 seconds long. Leap seconds are "smeared" so that no leap
+	//  second table is needed for interpretation, using a [24-hour linear
+	//  smear](https://developers.google.com/time/smear).
+	//
+	//  The range is from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z. By
+	//  restricting to that range, we ensure that we can convert to and from [RFC
+	//  3339](https://www.ietf.org/rfc/rfc3339.txt) date strings.
+	//
+	//  # Examples
+	//
+	//  Example 1: Compute Timestamp from POSIX `time()`.
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(time(NULL));
+	//      timestamp.set_nanos(0);
+	//
+	//  Example 2: Compute Timestamp from POSIX `gettimeofday()`.
+	//
+	//      struct timeval tv;
+	//      gettimeofday(&tv, NULL);
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(tv.tv_sec);
+	//      timestamp.set_nanos(tv.tv_usec * 1000);
+	//
+	//  Example 3: Compute Timestamp from Win32 `GetSystemTimeAsFileTime()`.
+	//
+	//      FILETIME ft;
+	//      GetSystemTimeAsFileTime(&ft);
+	//      UINT64 ticks = (((UINT64)ft.dwHighDateTime) << 32) | ft.dwLowDateTime;
+	//
+	//      // A Windows tick is 100 nanoseconds. Windows epoch 1601-01-01T00:00:00Z
+	//      // is 11644473600 seconds before Unix epoch 1970-01-01T00:00:00Z.
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds((INT64) ((ticks / 10000000) - 11644473600LL));
+	//      timestamp.set_nanos((INT32) ((ticks % 10000000) * 100));
+	//
+	//  Example 4: Compute Timestamp from Java `System.currentTimeMillis()`.
+	//
+	//      long millis = System.currentTimeMillis();
+	//
+	//      Timestamp timestamp = Timestamp.newBuilder().setSeconds(millis / 1000)
+	//          .setNanos((int) ((millis % 1000) * 1000000)).build();
+	//
+	//  Example 5: Compute Timestamp from Java `Instant.now()`.
+	//
+	//      Instant now = Instant.now();
+	//
+	//      Timestamp timestamp =
+	//          Timestamp.newBuilder().setSeconds(now.getEpochSecond())
+	//              .setNanos(now.getNano()).build();
+	//
+	//  Example 6: Compute Timestamp from current time in Python.
+	//
+	//      timestamp = Timestamp()
+	//      timestamp.GetCurrentTime()
+	//
+	//  # JSON Mapping
+	//
+	//  In JSON format, the Timestamp type is encoded as a string in the
+	//  [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format. That is, the
+	//  format is "{year}-{month}-{day}T{hour}:{min}:{sec}[.{frac_sec}]Z"
+	//  where {year} is always expressed using four digits while {month}, {day},
+	//  {hour}, {min}, and {sec} are zero-padded to two digits each. The fractional
+	//  seconds, which can go up to 9 digits (i.e. up to 1 nanosecond resolution),
+	//  are optional. The "Z" suffix indicates the timezone ("UTC"); the timezone
+	//  is required. A proto3 JSON serializer should always use UTC (as indicated by
+	//  "Z") when printing the Timestamp type and a proto3 JSON parser should be
+	//  able to accept both UTC and other timezones (as indicated by an offset).
+	//
+	//  For example, "2017-01-15T01:30:15.01Z" encodes 15.01 seconds past
+	//  01:30 UTC on January 15, 2017.
+	//
+	//  In JavaScript, one can convert a Date object to this format using the
+	//  standard
+	//  [toISOString()](https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString)
+	//  method. In Python, a standard `datetime.datetime` object can be converted
+	//  to this format using
+	//  [`strftime`](https://docs.python.org/2/library/time.html#time.strftime) with
+	//  the time format spec '%Y-%m-%dT%H:%M:%S.%fZ'. Likewise, in Java, one can use
+	//  the Joda Time's [`ISODateTimeFormat.dateTime()`](
+	//  http://joda-time.sourceforge.net/apidocs/org/joda/time/format/ISODateTimeFormat.html#dateTime()
+	//  ) to obtain a formatter capable of generating timestamps in this format.
+	LastSeen *GoogleProtobufTimestamp `json:"lastSeen,omitempty"`
 
-	// AppVersion The version of the deployment package.
-	AppVersion string `json:"appVersion"`
+	// LastSeenAgeSeconds Seconds elapsed since the Fleet agent last checked in.
+	LastSeenAgeSeconds *int32 `json:"lastSeenAgeSeconds,omitempty"`
 
-	// Apps Application details.
-	Apps *[]DeploymentV1App `json:"apps,omitempty"`
+	// ReadyNodes Number of nodes whose Ready condition is true.
+	ReadyNodes *int32 `json:"readyNodes,omitempty"`
+
+	// Reasons Reasons the cluster is degraded, empty if the cluster is healthy.
+	Reasons *[]string `json:"reasons,omitempty"`
+
+	// State State of the cluster, can be Running, Degraded, Error, Terminating or Unknown. Error when the cluster provisioning failed, Terminating when the cluster is being deleted and Unknown when the Fleet agent did not check in recently.
+	State *string `json:"state,omitempty"`
+
+	// TotalNodes Number of nodes of the cluster.
+	TotalNodes *int32 `json:"totalNodes,omitempty"`
+}
+
+// DeploymentV1ClusterInfo Cluster defines the message for the Cluster object.
+type DeploymentV1ClusterInfo struct {
+	// Cordon Cordon state of the cluster, cordoned or drained, empty if the cluster is not cordoned.
+	Cordon *string `json:"cordon,omitempty"`
 
 	// CreateTime A Timestamp represents a point in time independent of any time zone or local
 	//  calendar, encoded as a count of seconds and fractions of seconds at
@@ -337,73 +595,351 @@ type DeploymentV1Deployment struct {
 	//  ) to obtain a formatter capable of generating timestamps in this format.
 	CreateTime *GoogleProtobufTimestamp `json:"createTime,omitempty"`
 
-	// DeployId The id of the deployment.
-	DeployId *string `json:"deployId,omitempty"`
+	// Health Health of a cluster observed through its nodes and Fleet agent.
+	Health *DeploymentV1ClusterHealth `json:"health,omitempty"`
 
-	// DeploymentType (OPTIONAL) The deployment type for the target cluster deployment can be either auto-scaling or targeted.
-	//  In Auto-scaling type, the application will be automatically deployed on all the
-	//  clusters which match the Target cluster label. In Targeted type, the user has to select among pre created
-	//  clusters to deploy the application.
-	DeploymentType *string `json:"deploymentType,omitempty"`
+	// Id ID is the cluster id which ECM generates and assigns to the Rancher cluster name.
+	Id *string `json:"id,omitempty"`
 
-	// DisplayName (OPTIONAL) Deployment display name.
-	DisplayName *string `json:"displayName,omitempty"`
+	// Labels List of cluster labels retrieved from Fleet cluster object.
+	Labels *map[string]string `json:"labels,omitempty"`
 
-	// Name Deployment name (unique string assigned by Orchestrator).
+	// Name Name is the display name which user provides and ECM creates and assigns clustername label to Fleet cluster object.
 	Name *string `json:"name,omitempty"`
+}
 
-	// NetworkName (OPTIONAL) network_name is the name of the interconnect network that deployment be part of
-	NetworkName *string `json:"networkName,omitempty"`
+// DeploymentV1CreateClusterGroupResponse Response message for the CreateClusterGroup method.
+type DeploymentV1CreateClusterGroupResponse struct {
+	// ClusterGroup ClusterGroup defines a named set of clusters deployments can target. The members are the static clusters, the
+	//  clusters matching the selector and the members of the union groups, restricted to the members of each intersection
+	//  group and minus the members of each exclude group.
+	ClusterGroup *DeploymentV1ClusterGroup `json:"clusterGroup,omitempty"`
+}
 
-	// OverrideValues (OPTIONAL) The Override values can be used to override any of the base profile values based on Deployment scenario.
-	OverrideValues *[]DeploymentV1OverrideValues `json:"overrideValues,omitempty"`
+// DeploymentV1CreateDeploymentResponse Response message for the CreateDeployment method.
+type DeploymentV1CreateDeploymentResponse struct {
+	// DeploymentId Returns the new Deployment Id.
+	DeploymentId string `json:"deploymentId"`
 
-	// ProfileName (OPTIONAL) The selected profile name to be used for the base Helm values of the different applications in the deployment package
-	ProfileName *string `json:"profileName,omitempty"`
+	// InsufficientCapacityClusters (OPTIONAL) Ids of the target clusters without enough free capacity for the resource requests of the deployment.
+	InsufficientCapacityClusters *[]string `json:"insufficientCapacityClusters,omitempty"`
+}
 
-	// ServiceExports (OPTIONAL)
-	ServiceExports *[]DeploymentV1ServiceExport `json:"serviceExports,omitempty"`
+// DeploymentV1DeleteType Different delete types to handle parent and child
+//
+//	lists, for dependency support. Available options: PARENT_ONLY, ALL.
+type DeploymentV1DeleteType string
 
-	// Status Status has details of the deployment.
-	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
+// DeploymentV1DependencyEdge Dependency between two deployments. The apps of the dependent deployment are only rolled out on a
+//
+//	cluster once the required deployment is running on that cluster.
+type DeploymentV1DependencyEdge struct {
+	// From The id of the dependent deployment.
+	From *string `json:"from,omitempty"`
 
-	// TargetClusters (OPTIONAL) Cluster labels on which we want to deploy the application.
-	TargetClusters *[]DeploymentV1TargetClusters `json:"targetClusters,omitempty"`
+	// To The id of the required deployment.
+	To *string `json:"to,omitempty"`
 }
 
-// DeploymentV1DeploymentStatus Status has details of the deployment.
-type DeploymentV1DeploymentStatus struct {
-	Message *string `json:"message,omitempty"`
-
-	// State State are the different states a deployment/cluster/app can be in.
-	State *DeploymentV1State `json:"state,omitempty"`
+// DeploymentV1DependencyNode Deployment in a dependency graph.
+type DeploymentV1DependencyNode struct {
+	// AppName The deployment package name.
+	AppName *string `json:"appName,omitempty"`
 
-	// Summary Count of status.
-	Summary *DeploymentV1Summary `json:"summary,omitempty"`
-}
+	// AppVersion The version of the deployment package.
+	AppVersion *string `json:"appVersion,omitempty"`
 
-// DeploymentV1DeploymentInstancesCluster defines model for deployment.v1.DeploymentInstancesCluster.
-type DeploymentV1DeploymentInstancesCluster struct {
-	// Apps Apps has per-app details.
-	Apps *[]DeploymentV1App `json:"apps,omitempty"`
+	// DeployId The id of the deployment.
+	DeployId *string `json:"deployId,omitempty"`
 
-	// DeploymentDisplayName (OPTIONAL) Deployment display name.
-	DeploymentDisplayName *string `json:"deploymentDisplayName,omitempty"`
+	// DisplayName Deployment display name.
+	DisplayName *string `json:"displayName,omitempty"`
 
-	// DeploymentName Deployment name (unique string assigned by Orchestrator).
-	DeploymentName *string `json:"deploymentName,omitempty"`
+	// Name Deployment name (unique string assigned by Orchestrator).
+	Name *string `json:"name,omitempty"`
 
-	// DeploymentUid Deployment CR UID.
-	DeploymentUid *string `json:"deploymentUid,omitempty"`
+	// ProfileName The profile name of the deployment package.
+	ProfileName *string `json:"profileName,omitempty"`
 
 	// Status Status has details of the deployment.
 	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
 }
 
-// DeploymentV1GetAppNamespaceRequest Request message for the GetappNamespace method.
-type DeploymentV1GetAppNamespaceRequest struct {
-	AppId string `json:"appId"`
-
+// DeploymentV1Deployment Deployment defines the specification to deploy a Deployment Package onto a set of clusters.
+type DeploymentV1Deployment struct {
+	// AllAppTargetClusters Set target clusters based on labels.
+	AllAppTargetClusters *DeploymentV1TargetClusters `json:"allAppTargetClusters,omitempty"`
+
+	// AppName The deployment package name to deploy from the catalog.
+	AppName string `json:"appName"`
+
+	// AppVersion The version of the deployment package.
+	AppVersion string `json:"appVersion"`
+
+	// Apps Application details.
+	Apps *[]DeploymentV1App `json:"apps,omitempty"`
+
+	// BlueGreenServices (OPTIONAL) Services exposing the applications of a blue-green deployment. They select the pods of the active release and
+	//  are switched to the new release once it is healthy on all target clusters.
+	BlueGreenServices *[]DeploymentV1BlueGreenService `json:"blueGreenServices,omitempty"`
+
+	// BlueGreenStatus Progress of the rollout of a blue-green deployment.
+	BlueGreenStatus *DeploymentV1BlueGreenStatus `json:"blueGreenStatus,omitempty"`
+
+	// Canary Canary stage of a deployment.
+	Canary *DeploymentV1Canary `json:"canary,omitempty"`
+
+	// CanaryStatus Progress of the canary stage of a deployment.
+	CanaryStatus *DeploymentV1CanaryStatus `json:"canaryStatus,omitempty"`
+
+	// CreateTime A Timestamp represents a point in time independent of any time zone or local
+	//  calendar, encoded as a count of seconds and fractions of seconds at
+	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
+	//  January 1, 1970, in the proleptic Gregorian calendar which extends the
+	//  Gregorian calendar backwards to year one.
+	//
+	//  All minutes are 60 seconds long. Leap seconds are "smeared" so that no leap
+	//  second table is needed for interpretation, using a [24-hour linear
+	//  smear](https://developers.google.com/time/smear).
+	//
+	//  The range is from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z. By
+	//  restricting to that range, we ensure that we can convert to and from [RFC
+	//  3339](https://www.ietf.org/rfc/rfc3339.txt) date strings.
+	//
+	//  # Examples
+	//
+	//  Example 1: Compute Timestamp from POSIX `time()`.
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(time(NULL));
+	//      timestamp.set_nanos(0);
+	//
+	//  Example 2: Compute Timestamp from POSIX `gettimeofday()`.
+	//
+	//      struct timeval tv;
+	//      gettimeofday(&tv, NULL);
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(tv.tv_sec);
+	//      timestamp.set_nanos(tv.tv_usec * 1000);
+	//
+	//  Example 3: Compute Timestamp from Win32 `GetSystemTimeAsFileTime()`.
+	//
+	//      FILETIME ft;
+	//      GetSystemTimeAsFileTime(&ft);
+	//      UINT64 ticks = (((UINT64)ft.dwHighDateTime) << 32) | ft.dwLowDateTime;
+	//
+	//      // A Windows tick is 100 nanoseconds. Windows epoch 1601-01-01T00:00:00Z
+	//      // is 11644473600 seconds before Unix epoch 1970-01-01T00:00:00Z.
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds((INT64) ((ticks / 10000000) - 11644473600LL));
+	//      timestamp.set_nanos((INT32) ((ticks % 10000000) * 100));
+	//
+	//  Example 4: Compute Timestamp from Java `System.currentTimeMillis()`.
+	//
+	//      long millis = System.currentTimeMillis();
+	//
+	//      Timestamp timestamp = Timestamp.newBuilder().setSeconds(millis / 1000)
+	//          .setNanos((int) ((millis % 1000) * 1000000)).build();
+	//
+	//  Example 5: Compute Timestamp from Java `Instant.now()`.
+	//
+	//      Instant now = Instant.now();
+	//
+	//      Timestamp timestamp =
+	//          Timestamp.newBuilder().setSeconds(now.getEpochSecond())
+	//              .setNanos(now.getNano()).build();
+	//
+	//  Example 6: Compute Timestamp from current time in Python.
+	//
+	//      timestamp = Timestamp()
+	//      timestamp.GetCurrentTime()
+	//
+	//  # JSON Mapping
+	//
+	//  In JSON format, the Timestamp type is encoded as a string in the
+	//  [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format. That is, the
+	//  format is "{year}-{month}-{day}T{hour}:{min}:{sec}[.{frac_sec}]Z"
+	//  where {year} is always expressed using four digits while {month}, {day},
+	//  {hour}, {min}, and {sec} are zero-padded to two digits each. The fractional
+	//  seconds, which can go up to 9 digits (i.e. up to 1 nanosecond resolution),
+	//  are optional. The "Z" suffix indicates the timezone ("UTC"); the timezone
+	//  is required. A proto3 JSON serializer should always use UTC (as indicated by
+	//  "Z") when printing the Timestamp type and a proto3 JSON parser should be
+	//  able to accept both UTC and other timezones (as indicated by an offset).
+	//
+	//  For example, "2017-01-15T01:30:15.01Z" encodes 15.01 seconds past
+	//  01:30 UTC on January 15, 2017.
+	//
+	//  In JavaScript, one can convert a Date object to this format using the
+	//  standard
+	//  [toISOString()](https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString)
+	//  method. In Python, a standard `datetime.datetime` object can be converted
+	//  to this format using
+	//  [`strftime`](https://docs.python.org/2/library/time.html#time.strftime) with
+	//  the time format spec '%Y-%m-%dT%H:%M:%S.%fZ'. Likewise, in Java, one can use
+	//  the Joda Time's [`ISODateTimeFormat.dateTime()`](
+	//  http://joda-time.sourceforge.net/apidocs/org/joda/time/format/ISODateTimeFormat.html#dateTime()
+	//  ) to obtain a formatter capable of generating timestamps in this format.
+	CreateTime *GoogleProtobufTimestamp `json:"createTime,omitempty"`
+
+	// DeployId The id of the deployment.
+	DeployId *string `json:"deployId,omitempty"`
+
+	// DeploymentType (OPTIONAL) The deployment type for the target cluster deployment can be either auto-scaling, targeted or blue-green.
+	//  In Auto-scaling type, the application will be automatically deployed on all the
+	//  clusters which match the Target cluster label. In Targeted type, the user has to select among pre created
+	//  clusters to deploy the application. In Blue-green type, the clusters are selected like in auto-scaling type
+	//  and updates are deployed side by side with the running release, which keeps serving the traffic until the
+	//  new release is healthy on all clusters.
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// DisplayName (OPTIONAL) Deployment display name.
+	DisplayName *string `json:"displayName,omitempty"`
+
+	// DriftPolicy (OPTIONAL) The drift policy defines how changes made to the deployed resources outside of the orchestrator are handled,
+	//  can be either alert-only or auto-correct. In alert-only mode (default) the drift is only reported, in
+	//  auto-correct mode the resources are reverted to the desired state.
+	DriftPolicy *string `json:"driftPolicy,omitempty"`
+
+	// HealthProbes (OPTIONAL) Health probes run on each target cluster after the applications are rolled out. An application is only
+	//  considered running once all of its health probes passed.
+	HealthProbes *[]DeploymentV1HealthProbe `json:"healthProbes,omitempty"`
+
+	// KustomizePatches (OPTIONAL) Kustomize patches applied to the rendered Helm charts of the applications, e.g. to add tolerations or node
+	//  selectors the charts values do not expose.
+	KustomizePatches *[]DeploymentV1KustomizePatch `json:"kustomizePatches,omitempty"`
+
+	// ManifestApps (OPTIONAL) Applications deployed from plain Kubernetes manifests or a Kustomize base instead of a Helm chart, next to the
+	//  applications of the deployment package.
+	ManifestApps *[]DeploymentV1ManifestApp `json:"manifestApps,omitempty"`
+
+	// Name Deployment name (unique string assigned by Orchestrator).
+	Name *string `json:"name,omitempty"`
+
+	// NamespacePolicies (OPTIONAL) Resource constraints and Pod Security admission level of the namespaces the applications are deployed to.
+	NamespacePolicies *[]DeploymentV1NamespacePolicy `json:"namespacePolicies,omitempty"`
+
+	// NetworkName (OPTIONAL) network_name is the name of the interconnect network that deployment be part of
+	NetworkName *string `json:"networkName,omitempty"`
+
+	// OverrideValues (OPTIONAL) The Override values can be used to override any of the base profile values based on Deployment scenario.
+	OverrideValues *[]DeploymentV1OverrideValues `json:"overrideValues,omitempty"`
+
+	// Priority (OPTIONAL) The priority of the deployment, can be either critical, high, normal or low. Deployments with a higher
+	//  priority are reconciled first by the orchestrator, e.g. to roll out security patches. Defaults to normal.
+	Priority *string `json:"priority,omitempty"`
+
+	// ProfileName (OPTIONAL) The selected profile name to be used for the base Helm values of the different applications in the deployment package
+	ProfileName *string `json:"profileName,omitempty"`
+
+	// ResourceRequests Compute resources requested by a deployment on each target cluster.
+	ResourceRequests *DeploymentV1ResourceRequests `json:"resourceRequests,omitempty"`
+
+	// ServiceExports (OPTIONAL)
+	ServiceExports *[]DeploymentV1ServiceExport `json:"serviceExports,omitempty"`
+
+	// Status Status has details of the deployment.
+	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
+
+	// TargetClusters (OPTIONAL) Cluster labels on which we want to deploy the application.
+	TargetClusters *[]DeploymentV1TargetClusters `json:"targetClusters,omitempty"`
+
+	// TestHooks (OPTIONAL) Test hooks run once on each target cluster after the applications are rolled out for a new generation of the
+	//  deployment. A failing test moves the deployment to the Error state.
+	TestHooks *[]DeploymentV1TestHook `json:"testHooks,omitempty"`
+}
+
+// DeploymentV1DeploymentStatus Status has details of the deployment.
+type DeploymentV1DeploymentStatus struct {
+	Message *string `json:"message,omitempty"`
+
+	// State State are the different states a deployment/cluster/app can be in.
+	State *DeploymentV1State `json:"state,omitempty"`
+
+	// Summary Count of status.
+	Summary *DeploymentV1Summary `json:"summary,omitempty"`
+}
+
+// DeploymentV1DeploymentInstancesCluster defines model for deployment.v1.DeploymentInstancesCluster.
+type DeploymentV1DeploymentInstancesCluster struct {
+	// Apps Apps has per-app details.
+	Apps *[]DeploymentV1App `json:"apps,omitempty"`
+
+	// DeploymentDisplayName (OPTIONAL) Deployment display name.
+	DeploymentDisplayName *string `json:"deploymentDisplayName,omitempty"`
+
+	// DeploymentName Deployment name (unique string assigned by Orchestrator).
+	DeploymentName *string `json:"deploymentName,omitempty"`
+
+	// DeploymentUid Deployment CR UID.
+	DeploymentUid *string `json:"deploymentUid,omitempty"`
+
+	// Status Status has details of the deployment.
+	Status *DeploymentV1DeploymentStatus `json:"status,omitempty"`
+}
+
+// DeploymentV1DriftedResource Details of a deployed resource which differs from the desired state.
+type DeploymentV1DriftedResource struct {
+	// ApiVersion API version of the resource.
+	ApiVersion *string `json:"apiVersion,omitempty"`
+
+	// Kind Kind of the resource.
+	Kind *string `json:"kind,omitempty"`
+
+	// Name Name of the resource.
+	Name *string `json:"name,omitempty"`
+
+	// Namespace Namespace of the resource.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Patch JSON merge patch from the live to the desired state, only set for modified resources.
+	Patch *string `json:"patch,omitempty"`
+
+	// Type Type of drift, can be Modified, Missing or Orphaned.
+	Type *string `json:"type,omitempty"`
+}
+
+// DeploymentV1ExportInventoryResponse Response message for the ExportInventory method.
+type DeploymentV1ExportInventoryResponse struct {
+	// Content The inventory of all the clusters of the project.
+	Content *[]byte `json:"content,omitempty"`
+
+	// FileName The suggested file name of the exported inventory.
+	FileName *string `json:"fileName,omitempty"`
+}
+
+// DeploymentV1ExportOfflineBundleResponse Response message for ExportOfflineBundle method.
+type DeploymentV1ExportOfflineBundleResponse struct {
+	// Archive The next chunk of the gzipped tar archive to import on the cluster.
+	Archive *[]byte `json:"archive,omitempty"`
+
+	// FileName The file name of the archive, set in the first message only.
+	FileName *string `json:"fileName,omitempty"`
+
+	// Generation The generation of the deployment in the archive, set in the first message only.
+	Generation *int32 `json:"generation,omitempty"`
+}
+
+// DeploymentV1ExternalSecretRef Secret of an external-secrets store. It is read on the edge cluster and never reaches the orchestrator.
+type DeploymentV1ExternalSecretRef struct {
+	// Key Key of the secret in the store.
+	Key string `json:"key"`
+
+	// Property (OPTIONAL) Property of the secret holding the value, defaults to the whole secret.
+	Property *string `json:"property,omitempty"`
+
+	// Store Name of the SecretStore or ClusterSecretStore.
+	Store string `json:"store"`
+
+	// StoreKind (OPTIONAL) Kind of the store, SecretStore or ClusterSecretStore. Defaults to ClusterSecretStore.
+	StoreKind *string `json:"storeKind,omitempty"`
+}
+
+// DeploymentV1GetAppNamespaceRequest Request message for the GetappNamespace method.
+type DeploymentV1GetAppNamespaceRequest struct {
+	AppId string `json:"appId"`
+
 	// ProjectName (OPTIONAL) Project name for multi-tenant path routing.
 	ProjectName *string `json:"projectName,omitempty"`
 }
@@ -413,47 +949,407 @@ type DeploymentV1GetAppNamespaceResponse struct {
 	Namespace string `json:"namespace"`
 }
 
+// DeploymentV1GetClusterGroupResponse Response message for the GetClusterGroup method.
+type DeploymentV1GetClusterGroupResponse struct {
+	// ClusterGroup ClusterGroup defines a named set of clusters deployments can target. The members are the static clusters, the
+	//  clusters matching the selector and the members of the union groups, restricted to the members of each intersection
+	//  group and minus the members of each exclude group.
+	ClusterGroup *DeploymentV1ClusterGroup `json:"clusterGroup,omitempty"`
+}
+
+// DeploymentV1GetClusterInventoryResponse Response message for the GetClusterInventory method.
+type DeploymentV1GetClusterInventoryResponse struct {
+	// Items The applications placed on the cluster, one item per application of each deployment.
+	Items         *[]DeploymentV1InventoryItem `json:"items,omitempty"`
+	TotalElements *int32                       `json:"totalElements,omitempty"`
+}
+
 // DeploymentV1GetClusterResponse Response message for GetCluster method.
 type DeploymentV1GetClusterResponse struct {
 	// Cluster Details of cluster.
 	Cluster *DeploymentV1Cluster `json:"cluster,omitempty"`
 }
 
-// DeploymentV1GetDeploymentResponse Response message for the GetDeployment method.
-type DeploymentV1GetDeploymentResponse struct {
-	// Deployment Deployment defines the specification to deploy a Deployment Package onto a set of clusters.
-	Deployment DeploymentV1Deployment `json:"deployment"`
+// DeploymentV1GetDependencyGraphResponse Response message for GetDependencyGraph method.
+type DeploymentV1GetDependencyGraphResponse struct {
+	// Edges Dependencies between the deployments of the graph.
+	Edges []DeploymentV1DependencyEdge `json:"edges"`
+
+	// Nodes Deployments of the dependency graph, including the requested one.
+	Nodes []DeploymentV1DependencyNode `json:"nodes"`
+}
+
+// DeploymentV1GetDeploymentDriftResponse Response message for GetDeploymentDrift method.
+type DeploymentV1GetDeploymentDriftResponse struct {
+	// Clusters Per-cluster drift details.
+	Clusters []DeploymentV1ClusterDrift `json:"clusters"`
+
+	// DriftPolicy The drift policy of the deployment, alert-only or auto-correct.
+	DriftPolicy string `json:"driftPolicy"`
+}
+
+// DeploymentV1GetDeploymentResponse Response message for the GetDeployment method.
+type DeploymentV1GetDeploymentResponse struct {
+	// Deployment Deployment defines the specification to deploy a Deployment Package onto a set of clusters.
+	Deployment DeploymentV1Deployment `json:"deployment"`
+}
+
+// DeploymentV1GetDeploymentsStatusResponse Response message for the GetDeploymentsStatus method.
+type DeploymentV1GetDeploymentsStatusResponse struct {
+	Deploying   *int32 `json:"deploying,omitempty"`
+	Down        *int32 `json:"down,omitempty"`
+	Error       *int32 `json:"error,omitempty"`
+	Running     *int32 `json:"running,omitempty"`
+	Terminating *int32 `json:"terminating,omitempty"`
+	Total       *int32 `json:"total,omitempty"`
+	Unknown     *int32 `json:"unknown,omitempty"`
+	Updating    *int32 `json:"updating,omitempty"`
+}
+
+// DeploymentV1GetKubeConfigRequest Request message for Get KubeConfig method
+type DeploymentV1GetKubeConfigRequest struct {
+	// ClusterId Required. The cluster id for the kubeconfig.
+	ClusterId string `json:"clusterId"`
+
+	// Namespace (OPTIONAL) Optional. The namespace the credentials are scoped to, required unless the caller is an orchestrator service.
+	//  The credentials scoped to the whole cluster are read-only.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// ProjectName (OPTIONAL) Project name for multi-tenant path routing.
+	ProjectName *string `json:"projectName,omitempty"`
+
+	// TtlSeconds (OPTIONAL) Optional. The lifetime of the credentials in seconds, at least 600 and at most 3600 for orchestrator services or 1800 for other callers.
+	TtlSeconds *int32 `json:"ttlSeconds,omitempty"`
+}
+
+// DeploymentV1GetKubeConfigResponse Response message for Get KubeConfig method
+type DeploymentV1GetKubeConfigResponse struct {
+	KubeConfigInfo *DeploymentV1KubeConfigInfo `json:"kubeConfigInfo,omitempty"`
+}
+
+// DeploymentV1GitManifestSource Directory of a Git repository holding the manifests of an application.
+type DeploymentV1GitManifestSource struct {
+	// Path (OPTIONAL) Path of the directory in the repository, defaults to the repository root.
+	Path *string `json:"path,omitempty"`
+
+	// Revision (OPTIONAL) Branch, tag or commit checked out, defaults to the default branch.
+	Revision *string `json:"revision,omitempty"`
+
+	// Url URL of the Git repository reachable from the orchestrator.
+	Url string `json:"url"`
+}
+
+// DeploymentV1HTTPProbe HTTP health probe on a service endpoint.
+type DeploymentV1HTTPProbe struct {
+	// ExpectedStatus (OPTIONAL) HTTP status code the endpoint must return, defaults to 200.
+	ExpectedStatus *int32 `json:"expectedStatus,omitempty"`
+
+	// Namespace (OPTIONAL) Namespace of the service, defaults to the namespace of the app.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Path (OPTIONAL) Path of the endpoint, e.g. /healthz.
+	Path *string `json:"path,omitempty"`
+
+	// Port Port of the service.
+	Port int32 `json:"port"`
+
+	// Scheme (OPTIONAL) Scheme of the endpoint, can be either http (default) or https.
+	Scheme *string `json:"scheme,omitempty"`
+
+	// Service Name of the service.
+	Service string `json:"service"`
+}
+
+// DeploymentV1HealthProbe Health probe run on each target cluster after the application is rolled out.
+type DeploymentV1HealthProbe struct {
+	// AppName The deployment package app name the probe belongs to.
+	AppName string `json:"appName"`
+
+	// Http HTTP health probe on a service endpoint.
+	Http *DeploymentV1HTTPProbe `json:"http,omitempty"`
+
+	// Job Job health probe which must complete successfully.
+	Job *DeploymentV1JobProbe `json:"job,omitempty"`
+
+	// Name Name of the probe, unique within the app.
+	Name string `json:"name"`
+
+	// Prometheus Prometheus health probe comparing the result of an instant query with a threshold.
+	Prometheus *DeploymentV1PrometheusProbe `json:"prometheus,omitempty"`
+
+	// Retries (OPTIONAL) Number of retries before the probe is considered failed, defaults to 3.
+	Retries *int32 `json:"retries,omitempty"`
+
+	// TimeoutSeconds (OPTIONAL) Time after which the probe is considered failed, defaults to 300 seconds.
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// DeploymentV1InventoryItem An application of a deployment placed on a cluster.
+type DeploymentV1InventoryItem struct {
+	// App The application name.
+	App *string `json:"app,omitempty"`
+
+	// AppName The deployment package name.
+	AppName *string `json:"appName,omitempty"`
+
+	// AppVersion The deployment package version.
+	AppVersion *string `json:"appVersion,omitempty"`
+
+	// BundleName The Fleet bundle name of the application.
+	BundleName *string `json:"bundleName,omitempty"`
+
+	// BundleState The state of the Fleet bundle of the application on the cluster.
+	BundleState *string `json:"bundleState,omitempty"`
+
+	// ChartName The helm chart of the application, empty for manifest applications.
+	ChartName *string `json:"chartName,omitempty"`
+
+	// ChartVersion The helm chart version of the application, empty for manifest applications.
+	ChartVersion *string `json:"chartVersion,omitempty"`
+
+	// ClusterId The cluster id.
+	ClusterId *string `json:"clusterId,omitempty"`
+
+	// ClusterName The display name of the cluster.
+	ClusterName *string `json:"clusterName,omitempty"`
+
+	// DeploymentDisplayName The deployment display name.
+	DeploymentDisplayName *string `json:"deploymentDisplayName,omitempty"`
+
+	// DeploymentName The deployment name.
+	DeploymentName *string `json:"deploymentName,omitempty"`
+
+	// DeploymentUid The deployment CR UID.
+	DeploymentUid *string `json:"deploymentUid,omitempty"`
+
+	// Namespace The namespace the application is deployed to.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// State State are the different states a deployment/cluster/app can be in.
+	State *DeploymentV1State `json:"state,omitempty"`
+
+	// UpdateTime A Timestamp represents a point in time independent of any time zone or local
+	//  calendar, encoded as a count of seconds and fractions of seconds at
+	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
+	//  January 1, 1970, in the proleptic Gregorian calendar which extends the
+	//  Gregorian calendar backwards to year one.
+	//
+	//  All minutes are 60 seconds long. Leap seconds are "smeared" so that no leap
+	//  second table is needed for interpretation, using a [24-hour linear
+	//  smear](https://developers.google.com/time/smear).
+	//
+	//  The range is from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z. By
+	//  restricting to that range, we ensure that we can convert to and from [RFC
+	//  3339](https://www.ietf.org/rfc/rfc3339.txt) date strings.
+	//
+	//  # Examples
+	//
+	//  Example 1: Compute Timestamp from POSIX `time()`.
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(time(NULL));
+	//      timestamp.set_nanos(0);
+	//
+	//  Example 2: Compute Timestamp from POSIX `gettimeofday()`.
+	//
+	//      struct timeval tv;
+	//      gettimeofday(&tv, NULL);
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(tv.tv_sec);
+	//      timestamp.set_nanos(tv.tv_usec * 1000);
+	//
+	//  Example 3: Compute Timestamp from Win32 `GetSystemTimeAsFileTime()`.
+	//
+	//      FILETIME ft;
+	//      GetSystemTimeAsFileTime(&ft);
+	//      UINT64 ticks = (((UINT64)ft.dwHighDateTime) << 32) | ft.dwLowDateTime;
+	//
+	//      // A Windows tick is 100 nanoseconds. Windows epoch 1601-01-01T00:00:00Z
+	//      // is 11644473600 seconds before Unix epoch 1970-01-01T00:00:00Z.
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds((INT64) ((ticks / 10000000) - 11644473600LL));
+	//      timestamp.set_nanos((INT32) ((ticks % 10000000) * 100));
+	//
+	//  Example 4: Compute Timestamp from Java `System.currentTimeMillis()`.
+	//
+	//      long millis = System.currentTimeMillis();
+	//
+	//      Timestamp timestamp = Timestamp.newBuilder().setSeconds(millis / 1000)
+	//          .setNanos((int) ((millis % 1000) * 1000000)).build();
+	//
+	//  Example 5: Compute Timestamp from Java `Instant.now()`.
+	//
+	//      Instant now = Instant.now();
+	//
+	//      Timestamp timestamp =
+	//          Timestamp.newBuilder().setSeconds(now.getEpochSecond())
+	//              .setNanos(now.getNano()).build();
+	//
+	//  Example 6: Compute Timestamp from current time in Python.
+	//
+	//      timestamp = Timestamp()
+	//      timestamp.GetCurrentTime()
+	//
+	//  # JSON Mapping
+	//
+	//  In JSON format, the Timestamp type is encoded as a string in the
+	//  [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format. That is, the
+	//  format is "{year}-{month}-{day}T{hour}:{min}:{sec}[.{frac_sec}]Z"
+	//  where {year} is always expressed using four digits while {month}, {day},
+	//  {hour}, {min}, and {sec} are zero-padded to two digits each. The fractional
+	//  seconds, which can go up to 9 digits (i.e. up to 1 nanosecond resolution),
+	//  are optional. The "Z" suffix indicates the timezone ("UTC"); the timezone
+	//  is required. A proto3 JSON serializer should always use UTC (as indicated by
+	//  "Z") when printing the Timestamp type and a proto3 JSON parser should be
+	//  able to accept both UTC and other timezones (as indicated by an offset).
+	//
+	//  For example, "2017-01-15T01:30:15.01Z" encodes 15.01 seconds past
+	//  01:30 UTC on January 15, 2017.
+	//
+	//  In JavaScript, one can convert a Date object to this format using the
+	//  standard
+	//  [toISOString()](https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString)
+	//  method. In Python, a standard `datetime.datetime` object can be converted
+	//  to this format using
+	//  [`strftime`](https://docs.python.org/2/library/time.html#time.strftime) with
+	//  the time format spec '%Y-%m-%dT%H:%M:%S.%fZ'. Likewise, in Java, one can use
+	//  the Joda Time's [`ISODateTimeFormat.dateTime()`](
+	//  http://joda-time.sourceforge.net/apidocs/org/joda/time/format/ISODateTimeFormat.html#dateTime()
+	//  ) to obtain a formatter capable of generating timestamps in this format.
+	UpdateTime *GoogleProtobufTimestamp `json:"updateTime,omitempty"`
+}
+
+// DeploymentV1JobProbe Job health probe which must complete successfully.
+type DeploymentV1JobProbe struct {
+	// Args (OPTIONAL) Arguments of the container.
+	Args *[]string `json:"args,omitempty"`
+
+	// Command (OPTIONAL) Command of the container, the entrypoint of the image is used if empty.
+	Command *[]string `json:"command,omitempty"`
+
+	// Image Image of the container running the check.
+	Image string `json:"image"`
+}
+
+// DeploymentV1KubeConfigInfo defines model for deployment.v1.KubeConfigInfo.
+type DeploymentV1KubeConfigInfo struct {
+	// ExpireTime A Timestamp represents a point in time independent of any time zone or local
+	//  calendar, encoded as a count of seconds and fractions of seconds at
+	//  nanosecond resolution. The count is relative to an epoch at UTC midnight on
+	//  January 1, 1970, in the proleptic Gregorian calendar which extends the
+	//  Gregorian calendar backwards to year one.
+	//
+	//  All minutes are 60 seconds long. Leap seconds are "smeared" so that no leap
+	//  second table is needed for interpretation, using a [24-hour linear
+	//  smear](https://developers.google.com/time/smear).
+	//
+	//  The range is from 0001-01-01T00:00:00Z to 9999-12-31T23:59:59.999999999Z. By
+	//  restricting to that range, we ensure that we can convert to and from [RFC
+	//  3339](https://www.ietf.org/rfc/rfc3339.txt) date strings.
+	//
+	//  # Examples
+	//
+	//  Example 1: Compute Timestamp from POSIX `time()`.
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(time(NULL));
+	//      timestamp.set_nanos(0);
+	//
+	//  Example 2: Compute Timestamp from POSIX `gettimeofday()`.
+	//
+	//      struct timeval tv;
+	//      gettimeofday(&tv, NULL);
+	//
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds(tv.tv_sec);
+	//      timestamp.set_nanos(tv.tv_usec * 1000);
+	//
+	//  Example 3: Compute Timestamp from Win32 `GetSystemTimeAsFileTime()`.
+	//
+	//      FILETIME ft;
+	//      GetSystemTimeAsFileTime(&ft);
+	//      UINT64 ticks = (((UINT64)ft.dwHighDateTime) << 32) | ft.dwLowDateTime;
+	//
+	//      // A Windows tick is 100 nanoseconds. Windows epoch 1601-01-01T00:00:00Z
+	//      // is 11644473600 seconds before Unix epoch 1970-01-01T00:00:00Z.
+	//      Timestamp timestamp;
+	//      timestamp.set_seconds((INT64) ((ticks / 10000000) - 11644473600LL));
+	//      timestamp.set_nanos((INT32) ((ticks % 10000000) * 100));
+	//
+	//  Example 4: Compute Timestamp from Java `System.currentTimeMillis()`.
+	//
+	//      long millis = System.currentTimeMillis();
+	//
+	//      Timestamp timestamp = Timestamp.newBuilder().setSeconds(millis / 1000)
+	//          .setNanos((int) ((millis % 1000) * 1000000)).build();
+	//
+	//  Example 5: Compute Timestamp from Java `Instant.now()`.
+	//
+	//      Instant now = Instant.now();
+	//
+	//      Timestamp timestamp =
+	//          Timestamp.newBuilder().setSeconds(now.getEpochSecond())
+	//              .setNanos(now.getNano()).build();
+	//
+	//  Example 6: Compute Timestamp from current time in Python.
+	//
+	//      timestamp = Timestamp()
+	//      timestamp.GetCurrentTime()
+	//
+	//  # JSON Mapping
+	//
+	//  In JSON format, the Timestamp type is encoded as a string in the
+	//  [RFC 3339](https://www.ietf.org/rfc/rfc3339.txt) format. That is, the
+	//  format is "{year}-{month}-{day}T{hour}:{min}:{sec}[.{frac_sec}]Z"
+	//  where {year} is always expressed using four digits while {month}, {day},
+	//  {hour}, {min}, and {sec} are zero-padded to two digits each. The fractional
+	//  seconds, which can go up to 9 digits (i.e. up to 1 nanosecond resolution),
+	//  are optional. The "Z" suffix indicates the timezone ("UTC"); the timezone
+	//  is required. A proto3 JSON serializer should always use UTC (as indicated by
+	//  "Z") when printing the Timestamp type and a proto3 JSON parser should be
+	//  able to accept both UTC and other timezones (as indicated by an offset).
+	//
+	//  For example, "2017-01-15T01:30:15.01Z" encodes 15.01 seconds past
+	//  01:30 UTC on January 15, 2017.
+	//
+	//  In JavaScript, one can convert a Date object to this format using the
+	//  standard
+	//  [toISOString()](https://developer.mozilla.org/en-US/docs/Web/JavaScript/Reference/Global_Objects/Date/toISOString)
+	//  method. In Python, a standard `datetime.datetime` object can be converted
+	//  to this format using
+	//  [`strftime`](https://docs.python.org/2/library/time.html#time.strftime) with
+	//  the time format spec '%Y-%m-%dT%H:%M:%S.%fZ'. Likewise, in Java, one can use
+	//  the Joda Time's [`ISODateTimeFormat.dateTime()`](
+	//  http://joda-time.sourceforge.net/apidocs/org/joda/time/format/ISODateTimeFormat.html#dateTime()
+	//  ) to obtain a formatter capable of generating timestamps in this format.
+	ExpireTime *GoogleProtobufTimestamp `json:"expireTime,omitempty"`
+
+	// KubeConfig Kubeconfig authenticated by a short-lived service account token.
+	KubeConfig *[]byte `json:"kubeConfig,omitempty"`
 }
 
-// DeploymentV1GetDeploymentsStatusResponse Response message for the GetDeploymentsStatus method.
-type DeploymentV1GetDeploymentsStatusResponse struct {
-	Deploying   *int32 `json:"deploying,omitempty"`
-	Down        *int32 `json:"down,omitempty"`
-	Error       *int32 `json:"error,omitempty"`
-	Running     *int32 `json:"running,omitempty"`
-	Terminating *int32 `json:"terminating,omitempty"`
-	Total       *int32 `json:"total,omitempty"`
-	Unknown     *int32 `json:"unknown,omitempty"`
-	Updating    *int32 `json:"updating,omitempty"`
-}
+// DeploymentV1KustomizePatch Kustomize patch applied to the resources rendered from the Helm chart of an application.
+type DeploymentV1KustomizePatch struct {
+	// AppName (OPTIONAL) The deployment package app name the patch is applied to, the patch is applied to all apps if empty.
+	AppName *string `json:"appName,omitempty"`
 
-// DeploymentV1GetKubeConfigRequest Request message for Get KubeConfig method
-type DeploymentV1GetKubeConfigRequest struct {
-	// ClusterId Required. The cluster id for the kubeconfig.
-	ClusterId string `json:"clusterId"`
+	// Patch The patch in YAML or JSON. A strategic merge patch is a partial resource, a JSON6902 patch is a list of
+	//  operations.
+	Patch string `json:"patch"`
 
-	// ProjectName (OPTIONAL) Project name for multi-tenant path routing.
-	ProjectName *string `json:"projectName,omitempty"`
-}
+	// Target Selects the resources a Kustomize patch is applied to, unset fields match any resource.
+	Target *DeploymentV1PatchTarget `json:"target,omitempty"`
 
-// DeploymentV1GetKubeConfigResponse Response message for Get KubeConfig method
-type DeploymentV1GetKubeConfigResponse struct {
-	KubeConfigInfo *DeploymentV1KubeConfigInfo `json:"kubeConfigInfo,omitempty"`
+	// Type (OPTIONAL) Type of the patch, can be either strategic-merge or json6902. Defaults to strategic-merge if omitted.
+	Type *string `json:"type,omitempty"`
 }
 
-// DeploymentV1KubeConfigInfo defines model for deployment.v1.KubeConfigInfo.
-type DeploymentV1KubeConfigInfo struct {
-	KubeConfig *[]byte `json:"kubeConfig,omitempty"`
+// DeploymentV1ListClusterGroupsResponse Response message for the ListClusterGroups method.
+type DeploymentV1ListClusterGroupsResponse struct {
+	// ClusterGroups A list of ClusterGroup Objects.
+	ClusterGroups []DeploymentV1ClusterGroup `json:"clusterGroups"`
+	TotalElements int32                      `json:"totalElements"`
 }
 
 // DeploymentV1ListClustersResponse Response message for the ListClusters method.
@@ -483,6 +1379,61 @@ type DeploymentV1ListDeploymentsResponse struct {
 	TotalElements int32                    `json:"totalElements"`
 }
 
+// DeploymentV1ManifestApp Application deployed from plain Kubernetes manifests or a Kustomize base. A source holding a kustomization.yaml
+//
+//	is built with Kustomize, otherwise all of its YAML files are applied.
+type DeploymentV1ManifestApp struct {
+	// Artifact Name of an artifact of the deployment package with the purpose "manifest" holding the manifests.
+	Artifact *string `json:"artifact,omitempty"`
+
+	// DependsOn (OPTIONAL) Names of the applications of the deployment which must be ready before this application is deployed.
+	DependsOn *[]string `json:"dependsOn,omitempty"`
+
+	// Git Directory of a Git repository holding the manifests of an application.
+	Git *DeploymentV1GitManifestSource `json:"git,omitempty"`
+
+	// Name Name of the application, unique among the applications of the deployment.
+	Name string `json:"name"`
+
+	// Namespace (OPTIONAL) Namespace the resources are deployed to, defaults to the deployment name.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Oci OCI artifact holding the manifests of an application.
+	Oci *DeploymentV1OCIManifestSource `json:"oci,omitempty"`
+}
+
+// DeploymentV1NamespacePolicy Constraints of the namespace an application is deployed to. They are applied to the namespace on each target
+//
+//	cluster together with the application.
+type DeploymentV1NamespacePolicy struct {
+	// AppName The deployment package app name whose namespace is constrained.
+	AppName string `json:"appName"`
+
+	// DefaultLimits (OPTIONAL) Limits set by the LimitRange of the namespace on containers without limits, by resource name, e.g. cpu.
+	DefaultLimits *map[string]string `json:"defaultLimits,omitempty"`
+
+	// DefaultRequests (OPTIONAL) Requests set by the LimitRange of the namespace on containers without requests, by resource name.
+	DefaultRequests *map[string]string `json:"defaultRequests,omitempty"`
+
+	// MaxLimits (OPTIONAL) Maximum limits of a container allowed by the LimitRange of the namespace, by resource name.
+	MaxLimits *map[string]string `json:"maxLimits,omitempty"`
+
+	// PodSecurityLevel (OPTIONAL) Pod Security admission level enforced on the namespace, can be privileged, baseline or restricted.
+	PodSecurityLevel *string `json:"podSecurityLevel,omitempty"`
+
+	// ResourceQuota (OPTIONAL) Hard limits of the ResourceQuota of the namespace by resource name, e.g. requests.cpu, limits.memory or pods.
+	ResourceQuota *map[string]string `json:"resourceQuota,omitempty"`
+}
+
+// DeploymentV1OCIManifestSource OCI artifact holding the manifests of an application.
+type DeploymentV1OCIManifestSource struct {
+	// Path (OPTIONAL) Path of the directory in the artifact, defaults to the artifact root.
+	Path *string `json:"path,omitempty"`
+
+	// Url Reference of the artifact, e.g. oci://registry.example.com/manifests/app:1.0.0.
+	Url string `json:"url"`
+}
+
 // DeploymentV1OverrideValues The Override values can be used to override any of the base helm values of
 //
 //	applications based on Deployment scenario.
@@ -490,6 +1441,9 @@ type DeploymentV1OverrideValues struct {
 	// AppName deployment package name to use when overriding values.
 	AppName string `json:"appName"`
 
+	// SecretRefs (OPTIONAL) Override values resolved from a secret store when the deployment is rendered, so that they are never stored in plain text.
+	SecretRefs *[]DeploymentV1SecretRef `json:"secretRefs,omitempty"`
+
 	// TargetNamespace (OPTIONAL) The namespace to deploy the app onto, default namespace is default.
 	TargetNamespace *string `json:"targetNamespace,omitempty"`
 
@@ -504,6 +1458,118 @@ type DeploymentV1OverrideValues struct {
 	Values *GoogleProtobufStruct `json:"values,omitempty"`
 }
 
+// DeploymentV1PatchTarget Selects the resources a Kustomize patch is applied to, unset fields match any resource.
+type DeploymentV1PatchTarget struct {
+	// Group (OPTIONAL) API group of the resources.
+	Group *string `json:"group,omitempty"`
+
+	// Kind (OPTIONAL) Kind of the resources.
+	Kind *string `json:"kind,omitempty"`
+
+	// LabelSelector (OPTIONAL) Label selector of the resources, e.g. app=wordpress.
+	LabelSelector *string `json:"labelSelector,omitempty"`
+
+	// Name (OPTIONAL) Name of the resources, may be a regular expression.
+	Name *string `json:"name,omitempty"`
+
+	// Namespace (OPTIONAL) Namespace of the resources.
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Version (OPTIONAL) API version of the resources.
+	Version *string `json:"version,omitempty"`
+}
+
+// DeploymentV1PreviewClusterGroupResponse Response message for the PreviewClusterGroup method.
+type DeploymentV1PreviewClusterGroupResponse struct {
+	// Clusters The member clusters of the group.
+	Clusters      *[]DeploymentV1ClusterInfo `json:"clusters,omitempty"`
+	TotalElements *int32                     `json:"totalElements,omitempty"`
+}
+
+// DeploymentV1PreviewTargetsRequest Request message for the PreviewTargets method.
+type DeploymentV1PreviewTargetsRequest struct {
+	// AllAppTargetClusters Set target clusters based on labels.
+	AllAppTargetClusters *DeploymentV1TargetClusters `json:"allAppTargetClusters,omitempty"`
+
+	// AppName (OPTIONAL) The deployment package name, required to preview all_app_target_clusters.
+	AppName *string `json:"appName,omitempty"`
+
+	// AppVersion (OPTIONAL) The version of the deployment package, required to preview all_app_target_clusters.
+	AppVersion *string `json:"appVersion,omitempty"`
+
+	// DeploymentType (OPTIONAL) The deployment type, auto-scaling if empty, the target clusters are matched like the deployment would.
+	DeploymentType *string `json:"deploymentType,omitempty"`
+
+	// ProjectName (OPTIONAL) Project name for multi-tenant path routing.
+	ProjectName *string `json:"projectName,omitempty"`
+
+	// TargetClusters (OPTIONAL) The target clusters of the applications, as set in the deployment.
+	TargetClusters *[]DeploymentV1TargetClusters `json:"targetClusters,omitempty"`
+}
+
+// DeploymentV1PreviewTargetsResponse Response message for the PreviewTargets method.
+type DeploymentV1PreviewTargetsResponse struct {
+	// Apps The clusters matched by each application.
+	Apps *[]DeploymentV1AppTargetClusters `json:"apps,omitempty"`
+
+	// PartialClusters The clusters matched by some applications but not by others.
+	PartialClusters *[]DeploymentV1ClusterInfo `json:"partialClusters,omitempty"`
+
+	// TotalElements The number of distinct clusters matched by any application.
+	TotalElements *int32 `json:"totalElements,omitempty"`
+}
+
+// DeploymentV1ProbeResult Result of a single health probe.
+type DeploymentV1ProbeResult struct {
+	// Message Message reported for a pending or failed probe.
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the probe.
+	Name *string `json:"name,omitempty"`
+
+	// State State of the probe, can be Pending, Passed or Failed.
+	State *string `json:"state,omitempty"`
+}
+
+// DeploymentV1PrometheusProbe Prometheus health probe comparing the result of an instant query with a threshold.
+type DeploymentV1PrometheusProbe struct {
+	// Operator Operator used to compare the query result with the threshold, one of >, >=, <, <=, == or !=.
+	Operator string `json:"operator"`
+
+	// Query Instant PromQL query which must return a single value.
+	Query string `json:"query"`
+
+	// Threshold (OPTIONAL) Threshold the query result is compared with.
+	Threshold *float64 `json:"threshold,omitempty"`
+
+	// Url URL of the Prometheus server reachable from the edge cluster.
+	Url string `json:"url"`
+}
+
+// DeploymentV1ResourceRequests Compute resources requested by a deployment on each target cluster.
+type DeploymentV1ResourceRequests struct {
+	// Cpu (OPTIONAL) CPU requested, as a Kubernetes quantity such as 500m or 2.
+	Cpu *string `json:"cpu,omitempty"`
+
+	// Enforce (OPTIONAL) Refuse to create the deployment if a target cluster lacks capacity instead of only reporting the cluster.
+	Enforce *bool `json:"enforce,omitempty"`
+
+	// Memory (OPTIONAL) Memory requested, as a Kubernetes quantity such as 512Mi or 2Gi.
+	Memory *string `json:"memory,omitempty"`
+}
+
+// DeploymentV1SecretRef Override value read from a secret store.
+type DeploymentV1SecretRef struct {
+	// External Secret of an external-secrets store. It is read on the edge cluster and never reaches the orchestrator.
+	External *DeploymentV1ExternalSecretRef `json:"external,omitempty"`
+
+	// Key Dotted path of the value in the Helm values, e.g. db.password.
+	Key string `json:"key"`
+
+	// Vault Secret stored in Vault. It is read by the orchestrator and delivered as a SealedSecret.
+	Vault *DeploymentV1VaultSecretRef `json:"vault,omitempty"`
+}
+
 // DeploymentV1ServiceExport defines model for deployment.v1.ServiceExport.
 type DeploymentV1ServiceExport struct {
 	AppName string `json:"appName"`
@@ -536,6 +1602,9 @@ type DeploymentV1TargetClusters struct {
 	// AppName (OPTIONAL) The targeted deployment package name.
 	AppName *string `json:"appName,omitempty"`
 
+	// ClusterGroup (OPTIONAL) Name of the cluster group whose member clusters are targeted, set instead of labels or cluster id.
+	ClusterGroup *string `json:"clusterGroup,omitempty"`
+
 	// ClusterId (OPTIONAL) Cluster id to match the target cluster when targeted deployment.
 	ClusterId *string `json:"clusterId,omitempty"`
 
@@ -543,12 +1612,65 @@ type DeploymentV1TargetClusters struct {
 	Labels *map[string]string `json:"labels,omitempty"`
 }
 
+// DeploymentV1TestHook Test hook run as a Kubernetes Job on each target cluster after the application is rolled out.
+type DeploymentV1TestHook struct {
+	// AppName The deployment package app name the test belongs to.
+	AppName string `json:"appName"`
+
+	// Artifact Name of an artifact of the deployment package with the purpose "test" holding a Job manifest.
+	Artifact *string `json:"artifact,omitempty"`
+
+	// BackoffLimit (OPTIONAL) Number of retries before the test is considered failed, defaults to 0.
+	BackoffLimit *int32 `json:"backoffLimit,omitempty"`
+
+	// Job Job health probe which must complete successfully.
+	Job *DeploymentV1JobProbe `json:"job,omitempty"`
+
+	// Name Name of the test, unique within the app.
+	Name string `json:"name"`
+
+	// TimeoutSeconds (OPTIONAL) Time after which the test is considered failed, defaults to 600 seconds.
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// DeploymentV1TestResult Result of a single test hook.
+type DeploymentV1TestResult struct {
+	// Logs Tail of the logs of the last test pod, collected once the test finished.
+	Logs *string `json:"logs,omitempty"`
+
+	// Message Message reported for a pending or failed test.
+	Message *string `json:"message,omitempty"`
+
+	// Name Name of the test.
+	Name *string `json:"name,omitempty"`
+
+	// State State of the test, can be Pending, Passed or Failed.
+	State *string `json:"state,omitempty"`
+}
+
+// DeploymentV1UpdateClusterGroupResponse Response message for the UpdateClusterGroup method.
+type DeploymentV1UpdateClusterGroupResponse struct {
+	// ClusterGroup ClusterGroup defines a named set of clusters deployments can target. The members are the static clusters, the
+	//  clusters matching the selector and the members of the union groups, restricted to the members of each intersection
+	//  group and minus the members of each exclude group.
+	ClusterGroup *DeploymentV1ClusterGroup `json:"clusterGroup,omitempty"`
+}
+
 // DeploymentV1UpdateDeploymentResponse Response message for the UpdateDeployment method.
 type DeploymentV1UpdateDeploymentResponse struct {
 	// Deployment Deployment defines the specification to deploy a Deployment Package onto a set of clusters.
 	Deployment DeploymentV1Deployment `json:"deployment"`
 }
 
+// DeploymentV1VaultSecretRef Secret stored in Vault. It is read by the orchestrator and delivered as a SealedSecret.
+type DeploymentV1VaultSecretRef struct {
+	// Key Key of the value in the secret.
+	Key string `json:"key"`
+
+	// Path Path of the secret, relative to the deployment values of the project.
+	Path string `json:"path"`
+}
+
 // GoogleProtobufEmpty A generic empty message that you can re-use to avoid defining duplicated
 //
 //	empty messages in your APIs. A typical example is to use it as the request
@@ -671,6 +1793,42 @@ type GoogleProtobufTimestamp = time.Time
 //	The JSON representation for `Value` is JSON value.
 type GoogleProtobufValue map[string]interface{}
 
+// DeploymentV1ClusterServiceListClusterGroups2Params defines parameters for DeploymentV1ClusterServiceListClusterGroups2.
+type DeploymentV1ClusterServiceListClusterGroups2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceCreateClusterGroup2Params defines parameters for DeploymentV1ClusterServiceCreateClusterGroup2.
+type DeploymentV1ClusterServiceCreateClusterGroup2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServicePreviewClusterGroup2Params defines parameters for DeploymentV1ClusterServicePreviewClusterGroup2.
+type DeploymentV1ClusterServicePreviewClusterGroup2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceDeleteClusterGroup2Params defines parameters for DeploymentV1ClusterServiceDeleteClusterGroup2.
+type DeploymentV1ClusterServiceDeleteClusterGroup2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceGetClusterGroup2Params defines parameters for DeploymentV1ClusterServiceGetClusterGroup2.
+type DeploymentV1ClusterServiceGetClusterGroup2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceUpdateClusterGroup2Params defines parameters for DeploymentV1ClusterServiceUpdateClusterGroup2.
+type DeploymentV1ClusterServiceUpdateClusterGroup2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
 // DeploymentV1ClusterServiceListClusters2Params defines parameters for DeploymentV1ClusterServiceListClusters2.
 type DeploymentV1ClusterServiceListClusters2Params struct {
 	// Labels Optional. A string array that filters cluster labels to be
@@ -700,6 +1858,30 @@ type DeploymentV1ClusterServiceGetCluster2Params struct {
 	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
 }
 
+// DeploymentV1ClusterServiceCordonCluster2Params defines parameters for DeploymentV1ClusterServiceCordonCluster2.
+type DeploymentV1ClusterServiceCordonCluster2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceDrainCluster2Params defines parameters for DeploymentV1ClusterServiceDrainCluster2.
+type DeploymentV1ClusterServiceDrainCluster2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceGetClusterInventory2Params defines parameters for DeploymentV1ClusterServiceGetClusterInventory2.
+type DeploymentV1ClusterServiceGetClusterInventory2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceUncordonCluster2Params defines parameters for DeploymentV1ClusterServiceUncordonCluster2.
+type DeploymentV1ClusterServiceUncordonCluster2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
 // DeploymentV1DeploymentServiceListDeployments2Params defines parameters for DeploymentV1DeploymentServiceListDeployments2.
 type DeploymentV1DeploymentServiceListDeployments2Params struct {
 	// Labels Optional. A string array that filters cluster labels to be
@@ -774,6 +1956,12 @@ type DeploymentV1DeploymentServiceUpdateDeployment2Params struct {
 	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
 }
 
+// DeploymentV1DeploymentServiceAbortDeployment2Params defines parameters for DeploymentV1DeploymentServiceAbortDeployment2.
+type DeploymentV1DeploymentServiceAbortDeployment2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
 // DeploymentV1DeploymentServiceListDeploymentClusters2Params defines parameters for DeploymentV1DeploymentServiceListDeploymentClusters2.
 type DeploymentV1DeploymentServiceListDeploymentClusters2Params struct {
 	// OrderBy Optional. Select field and order based on which Deployment cluster list will be sorted.
@@ -793,6 +1981,45 @@ type DeploymentV1DeploymentServiceListDeploymentClusters2Params struct {
 	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
 }
 
+// DeploymentV1DeploymentServiceExportOfflineBundle2Params defines parameters for DeploymentV1DeploymentServiceExportOfflineBundle2.
+type DeploymentV1DeploymentServiceExportOfflineBundle2Params struct {
+	// IncludeImages Optional. Include the container images found in the values as an OCI layout.
+	IncludeImages *bool `form:"includeImages,omitempty" json:"includeImages,omitempty"`
+
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1DeploymentServiceGetDependencyGraph2Params defines parameters for DeploymentV1DeploymentServiceGetDependencyGraph2.
+type DeploymentV1DeploymentServiceGetDependencyGraph2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1DeploymentServiceGetDeploymentDrift2Params defines parameters for DeploymentV1DeploymentServiceGetDeploymentDrift2.
+type DeploymentV1DeploymentServiceGetDeploymentDrift2Params struct {
+	// ClusterId Optional. The id of the cluster to get the drift for, all target clusters if empty.
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1DeploymentServicePromoteDeployment2Params defines parameters for DeploymentV1DeploymentServicePromoteDeployment2.
+type DeploymentV1DeploymentServicePromoteDeployment2Params struct {
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
+// DeploymentV1ClusterServiceExportInventory2Params defines parameters for DeploymentV1ClusterServiceExportInventory2.
+type DeploymentV1ClusterServiceExportInventory2Params struct {
+	// Format The format of the exported file, json or csv, json if empty.
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+
+	// ProjectName Project name for multi-tenant path routing.
+	ProjectName *string `form:"projectName,omitempty" json:"projectName,omitempty"`
+}
+
 // DeploymentV1DeploymentServiceGetDeploymentsStatus2Params defines parameters for DeploymentV1DeploymentServiceGetDeploymentsStatus2.
 type DeploymentV1DeploymentServiceGetDeploymentsStatus2Params struct {
 	// Labels Optional. A string array that filters cluster labels to be
@@ -898,6 +2125,24 @@ type DeploymentV1DeploymentServiceListDeploymentClustersParams struct {
 	Offset *int32 `form:"offset,omitempty" json:"offset,omitempty"`
 }
 
+// DeploymentV1DeploymentServiceExportOfflineBundleParams defines parameters for DeploymentV1DeploymentServiceExportOfflineBundle.
+type DeploymentV1DeploymentServiceExportOfflineBundleParams struct {
+	// IncludeImages Optional. Include the container images found in the values as an OCI layout.
+	IncludeImages *bool `form:"includeImages,omitempty" json:"includeImages,omitempty"`
+}
+
+// DeploymentV1DeploymentServiceGetDeploymentDriftParams defines parameters for DeploymentV1DeploymentServiceGetDeploymentDrift.
+type DeploymentV1DeploymentServiceGetDeploymentDriftParams struct {
+	// ClusterId Optional. The id of the cluster to get the drift for, all target clusters if empty.
+	ClusterId *string `form:"clusterId,omitempty" json:"clusterId,omitempty"`
+}
+
+// DeploymentV1ClusterServiceExportInventoryParams defines parameters for DeploymentV1ClusterServiceExportInventory.
+type DeploymentV1ClusterServiceExportInventoryParams struct {
+	// Format The format of the exported file, json or csv, json if empty.
+	Format *string `form:"format,omitempty" json:"format,omitempty"`
+}
+
 // DeploymentV1DeploymentServiceGetDeploymentsStatusParams defines parameters for DeploymentV1DeploymentServiceGetDeploymentsStatus.
 type DeploymentV1DeploymentServiceGetDeploymentsStatusParams struct {
 	// Labels Optional. A string array that filters cluster labels to be
@@ -905,24 +2150,48 @@ type DeploymentV1DeploymentServiceGetDeploymentsStatusParams struct {
 	Labels *[]string `form:"labels,omitempty" json:"labels,omitempty"`
 }
 
+// DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody defines body for DeploymentV1ClusterServiceCreateClusterGroup2 for application/json ContentType.
+type DeploymentV1ClusterServiceCreateClusterGroup2JSONRequestBody = DeploymentV1ClusterGroup
+
+// DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody defines body for DeploymentV1ClusterServicePreviewClusterGroup2 for application/json ContentType.
+type DeploymentV1ClusterServicePreviewClusterGroup2JSONRequestBody = DeploymentV1ClusterGroup
+
+// DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody defines body for DeploymentV1ClusterServiceUpdateClusterGroup2 for application/json ContentType.
+type DeploymentV1ClusterServiceUpdateClusterGroup2JSONRequestBody = DeploymentV1ClusterGroup
+
 // DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody defines body for DeploymentV1DeploymentServiceCreateDeployment2 for application/json ContentType.
 type DeploymentV1DeploymentServiceCreateDeployment2JSONRequestBody = DeploymentV1Deployment
 
 // DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody defines body for DeploymentV1DeploymentServiceUpdateDeployment2 for application/json ContentType.
 type DeploymentV1DeploymentServiceUpdateDeployment2JSONRequestBody = DeploymentV1Deployment
 
+// DeploymentV1ClusterServicePreviewTargets2JSONRequestBody defines body for DeploymentV1ClusterServicePreviewTargets2 for application/json ContentType.
+type DeploymentV1ClusterServicePreviewTargets2JSONRequestBody = DeploymentV1PreviewTargetsRequest
+
 // DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody defines body for DeploymentV1ClusterServiceGetKubeConfig for application/json ContentType.
 type DeploymentV1ClusterServiceGetKubeConfigJSONRequestBody = DeploymentV1GetKubeConfigRequest
 
 // DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody defines body for DeploymentV1DeploymentServiceGetAppNamespace for application/json ContentType.
 type DeploymentV1DeploymentServiceGetAppNamespaceJSONRequestBody = DeploymentV1GetAppNamespaceRequest
 
+// DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody defines body for DeploymentV1ClusterServiceCreateClusterGroup for application/json ContentType.
+type DeploymentV1ClusterServiceCreateClusterGroupJSONRequestBody = DeploymentV1ClusterGroup
+
+// DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody defines body for DeploymentV1ClusterServicePreviewClusterGroup for application/json ContentType.
+type DeploymentV1ClusterServicePreviewClusterGroupJSONRequestBody = DeploymentV1ClusterGroup
+
+// DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody defines body for DeploymentV1ClusterServiceUpdateClusterGroup for application/json ContentType.
+type DeploymentV1ClusterServiceUpdateClusterGroupJSONRequestBody = DeploymentV1ClusterGroup
+
 // DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody defines body for DeploymentV1DeploymentServiceCreateDeployment for application/json ContentType.
 type DeploymentV1DeploymentServiceCreateDeploymentJSONRequestBody = DeploymentV1Deployment
 
 // DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody defines body for DeploymentV1DeploymentServiceUpdateDeployment for application/json ContentType.
 type DeploymentV1DeploymentServiceUpdateDeploymentJSONRequestBody = DeploymentV1Deployment
 
+// DeploymentV1ClusterServicePreviewTargetsJSONRequestBody defines body for DeploymentV1ClusterServicePreviewTargets for application/json ContentType.
+type DeploymentV1ClusterServicePreviewTargetsJSONRequestBody = DeploymentV1PreviewTargetsRequest
+
 // Getter for additional properties for ConnectError. Returns the specified
 // element and whether it was found
 func (a ConnectError) Get(fieldName string) (value interface{}, found bool) {
@@ -9,14 +9,23 @@ import (
 	"time"
 
 	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	ClusterConditionKubeconfig = "KubeconfigReady"
 	ClusterOrchKeyProjectID    = "edge-orchestrator.intel.com/project-id"
+
+	// Cordoned clusters keep their applications but get no new deployment
+	// and no rollout, drained clusters also have their applications removed
+	Cordoned CordonType = "cordoned"
+	Drained  CordonType = "drained"
 )
 
+// +kubebuilder:validation:Enum=cordoned;drained
+type CordonType string
+
 // ClusterSpec defines the desired state of Cluster
 type ClusterSpec struct {
 	// Name is the name of this cluster
@@ -27,6 +36,11 @@ type ClusterSpec struct {
 
 	// KubeConfigSecretName contains the secret name for the kubeconfig data
 	KubeConfigSecretName string `json:"kubeConfigSecretName"`
+
+	// Cordon excludes the cluster from the deployment targets, the cluster is
+	// a target if not set
+	// +optional
+	Cordon CordonType `json:"cordon,omitempty"`
 }
 
 // ClusterStatus defines the observed state of Cluster
@@ -37,12 +51,18 @@ type ClusterStatus struct {
 	// A string to display in the CLI
 	Display string `json:"display,omitempty"`
 
-	// The state of the Cluster (Running / Down)
+	// The state of the Cluster (Running / Degraded / Unknown / Error / Terminating)
 	State StateType `json:"state"`
 
-	// An informative error message if State is Down
+	// An informative error message if State is not Running
 	Message string `json:"message,omitempty"`
 
+	// The reason reported by Cluster API when provisioning the cluster failed
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// The message reported by Cluster API when provisioning the cluster failed
+	FailureMessage string `json:"failureMessage,omitempty"`
+
 	// Time of last status update for the Cluster CR
 	LastStatusUpdate string `json:"lastStatusUpdate,omitempty"`
 
@@ -54,6 +74,61 @@ type ClusterStatus struct {
 
 	// The status reported from Fleet
 	FleetStatus FleetStatus `json:"fleetStatus,omitempty"`
+
+	// The compute capacity collected from the cluster nodes
+	Capacity *ClusterCapacity `json:"capacity,omitempty"`
+
+	// The health collected from the cluster nodes and Fleet agent
+	Health *ClusterHealth `json:"health,omitempty"`
+}
+
+// ClusterHealth defines the health of a cluster observed through its nodes and Fleet agent
+type ClusterHealth struct {
+	// Number of nodes whose Ready condition is true
+	ReadyNodes int `json:"readyNodes"`
+
+	// Number of nodes of the cluster
+	TotalNodes int `json:"totalNodes"`
+
+	// Names of the nodes which are not ready
+	NotReadyNodes []string `json:"notReadyNodes,omitempty"`
+
+	// Kubernetes version reported by the cluster API server
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// Version of the Fleet agent running on the cluster
+	AgentVersion string `json:"agentVersion,omitempty"`
+
+	// Reasons the cluster is degraded, empty if the cluster is healthy
+	Reasons []string `json:"reasons,omitempty"`
+
+	// Time the health was last collected
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// ClusterCapacity defines the compute capacity of the schedulable nodes of a cluster
+type ClusterCapacity struct {
+	// Allocatable resources of the schedulable nodes
+	Allocatable corev1.ResourceList `json:"allocatable,omitempty"`
+
+	// Resources requested by the pods running on the schedulable nodes
+	Requested corev1.ResourceList `json:"requested,omitempty"`
+
+	// Time the capacity was last collected
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+// Available returns the allocatable resources not requested by any pod
+func (c *ClusterCapacity) Available() corev1.ResourceList {
+	available := corev1.ResourceList{}
+	for name, allocatable := range c.Allocatable {
+		q := allocatable.DeepCopy()
+		if requested, ok := c.Requested[name]; ok {
+			q.Sub(requested)
+		}
+		available[name] = q
+	}
+	return available
 }
 
 // FleetStatus defines the observed state of Fleet
@@ -183,6 +258,14 @@ func (c *ClusterStatus) deepCopyClusterDisplay(src fleetv1alpha1.ClusterDisplay)
 		State:        src.State,
 	}
 
+	// Fleet does not report the nodes anymore, they are taken from the collected health
+	if c.Health != nil && c.Health.TotalNodes > 0 {
+		result.ReadyNodes = fmt.Sprintf("%d/%d", c.Health.ReadyNodes, c.Health.TotalNodes)
+		if len(c.Health.NotReadyNodes) > 0 {
+			result.SampleNode = c.Health.NotReadyNodes[0]
+		}
+	}
+
 	if result.ReadyBundles == "" {
 		result.ReadyBundles = "nil"
 	}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterGroupSpec defines the desired state of ClusterGroup.
+// The members of a group are its static clusters, the clusters matching its
+// selector and the members of its union groups, restricted to the members of
+// each intersection group and minus the members of each exclude group. A group
+// only made of intersection groups starts from the members of the first one.
+type ClusterGroupSpec struct {
+	// DisplayName of this cluster group
+	DisplayName string `json:"displayName,omitempty"`
+
+	// Description of this cluster group
+	Description string `json:"description,omitempty"`
+
+	// Clusters are the ids of the static members of the group
+	Clusters []string `json:"clusters,omitempty"`
+
+	// Selector selects the clusters having all these labels
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// Union lists the groups whose members are added to the group
+	Union []string `json:"union,omitempty"`
+
+	// Intersection lists the groups the members of the group must belong to
+	Intersection []string `json:"intersection,omitempty"`
+
+	// Exclude lists the groups whose members are removed from the group
+	Exclude []string `json:"exclude,omitempty"`
+}
+
+// ClusterGroupStatus defines the observed state of ClusterGroup
+type ClusterGroupStatus struct {
+	// Clusters are the ids of the resolved members of the group
+	Clusters []string `json:"clusters,omitempty"`
+
+	// The state of the ClusterGroup (Running / Error)
+	State StateType `json:"state,omitempty"`
+
+	// An informative error message if the members cannot be resolved
+	Message string `json:"message,omitempty"`
+
+	// The last generation that has been resolved
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Time the members were last resolved
+	LastUpdate metav1.Time `json:"lastUpdate,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Display Name",type="string",JSONPath=".spec.displayName",description="Display name of the ClusterGroup CR"
+//+kubebuilder:printcolumn:name="State",type=string,JSONPath=`.status.state`
+//+kubebuilder:printcolumn:name="Message",type=string,JSONPath=`.status.message`
+//+kubebuilder:storageversion
+
+// ClusterGroup is the Schema for the clustergroups API
+type ClusterGroup struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupSpec   `json:"spec,omitempty"`
+	Status ClusterGroupStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterGroupList contains a list of ClusterGroup
+type ClusterGroupList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGroup `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterGroup{}, &ClusterGroupList{})
+}
@@ -11,6 +11,13 @@ import (
 
 type StateType string
 type DeploymentType string
+type DriftPolicyType string
+type PriorityType string
+type BlueGreenSlot string
+type BlueGreenPhase string
+type CanaryPhase string
+type HealthProbeType string
+type PatchType string
 type LabelType string
 
 const (
@@ -23,13 +30,45 @@ const (
 	Updating         StateType = "Updating"
 	Terminating      StateType = "Terminating"
 	NoTargetClusters StateType = "NoTargetClusters"
+	Degraded         StateType = "Degraded"
 
 	AutoScaling DeploymentType = "auto-scaling"
 	Targeted    DeploymentType = "targeted"
+	BlueGreen   DeploymentType = "blue-green"
+
+	SlotBlue  BlueGreenSlot = "blue"
+	SlotGreen BlueGreenSlot = "green"
+
+	BlueGreenDeploying BlueGreenPhase = "Deploying"
+	BlueGreenSwitching BlueGreenPhase = "Switching"
+
+	CanaryDeploying CanaryPhase = "Deploying"
+	CanaryObserving CanaryPhase = "Observing"
+	CanaryPromoted  CanaryPhase = "Promoted"
+	CanaryAborted   CanaryPhase = "Aborted"
+
+	CanaryPromote = "promote"
+	CanaryAbort   = "abort"
+
+	AlertOnly   DriftPolicyType = "alert-only"
+	AutoCorrect DriftPolicyType = "auto-correct"
+
+	PriorityCritical PriorityType = "critical"
+	PriorityHigh     PriorityType = "high"
+	PriorityNormal   PriorityType = "normal"
+	PriorityLow      PriorityType = "low"
+
+	HealthProbeHTTP       HealthProbeType = "http"
+	HealthProbeJob        HealthProbeType = "job"
+	HealthProbePrometheus HealthProbeType = "prometheus"
+
+	PatchStrategicMerge PatchType = "strategic-merge"
+	PatchJSON6902       PatchType = "json6902"
 
 	AppName               LabelType = "app.edge-orchestrator.intel.com/app-name"
 	BundleName            LabelType = "app.edge-orchestrator.intel.com/bundle-name"
 	BundleType            LabelType = "app.edge-orchestrator.intel.com/bundle-type"
+	BlueGreenSlotLabel    LabelType = "app.edge-orchestrator.intel.com/blue-green-slot"
 	DeploymentID          LabelType = "app.edge-orchestrator.intel.com/deployment-id"
 	FleetClusterID        LabelType = "fleet.cattle.io/cluster"
 	FleetClusterNamespace LabelType = "fleet.cattle.io/cluster-namespace"
@@ -41,12 +80,29 @@ const (
 	ClusterName   LabelType = "edge-orchestrator.intel.com/clustername"
 	CapiInfraName LabelType = "edge-orchestrator.intel.com/capiInfraName"
 
+	// Application target key naming the ClusterGroup the application is
+	// deployed to, resolved to its member clusters by the controller
+	ClusterGroupName LabelType = "app.edge-orchestrator.intel.com/cluster-group"
+
+	// Fleet cluster label excluding a cordoned or drained cluster from the
+	// GitRepo target selectors, set by the controller from the Cluster CR
+	ClusterCordon LabelType = "app.edge-orchestrator.intel.com/cordon"
+
 	// fleet-global.yaml labels used for extension apps
 	FleetProjectID LabelType = "edge-orchestrator.intel.com/project-id"
 	FleetHostUuid  LabelType = "edge-orchestrator.intel.com/host-uuid"
 
 	AppOrchActiveProjectID LabelType = "app.edge-orchestrator.intel.com/project-id"
 
+	// Deployment annotation with the user who requested the latest create or update
+	RequestedBy LabelType = "app.edge-orchestrator.intel.com/requested-by"
+
+	// Deployment annotation requesting the promotion or abort of the canary stage
+	CanaryAction LabelType = "app.edge-orchestrator.intel.com/canary-action"
+
+	// Deployment annotation with the generation exported per cluster in offline bundles
+	OfflineBundles LabelType = "app.edge-orchestrator.intel.com/offline-bundles"
+
 	FinalizerGitRemote  = "app.edge-orchestrator.intel.com/git-remote"
 	FinalizerCatalog    = "app.edge-orchestrator.intel.com/catalog"
 	FinalizerDependency = "app.edge-orchestrator.intel.com/dependency"
@@ -117,6 +173,83 @@ type HelmApp struct {
 	ImageRegistrySecretName string `json:"imageRegistrySecretName,omitempty"`
 }
 
+// ManifestApp refer to an application deployed from plain manifests or a
+// Kustomize base. Exactly one source is set.
+type ManifestApp struct {
+	// Artifact is the name of the deployment package artifact Manifest was
+	// read from
+	Artifact string `json:"artifact,omitempty"`
+
+	// Manifest holds the manifests of the application, a multi-document YAML
+	// stream
+	Manifest string `json:"manifest,omitempty"`
+
+	// Git refers to a directory of a Git repository holding the manifests
+	Git *GitManifestSource `json:"git,omitempty"`
+
+	// OCI refers to an OCI artifact holding the manifests
+	OCI *OCIManifestSource `json:"oci,omitempty"`
+}
+
+type GitManifestSource struct {
+	// Repo is the URL of the Git repository
+	Repo string `json:"repo"`
+
+	// Path of the directory in the repository, defaults to the repository
+	// root
+	Path string `json:"path,omitempty"`
+
+	// Revision is the branch, tag or commit to check out, defaults to the
+	// default branch
+	Revision string `json:"revision,omitempty"`
+}
+
+type OCIManifestSource struct {
+	// URL of the OCI artifact, e.g. oci://registry.example.com/manifests/app:1.0.0
+	URL string `json:"url"`
+
+	// Path of the directory in the artifact, defaults to the artifact root
+	Path string `json:"path,omitempty"`
+}
+
+// SecretRef refer to an overriding value read from a secret store. Exactly
+// one of Vault and External is set.
+type SecretRef struct {
+	// Key is the dotted path of the value in the Helm values
+	Key string `json:"key"`
+
+	// Vault refer to a secret read from Vault when the application is
+	// rendered and delivered to the clusters as a SealedSecret
+	Vault *VaultSecretRef `json:"vault,omitempty"`
+
+	// External refer to a secret read by the external-secrets operator on
+	// the target clusters
+	External *ExternalSecretRef `json:"external,omitempty"`
+}
+
+type VaultSecretRef struct {
+	// Path of the secret, relative to the deployment values of the project
+	Path string `json:"path"`
+
+	// Key of the value in the secret
+	Key string `json:"key"`
+}
+
+type ExternalSecretRef struct {
+	// Store is the name of the SecretStore or ClusterSecretStore
+	Store string `json:"store"`
+
+	// StoreKind is SecretStore or ClusterSecretStore
+	// +kubebuilder:validation:Enum=SecretStore;ClusterSecretStore
+	StoreKind string `json:"storeKind"`
+
+	// Key of the secret in the store
+	Key string `json:"key"`
+
+	// Property of the secret holding the value, defaults to the whole secret
+	Property string `json:"property,omitempty"`
+}
+
 type IgnoreResource struct {
 	// Name of the resource to ignore
 	Name string `json:"name"`
@@ -128,6 +261,177 @@ type IgnoreResource struct {
 	Namespace string `json:"namespace,omitempty"`
 }
 
+type HTTPProbe struct {
+	// Service name of the endpoint to probe
+	Service string `json:"service"`
+
+	// Namespace of the service, defaults to the application namespace
+	Namespace string `json:"namespace,omitempty"`
+
+	// Port of the service
+	Port int32 `json:"port"`
+
+	// Path of the endpoint, e.g. /healthz
+	Path string `json:"path,omitempty"`
+
+	// Scheme to use, either http (default) or https
+	Scheme string `json:"scheme,omitempty"`
+
+	// ExpectedStatus is the HTTP status code the endpoint must return, defaults to 200
+	ExpectedStatus int32 `json:"expectedStatus,omitempty"`
+}
+
+type JobProbe struct {
+	// Image of the container that runs the check
+	Image string `json:"image"`
+
+	// Command of the container, the image entrypoint is used if empty
+	Command []string `json:"command,omitempty"`
+
+	// Args of the container
+	Args []string `json:"args,omitempty"`
+}
+
+type PrometheusProbe struct {
+	// URL of the Prometheus server reachable from the edge cluster
+	URL string `json:"url"`
+
+	// Query is an instant PromQL query that must return a single value
+	Query string `json:"query"`
+
+	// Operator used to compare the query result with the threshold, one of
+	// >, >=, <, <=, == or !=
+	Operator string `json:"operator"`
+
+	// Threshold the query result is compared with
+	Threshold string `json:"threshold"`
+}
+
+type HealthProbe struct {
+	// Name of the probe, unique within the application
+	Name string `json:"name"`
+
+	// Type of the probe, one of http, job or prometheus
+	Type HealthProbeType `json:"type"`
+
+	// HTTP probe specification, valid only when Type is http
+	HTTP *HTTPProbe `json:"http,omitempty"`
+
+	// Job probe specification, valid only when Type is job
+	Job *JobProbe `json:"job,omitempty"`
+
+	// Prometheus probe specification, valid only when Type is prometheus
+	Prometheus *PrometheusProbe `json:"prometheus,omitempty"`
+
+	// TimeoutSeconds after which the probe is considered failed
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// Retries before the probe is considered failed
+	Retries int32 `json:"retries,omitempty"`
+}
+
+type TestHook struct {
+	// Name of the test, unique within the application
+	Name string `json:"name"`
+
+	// Job runs a single container, mutually exclusive with Manifest
+	Job *JobProbe `json:"job,omitempty"`
+
+	// Artifact is the name of the deployment package artifact Manifest was
+	// read from
+	Artifact string `json:"artifact,omitempty"`
+
+	// Manifest of a batch/v1 Job, mutually exclusive with Job
+	Manifest string `json:"manifest,omitempty"`
+
+	// TimeoutSeconds after which the test is considered failed
+	TimeoutSeconds int32 `json:"timeoutSeconds,omitempty"`
+
+	// BackoffLimit is the number of retries before the test is considered failed
+	BackoffLimit int32 `json:"backoffLimit,omitempty"`
+}
+
+type BlueGreenServicePort struct {
+	// Name of the port, required if the service has several ports
+	Name string `json:"name,omitempty"`
+
+	// Port of the service
+	Port int32 `json:"port"`
+
+	// TargetPort of the pods, defaults to Port
+	TargetPort int32 `json:"targetPort,omitempty"`
+
+	// Protocol of the port, either TCP (default), UDP or SCTP
+	Protocol string `json:"protocol,omitempty"`
+}
+
+type BlueGreenService struct {
+	// Name of the service, must not clash with the resources of the application
+	Name string `json:"name"`
+
+	// Selector of the application pods. The Helm release of the active slot is
+	// added to it.
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// Ports of the service
+	Ports []BlueGreenServicePort `json:"ports"`
+}
+
+type NamespacePolicy struct {
+	// ResourceQuota are the hard limits of the namespace ResourceQuota
+	ResourceQuota corev1.ResourceList `json:"resourceQuota,omitempty"`
+
+	// DefaultLimits are set by the namespace LimitRange on containers
+	// without limits
+	DefaultLimits corev1.ResourceList `json:"defaultLimits,omitempty"`
+
+	// DefaultRequests are set by the namespace LimitRange on containers
+	// without requests
+	DefaultRequests corev1.ResourceList `json:"defaultRequests,omitempty"`
+
+	// MaxLimits are the maximum limits of a container allowed by the
+	// namespace LimitRange
+	MaxLimits corev1.ResourceList `json:"maxLimits,omitempty"`
+
+	// PodSecurityLevel is the Pod Security admission level enforced on the
+	// namespace, one of privileged, baseline or restricted
+	PodSecurityLevel string `json:"podSecurityLevel,omitempty"`
+}
+
+// PatchTarget selects the resources a patch is applied to, unset fields match
+// any resource
+type PatchTarget struct {
+	// Group is the API group of the resources
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resources
+	Version string `json:"version,omitempty"`
+
+	// Kind of the resources
+	Kind string `json:"kind,omitempty"`
+
+	// Name of the resources, may be a regular expression
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the resources
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector selects the resources by their labels
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+type KustomizePatch struct {
+	// Type of the patch, one of strategic-merge or json6902
+	Type PatchType `json:"type"`
+
+	// Patch is the strategic merge patch or the list of JSON6902 operations
+	Patch string `json:"patch"`
+
+	// Target selects the resources the patch is applied to, a strategic
+	// merge patch is applied to the resource it names if not set
+	Target *PatchTarget `json:"target,omitempty"`
+}
+
 type Application struct {
 	// Name of this application
 	Name string `json:"name"`
@@ -146,6 +450,7 @@ type Application struct {
 
 	// Targets refer to the clusters which will be deployed to
 	// If it's manual deployment, cluster id is set
+	// If it's a cluster group, the group name is set
 	Targets []map[string]string `json:"targets,omitempty"`
 
 	// ProfileSecretName contains the profile contents
@@ -154,6 +459,16 @@ type Application struct {
 	// ValueSecretName contains the deployment time overriding values
 	ValueSecretName string `json:"valueSecretName,omitempty"`
 
+	// ParameterSecretName contains the values of the secret parameters. They
+	// are removed from the overriding values and delivered to the clusters
+	// sealed, never in plain text in the Git repository.
+	ParameterSecretName string `json:"parameterSecretName,omitempty"`
+
+	// SecretRefs are overriding values read from a secret store when the
+	// application is rendered. They are never stored in plain text in the
+	// value secret nor in the Git repository.
+	SecretRefs []SecretRef `json:"secretRefs,omitempty"`
+
 	// DependsOn refers to the of applications which must be ready before this
 	// application can be deployed
 	DependsOn []string `json:"dependsOn,omitempty"`
@@ -170,11 +485,38 @@ type Application struct {
 	// HelmApp refer to the helm chart type application specification
 	HelmApp *HelmApp `json:"helmApp,omitempty"`
 
+	// ManifestApp refer to the plain manifest or Kustomize type application
+	// specification, set instead of HelmApp
+	ManifestApp *ManifestApp `json:"manifestApp,omitempty"`
+
 	// DependentDeploymentPackages has dependent deployment packages, which indicates application-level dependency
 	DependentDeploymentPackages map[string]DeploymentPackageRef `json:"dependentDeploymentPackages,omitempty"`
 
 	// If this flag is set, the services part of the application that are annotated should be exposed to other clusters
 	EnableServiceExport bool `json:"enableServiceExport,omitempty"`
+
+	// HealthProbes are run on each target cluster after the application is
+	// rolled out. The application is only considered running once all of
+	// them pass.
+	HealthProbes []HealthProbe `json:"healthProbes,omitempty"`
+
+	// TestHooks are run once on each target cluster after a new generation
+	// of the application is rolled out. A failing test moves the deployment
+	// to the Error state.
+	TestHooks []TestHook `json:"testHooks,omitempty"`
+
+	// BlueGreenServices expose the application of a blue-green deployment.
+	// They select the pods of the active slot and are switched to the new
+	// slot once it is running on all target clusters.
+	BlueGreenServices []BlueGreenService `json:"blueGreenServices,omitempty"`
+
+	// NamespacePolicy constrains the resources and the pod security of the
+	// application namespace on each target cluster
+	NamespacePolicy *NamespacePolicy `json:"namespacePolicy,omitempty"`
+
+	// KustomizePatches are applied to the resources rendered from the Helm
+	// chart of the application
+	KustomizePatches []KustomizePatch `json:"kustomizePatches,omitempty"`
 }
 
 // DeploymentSpec defines the desired state of Deployment
@@ -191,15 +533,57 @@ type DeploymentSpec struct {
 	// Applications is a list of applications included in this deployment
 	Applications []Application `json:"applications"`
 
-	// DeploymentType for this deployment, can be either auto-scaling or
-	// targeted.
+	// DeploymentType for this deployment, can be either auto-scaling,
+	// targeted or blue-green.
 	DeploymentType DeploymentType `json:"deploymentType"`
 
+	// DriftPolicy defines how changes made to deployed resources outside of
+	// Fleet are handled, can be either alert-only (default) or auto-correct.
+	DriftPolicy DriftPolicyType `json:"driftPolicy,omitempty"`
+
+	// Priority of this deployment in the controller queues, can be either
+	// critical, high, normal (default) or low. Deployments with a higher
+	// priority are reconciled first.
+	Priority PriorityType `json:"priority,omitempty"`
+
 	// ChildDeploymentList is the list of child deployment, which indicates deployment-level dependency
 	ChildDeploymentList map[string]DependentDeploymentRef `json:"childDeploymentList,omitempty"`
 
 	// NetworkRef a reference to Network Object for supporting interconnect between clusters
 	NetworkRef corev1.ObjectReference `json:"networkRef,omitempty"`
+
+	// Canary stage of this deployment. A new generation is first rolled out
+	// to a subset of the target clusters and observed there before it is
+	// promoted to all target clusters or aborted.
+	Canary *CanarySpec `json:"canary,omitempty"`
+
+	// ResourceRequests are the compute resources the applications of this
+	// deployment are expected to request on each target cluster
+	ResourceRequests *ResourceRequests `json:"resourceRequests,omitempty"`
+}
+
+type CanarySpec struct {
+	// ClusterCount is the number of target clusters the new generation is
+	// rolled out to first
+	ClusterCount int32 `json:"clusterCount"`
+
+	// Selector of the canary clusters by cluster labels, chosen at random
+	// among the target clusters if empty
+	Selector map[string]string `json:"selector,omitempty"`
+
+	// ObservationSeconds is the time the canary clusters are observed before
+	// the new generation is promoted, 600 if not set
+	ObservationSeconds int32 `json:"observationSeconds,omitempty"`
+}
+
+type ResourceRequests struct {
+	// Requests are the resources requested on each target cluster, only
+	// cpu and memory are checked against the cluster capacity
+	Requests corev1.ResourceList `json:"requests,omitempty"`
+
+	// Enforce refuses the deployment if a target cluster lacks capacity
+	// instead of only reporting the cluster
+	Enforce bool `json:"enforce,omitempty"`
 }
 
 // Deployment status summary
@@ -217,6 +601,49 @@ type ClusterSummary struct {
 	Unknown int `json:"unknown,omitempty"`
 }
 
+// Blue-green rollout status
+type BlueGreenStatus struct {
+	// ActiveSlot is the slot whose release serves the traffic
+	ActiveSlot BlueGreenSlot `json:"activeSlot"`
+
+	// ActiveGeneration is the deployment generation deployed in ActiveSlot
+	ActiveGeneration int64 `json:"activeGeneration,omitempty"`
+
+	// PreviewSlot is the slot the new generation is rolled out to, empty if
+	// no rollout is in progress
+	PreviewSlot BlueGreenSlot `json:"previewSlot,omitempty"`
+
+	// PreviewGeneration is the deployment generation deployed in PreviewSlot
+	PreviewGeneration int64 `json:"previewGeneration,omitempty"`
+
+	// Phase of the rollout, Deploying until the preview slot is running
+	// and Switching until the services select it
+	Phase BlueGreenPhase `json:"phase,omitempty"`
+}
+
+// Canary stage status
+type CanaryStatus struct {
+	// Phase of the canary stage, Deploying until the canary clusters are
+	// running and Observing until the new generation is promoted or aborted
+	Phase CanaryPhase `json:"phase"`
+
+	// Generation is the deployment generation rolled out to the canary clusters
+	Generation int64 `json:"generation,omitempty"`
+
+	// StableGeneration is the deployment generation kept on the other target
+	// clusters until the canary is promoted
+	StableGeneration int64 `json:"stableGeneration,omitempty"`
+
+	// Clusters are the ids of the canary clusters
+	Clusters []string `json:"clusters,omitempty"`
+
+	// StartTime of the current phase
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Message with the reason of the last phase transition
+	Message string `json:"message,omitempty"`
+}
+
 // DeploymentStatus defines the observed state of Deployment
 type DeploymentStatus struct {
 	// Conditions is a list conditions that describe the state of the deployment
@@ -248,6 +675,12 @@ type DeploymentStatus struct {
 
 	// ParentDeploymentList is the list of parent deployment, which indicates deployment-level dependency
 	ParentDeploymentList map[string]DependentDeploymentRef `json:"parentDeploymentList,omitempty"`
+
+	// BlueGreen is the rollout status of a blue-green deployment
+	BlueGreen *BlueGreenStatus `json:"blueGreen,omitempty"`
+
+	// Canary is the status of the canary stage
+	Canary *CanaryStatus `json:"canary,omitempty"`
 }
 
 //+kubebuilder:object:root=true
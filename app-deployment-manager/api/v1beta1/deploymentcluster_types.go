@@ -57,6 +57,123 @@ type App struct {
 
 	// Status of the app
 	Status Status `json:"status,omitempty"`
+
+	// State of the Fleet BundleDeployment of the app (Ready, Modified,
+	// WaitApplied, ErrApplied, ...)
+	BundleState string `json:"bundleState,omitempty"`
+
+	// Last time a condition of the Fleet BundleDeployment of the app changed
+	LastUpdate *metav1.Time `json:"lastUpdate,omitempty"`
+
+	// Drift of the deployed resources from the desired state
+	Drift *Drift `json:"drift,omitempty"`
+
+	// Health of the app as reported by its health probes, nil if the app has
+	// no health probes
+	Health *Health `json:"health,omitempty"`
+
+	// Tests has the test hook results of the app for its current deployment
+	// generation, nil if the app has no test hooks
+	Tests *Tests `json:"tests,omitempty"`
+}
+
+type HealthStateType string
+
+const (
+	HealthPending HealthStateType = "Pending"
+	HealthPassed  HealthStateType = "Passed"
+	HealthFailed  HealthStateType = "Failed"
+)
+
+// Health summarizes the health probe results of an app
+type Health struct {
+	// State is Passed only when all probes passed
+	State HealthStateType `json:"state"`
+
+	// Probes results
+	Probes []ProbeResult `json:"probes,omitempty"`
+}
+
+// ProbeResult is the result of a single health probe
+type ProbeResult struct {
+	// Name of the probe
+	Name string `json:"name"`
+
+	// State of the probe
+	State HealthStateType `json:"state"`
+
+	// Message reported for a pending or failed probe
+	Message string `json:"message,omitempty"`
+}
+
+type TestStateType string
+
+const (
+	TestPending TestStateType = "Pending"
+	TestPassed  TestStateType = "Passed"
+	TestFailed  TestStateType = "Failed"
+)
+
+// Tests summarizes the test hook results of an app
+type Tests struct {
+	// State is Passed only when all tests passed
+	State TestStateType `json:"state"`
+
+	// Results of the tests
+	Results []TestResult `json:"results,omitempty"`
+}
+
+// TestResult is the result of a single test hook
+type TestResult struct {
+	// Name of the test
+	Name string `json:"name"`
+
+	// State of the test
+	State TestStateType `json:"state"`
+
+	// Message reported for a pending or failed test
+	Message string `json:"message,omitempty"`
+
+	// Logs is the tail of the logs of the last test pod, collected once the
+	// test finished
+	Logs string `json:"logs,omitempty"`
+}
+
+type DriftType string
+
+const (
+	DriftModified DriftType = "Modified"
+	DriftMissing  DriftType = "Missing"
+	DriftOrphaned DriftType = "Orphaned"
+)
+
+// Drift summarizes the resources of an app that differ from the desired state
+type Drift struct {
+	// Number of resources modified outside of Fleet
+	Modified int `json:"modified,omitempty"`
+
+	// Number of desired resources missing on the cluster
+	Missing int `json:"missing,omitempty"`
+
+	// Number of resources on the cluster that are no longer desired
+	Orphaned int `json:"orphaned,omitempty"`
+
+	// Resources reported by the Fleet agent as not matching the desired state
+	Resources []DriftedResource `json:"resources,omitempty"`
+}
+
+// DriftedResource is a single resource that differs from the desired state
+type DriftedResource struct {
+	Kind       string `json:"kind"`
+	APIVersion string `json:"apiVersion"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+
+	// Type of drift (Modified, Missing, Orphaned)
+	Type DriftType `json:"type"`
+
+	// JSON merge patch from the live to the desired state, only set for modified resources
+	Patch string `json:"patch,omitempty"`
 }
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -25,4 +25,5 @@ var (
 	DeploymentsResource        = "deployments"
 	DeploymentClustersResource = "deploymentclusters"
 	ClustersResource           = "clusters"
+	ClusterGroupsResource      = "clustergroups"
 )
@@ -15,15 +15,17 @@ func main() {
 	keyPath := flag.String("keyPath", "", "path to client private key")
 	certPath := flag.String("certPath", "", "path to client certificate")
 	kubeconfig := flag.String("kubeconfig", "", "path to kubeconfig")
+	metricsPort := flag.Int("metricsPort", 8082, "port the API metrics are served on, disabled if 0")
 	flag.Parse()
 
 	ready := make(chan bool)
 	cfg := manager.Config{
-		CAPath:     *caPath,
-		KeyPath:    *keyPath,
-		CertPath:   *certPath,
-		GRPCPort:   8080,
-		Kubeconfig: *kubeconfig,
+		CAPath:      *caPath,
+		KeyPath:     *keyPath,
+		CertPath:    *certPath,
+		GRPCPort:    8080,
+		MetricsPort: *metricsPort,
+		Kubeconfig:  *kubeconfig,
 	}
 
 	mgr := manager.NewManager(cfg)
@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package main
+
+import (
+	"context"
+	"flag"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/offlinebundle"
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	_ "github.com/open-edge-platform/orch-library/go/dazl/zap"
+)
+
+var log = dazl.GetPackageLogger()
+
+type flags struct {
+	archive         string
+	kubeconfig      string
+	recordNamespace string
+	extractDir      string
+	maxSize         int64
+	dryRun          bool
+}
+
+func parseFlags() flags {
+	f := flags{}
+	flag.StringVar(&f.archive, "archive", "", "Path of the offline bundle exported by ExportOfflineBundle")
+	flag.StringVar(&f.kubeconfig, "kubeconfig", "", "Path of the kubeconfig of the cluster, the default one of helm and kubectl if empty")
+	flag.StringVar(&f.recordNamespace, "record-namespace", "default", "Namespace of the ConfigMap recording the imported deployment generation")
+	flag.StringVar(&f.extractDir, "extract-dir", "", "Directory to extract the archive to, a temporary directory removed after the import if empty")
+	flag.Int64Var(&f.maxSize, "max-size", 16<<30, "Maximum size in bytes of the extracted files")
+	flag.BoolVar(&f.dryRun, "dry-run", false, "Print the commands instead of running them")
+
+	flag.Parse()
+
+	return f
+}
+
+func main() {
+	f := parseFlags()
+	if f.archive == "" {
+		log.Fatal("-archive is required")
+	}
+
+	dir := f.extractDir
+	if dir == "" {
+		tmp, err := os.MkdirTemp("", "offline-bundle-")
+		if err != nil {
+			log.Fatalf("Failed to create extract directory %v", err)
+		}
+		defer os.RemoveAll(tmp)
+		dir = tmp
+	}
+
+	archive, err := os.Open(f.archive)
+	if err != nil {
+		log.Fatalf("Failed to open archive %v", err)
+	}
+	manifest, err := offlinebundle.Extract(archive, dir, f.maxSize)
+	archive.Close()
+	if err != nil {
+		log.Fatalf("Failed to extract archive %v", err)
+	}
+	log.Infof("Importing deployment %s (%s) generation %d exported for cluster %s",
+		manifest.DeploymentName, manifest.DeploymentID, manifest.Generation, manifest.ClusterID)
+
+	if _, err := os.Stat(filepath.Join(dir, offlinebundle.ImagesDir)); err == nil {
+		log.Infof("Images extracted as an OCI layout to %s, load them into the registry of the site before the import",
+			filepath.Join(dir, offlinebundle.ImagesDir))
+	}
+
+	opts := offlinebundle.ImportOptions{
+		Kubeconfig:      f.kubeconfig,
+		RecordNamespace: f.recordNamespace,
+	}
+	if f.dryRun {
+		opts.Run = func(_ context.Context, stdin []byte, name string, args ...string) error {
+			log.Infof("%s %s", name, strings.Join(args, " "))
+			if stdin != nil {
+				log.Infof("%s", stdin)
+			}
+			return nil
+		}
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := offlinebundle.Import(ctx, dir, manifest, opts); err != nil {
+		log.Errorf("Failed to import deployment %s: %v", manifest.DeploymentID, err)
+		cancel()
+		os.Exit(1)
+	}
+	log.Infof("Imported deployment %s generation %d", manifest.DeploymentID, manifest.Generation)
+}
@@ -9,11 +9,15 @@ import (
 	"fmt"
 	v1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers"
+	ctrlmetrics "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
 	"github.com/open-edge-platform/orch-library/go/dazl"
+	"github.com/prometheus/client_golang/prometheus"
 	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -68,15 +72,29 @@ func (r *ClusterController) Reconcile(ctx context.Context, request reconcile.Req
 		return r.reconcilePhaseProvisioned(ctx, capiCluster)
 		// Reconcile Cluster Phase Provisioned
 	case capiv1beta1.ClusterPhaseDeleting:
-		// No op for now
+		return r.reconcilePhaseDeleting(ctx, capiCluster)
 	case capiv1beta1.ClusterPhaseFailed:
-		// No op for now
+		return r.reconcilePhaseFailed(ctx, capiCluster)
 	}
 
 	return reconcile.Result{}, nil
 }
 
+//+kubebuilder:rbac:groups=cluster.x-k8s.io,resources=clusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups=fleet.cattle.io,resources=clusters,verbs=get;list;watch;create;update;delete
+//+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=deploymentclusters,verbs=get;list;delete
+//+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clusters/status,verbs=get;patch
+//+kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+
 func (r *ClusterController) reconcilePhaseProvisioned(ctx context.Context, capiCluster *capiv1beta1.Cluster) (reconcile.Result, error) {
+	// Clear the failure of a cluster which recovered
+	if err := r.updateClusterStatus(ctx, capiCluster, func(status *v1beta1.ClusterStatus) {
+		status.FailureReason = ""
+		status.FailureMessage = ""
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
 	// Wait until capi cluster is fully ready
 	if !(capiCluster.Status.ControlPlaneReady) || !(capiCluster.Status.InfrastructureReady) {
 		log.Infof("CapiCluster %s is not ready, requeuing", capiCluster.Name)
@@ -150,3 +168,130 @@ func (r *ClusterController) reconcilePhaseProvisioned(ctx context.Context, capiC
 
 	return reconcile.Result{}, nil
 }
+
+// reconcilePhaseDeleting marks the cluster Terminating and removes what ADM
+// keeps for the cluster without waiting for the CAPI cluster to be gone: the
+// DeploymentClusters, their metrics and the Fleet cluster. The deployments
+// which had applications on the cluster are notified with an event.
+func (r *ClusterController) reconcilePhaseDeleting(ctx context.Context, capiCluster *capiv1beta1.Cluster) (reconcile.Result, error) {
+	if err := r.updateClusterStatus(ctx, capiCluster, func(status *v1beta1.ClusterStatus) {
+		if status.State != v1beta1.Terminating {
+			status.State = v1beta1.Terminating
+			status.Message = "CAPI cluster is being deleted"
+			status.Display = string(v1beta1.Terminating)
+			status.LastStatusUpdate = time.Now().Format(time.RFC3339)
+		}
+	}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	dcList := &v1beta1.DeploymentClusterList{}
+	if err := r.List(ctx, dcList, client.MatchingLabels{string(v1beta1.ClusterName): capiCluster.Name}); err != nil {
+		return reconcile.Result{}, err
+	}
+
+	notified := map[string]bool{}
+	for i := range dcList.Items {
+		dc := &dcList.Items[i]
+		if dc.Spec.Namespace != capiCluster.Namespace {
+			continue
+		}
+
+		projectID := dc.Labels[string(v1beta1.AppOrchActiveProjectID)]
+		if !notified[dc.Spec.DeploymentID] {
+			notified[dc.Spec.DeploymentID] = true
+			r.notifyDeployment(ctx, projectID, dc.Spec.DeploymentID, capiCluster.Name)
+		}
+
+		if err := r.Delete(ctx, dc); client.IgnoreNotFound(err) != nil {
+			log.Warnf("Failed to delete DeploymentCluster %s: %v", dc.Name, err)
+			return reconcile.Result{}, err
+		}
+		log.Infof("Deleted DeploymentCluster %s of deleting cluster %s", dc.Name, capiCluster.Name)
+
+		clusterLabels := prometheus.Labels{"projectId": projectID, "cluster_id": dc.Spec.ClusterID}
+		ctrlmetrics.DeploymentClusterStatus.DeletePartialMatch(clusterLabels)
+		ctrlmetrics.DeploymentClusterDrift.DeletePartialMatch(clusterLabels)
+	}
+
+	fleetCluster := &fleetv1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      capiCluster.Name,
+			Namespace: capiCluster.Namespace,
+		},
+	}
+	if err := r.Delete(ctx, fleetCluster); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		log.Warnf("Failed to delete FleetCluster %s: %v", capiCluster.Name, err)
+		return reconcile.Result{}, err
+	}
+	log.Infof("Deleted FleetCluster %s", capiCluster.Name)
+
+	return reconcile.Result{}, nil
+}
+
+// reconcilePhaseFailed records the failure reported by CAPI in the cluster status
+func (r *ClusterController) reconcilePhaseFailed(ctx context.Context, capiCluster *capiv1beta1.Cluster) (reconcile.Result, error) {
+	reason := "Failed"
+	if capiCluster.Status.FailureReason != nil {
+		reason = string(*capiCluster.Status.FailureReason)
+	}
+	message := ""
+	if capiCluster.Status.FailureMessage != nil {
+		message = *capiCluster.Status.FailureMessage
+	}
+	log.Warnf("CapiCluster %s failed: %s: %s", capiCluster.Name, reason, message)
+
+	err := r.updateClusterStatus(ctx, capiCluster, func(status *v1beta1.ClusterStatus) {
+		if status.State == v1beta1.Error && status.FailureReason == reason && status.FailureMessage == message {
+			return
+		}
+		status.FailureReason = reason
+		status.FailureMessage = message
+		status.State = v1beta1.Error
+		status.Message = fmt.Sprintf("CAPI cluster failed: %s: %s", reason, message)
+		status.Display = string(v1beta1.Error)
+		status.LastStatusUpdate = time.Now().Format(time.RFC3339)
+	})
+	return reconcile.Result{}, err
+}
+
+// updateClusterStatus patches the status of the ADM cluster of a CAPI cluster,
+// if the cluster exists
+func (r *ClusterController) updateClusterStatus(ctx context.Context, capiCluster *capiv1beta1.Cluster, mutate func(status *v1beta1.ClusterStatus)) error {
+	cluster := &v1beta1.Cluster{}
+	if err := r.Get(ctx, client.ObjectKeyFromObject(capiCluster), cluster); err != nil {
+		return client.IgnoreNotFound(err)
+	}
+
+	orig := cluster.DeepCopy()
+	mutate(&cluster.Status)
+	if equality.Semantic.DeepEqual(orig.Status, cluster.Status) {
+		return nil
+	}
+	if err := r.Status().Patch(ctx, cluster, client.MergeFrom(orig)); client.IgnoreNotFound(err) != nil {
+		log.Warnf("Failed to update status of Cluster %s: %v", cluster.Name, err)
+		return err
+	}
+	return nil
+}
+
+// notifyDeployment records an event on the deployment, found by its UID, about
+// the deletion of one of its clusters
+func (r *ClusterController) notifyDeployment(ctx context.Context, projectID string, deploymentID string, clusterName string) {
+	deploymentList := &v1beta1.DeploymentList{}
+	if err := r.List(ctx, deploymentList, client.MatchingLabels{string(v1beta1.AppOrchActiveProjectID): projectID}); err != nil {
+		log.Warnf("Failed to list Deployments: %v", err)
+		return
+	}
+	for i := range deploymentList.Items {
+		d := &deploymentList.Items[i]
+		if d.UID == types.UID(deploymentID) {
+			r.Events.Eventf(d, corev1.EventTypeWarning, "ClusterDeleting",
+				"Cluster %s is being deleted, its applications are removed", clusterName)
+			return
+		}
+	}
+}
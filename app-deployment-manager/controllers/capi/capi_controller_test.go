@@ -0,0 +1,227 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capi
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers"
+	ctrlmetrics "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capierrors "sigs.k8s.io/cluster-api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+var _ = Describe("CAPI Cluster Controller", func() {
+	const (
+		clusterName  = "cluster-1"
+		otherCluster = "cluster-2"
+		projectID    = "project-1"
+		otherProject = "project-2"
+		deployment1  = "11111111-1111-1111-1111-111111111111"
+		deployment2  = "22222222-2222-2222-2222-222222222222"
+	)
+
+	var (
+		ctx      context.Context
+		c        client.Client
+		recorder *record.FakeRecorder
+		r        *ClusterController
+	)
+
+	capiCluster := func(phase capiv1beta1.ClusterPhase) *capiv1beta1.Cluster {
+		return &capiv1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: projectID},
+			Status:     capiv1beta1.ClusterStatus{Phase: string(phase)},
+		}
+	}
+
+	deploymentCluster := func(name string, cluster string, namespace string, deploymentID string) *v1beta1.DeploymentCluster {
+		return &v1beta1.DeploymentCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels: map[string]string{
+					string(v1beta1.ClusterName):            cluster,
+					string(v1beta1.AppOrchActiveProjectID): namespace,
+				},
+			},
+			Spec: v1beta1.DeploymentClusterSpec{
+				DeploymentID: deploymentID,
+				ClusterID:    cluster,
+				Namespace:    namespace,
+			},
+		}
+	}
+
+	deployment := func(name string, uid string) *v1beta1.Deployment {
+		return &v1beta1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: projectID,
+				UID:       types.UID(uid),
+				Labels:    map[string]string{string(v1beta1.AppOrchActiveProjectID): projectID},
+			},
+		}
+	}
+
+	setup := func(objs ...client.Object) {
+		c = fake.NewClientBuilder().
+			WithScheme(testScheme).
+			WithObjects(objs...).
+			WithStatusSubresource(&v1beta1.Cluster{}).
+			Build()
+		recorder = record.NewFakeRecorder(10)
+		r = &ClusterController{Controller: &controllers.Controller{Client: c, Scheme: testScheme, Events: recorder}}
+	}
+
+	reconcileCluster := func() (reconcile.Result, error) {
+		return r.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterName, Namespace: projectID}})
+	}
+
+	admCluster := func() *v1beta1.Cluster {
+		cluster := &v1beta1.Cluster{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: projectID}, cluster)).To(Succeed())
+		return cluster
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("Deleting phase", func() {
+		BeforeEach(func() {
+			setup(
+				capiCluster(capiv1beta1.ClusterPhaseDeleting),
+				&v1beta1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: projectID},
+					Status:     v1beta1.ClusterStatus{State: v1beta1.Running, Display: string(v1beta1.Running)},
+				},
+				&fleetv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: projectID}},
+				deployment("deployment-1", deployment1),
+				deployment("deployment-2", deployment2),
+				deploymentCluster("dc-1-app1", clusterName, projectID, deployment1),
+				deploymentCluster("dc-1-app2", clusterName, projectID, deployment1),
+				deploymentCluster("dc-2", otherCluster, projectID, deployment2),
+				deploymentCluster("dc-other-project", clusterName, otherProject, deployment2),
+			)
+
+			ctrlmetrics.DeploymentClusterStatus.Reset()
+			ctrlmetrics.DeploymentClusterDrift.Reset()
+			for _, cluster := range []string{clusterName, otherCluster} {
+				labels := prometheus.Labels{"projectId": projectID, "deployment_id": deployment1, "deployment_name": "deployment-1",
+					"cluster_id": cluster, "cluster_name": cluster}
+				ctrlmetrics.DeploymentClusterStatus.With(merge(labels, "status", "Running")).Set(1)
+				ctrlmetrics.DeploymentClusterDrift.With(merge(labels, "type", "modified")).Set(2)
+			}
+		})
+
+		It("marks the cluster Terminating and removes what ADM keeps for it", func() {
+			_, err := reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+
+			cluster := admCluster()
+			Expect(cluster.Status.State).To(Equal(v1beta1.Terminating))
+			Expect(cluster.Status.Display).To(Equal(string(v1beta1.Terminating)))
+			Expect(cluster.Status.Message).To(Equal("CAPI cluster is being deleted"))
+
+			dcList := &v1beta1.DeploymentClusterList{}
+			Expect(c.List(ctx, dcList)).To(Succeed())
+			var remaining []string
+			for _, dc := range dcList.Items {
+				remaining = append(remaining, dc.Name)
+			}
+			Expect(remaining).To(ConsistOf("dc-2", "dc-other-project"))
+
+			Expect(testutil.CollectAndCount(ctrlmetrics.DeploymentClusterStatus)).To(Equal(1))
+			Expect(testutil.ToFloat64(ctrlmetrics.DeploymentClusterStatus.With(prometheus.Labels{"projectId": projectID,
+				"deployment_id": deployment1, "deployment_name": "deployment-1", "cluster_id": otherCluster,
+				"cluster_name": otherCluster, "status": "Running"}))).To(Equal(float64(1)))
+			Expect(testutil.CollectAndCount(ctrlmetrics.DeploymentClusterDrift)).To(Equal(1))
+
+			err = c.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: projectID}, &fleetv1alpha1.Cluster{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(Equal("Warning ClusterDeleting Cluster cluster-1 is being deleted, its applications are removed"))
+		})
+
+		It("keeps the Terminating status on the next reconciliation", func() {
+			_, err := reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+			lastUpdate := admCluster().Status.LastStatusUpdate
+
+			_, err = reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+			cluster := admCluster()
+			Expect(cluster.Status.State).To(Equal(v1beta1.Terminating))
+			Expect(cluster.Status.LastStatusUpdate).To(Equal(lastUpdate))
+		})
+	})
+
+	Describe("Failed and Provisioned phases", func() {
+		It("records the CAPI failure and clears it once the cluster is provisioned", func() {
+			failed := capiCluster(capiv1beta1.ClusterPhaseFailed)
+			reason := capierrors.InvalidConfigurationClusterError
+			message := "invalid infrastructure template"
+			failed.Status.FailureReason = &reason
+			failed.Status.FailureMessage = &message
+			setup(failed, &v1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: projectID},
+				Status:     v1beta1.ClusterStatus{State: v1beta1.Running},
+			})
+
+			_, err := reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+			cluster := admCluster()
+			Expect(cluster.Status.State).To(Equal(v1beta1.Error))
+			Expect(cluster.Status.FailureReason).To(Equal(string(reason)))
+			Expect(cluster.Status.FailureMessage).To(Equal(message))
+			Expect(cluster.Status.Message).To(Equal("CAPI cluster failed: InvalidConfiguration: invalid infrastructure template"))
+
+			provisioned := &capiv1beta1.Cluster{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(failed), provisioned)).To(Succeed())
+			provisioned.Status = capiv1beta1.ClusterStatus{Phase: string(capiv1beta1.ClusterPhaseProvisioned)}
+			Expect(c.Update(ctx, provisioned)).To(Succeed())
+
+			// The failure is cleared before waiting for the cluster to be ready
+			result, err := reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+			cluster = admCluster()
+			Expect(cluster.Status.FailureReason).To(BeEmpty())
+			Expect(cluster.Status.FailureMessage).To(BeEmpty())
+		})
+
+		It("ignores CAPI clusters without ADM cluster", func() {
+			setup(capiCluster(capiv1beta1.ClusterPhaseFailed))
+
+			_, err := reconcileCluster()
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+})
+
+// merge returns a copy of the labels with the given label added
+func merge(labels prometheus.Labels, name string, value string) prometheus.Labels {
+	merged := prometheus.Labels{name: value}
+	for k, v := range labels {
+		merged[k] = v
+	}
+	return merged
+}
@@ -0,0 +1,34 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package capi
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+)
+
+// The CAPI controller is tested against the controller-runtime fake client
+
+var testScheme = runtime.NewScheme()
+
+func TestCapiController(t *testing.T) {
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "CAPI Controller Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(clientgoscheme.AddToScheme(testScheme)).To(Succeed())
+	Expect(v1beta1.AddToScheme(testScheme)).To(Succeed())
+	Expect(fleetv1alpha1.AddToScheme(testScheme)).To(Succeed())
+	Expect(capiv1beta1.AddToScheme(testScheme)).To(Succeed())
+})
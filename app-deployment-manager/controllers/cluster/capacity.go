@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	// The capacity is collected at most once per interval to limit the load on the edge clusters
+	capacityRefreshInterval = 5 * time.Minute
+)
+
+var (
+	// newClusterClientset returns a clientset for the edge cluster built from its kubeconfig secret
+	newClusterClientset = utils.NewClusterClientset
+
+	// capacityResources are the resources recorded in the cluster capacity
+	capacityResources = []corev1.ResourceName{corev1.ResourceCPU, corev1.ResourceMemory}
+)
+
+// updateCapacity records the capacity of the cluster if it is stale. The
+// previous capacity is kept if the cluster cannot be reached.
+func (r *Reconciler) updateCapacity(ctx context.Context, c *v1beta1.Cluster) {
+	log := log.FromContext(ctx)
+
+	if r.APIReader == nil {
+		return
+	}
+	if c.Status.Capacity != nil && time.Since(c.Status.Capacity.LastUpdate.Time) < capacityRefreshInterval {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, clusterRequestTimeout)
+	defer cancel()
+
+	cs, err := newClusterClientset(ctx, r.APIReader, c)
+	if err != nil {
+		log.Info("Failed to create clientset to collect cluster capacity", "cluster", c.Name, "error", err.Error())
+		return
+	}
+	capacity, err := collectCapacity(ctx, cs)
+	if err != nil {
+		log.Info("Failed to collect cluster capacity", "cluster", c.Name, "error", err.Error())
+		return
+	}
+	c.Status.Capacity = capacity
+}
+
+// collectCapacity sums the allocatable resources of the ready and schedulable
+// nodes and the resources requested by the pods running on them.
+func collectCapacity(ctx context.Context, cs kubernetes.Interface) (*v1beta1.ClusterCapacity, error) {
+	nodes, err := cs.CoreV1().Nodes().List(ctx, v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := &v1beta1.ClusterCapacity{
+		Allocatable: zeroResources(),
+		Requested:   zeroResources(),
+		LastUpdate:  v1.Now(),
+	}
+
+	schedulable := map[string]bool{}
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		if node.Spec.Unschedulable || !nodeReady(node) {
+			continue
+		}
+		schedulable[node.Name] = true
+		addResources(capacity.Allocatable, node.Status.Allocatable)
+	}
+
+	pods, err := cs.CoreV1().Pods("").List(ctx, v1.ListOptions{
+		FieldSelector: "status.phase!=" + string(corev1.PodSucceeded) + ",status.phase!=" + string(corev1.PodFailed),
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if schedulable[pod.Spec.NodeName] {
+			addResources(capacity.Requested, podRequests(pod))
+		}
+	}
+
+	return capacity, nil
+}
+
+// podRequests returns the resources requested by a pod, i.e. the larger of
+// the sum of its containers and of any of its init containers
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := zeroResources()
+	for _, container := range pod.Spec.Containers {
+		addResources(requests, container.Resources.Requests)
+	}
+	for _, container := range pod.Spec.InitContainers {
+		for _, name := range capacityResources {
+			if q, ok := container.Resources.Requests[name]; ok && q.Cmp(requests[name]) > 0 {
+				requests[name] = q.DeepCopy()
+			}
+		}
+	}
+	addResources(requests, pod.Spec.Overhead)
+	return requests
+}
+
+func nodeReady(node *corev1.Node) bool {
+	for _, cond := range node.Status.Conditions {
+		if cond.Type == corev1.NodeReady {
+			return cond.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+func zeroResources() corev1.ResourceList {
+	list := corev1.ResourceList{}
+	for _, name := range capacityResources {
+		list[name] = resource.Quantity{}
+	}
+	return list
+}
+
+// addResources adds the capacity resources of src to dst
+func addResources(dst corev1.ResourceList, src corev1.ResourceList) {
+	for _, name := range capacityResources {
+		if q, ok := src[name]; ok {
+			sum := dst[name]
+			sum.Add(q)
+			dst[name] = sum
+		}
+	}
+}
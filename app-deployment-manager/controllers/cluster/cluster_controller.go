@@ -8,10 +8,12 @@ import (
 	"context"
 	"fmt"
 	"maps"
+	"strings"
 	"time"
 
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -46,9 +48,11 @@ var (
 			// no action
 			return false
 		},
-		UpdateFunc: func(_ event.UpdateEvent) bool {
-			// no action
-			return false
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// process the cordon changes only
+			oldCluster, okOld := e.ObjectOld.(*v1beta1.Cluster)
+			newCluster, okNew := e.ObjectNew.(*v1beta1.Cluster)
+			return okOld && okNew && oldCluster.Spec.Cordon != newCluster.Spec.Cordon
 		},
 		GenericFunc: func(_ event.GenericEvent) bool {
 			// no action
@@ -62,6 +66,10 @@ type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
 
+	// APIReader reads the kubeconfig secrets of the edge clusters to collect
+	// their capacity, the capacity is not collected if not set
+	APIReader client.Reader
+
 	fleetCheckinTime time.Duration
 	recorder         record.EventRecorder
 }
@@ -70,6 +78,7 @@ type Reconciler struct {
 //+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clusters/status,verbs=get;create;update;patch
 //+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clusters/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch
+//+kubebuilder:rbac:groups=fleet.cattle.io,resources=clusters,verbs=get;list;watch;update
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -124,21 +133,46 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// The CAPI cluster is being deleted, the Cluster CR is removed with the Fleet cluster
+	if c.Status.State == v1beta1.Terminating {
+		return ctrl.Result{}, nil
+	}
+
 	// to make Cluster CR is deleted with Fleet Cluster, add owner reference
 	if err := cutil.SetControllerReference(fc, c, r.Scheme); err != nil {
 		log.Error(err, "Failed to set owner reference between Fleet cluster and Cluster CR")
 		return ctrl.Result{}, err
 	}
 
+	if err := r.syncCordon(ctx, c, fc); err != nil {
+		log.Error(err, "ClusterController: failed to update cordon of fleet cluster")
+		return ctrl.Result{}, err
+	}
+
 	// create or update event - start sync process
 	lastSeen := c.Status.FleetStatus.FleetAgentStatus.LastSeen
 	r.synchronizeWithFleetCluster(c, fc)
 
+	// A cluster whose provisioning failed is in Error, a disconnected cluster is
+	// Unknown and a connected one is Degraded if its health reports any reason
 	state := v1beta1.Running
-	if !r.isClusterConnected(ctx, fc) {
+	message := "Complete"
+	if c.Status.FailureReason != "" {
+		state = v1beta1.Error
+		message = fmt.Sprintf("CAPI cluster failed: %s: %s", c.Status.FailureReason, c.Status.FailureMessage)
+	} else if !r.isClusterConnected(ctx, fc) {
 		state = v1beta1.Unknown
+	} else {
+		r.updateHealth(ctx, c, fc)
+		if c.Status.Health != nil && len(c.Status.Health.Reasons) > 0 {
+			state = v1beta1.Degraded
+			message = strings.Join(c.Status.Health.Reasons, "; ")
+		}
+	}
+	c.Status.SetStatus(time.Now(), state, message, v1beta1.ClusterConditionKubeconfig, v1.ConditionTrue, c.Generation, fc.Status, fc.Generation)
+	if state == v1beta1.Running || state == v1beta1.Degraded {
+		r.updateCapacity(ctx, c)
 	}
-	c.Status.SetStatus(time.Now(), state, "Complete", v1beta1.ClusterConditionKubeconfig, v1.ConditionTrue, c.Generation, fc.Status, fc.Generation)
 
 	err = patchHelper.Patch(ctx, c)
 	if err != nil {
@@ -163,6 +197,30 @@ func (r *Reconciler) isClusterConnected(ctx context.Context, fc *fleetv1alpha1.C
 	return true
 }
 
+// syncCordon labels the Fleet cluster with the cordon of the cluster so that
+// the GitRepo target selectors exclude it, and pauses the Fleet cluster while
+// cordoned so that the applications it runs are not updated
+func (r *Reconciler) syncCordon(ctx context.Context, c *v1beta1.Cluster, fc *fleetv1alpha1.Cluster) error {
+	paused := c.Spec.Cordon == v1beta1.Cordoned
+	cordon, labeled := fc.Labels[string(v1beta1.ClusterCordon)]
+	if cordon == string(c.Spec.Cordon) && labeled == (c.Spec.Cordon != "") && fc.Spec.Paused == paused {
+		return nil
+	}
+
+	if c.Spec.Cordon == "" {
+		delete(fc.Labels, string(v1beta1.ClusterCordon))
+	} else {
+		if fc.Labels == nil {
+			fc.Labels = map[string]string{}
+		}
+		fc.Labels[string(v1beta1.ClusterCordon)] = string(c.Spec.Cordon)
+	}
+	fc.Spec.Paused = paused
+
+	log.FromContext(ctx).Info(fmt.Sprintf("Updating cordon of fleet cluster %s/%s to %q", fc.Namespace, fc.Name, c.Spec.Cordon))
+	return r.Client.Update(ctx, fc)
+}
+
 func (r *Reconciler) synchronizeWithFleetCluster(c *v1beta1.Cluster, fc *fleetv1alpha1.Cluster) {
 	labels := make(map[string]string)
 	maps.Copy(labels, fc.GetLabels())
@@ -190,7 +248,15 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 
 	r.recorder = mgr.GetEventRecorderFor("controller")
 
+	maxConcurrentReconciles, msg := utils.GetClusterMaxConcurrentReconciles()
+	if msg != "" {
+		ctrl.Log.Info(msg)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+		}).
 		For(
 			&v1beta1.Cluster{},
 			builder.WithPredicates(clusterPredicate),
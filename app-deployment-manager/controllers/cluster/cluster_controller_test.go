@@ -5,6 +5,7 @@
 package cluster
 
 import (
+	"context"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -12,8 +13,15 @@ import (
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Cluster Controller", func() {
@@ -182,3 +190,158 @@ var _ = Describe("Cluster Controller", func() {
 		})
 	})
 })
+
+var _ = Describe("Cluster capacity", func() {
+	node := func(name string, ready bool, unschedulable bool) *v1.Node {
+		status := v1.ConditionTrue
+		if !ready {
+			status = v1.ConditionFalse
+		}
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       v1.NodeSpec{Unschedulable: unschedulable},
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("4"),
+					v1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: status}},
+			},
+		}
+	}
+
+	pod := func(name string, nodeName string, cpu string, initCPU string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec: v1.PodSpec{
+				NodeName: nodeName,
+				InitContainers: []v1.Container{{
+					Name:      "init",
+					Resources: v1.ResourceRequirements{Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse(initCPU)}},
+				}},
+				Containers: []v1.Container{{
+					Name: "app",
+					Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse(cpu),
+						v1.ResourceMemory: resource.MustParse("1Gi"),
+					}},
+				}},
+			},
+		}
+	}
+
+	It("sums the capacity of the ready and schedulable nodes", func() {
+		cs := k8sfake.NewSimpleClientset(
+			node("node-1", true, false),
+			node("node-2", true, false),
+			node("node-3", false, false),
+			node("node-4", true, true),
+			pod("pod-1", "node-1", "500m", "100m"),
+			pod("pod-2", "node-2", "250m", "1"),
+			pod("pod-3", "node-3", "2", "100m"),
+		)
+
+		capacity, err := collectCapacity(context.Background(), cs)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(capacity.Allocatable.Cpu().String()).To(Equal("8"))
+		Expect(capacity.Allocatable.Memory().String()).To(Equal("16Gi"))
+		Expect(capacity.Requested.Cpu().String()).To(Equal("1500m"))
+		Expect(capacity.Requested.Memory().String()).To(Equal("2Gi"))
+
+		available := capacity.Available()
+		Expect(available.Cpu().String()).To(Equal("6500m"))
+		Expect(available.Memory().String()).To(Equal("14Gi"))
+	})
+})
+
+var _ = Describe("Cluster health", func() {
+	node := func(name string, ready bool) *v1.Node {
+		status := v1.ConditionTrue
+		if !ready {
+			status = v1.ConditionFalse
+		}
+		return &v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: v1.NodeStatus{
+				Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: status}},
+			},
+		}
+	}
+
+	agent := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "fleet-agent-0",
+			Namespace: defaultFleetAgentNamespace,
+			Labels:    map[string]string{"app": "fleet-agent"},
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{Name: fleetAgentContainer, Image: "rancher/fleet-agent:v0.12.1"}},
+		},
+	}
+
+	It("counts the ready nodes and reads the versions", func() {
+		cs := k8sfake.NewSimpleClientset(node("node-1", true), node("node-2", true), agent)
+		cs.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.30.6+rke2r1"}
+
+		health, err := collectHealth(context.Background(), cs, defaultFleetAgentNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(health.ReadyNodes).To(Equal(2))
+		Expect(health.TotalNodes).To(Equal(2))
+		Expect(health.KubernetesVersion).To(Equal("v1.30.6+rke2r1"))
+		Expect(health.AgentVersion).To(Equal("v0.12.1"))
+		Expect(health.Reasons).To(BeEmpty())
+	})
+
+	It("reports the not ready nodes and the missing agent", func() {
+		cs := k8sfake.NewSimpleClientset(node("node-2", false), node("node-1", false), node("node-3", true))
+
+		health, err := collectHealth(context.Background(), cs, defaultFleetAgentNamespace)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(health.ReadyNodes).To(Equal(1))
+		Expect(health.NotReadyNodes).To(Equal([]string{"node-1", "node-2"}))
+		Expect(health.Reasons).To(Equal([]string{
+			"2 of 3 nodes not ready: node-1, node-2",
+			"Fleet agent not found in namespace cattle-fleet-system",
+		}))
+	})
+
+	It("collects the health at most once per interval with bounded requests", func() {
+		collectNewClusterClientset := newClusterClientset
+		defer func() { newClusterClientset = collectNewClusterClientset }()
+
+		calls := 0
+		hasDeadline := false
+		cs := k8sfake.NewSimpleClientset(node("node-1", true), agent)
+		newClusterClientset = func(ctx context.Context, _ client.Reader, _ *v1beta1.Cluster) (kubernetes.Interface, error) {
+			calls++
+			_, hasDeadline = ctx.Deadline()
+			return cs, nil
+		}
+
+		r := &Reconciler{APIReader: ctrlfake.NewClientBuilder().Build()}
+		c := &v1beta1.Cluster{Status: v1beta1.ClusterStatus{
+			Health: &v1beta1.ClusterHealth{ReadyNodes: 3, TotalNodes: 3, LastUpdate: metav1.Now()},
+		}}
+		fc := &fleetv1alpha1.Cluster{}
+
+		r.updateHealth(context.Background(), c, fc)
+		Expect(calls).To(Equal(0))
+		Expect(c.Status.Health.ReadyNodes).To(Equal(3))
+
+		c.Status.Health.LastUpdate = metav1.NewTime(time.Now().Add(-healthRefreshInterval))
+		r.updateHealth(context.Background(), c, fc)
+		Expect(calls).To(Equal(1))
+		Expect(hasDeadline).To(BeTrue())
+		Expect(c.Status.Health.ReadyNodes).To(Equal(1))
+		Expect(c.Status.Health.TotalNodes).To(Equal(1))
+	})
+
+	It("reads the tag of the agent image", func() {
+		Expect(imageTag("registry:5000/rancher/fleet-agent:v0.12.1")).To(Equal("v0.12.1"))
+		Expect(imageTag("rancher/fleet-agent:v0.12.1@sha256:0123")).To(Equal("v0.12.1"))
+		Expect(imageTag("registry:5000/rancher/fleet-agent")).To(Equal(""))
+	})
+})
@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+const (
+	// Label and container of the Fleet agent pods
+	fleetAgentSelector  = "app=fleet-agent"
+	fleetAgentContainer = "fleet-agent"
+
+	// Namespace of the Fleet agent if not reported by Fleet
+	defaultFleetAgentNamespace = "cattle-fleet-system"
+
+	// The health is collected at most once per interval to limit the load on the edge clusters
+	healthRefreshInterval = 5 * time.Minute
+
+	// An unresponsive edge cluster must not block the reconciliation of the others
+	clusterRequestTimeout = 30 * time.Second
+)
+
+// updateHealth collects the health of a connected cluster if it is stale. The
+// previous node counts and versions are kept if the cluster cannot be reached,
+// the cluster being reported degraded.
+func (r *Reconciler) updateHealth(ctx context.Context, c *v1beta1.Cluster, fc *fleetv1alpha1.Cluster) {
+	log := log.FromContext(ctx)
+
+	if r.APIReader == nil {
+		return
+	}
+	if c.Status.Health != nil && time.Since(c.Status.Health.LastUpdate.Time) < healthRefreshInterval {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, clusterRequestTimeout)
+	defer cancel()
+
+	agentNamespace := fc.Status.Agent.Namespace
+	if agentNamespace == "" {
+		agentNamespace = defaultFleetAgentNamespace
+	}
+
+	cs, err := newClusterClientset(ctx, r.APIReader, c)
+	if err == nil {
+		var health *v1beta1.ClusterHealth
+		if health, err = collectHealth(ctx, cs, agentNamespace); err == nil {
+			c.Status.Health = health
+			return
+		}
+	}
+
+	log.Info("Failed to collect cluster health", "cluster", c.Name, "error", err.Error())
+	health := &v1beta1.ClusterHealth{}
+	if c.Status.Health != nil {
+		health = c.Status.Health.DeepCopy()
+	}
+	health.Reasons = []string{fmt.Sprintf("cluster API server unreachable: %v", err)}
+	health.LastUpdate = v1.Now()
+	c.Status.Health = health
+}
+
+// collectHealth counts the ready nodes and reads the Kubernetes and Fleet agent
+// versions of the cluster. The reasons of the health list the conditions
+// degrading the cluster.
+func collectHealth(ctx context.Context, cs kubernetes.Interface, agentNamespace string) (*v1beta1.ClusterHealth, error) {
+	nodes, err := cs.CoreV1().Nodes().List(ctx, v1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	health := &v1beta1.ClusterHealth{
+		TotalNodes: len(nodes.Items),
+		LastUpdate: v1.Now(),
+	}
+	for i := range nodes.Items {
+		if nodeReady(&nodes.Items[i]) {
+			health.ReadyNodes++
+		} else {
+			health.NotReadyNodes = append(health.NotReadyNodes, nodes.Items[i].Name)
+		}
+	}
+	sort.Strings(health.NotReadyNodes)
+
+	serverVersion, err := cs.Discovery().ServerVersion()
+	if err != nil {
+		return nil, err
+	}
+	health.KubernetesVersion = serverVersion.GitVersion
+
+	pods, err := cs.CoreV1().Pods(agentNamespace).List(ctx, v1.ListOptions{LabelSelector: fleetAgentSelector})
+	if err != nil {
+		return nil, err
+	}
+	for _, pod := range pods.Items {
+		for _, container := range pod.Spec.Containers {
+			if container.Name == fleetAgentContainer {
+				health.AgentVersion = imageTag(container.Image)
+			}
+		}
+	}
+
+	switch {
+	case health.TotalNodes == 0:
+		health.Reasons = append(health.Reasons, "cluster has no nodes")
+	case len(health.NotReadyNodes) > 0:
+		health.Reasons = append(health.Reasons, fmt.Sprintf("%d of %d nodes not ready: %s",
+			len(health.NotReadyNodes), health.TotalNodes, strings.Join(health.NotReadyNodes, ", ")))
+	}
+	if health.AgentVersion == "" {
+		health.Reasons = append(health.Reasons, fmt.Sprintf("Fleet agent not found in namespace %s", agentNamespace))
+	}
+
+	return health, nil
+}
+
+// imageTag returns the tag of a container image, ignoring its digest
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[i+1:]
+	}
+	return ""
+}
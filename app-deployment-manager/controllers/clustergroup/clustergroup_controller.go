@@ -0,0 +1,139 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergroup
+
+import (
+	"context"
+	"reflect"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/clustergroup"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/patch"
+)
+
+var (
+	// clusterPredicate only passes the cluster events which may change the
+	// members of a group
+	clusterPredicate = predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			return !reflect.DeepEqual(e.ObjectOld.GetLabels(), e.ObjectNew.GetLabels())
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			return false
+		},
+	}
+)
+
+// Reconciler reconciles a ClusterGroup object
+type Reconciler struct {
+	client.Client
+	Scheme *runtime.Scheme
+}
+
+//+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clustergroups,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clustergroups/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clusters,verbs=get;list;watch
+
+// Reconcile resolves the members of a cluster group from the clusters and the
+// groups of its project. The last resolved members are kept if the group
+// cannot be resolved, e.g. because it refers to a deleted group, so that the
+// deployments targeting it are not removed from their clusters.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := log.FromContext(ctx)
+
+	g := &v1beta1.ClusterGroup{}
+	if err := r.Get(ctx, req.NamespacedName, g); err != nil {
+		return ctrl.Result{}, client.IgnoreNotFound(err)
+	}
+	if !g.ObjectMeta.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	patchHelper, err := patch.NewPatchHelper(g, r.Client)
+	if err != nil {
+		log.Error(err, "error creating patch helper")
+		return ctrl.Result{}, err
+	}
+
+	groups := &v1beta1.ClusterGroupList{}
+	if err := r.List(ctx, groups, client.InNamespace(g.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+	clusters := &v1beta1.ClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(g.Namespace)); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	state, message := v1beta1.Running, ""
+	members, err := clustergroup.NewResolver(groups.Items, clusters.Items).Members(g.Name)
+	if err != nil {
+		state, message = v1beta1.Error, err.Error()
+		members = g.Status.Clusters
+	}
+	if len(members) == 0 {
+		members = nil
+	}
+
+	if reflect.DeepEqual(members, g.Status.Clusters) && state == g.Status.State &&
+		message == g.Status.Message && g.Status.ObservedGeneration == g.Generation {
+		return ctrl.Result{}, nil
+	}
+	g.Status.Clusters = members
+	g.Status.State = state
+	g.Status.Message = message
+	g.Status.ObservedGeneration = g.Generation
+	g.Status.LastUpdate = metav1.Now()
+
+	return ctrl.Result{}, patchHelper.Patch(ctx, g)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(
+			&v1beta1.ClusterGroup{},
+			builder.WithPredicates(predicate.GenerationChangedPredicate{}),
+		).
+		// A group may refer to the changed group, directly or not
+		Watches(&v1beta1.ClusterGroup{},
+			handler.EnqueueRequestsFromMapFunc(r.triggerReconcile),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&v1beta1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(r.triggerReconcile),
+			builder.WithPredicates(clusterPredicate)).
+		Complete(r)
+}
+
+// triggerReconcile reconciles all the groups of the project of the object
+func (r *Reconciler) triggerReconcile(ctx context.Context, o client.Object) []reconcile.Request {
+	groups := &v1beta1.ClusterGroupList{}
+	if err := r.List(ctx, groups, client.InNamespace(o.GetNamespace())); err != nil {
+		if !apierrors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to list cluster groups", "namespace", o.GetNamespace())
+		}
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(groups.Items))
+	for _, g := range groups.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: g.Namespace, Name: g.Name},
+		})
+	}
+	return requests
+}
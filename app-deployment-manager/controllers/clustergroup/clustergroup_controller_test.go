@@ -0,0 +1,101 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergroup
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("ClusterGroup Controller", func() {
+
+	const (
+		testNamespace = "default"
+
+		interval = time.Second
+		timeout  = 30 * interval
+	)
+
+	newCluster := func(name string, labels map[string]string) *v1beta1.Cluster {
+		return &v1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+				Labels:    labels,
+			},
+			Spec: v1beta1.ClusterSpec{
+				Name:                 name,
+				KubeConfigSecretName: name + "-kubeconfig",
+			},
+		}
+	}
+
+	newGroup := func(name string, spec v1beta1.ClusterGroupSpec) *v1beta1.ClusterGroup {
+		return &v1beta1.ClusterGroup{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: testNamespace,
+			},
+			Spec: spec,
+		}
+	}
+
+	members := func(name string) func() []string {
+		return func() []string {
+			g := &v1beta1.ClusterGroup{}
+			if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: name}, g); err != nil {
+				return nil
+			}
+			return g.Status.Clusters
+		}
+	}
+
+	When("cluster groups are created", func() {
+		It("should resolve their members and follow the cluster label changes", func() {
+			store1 := newCluster("store-1", map[string]string{"region": "west", "pilot": "true"})
+			store2 := newCluster("store-2", map[string]string{"region": "west"})
+			Expect(k8sClient.Create(ctx, store1)).Should(Succeed())
+			Expect(k8sClient.Create(ctx, store2)).Should(Succeed())
+
+			Expect(k8sClient.Create(ctx, newGroup("pilot", v1beta1.ClusterGroupSpec{
+				Selector: map[string]string{"pilot": "true"},
+			}))).Should(Succeed())
+			Expect(k8sClient.Create(ctx, newGroup("west-no-pilot", v1beta1.ClusterGroupSpec{
+				Selector: map[string]string{"region": "west"},
+				Exclude:  []string{"pilot"},
+			}))).Should(Succeed())
+
+			Eventually(members("pilot"), timeout, interval).Should(Equal([]string{"store-1"}))
+			Eventually(members("west-no-pilot"), timeout, interval).Should(Equal([]string{"store-2"}))
+
+			// The pilot store leaves the pilot
+			Expect(k8sClient.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "store-1"}, store1)).Should(Succeed())
+			delete(store1.Labels, "pilot")
+			Expect(k8sClient.Update(ctx, store1)).Should(Succeed())
+
+			Eventually(members("pilot"), timeout, interval).Should(BeEmpty())
+			Eventually(members("west-no-pilot"), timeout, interval).Should(Equal([]string{"store-1", "store-2"}))
+		})
+
+		It("should report the groups which cannot be resolved", func() {
+			Expect(k8sClient.Create(ctx, newGroup("unknown-ref", v1beta1.ClusterGroupSpec{
+				Union: []string{"missing"},
+			}))).Should(Succeed())
+
+			Eventually(func() v1beta1.StateType {
+				g := &v1beta1.ClusterGroup{}
+				if err := k8sClient.Get(ctx, types.NamespacedName{Namespace: testNamespace, Name: "unknown-ref"}, g); err != nil {
+					return ""
+				}
+				return g.Status.State
+			}, timeout, interval).Should(Equal(v1beta1.Error))
+		})
+	})
+})
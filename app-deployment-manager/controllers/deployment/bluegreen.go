@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"time"
+
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const blueGreenWait = 15 * time.Second
+
+func otherSlot(slot v1beta1.BlueGreenSlot) v1beta1.BlueGreenSlot {
+	if slot == v1beta1.SlotBlue {
+		return v1beta1.SlotGreen
+	}
+	return v1beta1.SlotBlue
+}
+
+// reconcileBlueGreen sets the blue-green slots of a new generation before the
+// fleet configurations are generated. A new generation is rolled out to the
+// preview slot while the active slot keeps serving the traffic. An update
+// received while switching completes the switch first.
+func (r *Reconciler) reconcileBlueGreen(_ context.Context, d *v1beta1.Deployment) (ctrl.Result, error) {
+	if d.Spec.DeploymentType != v1beta1.BlueGreen {
+		d.Status.BlueGreen = nil
+		return ctrl.Result{}, nil
+	}
+
+	bg := d.Status.BlueGreen
+	if bg == nil {
+		d.Status.BlueGreen = &v1beta1.BlueGreenStatus{
+			ActiveSlot:       v1beta1.SlotBlue,
+			ActiveGeneration: d.Generation,
+		}
+		return ctrl.Result{}, nil
+	}
+
+	// Nothing to do if this generation is already in a slot, e.g. on retry
+	if (bg.Phase == "" && bg.ActiveGeneration == d.Generation) ||
+		(bg.Phase != "" && bg.PreviewGeneration == d.Generation) {
+		return ctrl.Result{}, nil
+	}
+
+	switch bg.Phase {
+	case v1beta1.BlueGreenSwitching:
+		bg.ActiveSlot = bg.PreviewSlot
+		bg.ActiveGeneration = bg.PreviewGeneration
+		bg.PreviewSlot = otherSlot(bg.ActiveSlot)
+	case v1beta1.BlueGreenDeploying:
+		// The preview slot is overwritten with the new generation
+	default:
+		bg.PreviewSlot = otherSlot(bg.ActiveSlot)
+	}
+	bg.PreviewGeneration = d.Generation
+	bg.Phase = v1beta1.BlueGreenDeploying
+
+	return ctrl.Result{}, nil
+}
+
+// progressBlueGreen advances a blue-green rollout once the current phase is
+// complete. The Services are switched to the preview slot once it is running
+// on all target clusters, and the previously active slot is removed once the
+// Services have been switched on all target clusters.
+func (r *Reconciler) progressBlueGreen(ctx context.Context, d *v1beta1.Deployment) (ctrl.Result, error) {
+	bg := d.Status.BlueGreen
+	prev := *bg
+
+	switch bg.Phase {
+	case v1beta1.BlueGreenDeploying:
+		if d.Status.State != v1beta1.Running {
+			return ctrl.Result{RequeueAfter: blueGreenWait}, nil
+		}
+		bg.Phase = v1beta1.BlueGreenSwitching
+	case v1beta1.BlueGreenSwitching:
+		switched, err := r.blueGreenSwitched(ctx, d)
+		if err != nil || !switched {
+			return ctrl.Result{RequeueAfter: blueGreenWait}, err
+		}
+		bg.ActiveSlot = bg.PreviewSlot
+		bg.ActiveGeneration = bg.PreviewGeneration
+		bg.PreviewSlot = ""
+		bg.PreviewGeneration = 0
+		bg.Phase = ""
+	default:
+		return ctrl.Result{}, nil
+	}
+
+	if _, err := r.reconcileRepository(ctx, d); err != nil {
+		*bg = prev
+		return ctrl.Result{}, err
+	}
+
+	if bg.Phase == v1beta1.BlueGreenSwitching {
+		r.recorder.Eventf(d, corev1.EventTypeNormal, "BlueGreen", "Switching services to slot %s", bg.PreviewSlot)
+		return ctrl.Result{RequeueAfter: blueGreenWait}, nil
+	}
+	r.recorder.Eventf(d, corev1.EventTypeNormal, "BlueGreen", "Removing slot %s, slot %s is active", prev.ActiveSlot, bg.ActiveSlot)
+	return ctrl.Result{}, nil
+}
+
+// blueGreenSwitched returns true once the switch bundles of all apps select
+// the preview slot and are ready on all target clusters.
+func (r *Reconciler) blueGreenSwitched(ctx context.Context, d *v1beta1.Deployment) (bool, error) {
+	if d.Status.Summary.Total == 0 {
+		return false, nil
+	}
+
+	bdlist := &fleetv1alpha1.BundleDeploymentList{}
+	if err := r.List(ctx, bdlist, client.MatchingLabels{
+		string(v1beta1.DeploymentID):       d.GetId(),
+		string(v1beta1.BundleType):         fleet.BundleTypeSwitch.String(),
+		string(v1beta1.BlueGreenSlotLabel): string(d.Status.BlueGreen.PreviewSlot),
+	}); err != nil {
+		return false, err
+	}
+
+	switched := map[string]int{}
+	for i := range bdlist.Items {
+		bd := &bdlist.Items[i]
+		if utils.GetState(bd) == v1beta1.Running {
+			switched[utils.GetAppName(bd)]++
+		}
+	}
+	for _, app := range d.Spec.Applications {
+		if switched[app.Name] < d.Status.Summary.Total {
+			return false, nil
+		}
+	}
+	return true, nil
+}
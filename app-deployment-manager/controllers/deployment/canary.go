@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+)
+
+const (
+	canaryWait               = 15 * time.Second
+	defaultCanaryObservation = 600 * time.Second
+)
+
+// reconcileCanary starts the canary stage of a new generation before the
+// fleet configurations are generated. The new generation is rolled out to the
+// canary clusters only while the other target clusters keep the stable
+// generation. An update received during the canary stage is rolled out to the
+// same canary clusters.
+func (r *Reconciler) reconcileCanary(ctx context.Context, d *v1beta1.Deployment) (ctrl.Result, error) {
+	// Blue-green deployments have their own rollout stages and the first
+	// generation has no stable generation to compare with
+	if d.Spec.Canary == nil || d.Spec.DeploymentType == v1beta1.BlueGreen || d.Status.ReconciledGeneration == 0 {
+		d.Status.Canary = nil
+		return ctrl.Result{}, nil
+	}
+
+	// Nothing to do if this generation is already in the canary stage, e.g. on retry
+	c := d.Status.Canary
+	if c != nil && c.Generation == d.Generation {
+		return ctrl.Result{}, nil
+	}
+
+	stable := d.Status.ReconciledGeneration
+	var clusters []string
+	switch {
+	case fleet.CanaryActive(d):
+		stable = c.StableGeneration
+		clusters = c.Clusters
+	case c != nil && c.Phase == v1beta1.CanaryAborted:
+		// The aborted generation was never rolled out to the stable clusters
+		stable = c.StableGeneration
+	}
+
+	if len(clusters) == 0 {
+		var err error
+		if clusters, err = r.selectCanaryClusters(ctx, d); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if len(clusters) == 0 {
+		d.Status.Canary = nil
+		r.recorder.Eventf(d, corev1.EventTypeNormal, "Canary", "No canary cluster found, rolling out to all target clusters")
+		return ctrl.Result{}, nil
+	}
+
+	d.Status.Canary = &v1beta1.CanaryStatus{
+		Phase:            v1beta1.CanaryDeploying,
+		Generation:       d.Generation,
+		StableGeneration: stable,
+		Clusters:         clusters,
+		StartTime:        &metav1.Time{Time: Clock.Now()},
+		Message:          fmt.Sprintf("Rolling out generation %d to %d canary clusters", d.Generation, len(clusters)),
+	}
+	r.recorder.Eventf(d, corev1.EventTypeNormal, "Canary", "Rolling out to canary clusters %v", clusters)
+	return ctrl.Result{}, nil
+}
+
+// selectCanaryClusters picks the canary clusters at random among the target
+// clusters of the deployment matching the canary selector.
+func (r *Reconciler) selectCanaryClusters(ctx context.Context, d *v1beta1.Deployment) ([]string, error) {
+	dcList := &v1beta1.DeploymentClusterList{}
+	if err := r.List(ctx, dcList, client.MatchingLabels{string(v1beta1.DeploymentID): d.GetId()}); err != nil {
+		return nil, err
+	}
+
+	selector := labels.SelectorFromSet(d.Spec.Canary.Selector)
+	candidates := []string{}
+	for i := range dcList.Items {
+		dc := &dcList.Items[i]
+		if len(d.Spec.Canary.Selector) > 0 {
+			cluster := &v1beta1.Cluster{}
+			if err := r.Get(ctx, client.ObjectKey{Namespace: dc.Spec.Namespace, Name: dc.Spec.ClusterID}, cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					continue
+				}
+				return nil, err
+			}
+			if !selector.Matches(labels.Set(cluster.Labels)) {
+				continue
+			}
+		}
+		candidates = append(candidates, dc.Spec.ClusterID)
+	}
+
+	// #nosec G404 canary clusters are not security sensitive
+	rand.Shuffle(len(candidates), func(i, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+	clusters := candidates[:min(len(candidates), int(d.Spec.Canary.ClusterCount))]
+	slices.Sort(clusters)
+	return clusters, nil
+}
+
+// progressCanary advances the canary stage on request or once the canary
+// clusters have been observed long enough. The canary is aborted if it fails
+// to roll out or if proportionally more canary clusters than stable clusters
+// are not running during the observation period.
+func (r *Reconciler) progressCanary(ctx context.Context, d *v1beta1.Deployment) (ctrl.Result, error) {
+	c := d.Status.Canary
+	action := d.Annotations[string(v1beta1.CanaryAction)]
+	delete(d.Annotations, string(v1beta1.CanaryAction))
+
+	observation := defaultCanaryObservation
+	if d.Spec.Canary != nil && d.Spec.Canary.ObservationSeconds > 0 {
+		observation = time.Duration(d.Spec.Canary.ObservationSeconds) * time.Second
+	}
+	elapsed := Clock.Since(c.StartTime.Time)
+
+	switch {
+	case action == v1beta1.CanaryAbort:
+		return r.completeCanary(ctx, d, v1beta1.CanaryAborted, "Aborted on request")
+	case action == v1beta1.CanaryPromote:
+		return r.completeCanary(ctx, d, v1beta1.CanaryPromoted, "Promoted on request")
+	}
+
+	canary, stable, err := r.canaryHealth(ctx, d)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	switch c.Phase {
+	case v1beta1.CanaryDeploying:
+		switch {
+		case d.Status.State == v1beta1.Error:
+			return r.completeCanary(ctx, d, v1beta1.CanaryAborted, "Canary rollout failed: "+d.Status.Message)
+		case canary.running == len(c.Clusters):
+			c.Phase = v1beta1.CanaryObserving
+			c.StartTime = &metav1.Time{Time: Clock.Now()}
+			c.Message = fmt.Sprintf("Observing %d canary clusters for %s", len(c.Clusters), observation)
+			r.recorder.Eventf(d, corev1.EventTypeNormal, "Canary", c.Message)
+		case elapsed >= observation:
+			return r.completeCanary(ctx, d, v1beta1.CanaryAborted,
+				fmt.Sprintf("Canary clusters not running after %s", observation))
+		case d.Status.DeployInProgress:
+			// Since Fleet v0.7.0, explicit force update is required if
+			// Deployment update gets stuck on an error
+			if err := r.forceRedeployStuckApps(ctx, d); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+	case v1beta1.CanaryObserving:
+		switch {
+		case canary.failedRatio() > stable.failedRatio():
+			return r.completeCanary(ctx, d, v1beta1.CanaryAborted,
+				fmt.Sprintf("%d of %d canary clusters not running, %d of %d stable clusters not running",
+					canary.total-canary.running, canary.total, stable.total-stable.running, stable.total))
+		case elapsed >= observation:
+			return r.completeCanary(ctx, d, v1beta1.CanaryPromoted,
+				fmt.Sprintf("Canary clusters running for %s", observation))
+		}
+	}
+
+	return ctrl.Result{RequeueAfter: canaryWait}, nil
+}
+
+// completeCanary promotes the canary generation to all target clusters or
+// rolls the canary clusters back to the stable generation.
+func (r *Reconciler) completeCanary(ctx context.Context, d *v1beta1.Deployment, phase v1beta1.CanaryPhase, message string) (ctrl.Result, error) {
+	c := d.Status.Canary
+	prev := *c
+
+	c.Phase = phase
+	c.Message = message
+	c.StartTime = &metav1.Time{Time: Clock.Now()}
+	if _, err := r.reconcileRepository(ctx, d); err != nil {
+		*c = prev
+		return ctrl.Result{}, err
+	}
+	d.Status.DeployInProgress = true
+
+	if phase == v1beta1.CanaryAborted {
+		r.recorder.Eventf(d, corev1.EventTypeWarning, "Canary", "Canary aborted, rolling back to generation %d: %s", c.StableGeneration, message)
+		return ctrl.Result{}, nil
+	}
+	r.recorder.Eventf(d, corev1.EventTypeNormal, "Canary", "Canary promoted to all target clusters: %s", message)
+	return ctrl.Result{}, nil
+}
+
+// clusterHealth counts the clusters running their expected generation
+type clusterHealth struct {
+	total   int
+	running int
+}
+
+func (h clusterHealth) failedRatio() float64 {
+	if h.total == 0 {
+		return 0
+	}
+	return float64(h.total-h.running) / float64(h.total)
+}
+
+// canaryHealth returns the health of the canary and of the stable clusters
+// from their DeploymentCluster status.
+func (r *Reconciler) canaryHealth(ctx context.Context, d *v1beta1.Deployment) (clusterHealth, clusterHealth, error) {
+	var canary, stable clusterHealth
+
+	dcList := &v1beta1.DeploymentClusterList{}
+	if err := r.List(ctx, dcList, client.MatchingLabels{string(v1beta1.DeploymentID): d.GetId()}); err != nil {
+		return canary, stable, err
+	}
+
+	for i := range dcList.Items {
+		dc := &dcList.Items[i]
+		h := &stable
+		if slices.Contains(d.Status.Canary.Clusters, dc.Spec.ClusterID) {
+			h = &canary
+		}
+		h.total++
+
+		running := dc.Status.Status.State == v1beta1.Running
+		for _, app := range dc.Status.Apps {
+			if app.DeploymentGeneration != expectedGeneration(d, dc.Spec.ClusterID) {
+				running = false
+			}
+		}
+		if running {
+			h.running++
+		}
+	}
+	return canary, stable, nil
+}
+
+// expectedGeneration returns the deployment generation the apps should run on
+// a cluster. Only the canary clusters run the new generation during the
+// canary stage, and none once it is aborted.
+func expectedGeneration(d *v1beta1.Deployment, clusterID string) int64 {
+	c := d.Status.Canary
+	switch {
+	case c == nil:
+		return d.Generation
+	case fleet.CanaryActive(d) && !slices.Contains(c.Clusters, clusterID):
+		return c.StableGeneration
+	case c.Phase == v1beta1.CanaryAborted && c.Generation == d.Generation:
+		return c.StableGeneration
+	}
+	return d.Generation
+}
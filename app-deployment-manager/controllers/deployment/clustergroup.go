@@ -0,0 +1,173 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// +kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clustergroups,verbs=get;list;watch
+
+// clusterGroupPredicate only passes the cluster group events changing the
+// resolved members of the group
+var clusterGroupPredicate = predicate.Funcs{
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldGroup, okOld := e.ObjectOld.(*v1beta1.ClusterGroup)
+		newGroup, okNew := e.ObjectNew.(*v1beta1.ClusterGroup)
+		if !okOld || !okNew {
+			return false
+		}
+		return !reflect.DeepEqual(oldGroup.Status.Clusters, newGroup.Status.Clusters)
+	},
+	GenericFunc: func(_ event.GenericEvent) bool {
+		return false
+	},
+}
+
+// clusterGroupName returns the cluster group of an application target, if any
+func clusterGroupName(target map[string]string) (string, bool) {
+	name, ok := target[string(v1beta1.ClusterGroupName)]
+	return name, ok && name != ""
+}
+
+// gitTargets returns the Fleet targets of an application, the cluster group
+// targets being resolved to the current members of the group. A group without
+// members keeps its target label selector, which matches no cluster, so that
+// Fleet does not fall back to its default cluster group. The cordoned and
+// drained clusters are excluded, except the cordoned clusters already running
+// the application.
+func (r *Reconciler) gitTargets(ctx context.Context, d *v1beta1.Deployment, app v1beta1.Application) ([]fleetv1alpha1.GitTarget, error) {
+	cordoned, err := r.cordonedClusters(ctx, d.Namespace)
+	if err != nil {
+		return nil, fmt.Errorf("cordoned clusters of application %s: %w", app.Name, err)
+	}
+
+	gitRepoTargets := []fleetv1alpha1.GitTarget{}
+	for _, t := range app.Targets {
+		if name, ok := clusterGroupName(t); ok {
+			group := &v1beta1.ClusterGroup{}
+			if err := r.Get(ctx, types.NamespacedName{Namespace: d.Namespace, Name: name}, group); err != nil {
+				return nil, fmt.Errorf("cluster group %s of application %s: %w", name, app.Name, err)
+			}
+			members := 0
+			for _, clusterID := range group.Status.Clusters {
+				if _, ok := cordoned[clusterID]; ok {
+					continue
+				}
+				gitRepoTargets = append(gitRepoTargets, fleetv1alpha1.GitTarget{
+					Name:        names.SimpleNameGenerator.GenerateName("match-"),
+					ClusterName: clusterID,
+				})
+				members++
+			}
+			if members > 0 {
+				continue
+			}
+		}
+		gitRepoTargets = append(gitRepoTargets, fleetv1alpha1.GitTarget{
+			Name: names.SimpleNameGenerator.GenerateName("match-"),
+			ClusterSelector: &metav1.LabelSelector{
+				MatchLabels:      t,
+				MatchExpressions: uncordonedSelector,
+			},
+		})
+	}
+
+	if len(cordoned) > 0 {
+		keep, err := r.keepCordonedTargets(ctx, d, app, cordoned)
+		if err != nil {
+			return nil, fmt.Errorf("cordoned clusters of application %s: %w", app.Name, err)
+		}
+		gitRepoTargets = append(gitRepoTargets, keep...)
+	}
+	return gitRepoTargets, nil
+}
+
+// sameGitTargets returns true if both lists target the same clusters,
+// ignoring the generated target names
+func sameGitTargets(a, b []fleetv1alpha1.GitTarget) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i].ClusterName != b[i].ClusterName || !reflect.DeepEqual(a[i].ClusterSelector, b[i].ClusterSelector) {
+			return false
+		}
+	}
+	return true
+}
+
+// syncGitTargets updates the targets of the GitRepos of the applications
+// whose targeted cluster group members or cordoned clusters changed since the
+// deployment was last reconciled
+func (r *Reconciler) syncGitTargets(ctx context.Context, d *v1beta1.Deployment) error {
+	log := log.FromContext(ctx)
+	for _, app := range d.Spec.Applications {
+		gitRepo := &fleetv1alpha1.GitRepo{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: d.Namespace, Name: getGitRepoName(app.Name, d.GetId())}, gitRepo); err != nil {
+			return client.IgnoreNotFound(err)
+		}
+		targets, err := r.gitTargets(ctx, d, app)
+		if err != nil {
+			return err
+		}
+		if sameGitTargets(gitRepo.Spec.Targets, targets) {
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Target clusters of application %s changed, updating GitRepo %s", app.Name, gitRepo.Name))
+		gitRepo.Spec.Targets = targets
+		if err := r.Client.Update(ctx, gitRepo); err != nil {
+			return fmt.Errorf("GitRepo update failed(%v)", err)
+		}
+	}
+	return nil
+}
+
+// clusterGroupDeployments returns the deployments targeting a cluster group
+func (r *Reconciler) clusterGroupDeployments(ctx context.Context, o client.Object) []reconcile.Request {
+	deployments := &v1beta1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(o.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list the deployments of cluster group", "group", o.GetName())
+		return nil
+	}
+
+	var requests []reconcile.Request
+	for _, d := range deployments.Items {
+		if targetsClusterGroup(&d, o.GetName()) {
+			requests = append(requests, reconcile.Request{
+				NamespacedName: types.NamespacedName{Namespace: d.Namespace, Name: d.Name},
+			})
+		}
+	}
+	return requests
+}
+
+// targetsClusterGroup returns true if an application of the deployment
+// targets the cluster group
+func targetsClusterGroup(d *v1beta1.Deployment, group string) bool {
+	for _, app := range d.Spec.Applications {
+		for _, t := range app.Targets {
+			if name, ok := clusterGroupName(t); ok && name == group {
+				return true
+			}
+		}
+	}
+	return false
+}
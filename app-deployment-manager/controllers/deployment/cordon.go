@@ -0,0 +1,118 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deployment
+
+import (
+	"context"
+	"sort"
+
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apiserver/pkg/storage/names"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// +kubebuilder:rbac:groups=app.edge-orchestrator.intel.com,resources=clusters,verbs=get;list;watch
+
+// clusterCordonPredicate only passes the cluster events changing its cordon
+var clusterCordonPredicate = predicate.Funcs{
+	CreateFunc: func(_ event.CreateEvent) bool {
+		return false
+	},
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		oldCluster, okOld := e.ObjectOld.(*v1beta1.Cluster)
+		newCluster, okNew := e.ObjectNew.(*v1beta1.Cluster)
+		return okOld && okNew && oldCluster.Spec.Cordon != newCluster.Spec.Cordon
+	},
+	DeleteFunc: func(_ event.DeleteEvent) bool {
+		return false
+	},
+	GenericFunc: func(_ event.GenericEvent) bool {
+		return false
+	},
+}
+
+// uncordonedSelector excludes the cordoned and drained clusters from a
+// target label selector
+var uncordonedSelector = []metav1.LabelSelectorRequirement{{
+	Key:      string(v1beta1.ClusterCordon),
+	Operator: metav1.LabelSelectorOpDoesNotExist,
+}}
+
+// cordonedClusters returns the cordon of the cordoned and drained clusters of
+// a namespace
+func (r *Reconciler) cordonedClusters(ctx context.Context, namespace string) (map[string]v1beta1.CordonType, error) {
+	clusters := &v1beta1.ClusterList{}
+	if err := r.List(ctx, clusters, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	cordoned := map[string]v1beta1.CordonType{}
+	for _, c := range clusters.Items {
+		if c.Spec.Cordon != "" {
+			cordoned[c.Name] = c.Spec.Cordon
+		}
+	}
+	return cordoned, nil
+}
+
+// keepCordonedTargets returns the targets keeping an application on the
+// cordoned clusters already running it. Drained clusters are not kept, Fleet
+// removing the application bundles from them.
+func (r *Reconciler) keepCordonedTargets(ctx context.Context, d *v1beta1.Deployment, app v1beta1.Application,
+	cordoned map[string]v1beta1.CordonType) ([]fleetv1alpha1.GitTarget, error) {
+	bdlist := &fleetv1alpha1.BundleDeploymentList{}
+	if err := r.List(ctx, bdlist, client.MatchingLabels{
+		string(v1beta1.DeploymentID): d.GetId(),
+		string(v1beta1.LabelAppName): app.Name,
+	}); err != nil {
+		return nil, err
+	}
+
+	var clusterIDs []string
+	seen := map[string]bool{}
+	for _, bd := range bdlist.Items {
+		clusterID := bd.Labels[string(v1beta1.FleetClusterID)]
+		if cordoned[clusterID] == v1beta1.Cordoned && !seen[clusterID] {
+			seen[clusterID] = true
+			clusterIDs = append(clusterIDs, clusterID)
+		}
+	}
+	sort.Strings(clusterIDs)
+
+	targets := make([]fleetv1alpha1.GitTarget, 0, len(clusterIDs))
+	for _, clusterID := range clusterIDs {
+		targets = append(targets, fleetv1alpha1.GitTarget{
+			Name:        names.SimpleNameGenerator.GenerateName("match-"),
+			ClusterName: clusterID,
+		})
+	}
+	return targets, nil
+}
+
+// clusterDeployments returns the deployments of the namespace of a cluster
+// whose cordon changed
+func (r *Reconciler) clusterDeployments(ctx context.Context, o client.Object) []reconcile.Request {
+	deployments := &v1beta1.DeploymentList{}
+	if err := r.List(ctx, deployments, client.InNamespace(o.GetNamespace())); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to list the deployments of cluster", "cluster", o.GetName())
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(deployments.Items))
+	for _, d := range deployments.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Namespace: d.Namespace, Name: d.Name},
+		})
+	}
+	return requests
+}
@@ -20,7 +20,6 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
-	"k8s.io/apiserver/pkg/storage/names"
 
 	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	batchv1 "k8s.io/api/batch/v1"
@@ -34,13 +33,16 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	cutil "sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/fairqueue"
 	ctrlmetrics "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/patch"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/gitclient"
@@ -272,12 +274,26 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) (err error) {
 		return err
 	}
 
+	maxConcurrentReconciles, msg := utils.GetDeploymentMaxConcurrentReconciles()
+	if msg != "" {
+		ctrl.Log.Info(msg)
+	}
+
 	_, err = ctrl.NewControllerManagedBy(mgr).
 		WithOptions(controller.Options{
-			RateLimiter: workqueue.NewTypedWithMaxWaitRateLimiter(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), maxErrorBackoff),
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+			RateLimiter:             workqueue.NewTypedWithMaxWaitRateLimiter(workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](), maxErrorBackoff),
+			// Reconcile critical deployments first and share the workers fairly across projects
+			NewQueue: fairqueue.NewQueue(r.deploymentPriority),
 		}).
 		For(&v1beta1.Deployment{}).
 		Owns(&fleetv1alpha1.GitRepo{}).
+		Watches(&v1beta1.ClusterGroup{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterGroupDeployments),
+			builder.WithPredicates(clusterGroupPredicate)).
+		Watches(&v1beta1.Cluster{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterDeployments),
+			builder.WithPredicates(clusterCordonPredicate)).
 		Build(r)
 	if err != nil {
 		return fmt.Errorf("failed to setup deployment controller (%v)", err)
@@ -296,6 +312,15 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) (err error) {
 	return nil
 }
 
+// deploymentPriority returns the priority of the deployment of a reconcile request
+func (r *Reconciler) deploymentPriority(req reconcile.Request) v1beta1.PriorityType {
+	d := &v1beta1.Deployment{}
+	if err := r.Get(context.Background(), req.NamespacedName, d); err != nil {
+		return v1beta1.PriorityNormal
+	}
+	return d.Spec.Priority
+}
+
 // cleanupAllDeploymentClusterMetrics cleans DeploymentCluster metrics
 func (r *Reconciler) cleanupAllDeploymentClusterMetrics(ctx context.Context, d *v1beta1.Deployment) error {
 	log := log.FromContext(ctx)
@@ -840,11 +865,23 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrlRes c
 		return ctrl.Result{}, err
 	}
 	if ready && (!changed) && d.Status.ReconciledGeneration == d.Generation {
+		// Follow the member changes of the targeted cluster groups and the cordons
+		if err := r.syncGitTargets(ctx, d); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Canary stages advance on request or once the canary clusters were observed
+		if fleet.CanaryActive(d) {
+			return r.progressCanary(ctx, d)
+		}
 		// Since Fleet v0.7.0, explicit force update is required if
 		// Deployment update gets stuck on an error (LPOD-2953)
 		if d.Status.DeployInProgress {
 			return ctrl.Result{}, r.forceRedeployStuckApps(ctx, d)
 		}
+		// Blue-green rollouts advance once the current phase is complete
+		if d.Status.BlueGreen != nil && d.Status.BlueGreen.Phase != "" {
+			return r.progressBlueGreen(ctx, d)
+		}
 		return ctrl.Result{}, nil
 	}
 
@@ -915,6 +952,8 @@ func (r *Reconciler) reconcile(ctx context.Context, d *v1beta1.Deployment) (ctrl
 		r.reconcileState,
 		r.cleanupRemovedTargetClustersPhase,
 		r.reconcileDependency,
+		r.reconcileBlueGreen,
+		r.reconcileCanary,
 		r.reconcileRepository,
 		r.reconcileGitRepo,
 	}
@@ -1165,7 +1204,14 @@ func (r *Reconciler) reconcileRepository(_ context.Context, d *v1beta1.Deploymen
 		return ctrl.Result{}, err
 	}
 
-	if err := gc.CommitFiles(); err != nil {
+	commitInfo := gitclient.CommitInfo{
+		DeploymentID: d.GetId(),
+		Generation:   d.Generation,
+		RequestedBy:  d.Annotations[string(v1beta1.RequestedBy)],
+		Summary: fmt.Sprintf("%s %s (profile %s)", d.Spec.DeploymentPackageRef.Name,
+			d.Spec.DeploymentPackageRef.Version, d.Spec.DeploymentPackageRef.ProfileName),
+	}
+	if err := gc.CommitFiles(commitInfo); err != nil {
 		reason = reasonGitCommitFailed
 		return ctrl.Result{}, err
 	}
@@ -1209,6 +1255,14 @@ func (r *Reconciler) gitURLHasChanged(ctx context.Context, d *v1beta1.Deployment
 	return false, nil
 }
 
+// getCorrectDrift returns the Fleet drift correction settings for the Deployment's drift policy.
+// With the default alert-only policy drift is only reported in the DeploymentCluster status.
+func getCorrectDrift(d *v1beta1.Deployment) *fleetv1alpha1.CorrectDrift {
+	return &fleetv1alpha1.CorrectDrift{
+		Enabled: d.Spec.DriftPolicy == v1beta1.AutoCorrect,
+	}
+}
+
 func (r *Reconciler) reconcileGitRepo(ctx context.Context, d *v1beta1.Deployment) (result ctrl.Result, err error) {
 	status := metav1.ConditionFalse
 	reason := reasonGitRepoUpdateFailed
@@ -1244,20 +1298,19 @@ func (r *Reconciler) reconcileGitRepo(ctx context.Context, d *v1beta1.Deployment
 	for _, app := range d.Spec.Applications {
 		gitRepoName := getGitRepoName(app.Name, d.GetId())
 		gitRepoNamespace := d.Namespace
-		gitRepoTargets := []fleetv1alpha1.GitTarget{}
-		if len(app.Targets) > 0 {
-			for _, t := range app.Targets {
-				gitRepoTargets = append(gitRepoTargets, fleetv1alpha1.GitTarget{
-					Name: names.SimpleNameGenerator.GenerateName("match-"),
-					ClusterSelector: &metav1.LabelSelector{
-						MatchLabels: t,
-					},
-				})
-			}
+		gitRepoTargets, err := r.gitTargets(ctx, d, app)
+		if err != nil {
+			return ctrl.Result{}, err
 		}
 
 		gitRepo, gitRepoExists := grmap[gitRepoName]
 
+		// Manifest apps have no Helm repository
+		var helmSecretName string
+		if app.HelmApp != nil {
+			helmSecretName = app.HelmApp.RepoSecretName
+		}
+
 		if gitRepoExists {
 			// Remove it from the map to show we've processed it
 			delete(grmap, gitRepoName)
@@ -1273,8 +1326,9 @@ func (r *Reconciler) reconcileGitRepo(ctx context.Context, d *v1beta1.Deployment
 			// GitRepo already exists, update the existing one
 			gitRepo.ObjectMeta.Labels[string(v1beta1.BundleName)] = fleet.BundleName(app, d.GetName())
 			gitRepo.Spec.Repo = gitRepoURL
-			gitRepo.Spec.HelmSecretName = app.HelmApp.RepoSecretName
+			gitRepo.Spec.HelmSecretName = helmSecretName
 			gitRepo.Spec.Targets = gitRepoTargets
+			gitRepo.Spec.CorrectDrift = getCorrectDrift(d)
 
 			if err := r.Client.Update(ctx, gitRepo); err != nil {
 				return ctrl.Result{}, fmt.Errorf("GitRepo update failed(%v)", err)
@@ -1295,10 +1349,11 @@ func (r *Reconciler) reconcileGitRepo(ctx context.Context, d *v1beta1.Deployment
 				Spec: fleetv1alpha1.GitRepoSpec{
 					Repo:             gitRepoURL,
 					Paths:            []string{app.Name},
-					HelmSecretName:   app.HelmApp.RepoSecretName,
+					HelmSecretName:   helmSecretName,
 					ClientSecretName: v1beta1.FleetGitSecretName,
 					Targets:          gitRepoTargets,
 					PollingInterval:  r.fleetGitPollingInterval,
+					CorrectDrift:     getCorrectDrift(d),
 				},
 			}
 			// Set the CABundle for the GitRepo if one was provided
@@ -1590,6 +1645,8 @@ func updateStatusMetrics(d *v1beta1.Deployment, deleteMetrics bool) {
 func (r *Reconciler) updateDeploymentStatus(d *v1beta1.Deployment, grlist []fleetv1alpha1.GitRepo, dclist []v1beta1.DeploymentCluster) {
 	var newState v1beta1.StateType
 	stalledApps := false
+	failedProbes := false
+	failedTests := false
 	apps := 0
 	message := ""
 	r.requeueStatus = false
@@ -1641,10 +1698,22 @@ func (r *Reconciler) updateDeploymentStatus(d *v1beta1.Deployment, grlist []flee
 				stalledApps = true
 				message = utils.AppendMessage(message, dc.Status.Status.Message)
 			}
+			// Health probes that ran out of retries will not recover without an update
+			for _, app := range dc.Status.Apps {
+				if app.Health != nil && app.Health.State == v1beta1.HealthFailed {
+					failedProbes = true
+					message = utils.AppendMessage(message, fmt.Sprintf("Cluster %s: App %s: %s", dc.Spec.ClusterID, app.Name, app.Status.Message))
+				}
+				// Failed tests of the current generation are reported as an error
+				if app.Tests != nil && app.Tests.State == v1beta1.TestFailed && app.DeploymentGeneration == d.Generation {
+					failedTests = true
+					message = utils.AppendMessage(message, fmt.Sprintf("Cluster %s: App %s: %s", dc.Spec.ClusterID, app.Name, app.Status.Message))
+				}
+			}
 		case v1beta1.Running:
 			ready := true
 			for _, app := range dc.Status.Apps {
-				if d.Generation != app.DeploymentGeneration {
+				if expectedGeneration(d, dc.Spec.ClusterID) != app.DeploymentGeneration {
 					ready = false
 				}
 			}
@@ -1668,7 +1737,7 @@ func (r *Reconciler) updateDeploymentStatus(d *v1beta1.Deployment, grlist []flee
 
 	// Calculate the Deployment's state
 	switch {
-	case stalledApps:
+	case stalledApps || failedTests:
 		newState = v1beta1.Error
 	case clustercounts.Unknown > 0:
 		newState = v1beta1.Unknown
@@ -1689,7 +1758,7 @@ func (r *Reconciler) updateDeploymentStatus(d *v1beta1.Deployment, grlist []flee
 
 	case clustercounts.Down > 0 || clustercounts.Total > clustercounts.Running:
 		// Ignore Down state Deployment is updating
-		if d.Status.DeployInProgress {
+		if d.Status.DeployInProgress && !failedProbes {
 			if d.Generation <= 1 {
 				newState = v1beta1.Deploying
 			} else {
@@ -61,8 +61,8 @@ func (m *mockRepository) Clone(basedir string) error {
 	_, _ = fmt.Fprintf(GinkgoWriter, "[DEBUG] Clone returning %v\n", m.CloneError)
 	return m.CloneError
 }
-func (m *mockRepository) CommitFiles() error {
-	_, _ = fmt.Fprintf(GinkgoWriter, "[DEBUG] CommitFiles returning %v\n", m.CommitFilesError)
+func (m *mockRepository) CommitFiles(info gitclient.CommitInfo) error {
+	_, _ = fmt.Fprintf(GinkgoWriter, "[DEBUG] CommitFiles for %s generation %d returning %v\n", info.DeploymentID, info.Generation, m.CommitFilesError)
 	return m.CommitFilesError
 }
 func (m *mockRepository) PushToRemote() error {
@@ -1672,3 +1672,64 @@ var _ = Describe("Deployment Metadata Cache for Metrics Cleanup", func() {
 		})
 	})
 })
+
+var _ = Describe("Cordoned cluster targets", func() {
+	var (
+		ctx context.Context
+		r   *Reconciler
+		d   v1beta1.Deployment
+	)
+
+	newCluster := func(name string, cordon v1beta1.CordonType) *v1beta1.Cluster {
+		return &v1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+			Spec:       v1beta1.ClusterSpec{Cordon: cordon},
+		}
+	}
+
+	newBundleDeployment := func(clusterID string) *fleetv1alpha1.BundleDeployment {
+		return &fleetv1alpha1.BundleDeployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "wordpress-" + clusterID,
+				Namespace: "cluster-" + clusterID,
+				Labels: map[string]string{
+					string(v1beta1.DeploymentID):   string(d.UID),
+					string(v1beta1.LabelAppName):   "wordpress",
+					string(v1beta1.FleetClusterID): clusterID,
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		d = v1beta1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-deployment", Namespace: "default", UID: "test-uid-12345"},
+			Spec: v1beta1.DeploymentSpec{
+				Applications: []v1beta1.Application{{
+					Name:    "wordpress",
+					Targets: []map[string]string{{"color": "blue"}},
+				}},
+			},
+		}
+
+		c := fake.NewClientBuilder().
+			WithScheme(scheme.Scheme).
+			WithObjects(&d,
+				newCluster("store-1", v1beta1.Cordoned), newCluster("store-2", v1beta1.Drained),
+				newCluster("store-3", v1beta1.Cordoned), newCluster("store-4", ""),
+				newBundleDeployment("store-1"), newBundleDeployment("store-2"), newBundleDeployment("store-4")).
+			Build()
+		r = &Reconciler{Client: c, Scheme: scheme.Scheme}
+	})
+
+	It("excludes the cordoned clusters and keeps the ones running the application", func() {
+		targets, err := r.gitTargets(ctx, &d, d.Spec.Applications[0])
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(targets).To(HaveLen(2))
+		Expect(targets[0].ClusterSelector.MatchLabels).To(Equal(map[string]string{"color": "blue"}))
+		Expect(targets[0].ClusterSelector.MatchExpressions).To(Equal(uncordonedSelector))
+		Expect(targets[1].ClusterName).To(Equal("store-1"))
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
@@ -17,9 +18,11 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -35,6 +38,8 @@ import (
 var (
 	clusterKey = "metadata.clusterNamespacedName"
 
+	driftTypes = []v1beta1.DriftType{v1beta1.DriftModified, v1beta1.DriftMissing, v1beta1.DriftOrphaned}
+
 	deploymentClusterPredicate = predicate.Funcs{
 		CreateFunc: func(_ event.CreateEvent) bool {
 			// process
@@ -74,12 +79,36 @@ var (
 			return false
 		},
 	}
+
+	offlineBundlesPredicate = predicate.Funcs{
+		CreateFunc: func(_ event.CreateEvent) bool {
+			// no action
+			return false
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			// no action
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// process if a generation was exported in an offline bundle
+			key := string(v1beta1.OfflineBundles)
+			return e.ObjectOld.GetAnnotations()[key] != e.ObjectNew.GetAnnotations()[key]
+		},
+		GenericFunc: func(_ event.GenericEvent) bool {
+			// no action
+			return false
+		},
+	}
 )
 
 // Reconciler reconciles a DeploymentCluster object
 type Reconciler struct {
 	client.Client
 	Scheme *runtime.Scheme
+
+	// APIReader reads the kubeconfig secrets of the edge clusters to collect
+	// the logs of test hooks, logs are not collected if not set
+	APIReader client.Reader
 }
 
 type ClusterInfo struct {
@@ -132,6 +161,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 
 	}
 
+	// Keep the previous App status to avoid collecting the test logs again
+	prevApps := dc.Status.Apps
 	initializeStatus(dc)
 
 	// Get Cluster info for this DeploymentCluster
@@ -173,6 +204,38 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		}
 	}
 
+	// Apps with health probes are only running once all of their probes passed
+	healthlist := &fleetv1alpha1.BundleDeploymentList{}
+	labels[string(v1beta1.BundleType)] = fleet.BundleTypeHealth.String()
+	if err := r.List(ctx, healthlist, client.InNamespace(req.Namespace), client.MatchingLabels(labels)); err != nil {
+		return ctrl.Result{}, err
+	}
+	for i := range healthlist.Items {
+		bd := &healthlist.Items[i]
+		dci, err := deploymentClusterInfo(bd)
+		if err == nil && dci.Name == req.Name {
+			addDeploymentClusterAppHealth(bd, dc)
+		}
+	}
+
+	// Apps with test hooks are only running once all of their tests passed
+	testlist := &fleetv1alpha1.BundleDeploymentList{}
+	labels[string(v1beta1.BundleType)] = fleet.BundleTypeTest.String()
+	if err := r.List(ctx, testlist, client.InNamespace(req.Namespace), client.MatchingLabels(labels)); err != nil {
+		return ctrl.Result{}, err
+	}
+	tl := &testLogs{
+		reader:  r.APIReader,
+		cluster: &cluster,
+	}
+	for i := range testlist.Items {
+		bd := &testlist.Items[i]
+		dci, err := deploymentClusterInfo(bd)
+		if err == nil && dci.Name == req.Name {
+			addDeploymentClusterAppTests(ctx, bd, dc, prevApps, tl)
+		}
+	}
+
 	if dc.Status.Status.Summary.Total == 0 {
 		// Delete this DeploymentCluster since it has no Apps
 		err := r.deleteDeploymentCluster(ctx, dc)
@@ -189,6 +252,12 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		initializeStatus(dc)
 		dc.Status.Name = cluster.Spec.DisplayName
 		dc.Status.Status.State = v1beta1.Unknown
+
+		// The apps may be deployed from an offline bundle meanwhile
+		if generation, ok := r.offlineBundleGeneration(ctx, dc); ok {
+			reason = "OfflineBundleExported"
+			dc.Status.Status.Message = fmt.Sprintf("offline bundle of generation %d exported", generation)
+		}
 	}
 
 	if dc.Status.Status.State == v1beta1.Running {
@@ -281,6 +350,9 @@ func updateStatusMetrics(ctx context.Context, r *Reconciler, dc *v1beta1.Deploym
 		for i := range metricValue {
 			ctrlmetrics.DeploymentClusterStatus.DeleteLabelValues(projectID, dc.Spec.DeploymentID, displayName, dc.Spec.ClusterID, dc.Status.Name, i)
 		}
+		for _, driftType := range driftTypes {
+			ctrlmetrics.DeploymentClusterDrift.DeleteLabelValues(projectID, dc.Spec.DeploymentID, displayName, dc.Spec.ClusterID, dc.Status.Name, string(driftType))
+		}
 	} else {
 		// Only one status will be 1 and rest are 0
 		metricValue[string(dc.Status.Status.State)] = 1
@@ -290,6 +362,11 @@ func updateStatusMetrics(ctx context.Context, r *Reconciler, dc *v1beta1.Deploym
 			ctrlmetrics.DeploymentClusterStatus.WithLabelValues(projectID, dc.Spec.DeploymentID, displayName, dc.Spec.ClusterID, dc.Status.Name, i).Set(val)
 		}
 
+		// Number of drifted resources per type, used to alert on drift when it is not auto-corrected
+		for driftType, val := range getDriftCounts(dc) {
+			ctrlmetrics.DeploymentClusterDrift.WithLabelValues(projectID, dc.Spec.DeploymentID, displayName, dc.Spec.ClusterID, dc.Status.Name, string(driftType)).Set(val)
+		}
+
 		dcStatusChange := "dc-" + dc.Spec.ClusterID + "-status-change"
 		orchLibMetrics.RecordTimestamp(projectID, dc.Spec.DeploymentID, displayName, string(dc.Status.Status.State), dcStatusChange)
 		if metricValue[string(v1beta1.Running)] == 1 {
@@ -299,6 +376,23 @@ func updateStatusMetrics(ctx context.Context, r *Reconciler, dc *v1beta1.Deploym
 	}
 }
 
+// getDriftCounts sums the drifted resources of all apps in the DeploymentCluster per drift type
+func getDriftCounts(dc *v1beta1.DeploymentCluster) map[v1beta1.DriftType]float64 {
+	counts := make(map[v1beta1.DriftType]float64)
+	for _, driftType := range driftTypes {
+		counts[driftType] = 0
+	}
+	for _, app := range dc.Status.Apps {
+		if app.Drift == nil {
+			continue
+		}
+		counts[v1beta1.DriftModified] += float64(app.Drift.Modified)
+		counts[v1beta1.DriftMissing] += float64(app.Drift.Missing)
+		counts[v1beta1.DriftOrphaned] += float64(app.Drift.Orphaned)
+	}
+	return counts
+}
+
 // updateStatusMetricsOrphaned cleans up metrics for orphaned DeploymentClusters
 // where the parent Deployment no longer exists
 func updateStatusMetricsOrphaned(ctx context.Context, _ *Reconciler, dc *v1beta1.DeploymentCluster, deleteMetrics bool) {
@@ -353,6 +447,9 @@ func updateStatusMetricsOrphaned(ctx context.Context, _ *Reconciler, dc *v1beta1
 			for i := range metricValue {
 				ctrlmetrics.DeploymentClusterStatus.DeleteLabelValues(projectID, dc.Spec.DeploymentID, displayNameVariant, dc.Spec.ClusterID, dc.Status.Name, i)
 			}
+			for _, driftType := range driftTypes {
+				ctrlmetrics.DeploymentClusterDrift.DeleteLabelValues(projectID, dc.Spec.DeploymentID, displayNameVariant, dc.Spec.ClusterID, dc.Status.Name, string(driftType))
+			}
 		}
 
 		// Clean up timestamp metrics
@@ -372,7 +469,15 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 		return err
 	}
 
+	maxConcurrentReconciles, msg := utils.GetDeploymentClusterMaxConcurrentReconciles()
+	if msg != "" {
+		ctrl.Log.Info(msg)
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(controller.Options{
+			MaxConcurrentReconciles: maxConcurrentReconciles,
+		}).
 		For(
 			&v1beta1.DeploymentCluster{},
 			builder.WithPredicates(deploymentClusterPredicate),
@@ -386,6 +491,11 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			handler.EnqueueRequestsFromMapFunc(r.triggerReconcileCluster),
 			builder.WithPredicates(clusterStatePredicate),
 		).
+		Watches(
+			&v1beta1.Deployment{},
+			handler.EnqueueRequestsFromMapFunc(r.triggerReconcileDeployment),
+			builder.WithPredicates(offlineBundlesPredicate),
+		).
 		Complete(r)
 }
 
@@ -447,30 +557,72 @@ func (r *Reconciler) triggerReconcileCluster(ctx context.Context, o client.Objec
 	return requests
 }
 
-func getDisplayName(ctx context.Context, projectID, deploymentID string, r *Reconciler) (string, error) {
+// triggerReconcileDeployment reconciles the DeploymentClusters of a
+// Deployment exported in an offline bundle
+func (r *Reconciler) triggerReconcileDeployment(ctx context.Context, o client.Object) []reconcile.Request {
+	log := log.FromContext(ctx)
+	requests := []reconcile.Request{}
+
+	var dclist v1beta1.DeploymentClusterList
+	if err := r.List(ctx, &dclist, client.MatchingLabels{string(v1beta1.DeploymentID): string(o.GetUID())}); err != nil {
+		log.Error(err, "Failed to list DeploymentClusters")
+		return requests
+	}
+
+	for _, dc := range dclist.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: dc.Namespace,
+				Name:      dc.Name,
+			},
+		})
+	}
+	return requests
+}
+
+// offlineBundleGeneration returns the generation of the Deployment exported
+// in an offline bundle for the cluster of the DeploymentCluster, if any
+func (r *Reconciler) offlineBundleGeneration(ctx context.Context, dc *v1beta1.DeploymentCluster) (int64, bool) {
+	log := log.FromContext(ctx)
+
+	deployment, err := getDeployment(ctx, dc.Labels[string(v1beta1.AppOrchActiveProjectID)], dc.Spec.DeploymentID, r)
+	if err != nil {
+		return 0, false
+	}
+	exported, err := utils.GetOfflineBundles(deployment)
+	if err != nil {
+		log.Error(err, "Invalid offline bundles annotation", "DeploymentID", dc.Spec.DeploymentID)
+		return 0, false
+	}
+	generation, ok := exported[dc.Spec.ClusterID]
+	return generation, ok
+}
+
+func getDeployment(ctx context.Context, projectID, deploymentID string, r *Reconciler) (*v1beta1.Deployment, error) {
 	deploymentList := &v1beta1.DeploymentList{}
 	log := log.FromContext(ctx)
 
 	if err := r.Client.List(ctx, deploymentList, client.MatchingLabels{string(v1beta1.AppOrchActiveProjectID): projectID}); err != nil {
 		log.Error(err, "Failed to list Deployments")
-		return "", err
+		return nil, err
 	}
 
-	var deployment *v1beta1.Deployment
-	for _, d := range deploymentList.Items {
-		if d.ObjectMeta.UID == types.UID(deploymentID) {
-			deployment = &d
-			break
+	for i := range deploymentList.Items {
+		if deploymentList.Items[i].ObjectMeta.UID == types.UID(deploymentID) {
+			return &deploymentList.Items[i], nil
 		}
 	}
 
-	if deployment == nil {
-		log.Error(nil, "Deployment not found", "DeploymentID", deploymentID)
-		return "", errors.New("deployment not found")
-	}
+	log.Error(nil, "Deployment not found", "DeploymentID", deploymentID)
+	return nil, errors.New("deployment not found")
+}
 
-	displayName := deployment.Spec.DisplayName
-	return displayName, nil
+func getDisplayName(ctx context.Context, projectID, deploymentID string, r *Reconciler) (string, error) {
+	deployment, err := getDeployment(ctx, projectID, deploymentID, r)
+	if err != nil {
+		return "", err
+	}
+	return deployment.Spec.DisplayName, nil
 }
 
 func (r *Reconciler) createDeploymentCluster(ctx context.Context, req ctrl.Request) error {
@@ -620,6 +772,9 @@ func addDeploymentClusterApp(bd *fleetv1alpha1.BundleDeployment, dc *v1beta1.Dep
 				Type: v1beta1.NotUsed,
 			},
 		},
+		BundleState: utils.GetBundleState(bd),
+		LastUpdate:  utils.GetLastUpdateTime(bd),
+		Drift:       getAppDrift(bd),
 	}
 	dc.Status.Status.Summary.Total++
 	if app.Status.State == v1beta1.Running {
@@ -631,3 +786,322 @@ func addDeploymentClusterApp(bd *fleetv1alpha1.BundleDeployment, dc *v1beta1.Dep
 	}
 	dc.Status.Apps = append(dc.Status.Apps, app)
 }
+
+// addDeploymentClusterAppHealth sets the health probe results of the App the health BundleDeployment
+// belongs to. An App whose resources are ready is considered down until all of its probes passed.
+func addDeploymentClusterAppHealth(bd *fleetv1alpha1.BundleDeployment, dc *v1beta1.DeploymentCluster) {
+	appName := utils.GetAppName(bd)
+	for i := range dc.Status.Apps {
+		app := &dc.Status.Apps[i]
+		if app.Name != appName {
+			continue
+		}
+
+		app.Health = getAppHealth(bd, app)
+		if app.Health.State == v1beta1.HealthPassed || app.Status.State != v1beta1.Running {
+			return
+		}
+
+		app.Status.State = v1beta1.Down
+		app.Status.Message = getHealthMessage(app.Health)
+		dc.Status.Status.Summary.Running--
+		dc.Status.Status.Summary.Down++
+		dc.Status.Status.State = v1beta1.Down
+		dc.Status.Status.Message = utils.AppendMessage(dc.Status.Status.Message, app.Status.Message)
+		return
+	}
+}
+
+// getAppHealth maps the health probe Jobs of the health BundleDeployment to probe results
+func getAppHealth(bd *fleetv1alpha1.BundleDeployment, app *v1beta1.App) *v1beta1.Health {
+	health := &v1beta1.Health{
+		State: v1beta1.HealthPassed,
+	}
+
+	// Results of a previous generation are stale until the Fleet agent applied the current one
+	applied := bd.Status.AppliedDeploymentID == bd.Spec.DeploymentID &&
+		utils.GetDeploymentGeneration(bd) == app.DeploymentGeneration
+
+	nonReady := make(map[string]fleetv1alpha1.NonReadyStatus)
+	for _, nr := range bd.Status.NonReadyStatus {
+		if nr.Kind == "Job" {
+			nonReady[nr.Name] = nr
+		}
+	}
+
+	for _, res := range bd.Status.Resources {
+		if res.Kind != "Job" {
+			continue
+		}
+		name := fleet.HealthProbeNameFromJob(app.Id, res.Name)
+		if name == "" {
+			continue
+		}
+
+		result := v1beta1.ProbeResult{
+			Name:  name,
+			State: v1beta1.HealthPassed,
+		}
+		if nr, ok := nonReady[res.Name]; ok {
+			result.State = v1beta1.HealthPending
+			if nr.Summary.Error {
+				result.State = v1beta1.HealthFailed
+			}
+			result.Message = strings.Join(nr.Summary.Message, "; ")
+		} else if !applied {
+			result.State = v1beta1.HealthPending
+		}
+		health.Probes = append(health.Probes, result)
+	}
+
+	if !applied || len(health.Probes) == 0 {
+		health.State = v1beta1.HealthPending
+	}
+	for _, p := range health.Probes {
+		if p.State == v1beta1.HealthFailed {
+			health.State = v1beta1.HealthFailed
+			break
+		}
+		if p.State == v1beta1.HealthPending {
+			health.State = v1beta1.HealthPending
+		}
+	}
+
+	return health
+}
+
+func getHealthMessage(health *v1beta1.Health) string {
+	if health.State != v1beta1.HealthFailed {
+		return "waiting for health probes"
+	}
+	msg := ""
+	for _, p := range health.Probes {
+		if p.State != v1beta1.HealthFailed {
+			continue
+		}
+		probeMsg := fmt.Sprintf("health probe %s failed", p.Name)
+		if p.Message != "" {
+			probeMsg = fmt.Sprintf("%s: %s", probeMsg, p.Message)
+		}
+		msg = utils.AppendMessage(msg, probeMsg)
+	}
+	return msg
+}
+
+// addDeploymentClusterAppTests sets the test hook results of the App the test BundleDeployment
+// belongs to. An App whose resources are ready is considered down until all of its tests passed.
+func addDeploymentClusterAppTests(ctx context.Context, bd *fleetv1alpha1.BundleDeployment, dc *v1beta1.DeploymentCluster,
+	prevApps []v1beta1.App, tl *testLogs) {
+	appName := utils.GetAppName(bd)
+	for i := range dc.Status.Apps {
+		app := &dc.Status.Apps[i]
+		if app.Name != appName {
+			continue
+		}
+
+		app.Tests = getAppTests(bd, app)
+		addTestLogs(ctx, bd, app, prevApps, tl)
+		if app.Tests.State == v1beta1.TestPassed || app.Status.State != v1beta1.Running {
+			return
+		}
+
+		app.Status.State = v1beta1.Down
+		app.Status.Message = getTestMessage(app.Tests)
+		dc.Status.Status.Summary.Running--
+		dc.Status.Status.Summary.Down++
+		dc.Status.Status.State = v1beta1.Down
+		dc.Status.Status.Message = utils.AppendMessage(dc.Status.Status.Message, app.Status.Message)
+		return
+	}
+}
+
+// getAppTests maps the test hook Jobs of the test BundleDeployment to test results
+func getAppTests(bd *fleetv1alpha1.BundleDeployment, app *v1beta1.App) *v1beta1.Tests {
+	tests := &v1beta1.Tests{
+		State: v1beta1.TestPassed,
+	}
+
+	// Results of a previous generation are stale until the Fleet agent applied the current one
+	applied := bd.Status.AppliedDeploymentID == bd.Spec.DeploymentID &&
+		utils.GetDeploymentGeneration(bd) == app.DeploymentGeneration
+
+	nonReady := make(map[string]fleetv1alpha1.NonReadyStatus)
+	for _, nr := range bd.Status.NonReadyStatus {
+		if nr.Kind == "Job" {
+			nonReady[nr.Name] = nr
+		}
+	}
+
+	for _, res := range bd.Status.Resources {
+		if res.Kind != "Job" {
+			continue
+		}
+		name := fleet.TestHookNameFromJob(app.Id, res.Name)
+		if name == "" {
+			continue
+		}
+
+		result := v1beta1.TestResult{
+			Name:  name,
+			State: v1beta1.TestPassed,
+		}
+		if nr, ok := nonReady[res.Name]; ok {
+			result.State = v1beta1.TestPending
+			if nr.Summary.Error {
+				result.State = v1beta1.TestFailed
+			}
+			result.Message = strings.Join(nr.Summary.Message, "; ")
+		} else if !applied {
+			result.State = v1beta1.TestPending
+		}
+		tests.Results = append(tests.Results, result)
+	}
+
+	if !applied || len(tests.Results) == 0 {
+		tests.State = v1beta1.TestPending
+	}
+	for _, r := range tests.Results {
+		if r.State == v1beta1.TestFailed {
+			tests.State = v1beta1.TestFailed
+			break
+		}
+		if r.State == v1beta1.TestPending {
+			tests.State = v1beta1.TestPending
+		}
+	}
+
+	return tests
+}
+
+// testLogs lazily creates a client of the edge cluster to collect the logs of finished tests
+type testLogs struct {
+	reader    client.Reader
+	cluster   *v1beta1.Cluster
+	clientset kubernetes.Interface
+	err       error
+}
+
+func (tl *testLogs) get(ctx context.Context, namespace string, jobName string) (string, error) {
+	if tl.reader == nil {
+		return "", nil
+	}
+	if tl.clientset == nil && tl.err == nil {
+		tl.clientset, tl.err = newClusterClientset(ctx, tl.reader, tl.cluster)
+	}
+	if tl.err != nil {
+		return "", tl.err
+	}
+	return getJobLogs(ctx, tl.clientset, namespace, jobName)
+}
+
+// addTestLogs records the logs of finished tests. Logs are collected once per deployment generation
+// and test result and carried over from the previous status afterwards.
+func addTestLogs(ctx context.Context, bd *fleetv1alpha1.BundleDeployment, app *v1beta1.App, prevApps []v1beta1.App, tl *testLogs) {
+	log := log.FromContext(ctx)
+
+	prevLogs := make(map[string]v1beta1.TestResult)
+	for _, prev := range prevApps {
+		if prev.Name != app.Name || prev.DeploymentGeneration != app.DeploymentGeneration || prev.Tests == nil {
+			continue
+		}
+		for _, r := range prev.Tests.Results {
+			prevLogs[r.Name] = r
+		}
+	}
+
+	jobs := make(map[string]fleetv1alpha1.BundleDeploymentResource)
+	for _, res := range bd.Status.Resources {
+		if res.Kind == "Job" {
+			jobs[fleet.TestHookNameFromJob(app.Id, res.Name)] = res
+		}
+	}
+
+	for i := range app.Tests.Results {
+		result := &app.Tests.Results[i]
+		if result.State == v1beta1.TestPending {
+			continue
+		}
+		if prev, ok := prevLogs[result.Name]; ok && prev.State == result.State && prev.Logs != "" {
+			result.Logs = prev.Logs
+			continue
+		}
+		job, ok := jobs[result.Name]
+		if !ok {
+			continue
+		}
+
+		logs, err := tl.get(ctx, job.Namespace, job.Name)
+		if err != nil {
+			log.Info("Failed to collect test logs", "cluster", tl.cluster.Name, "job", job.Name, "error", err.Error())
+			continue
+		}
+		result.Logs = logs
+	}
+}
+
+func getTestMessage(tests *v1beta1.Tests) string {
+	if tests.State != v1beta1.TestFailed {
+		return "waiting for tests"
+	}
+	msg := ""
+	for _, r := range tests.Results {
+		if r.State != v1beta1.TestFailed {
+			continue
+		}
+		testMsg := fmt.Sprintf("test %s failed", r.Name)
+		if r.Message != "" {
+			testMsg = fmt.Sprintf("%s: %s", testMsg, r.Message)
+		}
+		msg = utils.AppendMessage(msg, testMsg)
+	}
+	return msg
+}
+
+// getAppDrift returns the drift reported by the Fleet agent for the BundleDeployment, or nil if
+// the deployed resources match the desired state
+func getAppDrift(bd *fleetv1alpha1.BundleDeployment) *v1beta1.Drift {
+	counts := bd.Status.ResourceCounts
+	drift := &v1beta1.Drift{
+		Modified: counts.Modified,
+		Missing:  counts.Missing,
+		Orphaned: counts.Orphaned,
+	}
+
+	for _, m := range bd.Status.ModifiedStatus {
+		resource := v1beta1.DriftedResource{
+			Kind:       m.Kind,
+			APIVersion: m.APIVersion,
+			Namespace:  m.Namespace,
+			Name:       m.Name,
+		}
+		switch {
+		case m.Delete:
+			resource.Type = v1beta1.DriftOrphaned
+		case m.Create:
+			resource.Type = v1beta1.DriftMissing
+		default:
+			resource.Type = v1beta1.DriftModified
+			resource.Patch = m.Patch
+		}
+		drift.Resources = append(drift.Resources, resource)
+	}
+
+	// Resource counts are not reported by older agents, fall back to the (possibly truncated) list
+	if drift.Modified+drift.Missing+drift.Orphaned == 0 {
+		for _, r := range drift.Resources {
+			switch r.Type {
+			case v1beta1.DriftOrphaned:
+				drift.Orphaned++
+			case v1beta1.DriftMissing:
+				drift.Missing++
+			default:
+				drift.Modified++
+			}
+		}
+	}
+
+	if drift.Modified+drift.Missing+drift.Orphaned == 0 {
+		return nil
+	}
+	return drift
+}
@@ -5,16 +5,23 @@
 package deploymentcluster
 
 import (
-	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+	"context"
 	"time"
 
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	"github.com/rancher/wrangler/v3/pkg/genericcondition"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 )
@@ -295,6 +302,379 @@ var _ = Describe("DeploymentCluster controller", func() {
 		})
 	})
 
+	When("adding App status with a bundle state to a DeploymentCluster", func() {
+		It("should record the bundle state and last update of the App", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			bd2.Status.Display.State = "ErrApplied"
+			bd2.Status.Conditions[0].LastUpdateTime = "2025-03-01T10:00:00Z"
+
+			addDeploymentClusterApp(bd2, dc)
+			Expect(dc.Status.Apps).To(HaveLen(1))
+			Expect(dc.Status.Apps[0].BundleState).To(Equal("ErrApplied"))
+			Expect(dc.Status.Apps[0].LastUpdate).ToNot(BeNil())
+			Expect(dc.Status.Apps[0].LastUpdate.Time).To(Equal(time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)))
+		})
+	})
+
+	When("adding App status with drifted resources to a DeploymentCluster", func() {
+		It("should record the drift of the App", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			bd1.Status.NonModified = false
+			bd1.Status.ResourceCounts = fleetv1alpha1.ResourceCounts{
+				Modified: 1,
+				Missing:  1,
+			}
+			bd1.Status.ModifiedStatus = []fleetv1alpha1.ModifiedStatus{
+				{
+					Kind:       "ConfigMap",
+					APIVersion: "v1",
+					Namespace:  namespace,
+					Name:       "cm",
+					Patch:      `{"data":{"foo":"bar"}}`,
+				},
+				{
+					Kind:       "Service",
+					APIVersion: "v1",
+					Namespace:  namespace,
+					Name:       "svc",
+					Create:     true,
+				},
+			}
+
+			addDeploymentClusterApp(bd1, dc)
+			addDeploymentClusterApp(bd2, dc)
+			Expect(dc.Status.Apps).To(HaveLen(2))
+			Expect(dc.Status.Apps[0].Drift).To(Equal(&v1beta1.Drift{
+				Modified: 1,
+				Missing:  1,
+				Resources: []v1beta1.DriftedResource{
+					{
+						Kind:       "ConfigMap",
+						APIVersion: "v1",
+						Namespace:  namespace,
+						Name:       "cm",
+						Type:       v1beta1.DriftModified,
+						Patch:      `{"data":{"foo":"bar"}}`,
+					},
+					{
+						Kind:       "Service",
+						APIVersion: "v1",
+						Namespace:  namespace,
+						Name:       "svc",
+						Type:       v1beta1.DriftMissing,
+					},
+				},
+			}))
+			Expect(dc.Status.Apps[1].Drift).To(BeNil())
+		})
+	})
+
+	When("adding health probe results of an App to a DeploymentCluster", func() {
+		It("should mark the App down when a health probe failed", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			hbd := bd1.DeepCopy()
+			hbd.Name = "health-" + bdName1
+			hbd.Labels[string(v1beta1.BundleType)] = fleet.BundleTypeHealth.String()
+			hbd.Spec.DeploymentID = "s-1"
+			hbd.Status.AppliedDeploymentID = "s-1"
+			hbd.Status.Ready = false
+			hbd.Status.Resources = []fleetv1alpha1.BundleDeploymentResource{
+				{Kind: "Job", APIVersion: "batch/v1", Namespace: namespace, Name: fleet.HealthProbeJobName(bundleName1, "homepage", 1)},
+				{Kind: "Job", APIVersion: "batch/v1", Namespace: namespace, Name: fleet.HealthProbeJobName(bundleName1, "error-rate", 1)},
+			}
+			failed := fleetv1alpha1.NonReadyStatus{
+				Kind:      "Job",
+				Namespace: namespace,
+				Name:      fleet.HealthProbeJobName(bundleName1, "error-rate", 1),
+			}
+			failed.Summary.State = "error"
+			failed.Summary.Error = true
+			failed.Summary.Message = []string{"Job has reached the specified backoff limit"}
+			hbd.Status.NonReadyStatus = []fleetv1alpha1.NonReadyStatus{failed}
+
+			addDeploymentClusterApp(bd1, dc)
+			addDeploymentClusterAppHealth(hbd, dc)
+			Expect(dc.Status.Status.State).To(Equal(v1beta1.Down))
+			Expect(dc.Status.Status.Summary).To(Equal(v1beta1.Summary{
+				Type:  v1beta1.AppCounts,
+				Total: 1,
+				Down:  1,
+			}))
+			Expect(dc.Status.Apps[0].Status.State).To(Equal(v1beta1.Down))
+			Expect(dc.Status.Apps[0].Status.Message).To(Equal("health probe error-rate failed: Job has reached the specified backoff limit"))
+			Expect(dc.Status.Apps[0].Health).To(Equal(&v1beta1.Health{
+				State: v1beta1.HealthFailed,
+				Probes: []v1beta1.ProbeResult{
+					{
+						Name:  "homepage",
+						State: v1beta1.HealthPassed,
+					},
+					{
+						Name:    "error-rate",
+						State:   v1beta1.HealthFailed,
+						Message: "Job has reached the specified backoff limit",
+					},
+				},
+			}))
+		})
+
+		It("should keep the App running when all health probes passed", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			hbd := bd1.DeepCopy()
+			hbd.Name = "health-" + bdName1
+			hbd.Labels[string(v1beta1.BundleType)] = fleet.BundleTypeHealth.String()
+			hbd.Status.Resources = []fleetv1alpha1.BundleDeploymentResource{
+				{Kind: "Job", APIVersion: "batch/v1", Namespace: namespace, Name: fleet.HealthProbeJobName(bundleName1, "homepage", 1)},
+			}
+
+			addDeploymentClusterApp(bd1, dc)
+			addDeploymentClusterAppHealth(hbd, dc)
+			Expect(dc.Status.Status.State).To(Equal(v1beta1.Running))
+			Expect(dc.Status.Apps[0].Health).To(Equal(&v1beta1.Health{
+				State: v1beta1.HealthPassed,
+				Probes: []v1beta1.ProbeResult{
+					{
+						Name:  "homepage",
+						State: v1beta1.HealthPassed,
+					},
+				},
+			}))
+		})
+	})
+
+	When("adding test hook results of an App to a DeploymentCluster", func() {
+		var (
+			tbd     *fleetv1alpha1.BundleDeployment
+			tl      *testLogs
+			origNew func(context.Context, client.Reader, *v1beta1.Cluster) (kubernetes.Interface, error)
+		)
+
+		BeforeEach(func() {
+			tbd = bd1.DeepCopy()
+			tbd.Name = "test-" + bdName1
+			tbd.Labels[string(v1beta1.BundleType)] = fleet.BundleTypeTest.String()
+			tbd.Status.Resources = []fleetv1alpha1.BundleDeploymentResource{
+				{Kind: "Job", APIVersion: "batch/v1", Namespace: namespace, Name: fleet.TestHookJobName(bundleName1, "smoke", 1)},
+				{Kind: "Job", APIVersion: "batch/v1", Namespace: namespace, Name: fleet.TestHookJobName(bundleName1, "e2e", 1)},
+			}
+
+			pods := []runtime.Object{}
+			for _, name := range []string{"smoke", "e2e"} {
+				pods = append(pods, &v1.Pod{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      name + "-pod",
+						Namespace: namespace,
+						Labels: map[string]string{
+							"job-name": fleet.TestHookJobName(bundleName1, name, 1),
+						},
+					},
+				})
+			}
+			origNew = newClusterClientset
+			newClusterClientset = func(_ context.Context, _ client.Reader, _ *v1beta1.Cluster) (kubernetes.Interface, error) {
+				return k8sfake.NewSimpleClientset(pods...), nil
+			}
+			tl = &testLogs{
+				reader:  k8sClient,
+				cluster: cluster,
+			}
+		})
+
+		AfterEach(func() {
+			newClusterClientset = origNew
+		})
+
+		It("should mark the App down and record the logs when a test failed", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			failed := fleetv1alpha1.NonReadyStatus{
+				Kind:      "Job",
+				Namespace: namespace,
+				Name:      fleet.TestHookJobName(bundleName1, "e2e", 1),
+			}
+			failed.Summary.State = "error"
+			failed.Summary.Error = true
+			failed.Summary.Message = []string{"Job has reached the specified backoff limit"}
+			tbd.Status.NonReadyStatus = []fleetv1alpha1.NonReadyStatus{failed}
+
+			addDeploymentClusterApp(bd1, dc)
+			addDeploymentClusterAppTests(ctx, tbd, dc, nil, tl)
+			Expect(dc.Status.Status.State).To(Equal(v1beta1.Down))
+			Expect(dc.Status.Status.Summary).To(Equal(v1beta1.Summary{
+				Type:  v1beta1.AppCounts,
+				Total: 1,
+				Down:  1,
+			}))
+			Expect(dc.Status.Apps[0].Status.Message).To(Equal("test e2e failed: Job has reached the specified backoff limit"))
+			Expect(dc.Status.Apps[0].Tests).To(Equal(&v1beta1.Tests{
+				State: v1beta1.TestFailed,
+				Results: []v1beta1.TestResult{
+					{
+						Name:  "smoke",
+						State: v1beta1.TestPassed,
+						Logs:  "fake logs",
+					},
+					{
+						Name:    "e2e",
+						State:   v1beta1.TestFailed,
+						Message: "Job has reached the specified backoff limit",
+						Logs:    "fake logs",
+					},
+				},
+			}))
+		})
+
+		It("should keep the logs of the previous status and not record logs of pending tests", func() {
+			dc := &v1beta1.DeploymentCluster{
+				Status: v1beta1.DeploymentClusterStatus{
+					Name: clusterDisplayName,
+				},
+			}
+			initializeStatus(dc)
+
+			pending := fleetv1alpha1.NonReadyStatus{
+				Kind:      "Job",
+				Namespace: namespace,
+				Name:      fleet.TestHookJobName(bundleName1, "e2e", 1),
+			}
+			pending.Summary.State = "in-progress"
+			tbd.Status.NonReadyStatus = []fleetv1alpha1.NonReadyStatus{pending}
+
+			prevApps := []v1beta1.App{
+				{
+					Name:                 appName1,
+					DeploymentGeneration: 1,
+					Tests: &v1beta1.Tests{
+						State: v1beta1.TestPending,
+						Results: []v1beta1.TestResult{
+							{
+								Name:  "smoke",
+								State: v1beta1.TestPassed,
+								Logs:  "ok",
+							},
+						},
+					},
+				},
+			}
+
+			addDeploymentClusterApp(bd1, dc)
+			addDeploymentClusterAppTests(ctx, tbd, dc, prevApps, tl)
+			Expect(dc.Status.Status.State).To(Equal(v1beta1.Down))
+			Expect(dc.Status.Apps[0].Status.Message).To(Equal("waiting for tests"))
+			Expect(dc.Status.Apps[0].Tests).To(Equal(&v1beta1.Tests{
+				State: v1beta1.TestPending,
+				Results: []v1beta1.TestResult{
+					{
+						Name:  "smoke",
+						State: v1beta1.TestPassed,
+						Logs:  "ok",
+					},
+					{
+						Name:  "e2e",
+						State: v1beta1.TestPending,
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("Getting the generation exported in an offline bundle", func() {
+		var (
+			deployment *v1beta1.Deployment
+			dc         *v1beta1.DeploymentCluster
+		)
+
+		BeforeEach(func() {
+			deployment = &v1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deployment-1",
+					Namespace: namespace,
+					UID:       types.UID(deploymentId),
+					Labels: map[string]string{
+						string(v1beta1.AppOrchActiveProjectID): activeProjectId,
+					},
+					Annotations: map[string]string{
+						string(v1beta1.OfflineBundles): `{"` + clusterId + `":3}`,
+					},
+				},
+			}
+			dc = &v1beta1.DeploymentCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      depClusterName,
+					Namespace: namespace,
+					Labels: map[string]string{
+						string(v1beta1.AppOrchActiveProjectID): activeProjectId,
+					},
+				},
+				Spec: v1beta1.DeploymentClusterSpec{
+					DeploymentID: deploymentId,
+					ClusterID:    clusterId,
+				},
+			}
+		})
+
+		reconciler := func(objs ...client.Object) *Reconciler {
+			scheme := runtime.NewScheme()
+			Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
+			return &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+				Scheme: scheme,
+			}
+		}
+
+		It("should return the generation exported for the cluster", func() {
+			generation, ok := reconciler(deployment).offlineBundleGeneration(ctx, dc)
+			Expect(ok).To(BeTrue())
+			Expect(generation).To(Equal(int64(3)))
+		})
+
+		It("should return none for another cluster", func() {
+			dc.Spec.ClusterID = "cluster-other"
+			_, ok := reconciler(deployment).offlineBundleGeneration(ctx, dc)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return none for an invalid annotation", func() {
+			deployment.Annotations[string(v1beta1.OfflineBundles)] = "invalid"
+			_, ok := reconciler(deployment).offlineBundleGeneration(ctx, dc)
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should return none without the Deployment", func() {
+			_, ok := reconciler().offlineBundleGeneration(ctx, dc)
+			Expect(ok).To(BeFalse())
+		})
+	})
+
 	// Create / delete BundleDeployments and ensure DeploymentCluster behavior.
 	When("multiple BundleDeployments are created / deleted", func() {
 
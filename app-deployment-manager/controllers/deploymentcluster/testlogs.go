@@ -0,0 +1,56 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package deploymentcluster
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	// Only the tail of the test logs is recorded to keep the DeploymentCluster small
+	testLogTailLines  int64 = 50
+	testLogLimitBytes int64 = 4096
+)
+
+// newClusterClientset returns a clientset for the edge cluster built from its kubeconfig secret
+var newClusterClientset = utils.NewClusterClientset
+
+// getJobLogs returns the tail of the logs of the most recent pod of a Job
+func getJobLogs(ctx context.Context, cs kubernetes.Interface, namespace string, jobName string) (string, error) {
+	pods, err := cs.CoreV1().Pods(namespace).List(ctx, v1.ListOptions{
+		LabelSelector: "job-name=" + jobName,
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(pods.Items) == 0 {
+		return "", fmt.Errorf("no pods found for job %s/%s", namespace, jobName)
+	}
+
+	latest := &pods.Items[0]
+	for i := range pods.Items {
+		if latest.CreationTimestamp.Before(&pods.Items[i].CreationTimestamp) {
+			latest = &pods.Items[i]
+		}
+	}
+
+	tailLines := testLogTailLines
+	limitBytes := testLogLimitBytes
+	logs, err := cs.CoreV1().Pods(namespace).GetLogs(latest.Name, &corev1.PodLogOptions{
+		TailLines:  &tailLines,
+		LimitBytes: &limitBytes,
+	}).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+	return string(logs), nil
+}
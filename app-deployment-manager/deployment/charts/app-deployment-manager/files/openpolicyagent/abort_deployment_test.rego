@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_abort_deployment_write_role if {
+	AbortDeploymentRequest with input as {
+		"request": {"deplId": "deployment-1"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_abort_deployment_no_role if {
+	not AbortDeploymentRequest with input as {
+		"request": {"deplId": "deployment-1"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
@@ -0,0 +1,10 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+import future.keywords.in
+
+CordonClusterRequest if {
+	hasWriteAccess
+}
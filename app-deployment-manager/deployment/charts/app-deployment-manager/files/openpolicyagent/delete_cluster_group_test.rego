@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_delete_cluster_group_write_role if {
+	DeleteClusterGroupRequest with input as {
+		"request": {"name": "west"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_delete_cluster_group_no_role if {
+	not DeleteClusterGroupRequest with input as {
+		"request": {"name": "west"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
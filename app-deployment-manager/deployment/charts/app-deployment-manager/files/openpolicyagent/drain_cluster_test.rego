@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_drain_cluster_write_role if {
+	DrainClusterRequest with input as {
+		"request": {"cluster_id": "cluster-01234567"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_drain_cluster_no_role if {
+	not DrainClusterRequest with input as {
+		"request": {"cluster_id": "cluster-01234567"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
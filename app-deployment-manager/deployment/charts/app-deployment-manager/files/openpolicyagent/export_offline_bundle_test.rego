@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_export_offline_bundle_write_role if {
+	ExportOfflineBundleRequest with input as {
+		"request": {"deplId": "deployment-1", "clusterId": "cluster-1"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_export_offline_bundle_no_role if {
+	not ExportOfflineBundleRequest with input as {
+		"request": {"deplId": "deployment-1", "clusterId": "cluster-1"},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_preview_targets_read_role if {
+	PreviewTargetsRequest with input as {
+		"request": {"targetClusters": [{"appName": "wordpress", "labels": {"region": "west"}}]},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_preview_targets_no_role if {
+	not PreviewTargetsRequest with input as {
+		"request": {"targetClusters": [{"appName": "wordpress", "labels": {"region": "west"}}]},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
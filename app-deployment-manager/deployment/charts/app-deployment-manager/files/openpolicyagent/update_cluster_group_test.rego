@@ -0,0 +1,29 @@
+# SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+# SPDX-License-Identifier: Apache-2.0
+
+package deploymentv1
+
+# ao-m2m-rw
+test_update_cluster_group_write_role if {
+	UpdateClusterGroupRequest with input as {
+		"request": {"name": "west", "clusterGroup": {"name": "west"}},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"ao-m2m-rw",
+			"uma_authorization",
+		]},
+	}
+}
+
+# no role
+test_update_cluster_group_no_role if {
+	not UpdateClusterGroupRequest with input as {
+		"request": {"name": "west", "clusterGroup": {"name": "west"}},
+		"metadata": {"realm_access/roles": [
+			"default-roles-master",
+			"offline_access",
+			"uma_authorization",
+		]},
+	}
+}
@@ -24,7 +24,9 @@ import (
 var log = dazl.GetPackageLogger()
 
 const (
-	ArtifactGrafanaPurpose string = "grafana"
+	ArtifactGrafanaPurpose  string = "grafana"
+	ArtifactTestPurpose     string = "test"
+	ArtifactManifestPurpose string = "manifest"
 )
 
 // Reference: https://fleet.rancher.io/gitrepo-add#using-private-helm-repositories
@@ -463,3 +465,39 @@ var UpdateIsDeployed = func(ctx context.Context, client CatalogClient, dpName st
 
 	return nil
 }
+
+// CatalogLookupTestArtifact returns the artifact with the given name if it is referenced by the
+// deployment package with the test purpose.
+var CatalogLookupTestArtifact = func(ctx context.Context, client CatalogClient, dp *catalog.DeploymentPackage, name string) (*catalog.Artifact, error) {
+	return lookupArtifact(ctx, client, dp, name, ArtifactTestPurpose)
+}
+
+// CatalogLookupManifestArtifact returns the artifact with the given name if it is referenced by the
+// deployment package with the manifest purpose.
+var CatalogLookupManifestArtifact = func(ctx context.Context, client CatalogClient, dp *catalog.DeploymentPackage, name string) (*catalog.Artifact, error) {
+	return lookupArtifact(ctx, client, dp, name, ArtifactManifestPurpose)
+}
+
+func lookupArtifact(ctx context.Context, client CatalogClient, dp *catalog.DeploymentPackage, name string, purpose string) (*catalog.Artifact, error) {
+	log.Info(fmt.Sprintf("Look up %s artifact %s: Deployment Package Name %s | Version %s", purpose, name, dp.GetName(), dp.GetVersion()))
+
+	found := false
+	for _, ar := range dp.GetArtifacts() {
+		if ar.Name == name && strings.EqualFold(ar.Purpose, purpose) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("artifact %s with purpose %s not found in deployment package %s", name, purpose, dp.GetName())
+	}
+
+	response, err := client.GetArtifact(ctx, &catalog.GetArtifactRequest{
+		ArtifactName: name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.GetArtifact(), nil
+}
@@ -525,3 +525,83 @@ func TestCatalogLookupGrafanaArtifacts(t *testing.T) {
 	assert.NotNil(t, artifacts)
 
 }
+
+func TestCatalogLookupTestArtifact(t *testing.T) {
+	md := metadata.Pairs("foo", "test")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	dp := &catalog.DeploymentPackage{
+		Name:    "deployment-package",
+		Version: "version",
+		Artifacts: []*catalog.ArtifactReference{
+			{
+				Name:    "sample-dashboard",
+				Purpose: "grafana",
+			},
+			{
+				Name:    "smoke-test",
+				Purpose: "test",
+			},
+		},
+	}
+	artiReq := &catalog.GetArtifactRequest{
+		ArtifactName: "smoke-test",
+	}
+	artiResp := &catalog.GetArtifactResponse{
+		Artifact: &catalog.Artifact{
+			Name:     "smoke-test",
+			MimeType: "application/yaml",
+			Artifact: []byte("YAML_CONTENTS"),
+		},
+	}
+	cc := mockerymock.NewMockeryCatalogClient(t)
+	cc.On("GetArtifact", mock.AnythingOfType("*context.valueCtx"), artiReq).Return(artiResp, nil)
+
+	artifact, err := catalogclient.CatalogLookupTestArtifact(ctx, cc, dp, "smoke-test")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("YAML_CONTENTS"), artifact.GetArtifact())
+
+	// Artifacts with another purpose are not test hooks
+	_, err = catalogclient.CatalogLookupTestArtifact(ctx, cc, dp, "sample-dashboard")
+	assert.Error(t, err)
+}
+
+func TestCatalogLookupManifestArtifact(t *testing.T) {
+	md := metadata.Pairs("foo", "test")
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	dp := &catalog.DeploymentPackage{
+		Name:    "deployment-package",
+		Version: "version",
+		Artifacts: []*catalog.ArtifactReference{
+			{
+				Name:    "smoke-test",
+				Purpose: "test",
+			},
+			{
+				Name:    "crds",
+				Purpose: "manifest",
+			},
+		},
+	}
+	artiReq := &catalog.GetArtifactRequest{
+		ArtifactName: "crds",
+	}
+	artiResp := &catalog.GetArtifactResponse{
+		Artifact: &catalog.Artifact{
+			Name:     "crds",
+			MimeType: "application/yaml",
+			Artifact: []byte("YAML_CONTENTS"),
+		},
+	}
+	cc := mockerymock.NewMockeryCatalogClient(t)
+	cc.On("GetArtifact", mock.AnythingOfType("*context.valueCtx"), artiReq).Return(artiResp, nil)
+
+	artifact, err := catalogclient.CatalogLookupManifestArtifact(ctx, cc, dp, "crds")
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("YAML_CONTENTS"), artifact.GetArtifact())
+
+	// Test artifacts are not manifests
+	_, err = catalogclient.CatalogLookupManifestArtifact(ctx, cc, dp, "smoke-test")
+	assert.Error(t, err)
+}
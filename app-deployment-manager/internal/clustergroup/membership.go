@@ -0,0 +1,175 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package clustergroup resolves the member clusters of the cluster groups of a
+// project from their static members, label selector and referenced groups.
+package clustergroup
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// Resolver resolves the members of the cluster groups of a project. Only the
+// existing clusters are members of a group, so a static member is only
+// resolved once its cluster is registered.
+type Resolver struct {
+	groups   map[string]*v1beta1.ClusterGroup
+	clusters map[string]map[string]string
+
+	members  map[string]map[string]struct{}
+	visiting map[string]bool
+}
+
+// NewResolver returns a resolver of the given groups over the given clusters,
+// which must all belong to the same project.
+func NewResolver(groups []v1beta1.ClusterGroup, clusters []v1beta1.Cluster) *Resolver {
+	r := &Resolver{
+		groups:   make(map[string]*v1beta1.ClusterGroup, len(groups)),
+		clusters: make(map[string]map[string]string, len(clusters)),
+		members:  make(map[string]map[string]struct{}),
+		visiting: make(map[string]bool),
+	}
+	for i := range groups {
+		r.groups[groups[i].Name] = &groups[i]
+	}
+	for _, c := range clusters {
+		r.clusters[c.Name] = c.Labels
+	}
+	return r
+}
+
+// Members returns the sorted ids of the member clusters of a group.
+func (r *Resolver) Members(name string) ([]string, error) {
+	g, ok := r.groups[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster group %s", name)
+	}
+	return r.Resolve(g)
+}
+
+// Resolve returns the sorted ids of the member clusters of a group, which may
+// not be stored yet or differ from the stored group of the same name.
+func (r *Resolver) Resolve(g *v1beta1.ClusterGroup) ([]string, error) {
+	if stored := r.groups[g.Name]; stored != g {
+		// Resolve the given group in place of the stored one of the same name
+		r.groups[g.Name] = g
+		r.members = make(map[string]map[string]struct{})
+		defer func() {
+			if stored != nil {
+				r.groups[g.Name] = stored
+			} else {
+				delete(r.groups, g.Name)
+			}
+			r.members = make(map[string]map[string]struct{})
+		}()
+	}
+
+	members, err := r.resolve(g.Name)
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(members))
+	for id := range members {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids, nil
+}
+
+// References returns the names of the groups a group refers to.
+func References(g *v1beta1.ClusterGroup) []string {
+	refs := make([]string, 0, len(g.Spec.Union)+len(g.Spec.Intersection)+len(g.Spec.Exclude))
+	refs = append(refs, g.Spec.Union...)
+	refs = append(refs, g.Spec.Intersection...)
+	return append(refs, g.Spec.Exclude...)
+}
+
+// Matches returns true if the labels have all the labels of a selector, an
+// empty selector matching no cluster.
+func Matches(selector map[string]string, labels map[string]string) bool {
+	if len(selector) == 0 {
+		return false
+	}
+	for k, v := range selector {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Resolver) resolve(name string) (map[string]struct{}, error) {
+	if members, ok := r.members[name]; ok {
+		return members, nil
+	}
+	g, ok := r.groups[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown cluster group %s", name)
+	}
+	if r.visiting[name] {
+		return nil, fmt.Errorf("cluster group %s refers to itself", name)
+	}
+	r.visiting[name] = true
+	defer delete(r.visiting, name)
+
+	members := make(map[string]struct{})
+	for _, id := range g.Spec.Clusters {
+		if _, ok := r.clusters[id]; ok {
+			members[id] = struct{}{}
+		}
+	}
+	for id, labels := range r.clusters {
+		if Matches(g.Spec.Selector, labels) {
+			members[id] = struct{}{}
+		}
+	}
+	for _, union := range g.Spec.Union {
+		unionMembers, err := r.resolve(union)
+		if err != nil {
+			return nil, fmt.Errorf("cluster group %s: %w", name, err)
+		}
+		for id := range unionMembers {
+			members[id] = struct{}{}
+		}
+	}
+
+	intersection := g.Spec.Intersection
+	if len(g.Spec.Clusters) == 0 && len(g.Spec.Selector) == 0 && len(g.Spec.Union) == 0 && len(intersection) > 0 {
+		// A group only made of intersections starts from the first one
+		first, err := r.resolve(intersection[0])
+		if err != nil {
+			return nil, fmt.Errorf("cluster group %s: %w", name, err)
+		}
+		for id := range first {
+			members[id] = struct{}{}
+		}
+		intersection = intersection[1:]
+	}
+	for _, other := range intersection {
+		otherMembers, err := r.resolve(other)
+		if err != nil {
+			return nil, fmt.Errorf("cluster group %s: %w", name, err)
+		}
+		for id := range members {
+			if _, ok := otherMembers[id]; !ok {
+				delete(members, id)
+			}
+		}
+	}
+	for _, exclude := range g.Spec.Exclude {
+		excludeMembers, err := r.resolve(exclude)
+		if err != nil {
+			return nil, fmt.Errorf("cluster group %s: %w", name, err)
+		}
+		for id := range excludeMembers {
+			delete(members, id)
+		}
+	}
+
+	r.members[name] = members
+	return members, nil
+}
@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clustergroup
+
+import (
+	"testing"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func cluster(id string, labels map[string]string) v1beta1.Cluster {
+	return v1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: id, Labels: labels}}
+}
+
+func group(name string, spec v1beta1.ClusterGroupSpec) v1beta1.ClusterGroup {
+	return v1beta1.ClusterGroup{ObjectMeta: metav1.ObjectMeta{Name: name}, Spec: spec}
+}
+
+var testClusters = []v1beta1.Cluster{
+	cluster("store-1", map[string]string{"region": "west", "pilot": "true"}),
+	cluster("store-2", map[string]string{"region": "west"}),
+	cluster("store-3", map[string]string{"region": "east"}),
+	cluster("store-4", map[string]string{"region": "east", "pilot": "true"}),
+}
+
+func TestMembers(t *testing.T) {
+	groups := []v1beta1.ClusterGroup{
+		group("west", v1beta1.ClusterGroupSpec{Selector: map[string]string{"region": "west"}}),
+		group("east", v1beta1.ClusterGroupSpec{Selector: map[string]string{"region": "east"}}),
+		group("pilot", v1beta1.ClusterGroupSpec{Selector: map[string]string{"pilot": "true"}}),
+		group("static", v1beta1.ClusterGroupSpec{Clusters: []string{"store-3", "store-9"}}),
+		group("west-no-pilot", v1beta1.ClusterGroupSpec{
+			Selector: map[string]string{"region": "west"},
+			Exclude:  []string{"pilot"},
+		}),
+		group("all", v1beta1.ClusterGroupSpec{Union: []string{"west", "east"}}),
+		group("east-pilot", v1beta1.ClusterGroupSpec{Intersection: []string{"east", "pilot"}}),
+		group("static-or-west-pilot", v1beta1.ClusterGroupSpec{
+			Clusters:     []string{"store-3"},
+			Union:        []string{"west"},
+			Intersection: []string{"pilot", "all"},
+		}),
+	}
+
+	cases := map[string][]string{
+		"west":                 {"store-1", "store-2"},
+		"pilot":                {"store-1", "store-4"},
+		"static":               {"store-3"},
+		"west-no-pilot":        {"store-2"},
+		"all":                  {"store-1", "store-2", "store-3", "store-4"},
+		"east-pilot":           {"store-4"},
+		"static-or-west-pilot": {"store-1"},
+	}
+	r := NewResolver(groups, testClusters)
+	for name, expected := range cases {
+		members, err := r.Members(name)
+		assert.NoError(t, err, name)
+		assert.Equal(t, expected, members, name)
+	}
+}
+
+func TestMembersErrors(t *testing.T) {
+	groups := []v1beta1.ClusterGroup{
+		group("a", v1beta1.ClusterGroupSpec{Union: []string{"b"}}),
+		group("b", v1beta1.ClusterGroupSpec{Exclude: []string{"a"}, Selector: map[string]string{"region": "west"}}),
+		group("c", v1beta1.ClusterGroupSpec{Intersection: []string{"missing"}}),
+	}
+	r := NewResolver(groups, testClusters)
+
+	_, err := r.Members("a")
+	assert.ErrorContains(t, err, "cluster group a refers to itself")
+	_, err = r.Members("c")
+	assert.ErrorContains(t, err, "unknown cluster group missing")
+	_, err = r.Members("missing")
+	assert.ErrorContains(t, err, "unknown cluster group missing")
+}
+
+func TestResolve(t *testing.T) {
+	groups := []v1beta1.ClusterGroup{
+		group("west", v1beta1.ClusterGroupSpec{Selector: map[string]string{"region": "west"}}),
+		group("pilot", v1beta1.ClusterGroupSpec{Selector: map[string]string{"pilot": "true"}}),
+	}
+	r := NewResolver(groups, testClusters)
+
+	// A group not stored yet
+	preview := group("west-no-pilot", v1beta1.ClusterGroupSpec{Union: []string{"west"}, Exclude: []string{"pilot"}})
+	members, err := r.Resolve(&preview)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"store-2"}, members)
+	_, err = r.Members("west-no-pilot")
+	assert.Error(t, err)
+
+	// A change of a stored group
+	preview = group("west", v1beta1.ClusterGroupSpec{Selector: map[string]string{"region": "east"}})
+	members, err = r.Resolve(&preview)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"store-3", "store-4"}, members)
+	members, err = r.Members("west")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"store-1", "store-2"}, members)
+}
+
+func TestMatches(t *testing.T) {
+	assert.True(t, Matches(map[string]string{"region": "west"}, map[string]string{"region": "west", "pilot": "true"}))
+	assert.False(t, Matches(map[string]string{"region": "west", "pilot": "true"}, map[string]string{"region": "west"}))
+	assert.False(t, Matches(nil, map[string]string{"region": "west"}))
+}
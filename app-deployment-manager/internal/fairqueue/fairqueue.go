@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fairqueue provides a controller work queue which processes reconcile
+// requests by priority class first and then round-robin across namespaces, so
+// that critical deployments are reconciled first and a single project with many
+// deployments cannot starve the other projects.
+package fairqueue
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
+)
+
+// Priorities lists the priority classes from the highest to the lowest.
+var Priorities = []v1beta1.PriorityType{
+	v1beta1.PriorityCritical,
+	v1beta1.PriorityHigh,
+	v1beta1.PriorityNormal,
+	v1beta1.PriorityLow,
+}
+
+// PriorityFunc returns the priority class of a reconcile request. Unknown
+// priorities are queued as normal.
+type PriorityFunc func(req reconcile.Request) v1beta1.PriorityType
+
+// class holds the queued requests of one priority class per namespace.
+type class struct {
+	namespaces []string
+	items      map[string][]reconcile.Request
+	next       int
+}
+
+type entry struct {
+	priority v1beta1.PriorityType
+	added    time.Time
+}
+
+// Queue implements workqueue.Queue. It is only called by the workqueue with its
+// lock held, so it does not need its own locking.
+type Queue struct {
+	controller string
+	priority   PriorityFunc
+	classes    map[v1beta1.PriorityType]*class
+	entries    map[reconcile.Request]entry
+	now        func() time.Time
+}
+
+// New returns an empty fair queue for the given controller.
+func New(controller string, priority PriorityFunc) *Queue {
+	q := &Queue{
+		controller: controller,
+		priority:   priority,
+		classes:    make(map[v1beta1.PriorityType]*class, len(Priorities)),
+		entries:    make(map[reconcile.Request]entry),
+		now:        time.Now,
+	}
+	for _, p := range Priorities {
+		q.classes[p] = &class{items: make(map[string][]reconcile.Request)}
+	}
+	return q
+}
+
+// NewQueue returns a controller NewQueue option which orders the reconcile
+// requests of the controller with a fair queue.
+func NewQueue(priority PriorityFunc) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		return workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+			Name: controllerName,
+			DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[reconcile.Request]{
+				Name: controllerName,
+				Queue: workqueue.NewTypedWithConfig(workqueue.TypedQueueConfig[reconcile.Request]{
+					Name:  controllerName,
+					Queue: New(controllerName, priority),
+				}),
+			}),
+		})
+	}
+}
+
+// Touch is called when a queued request is added again. The request keeps its
+// position.
+func (q *Queue) Touch(_ reconcile.Request) {}
+
+// Push queues a request at the end of its namespace in its priority class.
+func (q *Queue) Push(req reconcile.Request) {
+	p := q.priority(req)
+	c, ok := q.classes[p]
+	if !ok {
+		p = v1beta1.PriorityNormal
+		c = q.classes[p]
+	}
+
+	if _, ok := c.items[req.Namespace]; !ok {
+		c.namespaces = append(c.namespaces, req.Namespace)
+	}
+	c.items[req.Namespace] = append(c.items[req.Namespace], req)
+	q.entries[req] = entry{priority: p, added: q.now()}
+
+	metrics.ReconcileQueueDepth.WithLabelValues(q.controller, string(p)).Inc()
+}
+
+// Len returns the number of queued requests.
+func (q *Queue) Len() int {
+	return len(q.entries)
+}
+
+// Pop returns the next request of the highest non-empty priority class, taking
+// the namespaces of the class in turn.
+func (q *Queue) Pop() reconcile.Request {
+	for _, p := range Priorities {
+		c := q.classes[p]
+		if len(c.namespaces) == 0 {
+			continue
+		}
+
+		if c.next >= len(c.namespaces) {
+			c.next = 0
+		}
+		ns := c.namespaces[c.next]
+		req := c.items[ns][0]
+		if len(c.items[ns]) == 1 {
+			delete(c.items, ns)
+			c.namespaces = append(c.namespaces[:c.next], c.namespaces[c.next+1:]...)
+		} else {
+			c.items[ns] = c.items[ns][1:]
+			c.next++
+		}
+
+		e := q.entries[req]
+		delete(q.entries, req)
+		metrics.ReconcileQueueDepth.WithLabelValues(q.controller, string(p)).Dec()
+		metrics.ReconcileQueueLatency.WithLabelValues(q.controller, string(p)).Observe(q.now().Sub(e.added).Seconds())
+
+		return req
+	}
+
+	// the workqueue never pops an empty queue
+	return reconcile.Request{}
+}
@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fairqueue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+func request(namespace, name string) reconcile.Request {
+	return reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+}
+
+func priorities(p map[string]v1beta1.PriorityType) PriorityFunc {
+	return func(req reconcile.Request) v1beta1.PriorityType {
+		return p[req.Name]
+	}
+}
+
+func popAll(q *Queue) []reconcile.Request {
+	var reqs []reconcile.Request
+	for q.Len() > 0 {
+		reqs = append(reqs, q.Pop())
+	}
+	return reqs
+}
+
+func TestPopByPriority(t *testing.T) {
+	q := New("test-priority", priorities(map[string]v1beta1.PriorityType{
+		"patch":   v1beta1.PriorityCritical,
+		"batch":   v1beta1.PriorityLow,
+		"web":     v1beta1.PriorityHigh,
+		"unknown": "urgent",
+	}))
+
+	q.Push(request("project-a", "batch"))
+	q.Push(request("project-a", "unknown"))
+	q.Push(request("project-a", "web"))
+	q.Push(request("project-a", "patch"))
+	assert.Equal(t, 4, q.Len())
+
+	assert.Equal(t, []reconcile.Request{
+		request("project-a", "patch"),
+		request("project-a", "web"),
+		request("project-a", "unknown"),
+		request("project-a", "batch"),
+	}, popAll(q))
+}
+
+func TestPopRoundRobinNamespaces(t *testing.T) {
+	q := New("test-fair", priorities(nil))
+
+	q.Push(request("project-a", "a1"))
+	q.Push(request("project-a", "a2"))
+	q.Push(request("project-a", "a3"))
+	q.Push(request("project-b", "b1"))
+	q.Push(request("project-c", "c1"))
+	q.Push(request("project-c", "c2"))
+
+	assert.Equal(t, []reconcile.Request{
+		request("project-a", "a1"),
+		request("project-b", "b1"),
+		request("project-c", "c1"),
+		request("project-a", "a2"),
+		request("project-c", "c2"),
+		request("project-a", "a3"),
+	}, popAll(q))
+}
+
+func TestPopAfterPush(t *testing.T) {
+	q := New("test-interleaved", priorities(map[string]v1beta1.PriorityType{
+		"patch": v1beta1.PriorityCritical,
+	}))
+
+	q.Push(request("project-a", "a1"))
+	q.Push(request("project-a", "a2"))
+	assert.Equal(t, request("project-a", "a1"), q.Pop())
+
+	q.Push(request("project-b", "patch"))
+	q.Push(request("project-a", "a3"))
+	assert.Equal(t, []reconcile.Request{
+		request("project-b", "patch"),
+		request("project-a", "a2"),
+		request("project-a", "a3"),
+	}, popAll(q))
+}
+
+func TestNewQueue(t *testing.T) {
+	q := NewQueue(priorities(map[string]v1beta1.PriorityType{
+		"patch": v1beta1.PriorityCritical,
+	}))("test-workqueue", workqueue.NewTypedMaxOfRateLimiter[reconcile.Request](
+		workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](time.Millisecond, time.Second),
+	))
+	defer q.ShutDown()
+
+	q.Add(request("project-a", "a1"))
+	q.Add(request("project-a", "a1"))
+	q.Add(request("project-b", "patch"))
+	assert.Equal(t, 2, q.Len())
+
+	req, shutdown := q.Get()
+	assert.False(t, shutdown)
+	assert.Equal(t, request("project-b", "patch"), req)
+	q.Done(req)
+
+	req, _ = q.Get()
+	assert.Equal(t, request("project-a", "a1"), req)
+	q.Done(req)
+	assert.Equal(t, 0, q.Len())
+}
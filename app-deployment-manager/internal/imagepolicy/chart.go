@@ -0,0 +1,299 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	yaml2 "sigs.k8s.io/yaml"
+)
+
+const (
+	// maxChartFilesSize is the maximum size of the extracted files of a chart
+	maxChartFilesSize = 64 << 20
+	maxChartDepth     = 8
+)
+
+// templateImageRegexp matches image references written literally in a
+// template, not computed from the values
+var templateImageRegexp = regexp.MustCompile(`^\s*(?:-\s+)?image:\s*["']?([^"'\s{}]+)["']?\s*(?:#.*)?$`)
+
+// Chart is what the image policy inspects of a Helm chart: its default values,
+// its subcharts and the images written literally in its templates.
+//
+// Templates are not rendered: images computed in templates from anything else
+// than values, such as the app version of the chart, are not seen. Image maps
+// without a tag are therefore rejected when digests are pinned or signatures
+// verified.
+type Chart struct {
+	name   string
+	values map[string]interface{}
+	// subcharts are keyed by their alias or name in the parent values
+	subcharts map[string]*subchart
+	images    []string
+}
+
+type subchart struct {
+	chart     *Chart
+	condition string
+}
+
+type chartMetadata struct {
+	Name         string            `json:"name"`
+	Dependencies []chartDependency `json:"dependencies"`
+}
+
+type chartDependency struct {
+	Name      string `json:"name"`
+	Alias     string `json:"alias"`
+	Condition string `json:"condition"`
+}
+
+// LoadChart loads a Helm chart tarball. Subcharts listed as dependencies must
+// be packaged with the chart.
+func LoadChart(data []byte) (*Chart, error) {
+	return loadChartArchive(data, 0)
+}
+
+func loadChartArchive(data []byte, depth int) (*Chart, error) {
+	if depth > maxChartDepth {
+		return nil, errors.New("too many nested subcharts")
+	}
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	var size int64
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		size += hdr.Size
+		if size > maxChartFilesSize {
+			return nil, errors.New("chart files exceed the size limit")
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, hdr.Size))
+		if err != nil {
+			return nil, err
+		}
+		files[path.Clean("/" + hdr.Name)[1:]] = content
+	}
+
+	var root string
+	for name := range files {
+		if dir, file := path.Split(name); file == "Chart.yaml" && (root == "" || len(dir) < len(root)) {
+			root = dir
+		}
+	}
+	if root == "" {
+		return nil, errors.New("no Chart.yaml found in the chart")
+	}
+	return loadChartDir(files, root, depth)
+}
+
+// loadChartDir loads the chart in the given directory of the files
+func loadChartDir(files map[string][]byte, dir string, depth int) (*Chart, error) {
+	if depth > maxChartDepth {
+		return nil, errors.New("too many nested subcharts")
+	}
+
+	var meta chartMetadata
+	if err := yaml2.Unmarshal(files[dir+"Chart.yaml"], &meta); err != nil {
+		return nil, fmt.Errorf("invalid %sChart.yaml: %w", dir, err)
+	}
+	// Charts with apiVersion v1 list their dependencies in requirements.yaml
+	if data, ok := files[dir+"requirements.yaml"]; ok && len(meta.Dependencies) == 0 {
+		if err := yaml2.Unmarshal(data, &meta); err != nil {
+			return nil, fmt.Errorf("invalid %srequirements.yaml: %w", dir, err)
+		}
+	}
+
+	c := &Chart{
+		name:      meta.Name,
+		values:    map[string]interface{}{},
+		subcharts: map[string]*subchart{},
+	}
+	if err := yaml2.Unmarshal(files[dir+"values.yaml"], &c.values); err != nil {
+		return nil, fmt.Errorf("invalid %svalues.yaml: %w", dir, err)
+	}
+	if c.values == nil {
+		c.values = map[string]interface{}{}
+	}
+
+	charts := map[string]*Chart{}
+	subdirs := map[string]bool{}
+	for name, content := range files {
+		if !strings.HasPrefix(name, dir) {
+			continue
+		}
+		rel := strings.TrimPrefix(name, dir)
+		switch {
+		case strings.HasPrefix(rel, "templates/"):
+			c.images = append(c.images, templateImages(content)...)
+		case strings.HasPrefix(rel, "charts/"):
+			sub := strings.TrimPrefix(rel, "charts/")
+			if i := strings.Index(sub, "/"); i > 0 {
+				subdirs[dir+"charts/"+sub[:i+1]] = true
+				continue
+			}
+			if !strings.HasSuffix(sub, ".tgz") {
+				continue
+			}
+			sc, err := loadChartArchive(content, depth+1)
+			if err != nil {
+				return nil, fmt.Errorf("invalid subchart %s: %w", sub, err)
+			}
+			charts[sc.name] = sc
+		}
+	}
+	for subdir := range subdirs {
+		if _, ok := files[subdir+"Chart.yaml"]; !ok {
+			continue
+		}
+		sc, err := loadChartDir(files, subdir, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		charts[sc.name] = sc
+	}
+
+	for _, dep := range meta.Dependencies {
+		sc, ok := charts[dep.Name]
+		if !ok {
+			return nil, fmt.Errorf("dependency %s of chart %s is not packaged", dep.Name, meta.Name)
+		}
+		key := dep.Name
+		if dep.Alias != "" {
+			key = dep.Alias
+		}
+		c.subcharts[key] = &subchart{chart: sc, condition: dep.Condition}
+	}
+	// Subcharts packaged without being listed are rendered too
+	for name, sc := range charts {
+		if _, ok := c.subcharts[name]; !ok && !isDependency(meta.Dependencies, name) {
+			c.subcharts[name] = &subchart{chart: sc}
+		}
+	}
+	sort.Strings(c.images)
+	return c, nil
+}
+
+func isDependency(deps []chartDependency, name string) bool {
+	for _, dep := range deps {
+		if dep.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// templateImages returns the image references written literally in a template
+func templateImages(content []byte) []string {
+	var images []string
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	scanner.Buffer(nil, 1<<20)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.Contains(line, "{{") {
+			continue
+		}
+		if m := templateImageRegexp.FindStringSubmatch(line); m != nil {
+			if _, ok := parseImageString(m[1]); ok {
+				images = append(images, m[1])
+			}
+		}
+	}
+	return images
+}
+
+// Values returns the values the chart is rendered with: its default values and
+// the ones of its enabled subcharts, overridden by the given values. It also
+// returns the images written in the templates of the chart and its enabled
+// subcharts.
+func (c *Chart) Values(values map[string]interface{}) (map[string]interface{}, []string) {
+	merged := copyValues(c.values)
+	MergeValues(merged, values)
+	images := append([]string{}, c.images...)
+
+	keys := make([]string, 0, len(c.subcharts))
+	for key := range c.subcharts {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		sc := c.subcharts[key]
+		if !enabled(merged, sc.condition) {
+			delete(merged, key)
+			continue
+		}
+		subValues, _ := merged[key].(map[string]interface{})
+		var subImages []string
+		merged[key], subImages = sc.chart.Values(subValues)
+		images = append(images, subImages...)
+	}
+	return merged, images
+}
+
+// enabled evaluates the condition of a subchart: the first of its
+// comma-separated value paths set to a boolean
+func enabled(values map[string]interface{}, condition string) bool {
+	for _, p := range strings.Split(condition, ",") {
+		p = strings.TrimSpace(p)
+		if p == "" {
+			continue
+		}
+		if b, ok := lookup(values, strings.Split(p, ".")).(bool); ok {
+			return b
+		}
+	}
+	return true
+}
+
+// MergeValues merges src into dest the way Helm merges values files
+func MergeValues(dest map[string]interface{}, src map[string]interface{}) {
+	for k, v := range src {
+		srcMap, ok := v.(map[string]interface{})
+		destMap, destOk := dest[k].(map[string]interface{})
+		if ok && destOk {
+			MergeValues(destMap, srcMap)
+			continue
+		}
+		dest[k] = v
+	}
+}
+
+// copyValues returns a deep copy of the maps of the values
+func copyValues(values map[string]interface{}) map[string]interface{} {
+	c := make(map[string]interface{}, len(values))
+	for k, v := range values {
+		if m, ok := v.(map[string]interface{}); ok {
+			v = copyValues(m)
+		}
+		c[k] = v
+	}
+	return c
+}
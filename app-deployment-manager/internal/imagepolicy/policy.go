@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package imagepolicy finds the container images set in the Helm values of a
+// deployment, checks them against the image policy of the orchestrator and
+// pins their tags to the digest resolved from their registry.
+package imagepolicy
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	latestTag = "latest"
+	maxDepth  = 32
+)
+
+// Policy is the image policy enforced on deployments.
+type Policy struct {
+	// PinDigests resolves the images to their digest and pins them in the values
+	PinDigests bool
+	// AllowedRegistries lists the registries, optionally followed by a
+	// repository prefix, images may be pulled from. All are allowed if empty.
+	AllowedRegistries []string
+	// DenyLatestTag rejects images using the latest tag or no tag
+	DenyLatestTag bool
+	// CosignKey is the public key the cosign signatures of the images are
+	// verified against. Signatures are not verified if nil.
+	CosignKey crypto.PublicKey
+}
+
+// Credential authenticates to the image registry of an application.
+type Credential struct {
+	// Registry is the root URL of the image registry
+	Registry string
+	Username string
+	Password string
+}
+
+// Pin is a value to set in the override values to pin images to their digest.
+type Pin struct {
+	// Path is the key path of the value
+	Path []string
+	// Value is a string for images set in maps and the whole list for images
+	// set in lists, as Helm does not merge lists
+	Value interface{}
+}
+
+// FromEnv returns the image policy configured in the environment.
+func FromEnv() (*Policy, error) {
+	p := &Policy{
+		PinDigests:        utils.IsImageDigestPinningEnabled(),
+		AllowedRegistries: utils.GetImagePolicyAllowedRegistries(),
+		DenyLatestTag:     utils.IsImagePolicyDenyLatestTagEnabled(),
+	}
+	if key := utils.GetImagePolicyCosignPublicKey(); key != "" {
+		var err error
+		if p.CosignKey, err = ParsePublicKey([]byte(key)); err != nil {
+			return nil, fmt.Errorf("invalid cosign public key: %w", err)
+		}
+	}
+	return p, nil
+}
+
+// ParsePublicKey parses a PEM encoded ECDSA, RSA or Ed25519 public key.
+func ParsePublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("no PEM public key found")
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// Enabled returns true if any rule of the policy is set.
+func (p *Policy) Enabled() bool {
+	return p != nil && (p.PinDigests || len(p.AllowedRegistries) > 0 || p.DenyLatestTag || p.CosignKey != nil)
+}
+
+// image is an image found in the values
+type image struct {
+	// path is the key path of the image
+	path []string
+	// parent holds the image at key
+	parent map[string]interface{}
+	key    string
+	ref    reference
+	// listPath is the key path of the outermost list holding the image
+	listPath []string
+	// template is set for images written in the chart templates, which
+	// cannot be pinned
+	template bool
+}
+
+// Enforce checks the images of the given values, the profile values merged
+// with the override values of an application, against the policy. It returns
+// the values to set in the override values to pin the images to their
+// digest, and the policy violations.
+func (p *Policy) Enforce(ctx context.Context, values map[string]interface{}, cred Credential) ([]Pin, []string) {
+	return p.enforce(ctx, values, nil, cred)
+}
+
+// EnforceChart checks the images of a chart deployed with the given values,
+// the profile values merged with the override values of an application,
+// against the policy. The default values of the chart and its subcharts are
+// checked along with the given values, and the images written in its
+// templates. The latter cannot be pinned to their digest.
+func (p *Policy) EnforceChart(ctx context.Context, chart *Chart, values map[string]interface{}, cred Credential) ([]Pin, []string) {
+	values, templateImages := chart.Values(values)
+	return p.enforce(ctx, values, templateImages, cred)
+}
+
+func (p *Policy) enforce(ctx context.Context, values map[string]interface{}, templateImages []string, cred Credential) ([]Pin, []string) {
+	if !p.Enabled() {
+		return nil, nil
+	}
+
+	var images []*image
+	findImages(values, nil, nil, &images, 0)
+	sort.SliceStable(images, func(i, j int) bool {
+		return strings.Join(images[i].path, ".") < strings.Join(images[j].path, ".")
+	})
+	seen := map[string]bool{}
+	for _, raw := range templateImages {
+		if ref, ok := parseImageString(raw); ok && !seen[raw] {
+			seen[raw] = true
+			images = append(images, &image{parent: map[string]interface{}{"image": raw}, key: "image", ref: ref, template: true})
+		}
+	}
+
+	var violations []string
+	pins := map[string]Pin{}
+	digests := map[string]string{}
+	for _, img := range images {
+		name := img.ref.String()
+		if !p.allowed(img.ref) {
+			violations = append(violations, fmt.Sprintf("image %s is not pulled from an allowed registry", name))
+			continue
+		}
+
+		if img.ref.digest == "" {
+			// Charts default an empty tag of image maps to their app version
+			_, isMap := img.parent[img.key].(map[string]interface{})
+			if p.DenyLatestTag && (img.ref.tag == latestTag || (img.ref.tag == "" && !isMap)) {
+				violations = append(violations, fmt.Sprintf("image %s uses the latest tag", name))
+				continue
+			}
+			if img.template && p.PinDigests {
+				violations = append(violations, fmt.Sprintf("image %s is set in the chart templates and cannot be pinned", name))
+				continue
+			}
+			if !p.PinDigests && p.CosignKey == nil {
+				continue
+			}
+			if isMap && img.ref.tag == "" {
+				violations = append(violations, fmt.Sprintf("image %s has no tag to resolve", name))
+				continue
+			}
+		} else if p.CosignKey == nil {
+			continue
+		}
+
+		client := newRegistryClient(img.ref, cred)
+		digest := img.ref.digest
+		if digest == "" {
+			var ok bool
+			if digest, ok = digests[name]; !ok {
+				var err error
+				if digest, err = client.Resolve(ctx, img.ref.tagOrDefault()); err != nil {
+					violations = append(violations, fmt.Sprintf("image %s cannot be resolved: %v", name, err))
+					continue
+				}
+				digests[name] = digest
+			}
+		}
+
+		if p.CosignKey != nil {
+			if err := verifySignature(ctx, client, digest, p.CosignKey); err != nil {
+				violations = append(violations, fmt.Sprintf("image %s is not signed: %v", name, err))
+				continue
+			}
+		}
+
+		if p.PinDigests && img.ref.digest == "" {
+			pin := img.pin(digest)
+			pins[strings.Join(pin.Path, "\x00")] = pin
+		}
+	}
+
+	var list []Pin
+	for _, pin := range pins {
+		if pin.Value == nil {
+			pin.Value = lookup(values, pin.Path)
+		}
+		list = append(list, pin)
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return strings.Join(list[i].Path, ".") < strings.Join(list[j].Path, ".")
+	})
+	return list, violations
+}
+
+// Images returns the fully qualified references of the images found in the
+// values, sorted and without duplicates. Images set as a map without tag are
+// skipped, their tag being defaulted by the chart.
+func Images(values map[string]interface{}) []string {
+	var images []*image
+	findImages(values, nil, nil, &images, 0)
+
+	seen := map[string]bool{}
+	var list []string
+	for _, img := range images {
+		if _, ok := img.parent[img.key].(map[string]interface{}); ok && img.ref.tag == "" && img.ref.digest == "" {
+			continue
+		}
+		if name := img.ref.String(); !seen[name] {
+			seen[name] = true
+			list = append(list, name)
+		}
+	}
+	sort.Strings(list)
+	return list
+}
+
+// allowed returns true if the image is pulled from an allowed registry
+func (p *Policy) allowed(ref reference) bool {
+	if len(p.AllowedRegistries) == 0 {
+		return true
+	}
+	name := ref.registry + "/" + ref.repository
+	for _, r := range p.AllowedRegistries {
+		r = strings.TrimSuffix(r, "/")
+		if name == r || strings.HasPrefix(name, r+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// pin sets the digest in the values holding the image and returns the value
+// to set in the override values
+func (img *image) pin(digest string) Pin {
+	path := img.path
+	var value interface{}
+	if fields, ok := img.parent[img.key].(map[string]interface{}); ok {
+		if _, ok := fields["digest"]; ok {
+			fields["digest"] = digest
+			path = append(append([]string{}, path...), "digest")
+		} else {
+			fields["tag"] = img.ref.tag + "@" + digest
+			path = append(append([]string{}, path...), "tag")
+		}
+		value = fields[path[len(path)-1]]
+	} else {
+		value = img.ref.raw + "@" + digest
+		img.parent[img.key] = value
+	}
+
+	if img.listPath != nil {
+		// The value of the list is read once all its images are pinned
+		return Pin{Path: img.listPath}
+	}
+	return Pin{Path: path, Value: value}
+}
+
+// findImages walks the values for image maps, with a repository and
+// optionally registry, tag and digest fields, and image reference strings set
+// in keys ending with image
+func findImages(v interface{}, path []string, listPath []string, images *[]*image, depth int) {
+	if depth > maxDepth {
+		return
+	}
+
+	switch val := v.(type) {
+	case map[string]interface{}:
+		for k, child := range val {
+			childPath := append(append([]string{}, path...), k)
+			if isImageKey(k) {
+				var ref reference
+				var ok bool
+				switch c := child.(type) {
+				case map[string]interface{}:
+					ref, ok = parseImageMap(c)
+				case string:
+					ref, ok = parseImageString(c)
+				}
+				if ok {
+					*images = append(*images, &image{path: childPath, parent: val, key: k, ref: ref, listPath: listPath})
+					continue
+				}
+			}
+			findImages(child, childPath, listPath, images, depth+1)
+		}
+	case []interface{}:
+		if listPath == nil {
+			listPath = path
+		}
+		for _, child := range val {
+			findImages(child, path, listPath, images, depth+1)
+		}
+	}
+}
+
+// lookup returns the value at the given key path
+func lookup(values map[string]interface{}, path []string) interface{} {
+	var v interface{} = values
+	for _, k := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[k]
+	}
+	return v
+}
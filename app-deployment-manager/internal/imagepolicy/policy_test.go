@@ -0,0 +1,358 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+// fakeRegistry serves the manifests and cosign signatures of its tags
+type fakeRegistry struct {
+	*httptest.Server
+	host string
+	// tags maps repository:tag to the digest
+	tags  map[string]string
+	blobs map[string][]byte
+	sigs  map[string][]byte
+	// username and password enable the token authentication
+	username string
+	password string
+}
+
+func newFakeRegistry(t *testing.T) *fakeRegistry {
+	r := &fakeRegistry{
+		tags:  map[string]string{},
+		blobs: map[string][]byte{},
+		sigs:  map[string][]byte{},
+	}
+	r.Server = httptest.NewTLSServer(r)
+	t.Cleanup(r.Close)
+	r.host = strings.TrimPrefix(r.URL, "https://")
+
+	client := ociregistry.HTTPClient
+	t.Cleanup(func() { ociregistry.HTTPClient = client })
+	ociregistry.HTTPClient = r.Client()
+	return r
+}
+
+func digestOf(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+func (r *fakeRegistry) addImage(repo string, tag string) string {
+	digest := digestOf([]byte(repo + ":" + tag))
+	r.tags[repo+":"+tag] = digest
+	return digest
+}
+
+func (r *fakeRegistry) sign(t *testing.T, key *ecdsa.PrivateKey, repo string, digest string) {
+	payload := []byte(fmt.Sprintf(`{"critical":{"identity":{"docker-reference":"%s/%s"},"image":{"docker-manifest-digest":"%s"},"type":"cosign container image signature"},"optional":null}`,
+		r.host, repo, digest))
+	sum := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, key, sum[:])
+	require.NoError(t, err)
+
+	r.blobs[digestOf(payload)] = payload
+	manifest, err := json.Marshal(map[string]interface{}{
+		"schemaVersion": 2,
+		"layers": []map[string]interface{}{{
+			"mediaType":   "application/vnd.dev.cosign.simplesigning.v1+json",
+			"digest":      digestOf(payload),
+			"annotations": map[string]string{cosignSignatureAnnotation: base64.StdEncoding.EncodeToString(sig)},
+		}},
+	})
+	require.NoError(t, err)
+	r.sigs[repo+":"+strings.Replace(digest, ":", "-", 1)+".sig"] = manifest
+}
+
+func (r *fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path == "/token" {
+		if user, pass, ok := req.BasicAuth(); !ok || user != r.username || pass != r.password {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"token":"pull-token"}`))
+		return
+	}
+	if r.username != "" && req.Header.Get("Authorization") != "Bearer pull-token" {
+		w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, r.URL))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	path := strings.TrimPrefix(req.URL.Path, "/v2/")
+	if i := strings.Index(path, "/manifests/"); i > 0 {
+		name := path[:i] + ":" + path[i+len("/manifests/"):]
+		if sig, ok := r.sigs[name]; ok {
+			_, _ = w.Write(sig)
+			return
+		}
+		if digest, ok := r.tags[name]; ok {
+			w.Header().Set("Docker-Content-Digest", digest)
+			return
+		}
+	}
+	if i := strings.Index(path, "/blobs/"); i > 0 {
+		if blob, ok := r.blobs[path[i+len("/blobs/"):]]; ok {
+			_, _ = w.Write(blob)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func TestParseReference(t *testing.T) {
+	for s, expected := range map[string]string{
+		"nginx":                                "docker.io/library/nginx",
+		"nginx:1.27":                           "docker.io/library/nginx:1.27",
+		"bitnami/wordpress:6.4":                "docker.io/bitnami/wordpress:6.4",
+		"index.docker.io/library/redis:7":      "docker.io/library/redis:7",
+		"registry.example.com:5000/app/api:v1": "registry.example.com:5000/app/api:v1",
+		"localhost/app@sha256:" + strings.Repeat("a", 64): "localhost/app@sha256:" + strings.Repeat("a", 64),
+	} {
+		ref, err := parseReference(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, expected, ref.String())
+	}
+
+	for _, s := range []string{"", "Nginx", "nginx:{{ .Values.tag }}", "nginx@sha256:0123", "%ImageRegistryURL%/nginx"} {
+		_, err := parseReference(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestEnforcePinsDigests(t *testing.T) {
+	r := newFakeRegistry(t)
+	apiDigest := r.addImage("app/api", "1.0.0")
+	dbDigest := r.addImage("app/db", "15")
+	proxyDigest := r.addImage("app/proxy", "2.1")
+
+	values := map[string]interface{}{
+		"image": map[string]interface{}{
+			"registry":   r.host,
+			"repository": "app/api",
+			"tag":        "1.0.0",
+			"digest":     "",
+		},
+		"db": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": r.host + "/app/db",
+				"tag":        float64(15),
+			},
+		},
+		"sidecars": []interface{}{
+			map[string]interface{}{"name": "proxy", "image": r.host + "/app/proxy:2.1"},
+			map[string]interface{}{"name": "pinned", "image": r.host + "/app/proxy@" + proxyDigest},
+		},
+		"replicaCount": float64(1),
+	}
+
+	p := &Policy{PinDigests: true}
+	pins, violations := p.Enforce(context.Background(), values, Credential{})
+	assert.Empty(t, violations)
+	assert.Equal(t, []Pin{
+		{Path: []string{"db", "image", "tag"}, Value: "15@" + dbDigest},
+		{Path: []string{"image", "digest"}, Value: apiDigest},
+		{Path: []string{"sidecars"}, Value: []interface{}{
+			map[string]interface{}{"name": "proxy", "image": r.host + "/app/proxy:2.1@" + proxyDigest},
+			map[string]interface{}{"name": "pinned", "image": r.host + "/app/proxy@" + proxyDigest},
+		}},
+	}, pins)
+}
+
+func TestEnforceViolations(t *testing.T) {
+	r := newFakeRegistry(t)
+	r.addImage("app/api", "latest")
+
+	values := map[string]interface{}{
+		"image":       map[string]interface{}{"repository": r.host + "/app/api", "tag": "latest"},
+		"initImage":   "busybox:1.36",
+		"workerImage": r.host + "/app/worker:1.0.0",
+		"chartImage":  map[string]interface{}{"repository": r.host + "/app/chart"},
+	}
+
+	p := &Policy{
+		PinDigests:        true,
+		AllowedRegistries: []string{r.host + "/app/"},
+		DenyLatestTag:     true,
+	}
+	pins, violations := p.Enforce(context.Background(), values, Credential{})
+	assert.Empty(t, pins)
+	assert.Equal(t, []string{
+		"image " + r.host + "/app/chart has no tag to resolve",
+		"image " + r.host + "/app/api:latest uses the latest tag",
+		"image docker.io/library/busybox:1.36 is not pulled from an allowed registry",
+		"image " + r.host + "/app/worker:1.0.0 cannot be resolved: manifest 1.0.0: 404 Not Found",
+	}, violations)
+
+	_, violations = (&Policy{}).Enforce(context.Background(), values, Credential{})
+	assert.Empty(t, violations)
+}
+
+// chartArchive returns a chart tarball with the given files
+func chartArchive(t *testing.T, files map[string]string) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func TestEnforceChart(t *testing.T) {
+	r := newFakeRegistry(t)
+	apiDigest := r.addImage("app/api", "1.0.0")
+	dbDigest := r.addImage("app/db", "15")
+	r.addImage("app/api", "1.1.0")
+
+	db := chartArchive(t, map[string]string{
+		"db/Chart.yaml":                 "name: db\nversion: 1.0.0\n",
+		"db/values.yaml":                "image:\n  repository: " + r.host + "/app/db\n  tag: \"15\"\n",
+		"db/templates/statefulset.yaml": "image: {{ .Values.image.repository }}:{{ .Values.image.tag }}\n",
+	})
+	data := chartArchive(t, map[string]string{
+		"api/Chart.yaml": "name: api\nversion: 1.0.0\ndependencies:\n" +
+			"- name: db\n  alias: database\n  condition: database.enabled\n" +
+			"- name: cache\n  condition: cache.enabled\n",
+		"api/values.yaml":                       "image:\n  repository: " + r.host + "/app/api\n  tag: 1.0.0\ncache:\n  enabled: false\n",
+		"api/templates/deployment.yaml":         "      containers:\n      - name: api\n        image: {{ .Values.image.repository }}\n      - name: exporter\n        image: \"busybox:1.36\" # metrics\n",
+		"api/charts/db-1.0.0.tgz":               string(db),
+		"api/charts/cache/Chart.yaml":           "name: cache\nversion: 1.0.0\n",
+		"api/charts/cache/templates/cache.yaml": "image: redis:7\n",
+	})
+	chart, err := LoadChart(data)
+	require.NoError(t, err)
+
+	values, images := chart.Values(map[string]interface{}{"database": map[string]interface{}{"enabled": true}})
+	assert.Equal(t, []string{"busybox:1.36"}, images)
+	assert.Equal(t, map[string]interface{}{"repository": r.host + "/app/db", "tag": "15"}, lookup(values, []string{"database", "image"}))
+	assert.NotContains(t, values, "cache")
+
+	// Chart defaults are pinned, the override values taking precedence
+	p := &Policy{PinDigests: true, AllowedRegistries: []string{r.host}}
+	pins, violations := p.EnforceChart(context.Background(), chart, map[string]interface{}{}, Credential{})
+	assert.Equal(t, []Pin{
+		{Path: []string{"database", "image", "tag"}, Value: "15@" + dbDigest},
+		{Path: []string{"image", "tag"}, Value: "1.0.0@" + apiDigest},
+	}, pins)
+	assert.Equal(t, []string{
+		"image docker.io/library/busybox:1.36 is not pulled from an allowed registry",
+	}, violations)
+
+	_, violations = (&Policy{PinDigests: true}).EnforceChart(context.Background(), chart, map[string]interface{}{
+		"image": map[string]interface{}{"tag": "1.1.0"},
+	}, Credential{})
+	assert.Equal(t, []string{
+		"image docker.io/library/busybox:1.36 is set in the chart templates and cannot be pinned",
+	}, violations)
+
+	// Enabling the cache subchart deploys its template image
+	_, violations = (&Policy{DenyLatestTag: true, AllowedRegistries: []string{r.host}}).EnforceChart(context.Background(), chart,
+		map[string]interface{}{"cache": map[string]interface{}{"enabled": true}}, Credential{})
+	assert.Equal(t, []string{
+		"image docker.io/library/busybox:1.36 is not pulled from an allowed registry",
+		"image docker.io/library/redis:7 is not pulled from an allowed registry",
+	}, violations)
+}
+
+func TestLoadChartRequiresDependencies(t *testing.T) {
+	_, err := LoadChart(chartArchive(t, map[string]string{
+		"api/Chart.yaml": "name: api\nversion: 1.0.0\ndependencies:\n- name: db\n",
+	}))
+	assert.EqualError(t, err, "dependency db of chart api is not packaged")
+
+	_, err = LoadChart(chartArchive(t, map[string]string{"api/values.yaml": "{}"}))
+	assert.EqualError(t, err, "no Chart.yaml found in the chart")
+
+	_, err = LoadChart([]byte("not a chart"))
+	assert.Error(t, err)
+}
+
+func TestEnforceVerifiesSignatures(t *testing.T) {
+	r := newFakeRegistry(t)
+	signedDigest := r.addImage("app/signed", "1.0.0")
+	r.addImage("app/unsigned", "1.0.0")
+	otherDigest := r.addImage("app/other", "1.0.0")
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	r.sign(t, key, "app/signed", signedDigest)
+	r.sign(t, otherKey, "app/other", otherDigest)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	t.Setenv("IMAGE_POLICY_COSIGN_PUBLIC_KEY", string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})))
+	p, err := FromEnv()
+	require.NoError(t, err)
+	assert.True(t, p.Enabled())
+	assert.False(t, p.PinDigests)
+
+	values := map[string]interface{}{
+		"signedImage":   r.host + "/app/signed:1.0.0",
+		"unsignedImage": r.host + "/app/unsigned:1.0.0",
+		"otherImage":    r.host + "/app/other:1.0.0",
+	}
+	pins, violations := p.Enforce(context.Background(), values, Credential{})
+	assert.Empty(t, pins)
+	assert.Len(t, violations, 2)
+	assert.Contains(t, violations, "image "+r.host+"/app/other:1.0.0 is not signed: no signature matches the cosign public key")
+	for _, v := range violations {
+		assert.NotContains(t, v, "app/signed")
+	}
+
+	t.Setenv("IMAGE_POLICY_COSIGN_PUBLIC_KEY", "invalid")
+	_, err = FromEnv()
+	assert.EqualError(t, err, "invalid cosign public key: no PEM public key found")
+}
+
+func TestEnforceAuthenticates(t *testing.T) {
+	r := newFakeRegistry(t)
+	r.username, r.password = "robot", "s3cr3t"
+	digest := r.addImage("app/api", "1.0.0")
+	values := map[string]interface{}{"image": r.host + "/app/api:1.0.0"}
+
+	p := &Policy{PinDigests: true}
+	pins, violations := p.Enforce(context.Background(), values, Credential{
+		Registry: "https://" + r.host + "/app",
+		Username: "robot",
+		Password: "s3cr3t",
+	})
+	assert.Empty(t, violations)
+	assert.Equal(t, []Pin{{Path: []string{"image"}, Value: r.host + "/app/api:1.0.0@" + digest}}, pins)
+
+	values = map[string]interface{}{"image": r.host + "/app/api:1.0.0"}
+	_, violations = p.Enforce(context.Background(), values, Credential{})
+	assert.Equal(t, []string{"image " + r.host + "/app/api:1.0.0 cannot be resolved: cannot get token: 401 Unauthorized"}, violations)
+}
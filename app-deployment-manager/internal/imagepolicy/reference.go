@@ -0,0 +1,142 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+const dockerHubLibrary = "library/"
+
+var (
+	repositoryRegexp = regexp.MustCompile(`^[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*$`)
+	tagRegexp        = regexp.MustCompile(`^[A-Za-z0-9_][A-Za-z0-9_.-]{0,127}$`)
+)
+
+// reference is a normalized image reference
+type reference struct {
+	// raw is the reference as set in the values
+	raw        string
+	registry   string
+	repository string
+	tag        string
+	digest     string
+}
+
+// String returns the fully qualified name of the image
+func (r reference) String() string {
+	s := r.registry + "/" + r.repository
+	if r.tag != "" {
+		s += ":" + r.tag
+	}
+	if r.digest != "" {
+		s += "@" + r.digest
+	}
+	return s
+}
+
+// tagOrDefault returns the tag pulled by the container runtime
+func (r reference) tagOrDefault() string {
+	if r.tag == "" {
+		return latestTag
+	}
+	return r.tag
+}
+
+// isImageKey returns true for the keys of the values which may hold an image
+func isImageKey(k string) bool {
+	return strings.HasSuffix(strings.ToLower(k), "image")
+}
+
+// parseReference parses [registry/]repository[:tag][@digest] with the
+// defaults of the container runtimes: docker.io and its library repositories.
+func parseReference(s string) (reference, error) {
+	ref := reference{raw: s}
+	name := s
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, ref.digest = name[:i], name[i+1:]
+		if !ociregistry.IsDigest(ref.digest) {
+			return ref, fmt.Errorf("invalid digest %s", ref.digest)
+		}
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, ref.tag = name[:i], name[i+1:]
+		if !tagRegexp.MatchString(ref.tag) {
+			return ref, fmt.Errorf("invalid tag %s", ref.tag)
+		}
+	}
+
+	ref.registry = ociregistry.DockerHubRegistry
+	ref.repository = name
+	if host, repo, ok := strings.Cut(name, "/"); ok &&
+		(strings.ContainsAny(host, ".:") || host == "localhost") {
+		ref.registry, ref.repository = host, repo
+	}
+	if ref.registry == "index.docker.io" {
+		ref.registry = ociregistry.DockerHubRegistry
+	}
+	if ref.registry == ociregistry.DockerHubRegistry && !strings.Contains(ref.repository, "/") {
+		ref.repository = dockerHubLibrary + ref.repository
+	}
+
+	if !repositoryRegexp.MatchString(ref.repository) {
+		return ref, fmt.Errorf("invalid repository %s", ref.repository)
+	}
+	return ref, nil
+}
+
+// parseImageString parses an image reference set as a string
+func parseImageString(s string) (reference, bool) {
+	ref, err := parseReference(s)
+	return ref, err == nil
+}
+
+// parseImageMap parses an image set as a map with a repository and
+// optionally registry, tag and digest fields
+func parseImageMap(m map[string]interface{}) (reference, bool) {
+	repository, ok := m["repository"].(string)
+	if !ok || repository == "" {
+		return reference{}, false
+	}
+	name := repository
+	if registry, ok := m["registry"].(string); ok && registry != "" {
+		name = strings.TrimSuffix(registry, "/") + "/" + repository
+	}
+
+	var tag string
+	switch t := m["tag"].(type) {
+	case string:
+		tag = t
+	case float64:
+		tag = strconv.FormatFloat(t, 'f', -1, 64)
+	}
+	if tag != "" {
+		name += ":" + tag
+	}
+	if digest, ok := m["digest"].(string); ok && digest != "" && !strings.Contains(name, "@") {
+		name += "@" + digest
+	}
+
+	ref, err := parseReference(name)
+	return ref, err == nil
+}
+
+// SplitReference splits an image reference into its registry, repository and
+// digest, or tag if not pinned.
+func SplitReference(image string) (registry string, repository string, ref string, err error) {
+	r, err := parseReference(image)
+	if err != nil {
+		return "", "", "", err
+	}
+	if r.digest != "" {
+		return r.registry, r.repository, r.digest, nil
+	}
+	return r.registry, r.repository, r.tagOrDefault(), nil
+}
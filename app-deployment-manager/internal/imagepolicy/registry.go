@@ -0,0 +1,91 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package imagepolicy
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+const (
+	maxSignatureSize = 1 << 20
+
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+)
+
+// newRegistryClient returns a client of the repository of the image, with the
+// credentials of the application registry if the image is pulled from it
+func newRegistryClient(ref reference, cred Credential) *ociregistry.Client {
+	var c ociregistry.Credential
+	if u, err := url.Parse(cred.Registry); err == nil && u.Host == ref.registry {
+		c = ociregistry.Credential{Username: cred.Username, Password: cred.Password}
+	}
+	return ociregistry.New(ref.registry, ref.repository, c)
+}
+
+// verifySignature checks that the image with the given digest has a cosign
+// signature made with the given key
+func verifySignature(ctx context.Context, c *ociregistry.Client, digest string, key crypto.PublicKey) error {
+	data, _, err := c.Manifest(ctx, strings.Replace(digest, ":", "-", 1)+".sig", ociregistry.ImageManifestMediaTypes...)
+	if err != nil {
+		return fmt.Errorf("no signature found: %w", err)
+	}
+	manifest := &ociregistry.Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return fmt.Errorf("invalid signature manifest: %w", err)
+	}
+
+	for _, layer := range manifest.Layers {
+		sig, err := base64.StdEncoding.DecodeString(layer.Annotations[cosignSignatureAnnotation])
+		if err != nil || len(sig) == 0 {
+			continue
+		}
+		payload, err := c.ReadBlob(ctx, layer.Digest, maxSignatureSize)
+		if err != nil {
+			return err
+		}
+		if !verify(key, payload, sig) {
+			continue
+		}
+
+		var simpleSigning struct {
+			Critical struct {
+				Image struct {
+					DockerManifestDigest string `json:"docker-manifest-digest"`
+				} `json:"image"`
+			} `json:"critical"`
+		}
+		if err := json.Unmarshal(payload, &simpleSigning); err == nil &&
+			simpleSigning.Critical.Image.DockerManifestDigest == digest {
+			return nil
+		}
+	}
+	return fmt.Errorf("no signature matches the cosign public key")
+}
+
+// verify checks the signature of the SHA-256 of the payload
+func verify(key crypto.PublicKey, payload []byte, sig []byte) bool {
+	sum := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(k, sum[:], sig)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, sum[:], sig) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(k, payload, sig)
+	}
+	return false
+}
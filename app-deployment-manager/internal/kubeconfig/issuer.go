@@ -0,0 +1,307 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package kubeconfig issues short-lived kubeconfigs for the edge clusters. The
+// kubeconfigs authenticate with the token of a service account bound to a
+// role scoped to the applications of the cluster, instead of the admin
+// credentials of the cluster.
+package kubeconfig
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	// Name is the name of the service accounts, role and bindings the
+	// kubeconfigs are issued for
+	Name = "app-orch-kubeconfig"
+
+	// ReadOnlyName is the name of the role and binding of the kubeconfigs
+	// scoped to the whole cluster
+	ReadOnlyName = "app-orch-kubeconfig-readonly"
+
+	// ClusterScopeNamespace holds the service account of the kubeconfigs
+	// scoped to the whole cluster
+	ClusterScopeNamespace = "kube-system"
+
+	// MinTTL is the shortest lifetime of a token accepted by Kubernetes
+	MinTTL = 10 * time.Minute
+
+	// readyInterval is how long the service account of a scope is assumed
+	// to be set up before being checked again
+	readyInterval = 10 * time.Minute
+)
+
+// managedLabels label the objects created in the edge clusters
+var managedLabels = map[string]string{
+	"app.kubernetes.io/managed-by": "app-deployment-manager",
+}
+
+// Rules of the role bound in the namespace of the kubeconfigs scoped to a
+// namespace, giving access to the application resources and virtual machines
+// but not to the secrets nor to the service account tokens
+var Rules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "pods/log", "services", "endpoints", "configmaps",
+			"persistentvolumeclaims", "serviceaccounts", "events"},
+		Verbs: []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods"},
+		Verbs:     []string{"delete"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets", "replicasets"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"kubevirt.io"},
+		Resources: []string{"virtualmachines", "virtualmachineinstances"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"subresources.kubevirt.io"},
+		Resources: []string{"virtualmachines/start", "virtualmachines/stop", "virtualmachines/restart",
+			"virtualmachineinstances/vnc", "virtualmachineinstances/console"},
+		Verbs: []string{"get", "update"},
+	},
+	{
+		// The pods of the SR-IOV operator are owned by its configs
+		APIGroups: []string{"sriovnetwork.openshift.io"},
+		Resources: []string{"sriovoperatorconfigs"},
+		Verbs:     []string{"get"},
+	},
+}
+
+// ReadOnlyRules of the role of the kubeconfigs scoped to the whole cluster,
+// only reading the application resources and virtual machines of all the
+// namespaces
+var ReadOnlyRules = []rbacv1.PolicyRule{
+	{
+		APIGroups: []string{""},
+		Resources: []string{"pods", "pods/log", "services", "endpoints", "configmaps",
+			"persistentvolumeclaims", "serviceaccounts", "events", "namespaces"},
+		Verbs: []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"apps"},
+		Resources: []string{"deployments", "statefulsets", "daemonsets", "replicasets"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"batch"},
+		Resources: []string{"jobs", "cronjobs"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"networking.k8s.io"},
+		Resources: []string{"ingresses"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+	{
+		APIGroups: []string{"kubevirt.io"},
+		Resources: []string{"virtualmachines", "virtualmachineinstances"},
+		Verbs:     []string{"get", "list", "watch"},
+	},
+}
+
+// Request describes a kubeconfig to issue
+type Request struct {
+	// ClusterID names the cluster, user and context of the kubeconfig
+	ClusterID string
+	// Namespace scopes the credentials to a namespace, the credentials are
+	// scoped to the whole cluster if empty
+	Namespace string
+	// TTL is the requested lifetime of the credentials, raised to MinTTL
+	TTL time.Duration
+}
+
+// Issuer issues the kubeconfigs of the edge clusters
+type Issuer struct {
+	newClientset func(*rest.Config) (kubernetes.Interface, error)
+
+	mu    sync.Mutex
+	ready map[string]time.Time
+}
+
+// NewIssuer returns an issuer of kubeconfigs
+func NewIssuer() *Issuer {
+	return &Issuer{
+		newClientset: func(config *rest.Config) (kubernetes.Interface, error) {
+			return kubernetes.NewForConfig(config)
+		},
+		ready: map[string]time.Time{},
+	}
+}
+
+// Issue sets up the service account of the requested scope with the admin
+// kubeconfig of the cluster and returns a kubeconfig authenticated by a token
+// of that service account, along with the expiration of the token.
+func (i *Issuer) Issue(ctx context.Context, adminKubeConfig []byte, req Request) ([]byte, time.Time, error) {
+	admin, err := clientcmd.Load(adminKubeConfig)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("invalid kubeconfig of cluster %s: %w", req.ClusterID, err)
+	}
+	adminContext, ok := admin.Contexts[admin.CurrentContext]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("kubeconfig of cluster %s has no current context", req.ClusterID)
+	}
+	cluster, ok := admin.Clusters[adminContext.Cluster]
+	if !ok {
+		return nil, time.Time{}, fmt.Errorf("kubeconfig of cluster %s has no cluster %s", req.ClusterID, adminContext.Cluster)
+	}
+
+	config, err := clientcmd.NewDefaultClientConfig(*admin, nil).ClientConfig()
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	cs, err := i.newClientset(config)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	namespace := req.Namespace
+	if namespace == "" {
+		namespace = ClusterScopeNamespace
+	}
+	if err := i.setup(ctx, cs, cluster.Server, req.Namespace); err != nil {
+		return nil, time.Time{}, err
+	}
+
+	ttl := int64(max(req.TTL, MinTTL).Seconds())
+	token, err := cs.CoreV1().ServiceAccounts(namespace).CreateToken(ctx, Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			ExpirationSeconds: &ttl,
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if token.Status.Token == "" {
+		return nil, time.Time{}, fmt.Errorf("empty token issued for service account %s/%s", namespace, Name)
+	}
+
+	kubeConfig := clientcmdapi.NewConfig()
+	kubeConfig.Clusters[req.ClusterID] = cluster
+	kubeConfig.AuthInfos[req.ClusterID] = &clientcmdapi.AuthInfo{Token: token.Status.Token}
+	kubeConfig.Contexts[req.ClusterID] = &clientcmdapi.Context{
+		Cluster:   req.ClusterID,
+		AuthInfo:  req.ClusterID,
+		Namespace: req.Namespace,
+	}
+	kubeConfig.CurrentContext = req.ClusterID
+
+	data, err := clientcmd.Write(*kubeConfig)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return data, token.Status.ExpirationTimestamp.Time, nil
+}
+
+// setup creates the roles, service account and binding of a scope, unless
+// recently done for that cluster. The namespaces are bound to the role of the
+// applications and the whole cluster to the read-only role.
+func (i *Issuer) setup(ctx context.Context, cs kubernetes.Interface, server, namespace string) error {
+	key := server + "/" + namespace
+	i.mu.Lock()
+	readyTime, ok := i.ready[key]
+	i.mu.Unlock()
+	if ok && time.Since(readyTime) < readyInterval {
+		return nil
+	}
+
+	if err := ensureClusterRole(ctx, cs, Name, Rules); err != nil {
+		return err
+	}
+	if err := ensureClusterRole(ctx, cs, ReadOnlyName, ReadOnlyRules); err != nil {
+		return err
+	}
+	// Earlier versions bound the role of the namespaces to the whole cluster
+	err := cs.RbacV1().ClusterRoleBindings().Delete(ctx, Name, metav1.DeleteOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+
+	saNamespace := namespace
+	if saNamespace == "" {
+		saNamespace = ClusterScopeNamespace
+	}
+	_, err = cs.CoreV1().ServiceAccounts(saNamespace).Create(ctx, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: Name, Namespace: saNamespace, Labels: managedLabels},
+	}, metav1.CreateOptions{})
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	subjects := []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: Name, Namespace: saNamespace}}
+	if namespace == "" {
+		_, err = cs.RbacV1().ClusterRoleBindings().Create(ctx, &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: ReadOnlyName, Labels: managedLabels},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: ReadOnlyName},
+			Subjects:   subjects,
+		}, metav1.CreateOptions{})
+	} else {
+		_, err = cs.RbacV1().RoleBindings(namespace).Create(ctx, &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: Name, Namespace: namespace, Labels: managedLabels},
+			RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: Name},
+			Subjects:   subjects,
+		}, metav1.CreateOptions{})
+	}
+	if err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	i.mu.Lock()
+	i.ready[key] = time.Now()
+	i.mu.Unlock()
+	return nil
+}
+
+// ensureClusterRole creates a role of the kubeconfigs or updates its rules
+func ensureClusterRole(ctx context.Context, cs kubernetes.Interface, name string, rules []rbacv1.PolicyRule) error {
+	role, err := cs.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = cs.RbacV1().ClusterRoles().Create(ctx, &rbacv1.ClusterRole{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: managedLabels},
+			Rules:      rules,
+		}, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(role.Rules, rules) {
+		return nil
+	}
+	role.Rules = rules
+	_, err = cs.RbacV1().ClusterRoles().Update(ctx, role, metav1.UpdateOptions{})
+	return err
+}
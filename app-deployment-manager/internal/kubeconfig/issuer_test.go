@@ -0,0 +1,242 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package kubeconfig
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const testServer = "https://edge.example.com:6443"
+
+func adminKubeConfig(t *testing.T) []byte {
+	config := clientcmdapi.NewConfig()
+	config.Clusters["edge"] = &clientcmdapi.Cluster{Server: testServer, CertificateAuthorityData: []byte("ca")}
+	config.AuthInfos["admin"] = &clientcmdapi.AuthInfo{ClientCertificateData: []byte("cert"), ClientKeyData: []byte("key")}
+	config.Contexts["admin@edge"] = &clientcmdapi.Context{Cluster: "edge", AuthInfo: "admin"}
+	config.CurrentContext = "admin@edge"
+	data, err := clientcmd.Write(*config)
+	require.NoError(t, err)
+	return data
+}
+
+// newTestIssuer returns an issuer using a fake clientset issuing tokens and
+// recording their requested lifetime
+func newTestIssuer(objects ...runtime.Object) (*Issuer, *fake.Clientset, *int64) {
+	cs := fake.NewClientset(objects...)
+	var ttl int64
+	cs.PrependReactor("create", "serviceaccounts", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		if action.GetSubresource() != "token" {
+			return false, nil, nil
+		}
+		req := action.(k8stesting.CreateAction).GetObject().(*authenticationv1.TokenRequest)
+		ttl = *req.Spec.ExpirationSeconds
+		req.Status = authenticationv1.TokenRequestStatus{
+			Token:               "token-" + action.GetNamespace(),
+			ExpirationTimestamp: metav1.NewTime(time.Now().Add(time.Duration(ttl) * time.Second)),
+		}
+		return true, req, nil
+	})
+
+	i := NewIssuer()
+	i.newClientset = func(_ *rest.Config) (kubernetes.Interface, error) {
+		return cs, nil
+	}
+	return i, cs, &ttl
+}
+
+func TestIssueClusterScope(t *testing.T) {
+	ctx := context.Background()
+	i, cs, ttl := newTestIssuer()
+
+	data, expiration, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1", TTL: time.Hour})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3600), *ttl)
+	assert.WithinDuration(t, time.Now().Add(time.Hour), expiration, time.Minute)
+
+	config, err := clientcmd.Load(data)
+	require.NoError(t, err)
+	assert.Equal(t, "cluster-1", config.CurrentContext)
+	assert.Equal(t, testServer, config.Clusters["cluster-1"].Server)
+	assert.Equal(t, []byte("ca"), config.Clusters["cluster-1"].CertificateAuthorityData)
+	assert.Equal(t, "token-kube-system", config.AuthInfos["cluster-1"].Token)
+	assert.Empty(t, config.AuthInfos["cluster-1"].ClientKeyData)
+	assert.Empty(t, config.Contexts["cluster-1"].Namespace)
+
+	role, err := cs.RbacV1().ClusterRoles().Get(ctx, ReadOnlyName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ReadOnlyRules, role.Rules)
+	_, err = cs.CoreV1().ServiceAccounts(ClusterScopeNamespace).Get(ctx, Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	binding, err := cs.RbacV1().ClusterRoleBindings().Get(ctx, ReadOnlyName, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, ReadOnlyName, binding.RoleRef.Name)
+	assert.Equal(t, ClusterScopeNamespace, binding.Subjects[0].Namespace)
+}
+
+func TestIssueRemovesClusterBindingOfApplicationRole(t *testing.T) {
+	ctx := context.Background()
+	i, cs, _ := newTestIssuer(&rbacv1.ClusterRoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: Name},
+		RoleRef:    rbacv1.RoleRef{APIGroup: rbacv1.GroupName, Kind: "ClusterRole", Name: Name},
+		Subjects:   []rbacv1.Subject{{Kind: rbacv1.ServiceAccountKind, Name: Name, Namespace: ClusterScopeNamespace}},
+	})
+
+	_, _, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1"})
+	require.NoError(t, err)
+
+	bindings, err := cs.RbacV1().ClusterRoleBindings().List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, bindings.Items, 1)
+	assert.Equal(t, ReadOnlyName, bindings.Items[0].RoleRef.Name)
+}
+
+// allows returns true if the rules grant the verb on the resource
+func allows(rules []rbacv1.PolicyRule, verb string, group string, resource string) bool {
+	matches := func(values []string, value string) bool {
+		return slices.Contains(values, value) || slices.Contains(values, rbacv1.ResourceAll)
+	}
+	for _, rule := range rules {
+		if matches(rule.Verbs, verb) && matches(rule.APIGroups, group) && matches(rule.Resources, resource) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestIssuedRolesDenyTokensAndSecrets(t *testing.T) {
+	ctx := context.Background()
+	i, cs, _ := newTestIssuer()
+	_, _, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1"})
+	require.NoError(t, err)
+	_, _, err = i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1", Namespace: "wordpress"})
+	require.NoError(t, err)
+
+	issued := map[string][]rbacv1.PolicyRule{}
+	for _, name := range []string{Name, ReadOnlyName} {
+		role, err := cs.RbacV1().ClusterRoles().Get(ctx, name, metav1.GetOptions{})
+		require.NoError(t, err)
+		issued[name] = role.Rules
+	}
+
+	for name, rules := range issued {
+		assert.False(t, allows(rules, "create", "", "serviceaccounts/token"), name)
+		for _, verb := range []string{"get", "list", "watch"} {
+			assert.False(t, allows(rules, verb, "", "secrets"), name)
+		}
+		for _, verb := range []string{"create", "update", "patch", "delete", "impersonate", "bind", "escalate"} {
+			assert.False(t, allows(rules, verb, rbacv1.GroupName, "clusterroles"), name)
+			assert.False(t, allows(rules, verb, rbacv1.GroupName, "rolebindings"), name)
+		}
+	}
+
+	// The role bound in a namespace cannot read the other namespaces
+	assert.False(t, allows(issued[Name], "list", "", "namespaces"))
+	assert.True(t, allows(issued[Name], "delete", "", "pods"))
+	assert.True(t, allows(issued[Name], "update", "subresources.kubevirt.io", "virtualmachines/start"))
+
+	for _, rule := range issued[ReadOnlyName] {
+		assert.Subset(t, []string{"get", "list", "watch"}, rule.Verbs)
+	}
+	assert.True(t, allows(issued[ReadOnlyName], "list", "", "namespaces"))
+}
+
+// The resource manager requests kubeconfigs scoped to the namespace of the
+// applications to list, delete and restart their pods and to run their VMs
+func TestNamespaceRoleAllowsResourceManager(t *testing.T) {
+	ctx := context.Background()
+	i, cs, _ := newTestIssuer()
+	_, _, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1", Namespace: "wordpress"})
+	require.NoError(t, err)
+
+	role, err := cs.RbacV1().ClusterRoles().Get(ctx, Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	for _, access := range []struct {
+		verb     string
+		group    string
+		resource string
+	}{
+		{"list", "", "pods"},
+		{"delete", "", "pods"},
+		{"list", "", "services"},
+		{"list", "", "endpoints"},
+		{"list", "networking.k8s.io", "ingresses"},
+		{"get", "apps", "replicasets"},
+		{"get", "apps", "deployments"},
+		{"get", "apps", "statefulsets"},
+		{"get", "apps", "daemonsets"},
+		{"get", "sriovnetwork.openshift.io", "sriovoperatorconfigs"},
+		{"list", "kubevirt.io", "virtualmachines"},
+		{"update", "subresources.kubevirt.io", "virtualmachines/start"},
+		{"update", "subresources.kubevirt.io", "virtualmachines/stop"},
+		{"update", "subresources.kubevirt.io", "virtualmachines/restart"},
+		{"get", "subresources.kubevirt.io", "virtualmachineinstances/vnc"},
+	} {
+		assert.True(t, allows(role.Rules, access.verb, access.group, access.resource), "%s %s/%s", access.verb, access.group, access.resource)
+	}
+}
+
+func TestIssueNamespaceScope(t *testing.T) {
+	ctx := context.Background()
+	i, cs, ttl := newTestIssuer()
+
+	data, _, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1", Namespace: "wordpress", TTL: time.Minute})
+	require.NoError(t, err)
+	assert.Equal(t, int64(MinTTL.Seconds()), *ttl)
+
+	config, err := clientcmd.Load(data)
+	require.NoError(t, err)
+	assert.Equal(t, "token-wordpress", config.AuthInfos["cluster-1"].Token)
+	assert.Equal(t, "wordpress", config.Contexts["cluster-1"].Namespace)
+
+	binding, err := cs.RbacV1().RoleBindings("wordpress").Get(ctx, Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "ClusterRole", binding.RoleRef.Kind)
+	assert.Equal(t, Name, binding.RoleRef.Name)
+	assert.Equal(t, "wordpress", binding.Subjects[0].Namespace)
+	_, err = cs.RbacV1().ClusterRoleBindings().Get(ctx, Name, metav1.GetOptions{})
+	assert.Error(t, err)
+}
+
+func TestIssueUpdatesRole(t *testing.T) {
+	ctx := context.Background()
+	i, cs, _ := newTestIssuer(&rbacv1.ClusterRole{
+		ObjectMeta: metav1.ObjectMeta{Name: Name},
+		Rules:      []rbacv1.PolicyRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+	})
+
+	_, _, err := i.Issue(ctx, adminKubeConfig(t), Request{ClusterID: "cluster-1", Namespace: "wordpress"})
+	require.NoError(t, err)
+
+	role, err := cs.RbacV1().ClusterRoles().Get(ctx, Name, metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, Rules, role.Rules)
+}
+
+func TestIssueInvalidKubeConfig(t *testing.T) {
+	i, _, _ := newTestIssuer()
+
+	_, _, err := i.Issue(context.Background(), []byte("not a kubeconfig"), Request{ClusterID: "cluster-1"})
+	assert.Error(t, err)
+
+	_, _, err = i.Issue(context.Background(), []byte("apiVersion: v1\nkind: Config\n"), Request{ClusterID: "cluster-1"})
+	assert.ErrorContains(t, err, "no current context")
+}
@@ -12,13 +12,17 @@ import (
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/k8sclient"
 	"github.com/open-edge-platform/orch-library/go/pkg/auth"
 	"k8s.io/client-go/tools/clientcmd"
+	"net/http"
 	"os"
 	"strconv"
+	"time"
 
 	"github.com/open-edge-platform/orch-library/go/dazl"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
 
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
 	internalgrpc "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound"
 	utils "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
 	"github.com/open-edge-platform/orch-library/go/pkg/northbound"
@@ -39,11 +43,12 @@ const (
 
 // Config is a manager configuration
 type Config struct {
-	CAPath     string
-	KeyPath    string
-	CertPath   string
-	GRPCPort   int16
-	Kubeconfig string
+	CAPath      string
+	KeyPath     string
+	CertPath    string
+	GRPCPort    int16
+	MetricsPort int
+	Kubeconfig  string
 }
 
 type Manager struct {
@@ -179,18 +184,31 @@ func (m *Manager) Start() error {
 		return err
 	}
 
+	// Per-project and per-user API rate limiter
+	rateLimiter, err := internalgrpc.NewRateLimiter()
+	if err != nil {
+		log.Fatalf("Failed to create API rate limiter %v", err)
+		return err
+	}
+
+	if m.Config.MetricsPort != 0 {
+		go m.serveMetrics()
+	}
+
 	doneCh := make(chan error)
 	go func() {
 		err := s.Serve(func(_ string) {
 			close(doneCh)
-		}, grpc.MaxRecvMsgSize(int(msgSizeLimitBytes)))
+		}, grpc.MaxRecvMsgSize(int(msgSizeLimitBytes)),
+			grpc.ChainUnaryInterceptor(rateLimiter.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(rateLimiter.StreamServerInterceptor()))
 		if err != nil {
 			doneCh <- err
 		}
 	}()
 
 	// Tenant event handler
-	nexusHook := tenant.NewNexusHook(crClient)
+	nexusHook := tenant.NewNexusHook()
 	err = nexusHook.Subscribe()
 	if err != nil {
 		log.Fatalf("Failed to subscribe to tenant events %v", err)
@@ -200,3 +218,18 @@ func (m *Manager) Start() error {
 
 	return <-doneCh
 }
+
+// serveMetrics serves the API metrics, such as the throttled requests
+func (m *Manager) serveMetrics() {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(metrics.Reg, promhttp.HandlerOpts{}))
+	server := &http.Server{
+		Addr:              fmt.Sprintf(":%d", m.Config.MetricsPort),
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	log.Infof("Serving metrics on port %d", m.Config.MetricsPort)
+	if err := server.ListenAndServe(); err != nil {
+		log.Errorf("Failed to serve metrics %v", err)
+	}
+}
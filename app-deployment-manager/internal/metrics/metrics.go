@@ -25,9 +25,39 @@ var (
 		Name: "adm_deployment_cluster_status",
 		Help: "Per-deployment per-cluster status",
 	}, []string{"projectId", "deployment_id", "deployment_name", "cluster_id", "cluster_name", "status"})
+
+	// DeploymentClusterDrift is a prometheus metric which holds the number of resources
+	// of a ADM per-deployment per-cluster that drifted from the desired state, per drift type.
+	DeploymentClusterDrift = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "adm_deployment_cluster_drift",
+		Help: "Per-deployment per-cluster number of drifted resources",
+	}, []string{"projectId", "deployment_id", "deployment_name", "cluster_id", "cluster_name", "type"})
+
+	// ReconcileQueueDepth is a prometheus metric which holds the number of reconcile
+	// requests waiting in the queue of a controller, per priority class.
+	ReconcileQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "adm_reconcile_queue_depth",
+		Help: "Per-controller per-priority number of queued reconcile requests",
+	}, []string{"controller", "priority"})
+
+	// ReconcileQueueLatency is a prometheus metric which holds how long reconcile
+	// requests waited in the queue of a controller before being processed, per priority class.
+	ReconcileQueueLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "adm_reconcile_queue_latency_seconds",
+		Help:    "Per-controller per-priority time reconcile requests wait in the queue",
+		Buckets: prometheus.ExponentialBuckets(0.01, 4, 10),
+	}, []string{"controller", "priority"})
+
+	// APIRequestsThrottled is a prometheus metric which holds the number of API
+	// requests rejected by the rate limiter, per RPC class and exhausted limit.
+	APIRequestsThrottled = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "adm_api_requests_throttled_total",
+		Help: "Per-project per-class number of API requests rejected by the rate limiter",
+	}, []string{"projectId", "class", "limit"})
 )
 
 func init() {
 	// Register custom metrics with prometheus registry
-	Reg.MustRegister(DeploymentStatus, DeploymentClusterStatus)
+	Reg.MustRegister(DeploymentStatus, DeploymentClusterStatus, DeploymentClusterDrift,
+		ReconcileQueueDepth, ReconcileQueueLatency, APIRequestsThrottled)
 }
@@ -8,7 +8,11 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
+	"sort"
+	"strings"
 
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
 	"github.com/open-edge-platform/orch-library/go/pkg/errors"
 	"k8s.io/apimachinery/pkg/labels"
@@ -41,6 +45,98 @@ func getTargetDeploymentsForDeleteDeployment(rootName string, targetList map[str
 	return nil
 }
 
+// collectDependencyGraph adds the root deployment and all deployments reachable from it to the target
+// list, following either the required deployments or the deployments requiring them.
+func collectDependencyGraph(rootName string, targetList map[string]*deploymentv1beta1.Deployment, allDeployments map[string]*deploymentv1beta1.Deployment, required bool, currentDepth int) error {
+	if currentDepth > maxDepth {
+		return fmt.Errorf("maximum recursion depth reached")
+	}
+
+	depl, ok := allDeployments[rootName]
+	if !ok {
+		return nil
+	}
+	if _, ok := targetList[rootName]; ok {
+		return nil
+	}
+	targetList[rootName] = depl
+
+	next := depl.Status.ParentDeploymentList
+	if required {
+		next = depl.Spec.ChildDeploymentList
+	}
+	for k := range next {
+		err := collectDependencyGraph(k, targetList, allDeployments, required, currentDepth+1)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getDependencyGraph returns the deployments required by the root deployment and the deployments
+// requiring it, transitively, with the dependencies between them.
+func getDependencyGraph(rootName string, allDeployments map[string]*deploymentv1beta1.Deployment) ([]*deploymentpb.DependencyNode, []*deploymentpb.DependencyEdge, error) {
+	requiredList := make(map[string]*deploymentv1beta1.Deployment)
+	if err := collectDependencyGraph(rootName, requiredList, allDeployments, true, 0); err != nil {
+		return nil, nil, err
+	}
+	dependentList := make(map[string]*deploymentv1beta1.Deployment)
+	if err := collectDependencyGraph(rootName, dependentList, allDeployments, false, 0); err != nil {
+		return nil, nil, err
+	}
+	for k, v := range dependentList {
+		requiredList[k] = v
+	}
+
+	names := make([]string, 0, len(requiredList))
+	for k := range requiredList {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	nodes := make([]*deploymentpb.DependencyNode, 0, len(names))
+	edges := make([]*deploymentpb.DependencyEdge, 0)
+	for _, name := range names {
+		depl := requiredList[name]
+		nodes = append(nodes, &deploymentpb.DependencyNode{
+			DeployId:    string(depl.ObjectMeta.UID),
+			Name:        depl.Name,
+			DisplayName: depl.Spec.DisplayName,
+			AppName:     depl.Spec.DeploymentPackageRef.Name,
+			AppVersion:  depl.Spec.DeploymentPackageRef.Version,
+			ProfileName: depl.Spec.DeploymentPackageRef.ProfileName,
+			Status: &deploymentpb.Deployment_Status{
+				State:   deploymentState(string(depl.Status.State)),
+				Message: depl.Status.Message,
+				Summary: &deploymentpb.Summary{
+					Total:   utils.ToInt32Clamped(depl.Status.Summary.Total),
+					Running: utils.ToInt32Clamped(depl.Status.Summary.Running),
+					Down:    utils.ToInt32Clamped(depl.Status.Summary.Down),
+					Unknown: utils.ToInt32Clamped(depl.Status.Summary.Unknown),
+					Type:    string(deploymentv1beta1.ClusterCounts),
+				},
+			},
+		})
+
+		children := make([]string, 0, len(depl.Spec.ChildDeploymentList))
+		for k := range depl.Spec.ChildDeploymentList {
+			if _, ok := requiredList[k]; ok {
+				children = append(children, k)
+			}
+		}
+		sort.Strings(children)
+		for _, child := range children {
+			edges = append(edges, &deploymentpb.DependencyEdge{
+				From: string(depl.ObjectMeta.UID),
+				To:   string(requiredList[child].ObjectMeta.UID),
+			})
+		}
+	}
+
+	return nodes, edges, nil
+}
+
 func validateTargetDeployments(targetList map[string]*deploymentv1beta1.Deployment) bool {
 	for _, v := range targetList {
 		for k := range v.Status.ParentDeploymentList {
@@ -133,6 +229,22 @@ func addTargetClusterEntry(newTargetClusters []*deploymentpb.TargetClusters, dep
 	}
 }
 
+type dependencyPathKey struct{}
+
+// withDependencyPath returns a context holding the deployment packages traversed from the root deployment
+// to the given deployment package. An error with the cyclic path is returned if the deployment package
+// was already traversed.
+func withDependencyPath(ctx context.Context, dpID string) (context.Context, error) {
+	path, _ := ctx.Value(dependencyPathKey{}).([]string)
+	if slices.Contains(path, dpID) {
+		return ctx, errors.NewInvalid("dependency cycle detected: %s", strings.Join(append(slices.Clone(path), dpID), " -> "))
+	}
+	if len(path) > maxDepth {
+		return ctx, errors.NewInvalid("maximum dependency depth %d exceeded: %s", maxDepth, strings.Join(path, " -> "))
+	}
+	return context.WithValue(ctx, dependencyPathKey{}, append(slices.Clone(path), dpID)), nil
+}
+
 // Add dependent deployments and their relationships
 func addDepAndRelationships(ctx context.Context, d *Deployment, s *DeploymentSvc, in *deploymentpb.Deployment, dependentDepls map[string]*Deployment, activeProjectID string) (*Deployment, error) {
 	// initialize dependent lists
@@ -142,10 +254,20 @@ func addDepAndRelationships(ctx context.Context, d *Deployment, s *DeploymentSvc
 	helmApps := d.HelmApps
 	activeProjectIDKey := string(deploymentv1beta1.AppOrchActiveProjectID)
 
+	// reject cyclic references among the required deployment packages
+	ctx, err := withDependencyPath(ctx, catalogclient.GetDeploymentPackageID(d.AppName, d.AppVersion, d.ProfileName))
+	if err != nil {
+		return d, err
+	}
+
 	// add dependent deployments and their relationships
 	if helmApps != nil {
 		for thisAppIdx := range *helmApps {
 			for _, dep := range (*helmApps)[thisAppIdx].RequiredDeploymentPackages {
+				if _, err := withDependencyPath(ctx, catalogclient.GetDeploymentPackageID(dep.Name, dep.Version, dep.Profile)); err != nil {
+					return d, err
+				}
+
 				depDP, depHelmApps, _, err := catalogclient.CatalogLookupDPAndHelmApps(ctx, s.catalogClient, dep.Name, dep.Version, dep.Profile)
 				if err != nil {
 					log.Warnf("failed to lookup dependent deployment package %+v", dep)
@@ -166,9 +288,10 @@ func addDepAndRelationships(ctx context.Context, d *Deployment, s *DeploymentSvc
 				if depHelmApps != nil {
 					for _, depApp := range *depHelmApps {
 						depTargetClusters = append(depTargetClusters, &deploymentpb.TargetClusters{
-							AppName:   depApp.Name,
-							Labels:    in.TargetClusters[0].Labels,
-							ClusterId: in.TargetClusters[0].ClusterId,
+							AppName:      depApp.Name,
+							Labels:       in.TargetClusters[0].Labels,
+							ClusterId:    in.TargetClusters[0].ClusterId,
+							ClusterGroup: in.TargetClusters[0].ClusterGroup,
 						})
 					}
 				}
@@ -194,9 +317,7 @@ func addDepAndRelationships(ctx context.Context, d *Deployment, s *DeploymentSvc
 					depDeployment = tmpDepDepl
 				} else {
 					// case 1, 3, 4
-					// recursive call - it will traverse to the leaf deployments
-					// todo: it would be good to add validation step to check if there is a cyclic reference among dependents
-					// todo: the above one could be low priority since catalog already checks it when loading app and deployment packages
+					// recursive call - it will traverse to the leaf deployments, cyclic references are rejected above
 					depDeployment, err = initDeployment(ctx, s, "create", &deploymentpb.Deployment{
 						AppName:        dep.Name,
 						AppVersion:     dep.Version,
@@ -204,8 +325,14 @@ func addDepAndRelationships(ctx context.Context, d *Deployment, s *DeploymentSvc
 						OverrideValues: make([]*deploymentpb.OverrideValues, 0),
 						TargetClusters: depTargetClusters,
 						DeploymentType: in.DeploymentType,
+						DriftPolicy:    in.DriftPolicy,
+						Priority:       in.Priority,
 					}, dependentDepls, activeProjectID)
 					if err != nil {
+						// keep a cycle in the dependency path as a validation error of the root deployment
+						if typedErr := errors.FromGRPC(err); errors.IsInvalid(typedErr) {
+							return d, typedErr
+						}
 						return d, errors.NewConflict("failed to create child deployment: %v", err)
 					}
 					// add childDeployment to dependentDepls map
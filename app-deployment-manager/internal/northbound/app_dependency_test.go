@@ -5,8 +5,11 @@
 package northbound
 
 import (
+	"context"
+
 	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
 	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -106,4 +109,48 @@ var _ = Describe("App Dependency", func() {
 			Expect(out[string(deploymentv1beta1.ClusterName)]).To(Equal("123456"))
 		})
 	})
+
+	Describe("withDependencyPath", func() {
+		It("successfully appends deployment packages to the dependency path", func() {
+			ctx, err := withDependencyPath(context.Background(), "app-0.1.0-default")
+			Expect(err).ToNot(HaveOccurred())
+
+			ctx, err = withDependencyPath(ctx, "platform-0.1.0-default")
+			Expect(err).ToNot(HaveOccurred())
+
+			path, _ := ctx.Value(dependencyPathKey{}).([]string)
+			Expect(path).To(Equal([]string{"app-0.1.0-default", "platform-0.1.0-default"}))
+		})
+
+		It("fails due to a dependency cycle", func() {
+			ctx, err := withDependencyPath(context.Background(), "app-0.1.0-default")
+			Expect(err).ToNot(HaveOccurred())
+
+			ctx, err = withDependencyPath(ctx, "platform-0.1.0-default")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = withDependencyPath(ctx, "app-0.1.0-default")
+			Expect(err).To(HaveOccurred())
+			Expect(errors.IsInvalid(err)).To(BeTrue())
+			Expect(err.Error()).To(Equal("dependency cycle detected: app-0.1.0-default -> platform-0.1.0-default -> app-0.1.0-default"))
+		})
+	})
+
+	Describe("getDependencyGraph", func() {
+		It("successfully returns a graph with a dependency cycle in the deployments", func() {
+			allDeploymentMap := map[string]*deploymentv1beta1.Deployment{}
+			for _, name := range []string{"a", "b"} {
+				d := &deploymentv1beta1.Deployment{}
+				d.Name = name
+				allDeploymentMap[name] = d
+			}
+			allDeploymentMap["a"].Spec.ChildDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{"b": {}}
+			allDeploymentMap["b"].Spec.ChildDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{"a": {}}
+
+			nodes, edges, err := getDependencyGraph("a", allDeploymentMap)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(nodes)).To(Equal(2))
+			Expect(len(edges)).To(Equal(2))
+		})
+	})
 })
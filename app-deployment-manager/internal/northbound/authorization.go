@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 
 	"reflect"
+	"slices"
 	"strings"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/errors"
@@ -39,6 +40,28 @@ func (s *DeploymentSvc) GetActiveProjectID(ctx context.Context) (string, error)
 	return activeProjectIDs[0], nil
 }
 
+// serviceRole is the role of the orchestrator services calling the API
+const serviceRole = "ao-m2m-rw"
+
+// isServiceCaller returns true if the caller is an orchestrator service, all
+// callers being trusted when the authorization is disabled
+func (s *DeploymentSvc) isServiceCaller(ctx context.Context) bool {
+	if s.opaClient == nil {
+		return true
+	}
+	return hasServiceRole(ctx)
+}
+
+// hasServiceRole returns true if the token of the caller has the role of the
+// orchestrator services
+func hasServiceRole(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	return slices.Contains(md.Get("realm_access/roles"), serviceRole)
+}
+
 func (s *DeploymentSvc) AuthCheckAllowed(ctx context.Context, request any) error {
 	if s.opaClient == nil {
 		return nil
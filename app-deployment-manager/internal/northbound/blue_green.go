@@ -0,0 +1,112 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// labelTargeted returns true if the clusters of the deployment type are
+// selected by labels, i.e. auto-scaling and blue-green deployments.
+func labelTargeted(deploymentType string) bool {
+	return deploymentType == string(deploymentv1beta1.AutoScaling) ||
+		deploymentType == string(deploymentv1beta1.BlueGreen)
+}
+
+// validateBlueGreenServices checks that blue-green services are only set on
+// blue-green deployments, refer to an app of the deployment package and that
+// service names are unique per app.
+func validateBlueGreenServices(d *Deployment) error {
+	if len(d.BlueGreenServices) == 0 {
+		return nil
+	}
+	if d.DeploymentType != string(deploymentv1beta1.BlueGreen) {
+		return errors.NewInvalid("blueGreenServices requires deployment type %s", deploymentv1beta1.BlueGreen)
+	}
+
+	apps := make(map[string]map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = make(map[string]bool)
+		}
+	}
+
+	for _, svc := range d.BlueGreenServices {
+		names, ok := apps[svc.AppName]
+		if !ok {
+			return errors.NewInvalid("blueGreenServices.appName %s not found in deployment package %s", svc.AppName, d.AppName)
+		}
+		if names[svc.Name] {
+			return errors.NewInvalid("duplicate blueGreenServices.name %s for app %s", svc.Name, svc.AppName)
+		}
+		names[svc.Name] = true
+	}
+	return nil
+}
+
+// blueGreenServices returns the blue-green services of the given app for the Deployment CR.
+func blueGreenServices(services []*deploymentpb.BlueGreenService, appName string) []deploymentv1beta1.BlueGreenService {
+	var list []deploymentv1beta1.BlueGreenService
+	for _, svc := range services {
+		if svc.GetAppName() != appName {
+			continue
+		}
+
+		s := deploymentv1beta1.BlueGreenService{
+			Name:     svc.GetName(),
+			Selector: svc.GetSelector(),
+		}
+		for _, p := range svc.GetPorts() {
+			s.Ports = append(s.Ports, deploymentv1beta1.BlueGreenServicePort{
+				Name:       p.GetName(),
+				Port:       p.GetPort(),
+				TargetPort: p.GetTargetPort(),
+				Protocol:   p.GetProtocol(),
+			})
+		}
+		list = append(list, s)
+	}
+	return list
+}
+
+// createBlueGreenServices returns the blue-green services of all apps of the Deployment CR.
+func createBlueGreenServices(apps []deploymentv1beta1.Application) []*deploymentpb.BlueGreenService {
+	var list []*deploymentpb.BlueGreenService
+	for _, app := range apps {
+		for _, s := range app.BlueGreenServices {
+			svc := &deploymentpb.BlueGreenService{
+				AppName:  app.Name,
+				Name:     s.Name,
+				Selector: s.Selector,
+			}
+			for _, p := range s.Ports {
+				svc.Ports = append(svc.Ports, &deploymentpb.BlueGreenServicePort{
+					Name:       p.Name,
+					Port:       p.Port,
+					TargetPort: p.TargetPort,
+					Protocol:   p.Protocol,
+				})
+			}
+			list = append(list, svc)
+		}
+	}
+	return list
+}
+
+// createBlueGreenStatus returns the blue-green rollout status, or nil if the
+// deployment is not blue-green.
+func createBlueGreenStatus(bg *deploymentv1beta1.BlueGreenStatus) *deploymentpb.BlueGreenStatus {
+	if bg == nil {
+		return nil
+	}
+	return &deploymentpb.BlueGreenStatus{
+		ActiveSlot:  string(bg.ActiveSlot),
+		PreviewSlot: string(bg.PreviewSlot),
+		Phase:       string(bg.Phase),
+	}
+}
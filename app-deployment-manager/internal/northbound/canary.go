@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+// validateCanary checks that the canary stage is not set on blue-green
+// deployments, which have their own rollout stages.
+func validateCanary(d *Deployment) error {
+	if d.Canary != nil && d.DeploymentType == string(deploymentv1beta1.BlueGreen) {
+		return errors.NewInvalid("canary is not supported with deployment type %s", deploymentv1beta1.BlueGreen)
+	}
+	return nil
+}
+
+// canarySpec returns the canary stage of the Deployment CR, nil if not set.
+func canarySpec(c *deploymentpb.Canary) *deploymentv1beta1.CanarySpec {
+	if c == nil {
+		return nil
+	}
+	return &deploymentv1beta1.CanarySpec{
+		ClusterCount:       c.GetClusterCount(),
+		Selector:           c.GetSelector(),
+		ObservationSeconds: c.GetObservationSeconds(),
+	}
+}
+
+// createCanary returns the canary stage of the deployment, nil if not set.
+func createCanary(c *deploymentv1beta1.CanarySpec) *deploymentpb.Canary {
+	if c == nil {
+		return nil
+	}
+	return &deploymentpb.Canary{
+		ClusterCount:       c.ClusterCount,
+		Selector:           c.Selector,
+		ObservationSeconds: c.ObservationSeconds,
+	}
+}
+
+// createCanaryStatus returns the canary stage status, nil if the deployment
+// had no canary stage.
+func createCanaryStatus(c *deploymentv1beta1.CanaryStatus) *deploymentpb.CanaryStatus {
+	if c == nil {
+		return nil
+	}
+	return &deploymentpb.CanaryStatus{
+		Phase:    string(c.Phase),
+		Clusters: c.Clusters,
+		Message:  c.Message,
+	}
+}
+
+// requestCanaryAction annotates the Deployment CR with the requested canary
+// action, which the deployment controller carries out. It fails if no canary
+// stage is in progress.
+func (s *DeploymentSvc) requestCanaryAction(ctx context.Context, UID string, action string) (*deploymentv1beta1.Deployment, error) {
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		return nil, errors.NewUnavailable("failed to get tenant project ID %s", err.Error())
+	}
+
+	// The canary action is serialized with the other mutations of the project
+	defer s.projectLocks.lock(activeProjectID)()
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: labels.Set{string(deploymentv1beta1.AppOrchActiveProjectID): activeProjectID}.String(),
+	}
+
+	deployment, err := matchUIDDeploymentFn(ctx, UID, activeProjectID, s, listOpts)
+	if err != nil {
+		return nil, err
+	} else if deployment.ObjectMeta.Name == "" {
+		return nil, errors.NewNotFound("deployment id %v not found", UID)
+	}
+
+	c := deployment.Status.Canary
+	if c == nil || (c.Phase != deploymentv1beta1.CanaryDeploying && c.Phase != deploymentv1beta1.CanaryObserving) {
+		return nil, errors.NewAborted("cannot %s deployment %v - no canary stage in progress", action, UID)
+	}
+
+	if deployment.Annotations == nil {
+		deployment.Annotations = map[string]string{}
+	}
+	deployment.Annotations[string(deploymentv1beta1.CanaryAction)] = action
+
+	deployment, err = s.crClient.Deployments(deployment.Namespace).Update(ctx, deployment.Name, deployment, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+	return deployment, nil
+}
@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+// validateResourceRequests checks that the resource requests are valid
+// Kubernetes quantities.
+func validateResourceRequests(d *Deployment) error {
+	if d.ResourceRequests == nil {
+		return nil
+	}
+	if _, err := parseResourceRequests(d.ResourceRequests); err != nil {
+		return errors.NewInvalid("invalid resourceRequests: %v", err)
+	}
+	return nil
+}
+
+func parseResourceRequests(r *deploymentpb.ResourceRequests) (corev1.ResourceList, error) {
+	list := corev1.ResourceList{}
+	for name, value := range map[corev1.ResourceName]string{
+		corev1.ResourceCPU:    r.GetCpu(),
+		corev1.ResourceMemory: r.GetMemory(),
+	} {
+		if value == "" {
+			continue
+		}
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		list[name] = q
+	}
+	return list, nil
+}
+
+// resourceRequestsSpec returns the resource requests of the Deployment CR, nil if not set.
+func resourceRequestsSpec(r *deploymentpb.ResourceRequests) *deploymentv1beta1.ResourceRequests {
+	if r == nil {
+		return nil
+	}
+	// Quantities were validated when the deployment was initialized
+	requests, _ := parseResourceRequests(r)
+	return &deploymentv1beta1.ResourceRequests{
+		Requests: requests,
+		Enforce:  r.GetEnforce(),
+	}
+}
+
+// createResourceRequests returns the resource requests of the deployment, nil if not set.
+func createResourceRequests(r *deploymentv1beta1.ResourceRequests) *deploymentpb.ResourceRequests {
+	if r == nil {
+		return nil
+	}
+	out := &deploymentpb.ResourceRequests{
+		Enforce: r.Enforce,
+	}
+	if q, ok := r.Requests[corev1.ResourceCPU]; ok {
+		out.Cpu = q.String()
+	}
+	if q, ok := r.Requests[corev1.ResourceMemory]; ok {
+		out.Memory = q.String()
+	}
+	return out
+}
+
+// checkCapacity returns the ids of the target clusters whose free capacity is
+// lower than the resource requests of the deployment. Clusters whose capacity
+// is not known yet are assumed to fit. It fails if the resource requests are
+// enforced and a target cluster lacks capacity.
+func (s *DeploymentSvc) checkCapacity(ctx context.Context, d *Deployment) ([]string, error) {
+	if d.ResourceRequests == nil {
+		return nil, nil
+	}
+	requests, err := parseResourceRequests(d.ResourceRequests)
+	if err != nil || len(requests) == 0 {
+		return nil, err
+	}
+
+	clusters, err := s.targetClusters(ctx, d)
+	if err != nil {
+		return nil, err
+	}
+
+	insufficient := []string{}
+	details := []string{}
+	for _, cluster := range clusters {
+		if cluster.Status.Capacity == nil {
+			continue
+		}
+		available := cluster.Status.Capacity.Available()
+		missing := []string{}
+		for name, requested := range requests {
+			if q, ok := available[name]; ok && q.Cmp(requested) < 0 {
+				missing = append(missing, fmt.Sprintf("%s %s of %s available", name, q.String(), requested.String()))
+			}
+		}
+		if len(missing) > 0 {
+			slices.Sort(missing)
+			insufficient = append(insufficient, cluster.Name)
+			details = append(details, fmt.Sprintf("%s (%s)", cluster.Name, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(insufficient) > 0 && d.ResourceRequests.GetEnforce() {
+		return nil, errors.NewConflict("insufficient capacity on target clusters: %s", strings.Join(details, "; "))
+	}
+	if len(insufficient) > 0 {
+		log.Warnf("deployment %s: insufficient capacity on target clusters: %s", d.DisplayName, strings.Join(details, "; "))
+	}
+	return insufficient, nil
+}
+
+// targetClusters returns the clusters of the project targeted by any app of
+// the deployment, sorted by name.
+func (s *DeploymentSvc) targetClusters(ctx context.Context, d *Deployment) ([]deploymentv1beta1.Cluster, error) {
+	found := map[string]deploymentv1beta1.Cluster{}
+	for _, tc := range d.TargetClusters {
+		if tc.GetClusterId() != "" {
+			cluster, err := s.crClient.Clusters(d.ActiveProjectID).Get(ctx, tc.GetClusterId(), metav1.GetOptions{})
+			if apierrors.IsNotFound(err) {
+				// The deployment is rolled out once the cluster is added
+				continue
+			} else if err != nil {
+				return nil, k8serrors.K8sToTypedError(err)
+			}
+			found[cluster.Name] = *cluster
+			continue
+		}
+		if tc.GetClusterGroup() != "" {
+			members, err := s.clusterGroupMembers(ctx, d.ActiveProjectID, tc.GetClusterGroup())
+			if err != nil {
+				return nil, err
+			}
+			for _, cluster := range members {
+				found[cluster.Name] = cluster
+			}
+			continue
+		}
+		if len(tc.GetLabels()) == 0 {
+			continue
+		}
+
+		clusters, err := s.crClient.Clusters(d.ActiveProjectID).List(ctx, metav1.ListOptions{
+			LabelSelector: labels.Set(tc.GetLabels()).String(),
+		})
+		if err != nil {
+			return nil, k8serrors.K8sToTypedError(err)
+		}
+		for _, cluster := range clusters.Items {
+			found[cluster.Name] = cluster
+		}
+	}
+
+	clusters := make([]deploymentv1beta1.Cluster, 0, len(found))
+	for _, name := range slices.Sorted(maps.Keys(found)) {
+		clusters = append(clusters, found[name])
+	}
+	return clusters, nil
+}
@@ -13,14 +13,26 @@ import (
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	"github.com/open-edge-platform/orch-library/go/pkg/errors"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 
 	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/kubeconfig"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
 )
 
+const (
+	// Maximum lifetime of the kubeConfig credentials issued to the orchestrator
+	// services and to the other callers
+	maxServiceKubeConfigTTL = time.Hour
+	maxUserKubeConfigTTL    = 30 * time.Minute
+)
+
+// issueKubeConfigFn issues the scoped kubeConfigs from the admin kubeConfig of the clusters
+var issueKubeConfigFn = kubeconfig.NewIssuer().Issue
+
 type ClusterInfo struct {
 	ID         string                 `yaml:"id"`
 	Labels     map[string]string      `yaml:"labels"`
@@ -45,7 +57,14 @@ func (s *DeploymentSvc) GetKubeConfig(ctx context.Context, in *deploymentpb.GetK
 		return nil, errors.Status(errors.NewForbidden("cannot get kubeConfig info")).Err()
 	}
 
-	log.Infow("Received GetKubeConfig Request", dazl.String("Cluster ID", in.ClusterId))
+	log.Infow("Received GetKubeConfig Request", dazl.String("Cluster ID", in.ClusterId), dazl.String("Namespace", in.Namespace))
+
+	// Only the orchestrator services get credentials scoped to the whole cluster
+	ttl, err := s.kubeConfigTTL(ctx, in)
+	if err != nil {
+		log.Warnf("cannot get kubeConfig info: %v", err)
+		return nil, errors.Status(err).Err()
+	}
 
 	namespace, err := s.GetActiveProjectID(ctx)
 	if err != nil {
@@ -66,8 +85,20 @@ func (s *DeploymentSvc) GetKubeConfig(ctx context.Context, in *deploymentpb.GetK
 		return nil, errors.Status(err).Err()
 	}
 
+	// The admin kubeConfig of the cluster only issues the scoped credentials
+	kubeConfig, expireTime, err := issueKubeConfigFn(ctx, kubeConfigValue.Data["value"], kubeconfig.Request{
+		ClusterID: in.ClusterId,
+		Namespace: in.Namespace,
+		TTL:       ttl,
+	})
+	if err != nil {
+		log.Warnf("cannot issue kubeConfig: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
 	kubeConfigInfo := &deploymentpb.KubeConfigInfo{
-		KubeConfig: kubeConfigValue.Data["value"],
+		KubeConfig: kubeConfig,
+		ExpireTime: timestamppb.New(expireTime),
 	}
 
 	utils.LogActivity(ctx, "get kubeConfig", "ADM")
@@ -77,6 +108,23 @@ func (s *DeploymentSvc) GetKubeConfig(ctx context.Context, in *deploymentpb.GetK
 	}, nil
 }
 
+// kubeConfigTTL returns the lifetime of the credentials of a kubeConfig. The
+// callers which are not orchestrator services must scope them to a namespace.
+func (s *DeploymentSvc) kubeConfigTTL(ctx context.Context, in *deploymentpb.GetKubeConfigRequest) (time.Duration, error) {
+	maxTTL := maxUserKubeConfigTTL
+	if s.isServiceCaller(ctx) {
+		maxTTL = maxServiceKubeConfigTTL
+	} else if in.Namespace == "" {
+		return 0, errors.NewInvalid("namespace is required to get a kubeConfig")
+	}
+
+	ttl := time.Duration(in.TtlSeconds) * time.Second
+	if ttl > maxTTL {
+		return 0, errors.NewInvalid("kubeConfig lifetime %v exceeds the maximum of %v", ttl, maxTTL)
+	}
+	return max(ttl, kubeconfig.MinTTL), nil
+}
+
 func (s *DeploymentSvc) ListClusters(ctx context.Context, in *deploymentpb.ListClustersRequest) (*deploymentpb.ListClustersResponse, error) {
 	if err := s.protoValidator.Validate(in); err != nil {
 		log.Warnf("%v", err)
@@ -114,19 +162,8 @@ func (s *DeploymentSvc) ListClusters(ctx context.Context, in *deploymentpb.ListC
 	}
 
 	clusterInfoList := make([]*deploymentpb.ClusterInfo, len(clusters.Items))
-	for index, cluster := range clusters.Items {
-		// Convert metav1.Time to protobuf time and return secs.
-		setPbTime := cluster.ObjectMeta.CreationTimestamp.ProtoTime()
-
-		// Return timestamp from the provided time.Time in unix.
-		createTimePbUnix := timestamppb.New(time.Unix(setPbTime.Seconds, 0))
-
-		clusterInfoList[index] = &deploymentpb.ClusterInfo{
-			Id:         cluster.Name,
-			Labels:     cluster.Labels,
-			CreateTime: createTimePbUnix,
-			Name:       cluster.Spec.DisplayName,
-		}
+	for index := range clusters.Items {
+		clusterInfoList[index] = clusterInfo(&clusters.Items[index])
 	}
 
 	totalNumClusters := len(clusterInfoList)
@@ -212,8 +249,41 @@ func (s *DeploymentSvc) GetCluster(ctx context.Context, in *deploymentpb.GetClus
 		clusterResponse.Apps = append(clusterResponse.Apps, tmpResp.Apps...)
 	}
 
+	// The health is only known once the cluster controller reconciled the cluster
+	cluster, err := s.crClient.Clusters(activeProjectID).Get(ctx, clusterID, metav1.GetOptions{})
+	if err == nil {
+		clusterResponse.Health = clusterHealth(cluster)
+	} else if !apierrors.IsNotFound(err) {
+		log.Warnf("cannot get cluster: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
 	utils.LogActivity(ctx, "get", "get Cluster", "cluster id "+clusterID)
 	return &deploymentpb.GetClusterResponse{
 		Cluster: clusterResponse,
 	}, nil
 }
+
+// clusterHealth returns the health of a cluster, nil until the cluster
+// controller reconciled the cluster
+func clusterHealth(cluster *deploymentv1beta1.Cluster) *deploymentpb.ClusterHealth {
+	if cluster.Status.State == "" {
+		return nil
+	}
+
+	health := &deploymentpb.ClusterHealth{
+		State: string(cluster.Status.State),
+	}
+	if lastSeen := cluster.Status.FleetStatus.FleetAgentStatus.LastSeen; !lastSeen.IsZero() {
+		health.LastSeen = timestamppb.New(lastSeen.Time)
+		health.LastSeenAgeSeconds = utils.ToInt32Clamped(int(time.Since(lastSeen.Time).Seconds()))
+	}
+	if h := cluster.Status.Health; h != nil {
+		health.ReadyNodes = utils.ToInt32Clamped(h.ReadyNodes)
+		health.TotalNodes = utils.ToInt32Clamped(h.TotalNodes)
+		health.KubernetesVersion = h.KubernetesVersion
+		health.AgentVersion = h.AgentVersion
+		health.Reasons = h.Reasons
+	}
+	return health
+}
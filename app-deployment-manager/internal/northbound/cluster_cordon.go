@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+// CordonCluster excludes a cluster from the deployment targets, the
+// applications it runs are kept but no longer updated.
+func (s *DeploymentSvc) CordonCluster(ctx context.Context, in *deploymentpb.CordonClusterRequest) (*emptypb.Empty, error) {
+	if in == nil || in.ClusterId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot cordon cluster: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot cordon cluster: %v", err)).Err()
+	}
+
+	log.Infow("Received CordonCluster Request", dazl.String("Cluster ID", in.ClusterId))
+
+	if err := s.setClusterCordon(ctx, in.ClusterId, deploymentv1beta1.Cordoned); err != nil {
+		log.Warnf("cannot cordon cluster: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "cordon", "ADM", "cluster "+in.ClusterId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// UncordonCluster includes a cordoned or drained cluster in the deployment
+// targets again.
+func (s *DeploymentSvc) UncordonCluster(ctx context.Context, in *deploymentpb.UncordonClusterRequest) (*emptypb.Empty, error) {
+	if in == nil || in.ClusterId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot uncordon cluster: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot uncordon cluster: %v", err)).Err()
+	}
+
+	log.Infow("Received UncordonCluster Request", dazl.String("Cluster ID", in.ClusterId))
+
+	if err := s.setClusterCordon(ctx, in.ClusterId, ""); err != nil {
+		log.Warnf("cannot uncordon cluster: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "uncordon", "ADM", "cluster "+in.ClusterId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// DrainCluster excludes a cluster from the deployment targets and removes
+// the applications it runs.
+func (s *DeploymentSvc) DrainCluster(ctx context.Context, in *deploymentpb.DrainClusterRequest) (*emptypb.Empty, error) {
+	if in == nil || in.ClusterId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot drain cluster: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot drain cluster: %v", err)).Err()
+	}
+
+	log.Infow("Received DrainCluster Request", dazl.String("Cluster ID", in.ClusterId))
+
+	if err := s.setClusterCordon(ctx, in.ClusterId, deploymentv1beta1.Drained); err != nil {
+		log.Warnf("cannot drain cluster: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "drain", "ADM", "cluster "+in.ClusterId)
+
+	return &emptypb.Empty{}, nil
+}
+
+// setClusterCordon updates the cordon of a cluster of the active project, the
+// cluster is left untouched if already in that cordon
+func (s *DeploymentSvc) setClusterCordon(ctx context.Context, clusterID string, cordon deploymentv1beta1.CordonType) error {
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		return errors.NewUnavailable(fmt.Sprintf("failed to get tenant project ID %s", err.Error()))
+	}
+
+	cluster, err := s.crClient.Clusters(activeProjectID).Get(ctx, clusterID, metav1.GetOptions{})
+	if err != nil {
+		return k8serrors.K8sToTypedError(err)
+	}
+
+	if cluster.Spec.Cordon == cordon {
+		return nil
+	}
+
+	cluster.Spec.Cordon = cordon
+	if _, err := s.crClient.Clusters(activeProjectID).Update(ctx, clusterID, cluster, metav1.UpdateOptions{}); err != nil {
+		return k8serrors.K8sToTypedError(err)
+	}
+	return nil
+}
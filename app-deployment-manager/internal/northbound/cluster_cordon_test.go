@@ -0,0 +1,166 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	nbmocks "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound/mocks"
+)
+
+var _ = Describe("Gateway API Cluster Cordon", func() {
+	var (
+		deploymentServer *DeploymentSvc
+		crClient         *nbmocks.FakeDeploymentV1
+	)
+
+	newCluster := func(cordon deploymentv1beta1.CordonType) *deploymentv1beta1.Cluster {
+		return &deploymentv1beta1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "store-1"},
+			Spec:       deploymentv1beta1.ClusterSpec{Cordon: cordon},
+		}
+	}
+
+	// expectCordon expects the cluster to be updated with the cordon
+	expectCordon := func(cordon deploymentv1beta1.CordonType) {
+		crClient.On(
+			"UpdateCluster", context.Background(), "store-1", mock.MatchedBy(func(c *deploymentv1beta1.Cluster) bool {
+				return c.Spec.Cordon == cordon
+			}), mock.AnythingOfType("v1.UpdateOptions"),
+		).Return(newCluster(cordon), nil).Once()
+	}
+
+	BeforeEach(func() {
+		crClient = &nbmocks.FakeDeploymentV1{}
+		deploymentServer = NewDeploymentMustSucceed(crClient, nil, nil, nil, nil, nil, nil)
+	})
+
+	Describe("CordonCluster", func() {
+		It("successfully cordons a cluster", func() {
+			crClient.On(
+				"Get", context.Background(), "store-1", mock.AnythingOfType("v1.GetOptions"),
+			).Return(newCluster(""), nil).Once()
+			expectCordon(deploymentv1beta1.Cordoned)
+
+			_, err := deploymentServer.CordonCluster(context.Background(), &deploymentpb.CordonClusterRequest{
+				ClusterId: "store-1",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			crClient.AssertExpectations(GinkgoT())
+		})
+
+		It("does not update an already cordoned cluster", func() {
+			crClient.On(
+				"Get", context.Background(), "store-1", mock.AnythingOfType("v1.GetOptions"),
+			).Return(newCluster(deploymentv1beta1.Cordoned), nil).Once()
+
+			_, err := deploymentServer.CordonCluster(context.Background(), &deploymentpb.CordonClusterRequest{
+				ClusterId: "store-1",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			crClient.AssertNotCalled(GinkgoT(), "UpdateCluster", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		})
+
+		It("fails due to unknown cluster", func() {
+			crClient.On(
+				"Get", context.Background(), "store-9", mock.AnythingOfType("v1.GetOptions"),
+			).Return((*deploymentv1beta1.Cluster)(nil),
+				apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, "store-9")).Once()
+
+			_, err := deploymentServer.CordonCluster(context.Background(), &deploymentpb.CordonClusterRequest{
+				ClusterId: "store-9",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.NotFound))
+		})
+
+		It("fails due to missing cluster id", func() {
+			_, err := deploymentServer.CordonCluster(context.Background(), &deploymentpb.CordonClusterRequest{})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+	})
+
+	Describe("UncordonCluster", func() {
+		It("successfully uncordons a drained cluster", func() {
+			crClient.On(
+				"Get", context.Background(), "store-1", mock.AnythingOfType("v1.GetOptions"),
+			).Return(newCluster(deploymentv1beta1.Drained), nil).Once()
+			expectCordon("")
+
+			_, err := deploymentServer.UncordonCluster(context.Background(), &deploymentpb.UncordonClusterRequest{
+				ClusterId: "store-1",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			crClient.AssertExpectations(GinkgoT())
+		})
+
+		It("fails due to invalid cluster id", func() {
+			_, err := deploymentServer.UncordonCluster(context.Background(), &deploymentpb.UncordonClusterRequest{
+				ClusterId: "Store_1",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+		})
+	})
+
+	Describe("DrainCluster", func() {
+		It("successfully drains a cordoned cluster", func() {
+			crClient.On(
+				"Get", context.Background(), "store-1", mock.AnythingOfType("v1.GetOptions"),
+			).Return(newCluster(deploymentv1beta1.Cordoned), nil).Once()
+			expectCordon(deploymentv1beta1.Drained)
+
+			_, err := deploymentServer.DrainCluster(context.Background(), &deploymentpb.DrainClusterRequest{
+				ClusterId: "store-1",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			crClient.AssertExpectations(GinkgoT())
+		})
+
+		It("fails if the cluster update fails", func() {
+			crClient.On(
+				"Get", context.Background(), "store-1", mock.AnythingOfType("v1.GetOptions"),
+			).Return(newCluster(""), nil).Once()
+			crClient.On(
+				"UpdateCluster", context.Background(), "store-1", mock.AnythingOfType("*v1beta1.Cluster"), mock.AnythingOfType("v1.UpdateOptions"),
+			).Return((*deploymentv1beta1.Cluster)(nil),
+				apierrors.NewConflict(schema.GroupResource{Resource: "clusters"}, "store-1", nil)).Once()
+
+			_, err := deploymentServer.DrainCluster(context.Background(), &deploymentpb.DrainClusterRequest{
+				ClusterId: "store-1",
+			})
+
+			Expect(err).To(HaveOccurred())
+			_, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+		})
+	})
+})
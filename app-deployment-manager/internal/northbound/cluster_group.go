@@ -0,0 +1,476 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/clustergroup"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+// CreateClusterGroup creates a cluster group.
+func (s *DeploymentSvc) CreateClusterGroup(ctx context.Context, in *deploymentpb.CreateClusterGroupRequest) (*deploymentpb.CreateClusterGroupResponse, error) {
+	if in == nil || in.ClusterGroup == nil {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot create cluster group: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot create cluster group: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	log.Infow("Received CreateClusterGroup Request", dazl.String("Cluster Group", in.ClusterGroup.Name))
+
+	group := clusterGroupCR(in.ClusterGroup, activeProjectID)
+	members, err := s.resolveClusterGroup(ctx, activeProjectID, group)
+	if err != nil {
+		log.Warnf("cannot create cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	group, err = s.crClient.ClusterGroups(activeProjectID).Create(ctx, group, metav1.CreateOptions{})
+	if err != nil {
+		log.Warnf("cannot create cluster group: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	utils.LogActivity(ctx, "create", "ADM", "cluster group "+group.Name)
+
+	// The controller resolves the members again once the group is created
+	resp := createClusterGroupObject(group)
+	resp.Members = clusterIDs(members)
+	return &deploymentpb.CreateClusterGroupResponse{
+		ClusterGroup: resp,
+	}, nil
+}
+
+// ListClusterGroups lists the cluster groups of the project.
+func (s *DeploymentSvc) ListClusterGroups(ctx context.Context, in *deploymentpb.ListClusterGroupsRequest) (*deploymentpb.ListClusterGroupsResponse, error) {
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot list cluster groups: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot list cluster groups: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	groups, err := s.listClusterGroups(ctx, activeProjectID)
+	if err != nil {
+		log.Warnf("cannot list cluster groups: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	groupList := make([]*deploymentpb.ClusterGroup, 0, len(groups))
+	for i := range groups {
+		groupList = append(groupList, createClusterGroupObject(&groups[i]))
+	}
+	sort.Slice(groupList, func(i, j int) bool {
+		return groupList[i].Name < groupList[j].Name
+	})
+
+	utils.LogActivity(ctx, "list cluster groups", "ADM")
+
+	return &deploymentpb.ListClusterGroupsResponse{
+		ClusterGroups: groupList,
+		TotalElements: utils.ToInt32Clamped(len(groupList)),
+	}, nil
+}
+
+// GetClusterGroup gets a cluster group with its resolved members.
+func (s *DeploymentSvc) GetClusterGroup(ctx context.Context, in *deploymentpb.GetClusterGroupRequest) (*deploymentpb.GetClusterGroupResponse, error) {
+	if in == nil || in.Name == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot get cluster group: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot get cluster group: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	group, err := s.getClusterGroup(ctx, activeProjectID, in.Name)
+	if err != nil {
+		log.Warnf("cannot get cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "get", "ADM", "cluster group "+in.Name)
+
+	return &deploymentpb.GetClusterGroupResponse{
+		ClusterGroup: createClusterGroupObject(group),
+	}, nil
+}
+
+// UpdateClusterGroup updates a cluster group, the deployments targeting the
+// group being updated by the controller once its members are resolved.
+func (s *DeploymentSvc) UpdateClusterGroup(ctx context.Context, in *deploymentpb.UpdateClusterGroupRequest) (*deploymentpb.UpdateClusterGroupResponse, error) {
+	if in == nil || in.ClusterGroup == nil || in.Name == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot update cluster group: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot update cluster group: %v", err)).Err()
+	}
+
+	if in.ClusterGroup.Name != in.Name {
+		return nil, errors.Status(errors.NewInvalid("cluster group name %s does not match %s", in.ClusterGroup.Name, in.Name)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	group, err := s.getClusterGroup(ctx, activeProjectID, in.Name)
+	if err != nil {
+		log.Warnf("cannot update cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+	group.Spec = clusterGroupCR(in.ClusterGroup, activeProjectID).Spec
+
+	members, err := s.resolveClusterGroup(ctx, activeProjectID, group)
+	if err != nil {
+		log.Warnf("cannot update cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	group, err = s.crClient.ClusterGroups(activeProjectID).Update(ctx, group.Name, group, metav1.UpdateOptions{})
+	if err != nil {
+		log.Warnf("cannot update cluster group: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	utils.LogActivity(ctx, "update", "ADM", "cluster group "+group.Name)
+
+	resp := createClusterGroupObject(group)
+	resp.Members = clusterIDs(members)
+	return &deploymentpb.UpdateClusterGroupResponse{
+		ClusterGroup: resp,
+	}, nil
+}
+
+// DeleteClusterGroup deletes a cluster group which is neither targeted by a
+// deployment nor referred to by another group.
+func (s *DeploymentSvc) DeleteClusterGroup(ctx context.Context, in *deploymentpb.DeleteClusterGroupRequest) (*emptypb.Empty, error) {
+	if in == nil || in.Name == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot delete cluster group: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot delete cluster group: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	if _, err := s.getClusterGroup(ctx, activeProjectID, in.Name); err != nil {
+		log.Warnf("cannot delete cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	groups, err := s.listClusterGroups(ctx, activeProjectID)
+	if err != nil {
+		log.Warnf("cannot delete cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+	for i := range groups {
+		for _, ref := range clustergroup.References(&groups[i]) {
+			if ref == in.Name {
+				return nil, errors.Status(errors.NewConflict("cluster group %s is referred to by cluster group %s", in.Name, groups[i].Name)).Err()
+			}
+		}
+	}
+
+	deployments, err := s.crClient.Deployments(activeProjectID).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{string(deploymentv1beta1.AppOrchActiveProjectID): activeProjectID}.String(),
+	})
+	if err != nil {
+		log.Warnf("cannot delete cluster group: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+	for _, d := range deployments.Items {
+		for _, app := range d.Spec.Applications {
+			for _, t := range app.Targets {
+				if t[string(deploymentv1beta1.ClusterGroupName)] == in.Name {
+					return nil, errors.Status(errors.NewConflict("cluster group %s is targeted by deployment %s", in.Name, d.Spec.DisplayName)).Err()
+				}
+			}
+		}
+	}
+
+	if err := s.crClient.ClusterGroups(activeProjectID).Delete(ctx, in.Name, metav1.DeleteOptions{}); err != nil {
+		log.Warnf("cannot delete cluster group: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	utils.LogActivity(ctx, "delete", "ADM", "cluster group "+in.Name)
+
+	return &emptypb.Empty{}, nil
+}
+
+// PreviewClusterGroup resolves the members of a cluster group without storing it.
+func (s *DeploymentSvc) PreviewClusterGroup(ctx context.Context, in *deploymentpb.PreviewClusterGroupRequest) (*deploymentpb.PreviewClusterGroupResponse, error) {
+	if in == nil || in.ClusterGroup == nil {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot preview cluster group: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot preview cluster group: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	members, err := s.resolveClusterGroup(ctx, activeProjectID, clusterGroupCR(in.ClusterGroup, activeProjectID))
+	if err != nil {
+		log.Warnf("cannot preview cluster group: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	clusters := make([]*deploymentpb.ClusterInfo, 0, len(members))
+	for i := range members {
+		clusters = append(clusters, clusterInfo(&members[i]))
+	}
+
+	utils.LogActivity(ctx, "preview", "ADM", "cluster group "+in.ClusterGroup.Name)
+
+	return &deploymentpb.PreviewClusterGroupResponse{
+		Clusters:      clusters,
+		TotalElements: utils.ToInt32Clamped(len(clusters)),
+	}, nil
+}
+
+// clusterGroupTarget returns the application target of a cluster group
+func clusterGroupTarget(name string, activeProjectID string) map[string]string {
+	return map[string]string{
+		string(deploymentv1beta1.ClusterGroupName): name,
+		deploymentv1beta1.ClusterOrchKeyProjectID:  activeProjectID,
+	}
+}
+
+// checkClusterGroup checks that a cluster group targeted by a deployment exists
+func (s *DeploymentSvc) checkClusterGroup(ctx context.Context, activeProjectID string, name string) error {
+	if _, err := s.getClusterGroup(ctx, activeProjectID, name); err != nil {
+		if errors.IsNotFound(err) {
+			return errors.NewInvalid("unknown cluster group %s", name)
+		}
+		return err
+	}
+	return nil
+}
+
+// clusterGroupMembers returns the resolved member clusters of a cluster group
+func (s *DeploymentSvc) clusterGroupMembers(ctx context.Context, activeProjectID string, name string) ([]deploymentv1beta1.Cluster, error) {
+	group, err := s.getClusterGroup(ctx, activeProjectID, name)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := s.listProjectClusters(ctx, activeProjectID)
+	if err != nil {
+		return nil, err
+	}
+	return filterClusters(clusters, group.Status.Clusters), nil
+}
+
+// resolveClusterGroup returns the member clusters of a cluster group, which
+// may differ from the stored group of the same name
+func (s *DeploymentSvc) resolveClusterGroup(ctx context.Context, activeProjectID string, group *deploymentv1beta1.ClusterGroup) ([]deploymentv1beta1.Cluster, error) {
+	spec := group.Spec
+	if len(spec.Clusters) == 0 && len(spec.Selector) == 0 && len(spec.Union) == 0 && len(spec.Intersection) == 0 {
+		return nil, errors.NewInvalid("cluster group %s has neither clusters, selector, union nor intersection", group.Name)
+	}
+
+	groups, err := s.listClusterGroups(ctx, activeProjectID)
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := s.listProjectClusters(ctx, activeProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	ids, err := clustergroup.NewResolver(groups, clusters).Resolve(group)
+	if err != nil {
+		return nil, errors.NewInvalid("%v", err)
+	}
+	return filterClusters(clusters, ids), nil
+}
+
+func (s *DeploymentSvc) getClusterGroup(ctx context.Context, activeProjectID string, name string) (*deploymentv1beta1.ClusterGroup, error) {
+	group, err := s.crClient.ClusterGroups(activeProjectID).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+	if group.Labels[string(deploymentv1beta1.AppOrchActiveProjectID)] != activeProjectID {
+		return nil, errors.NewNotFound("cluster group %s not found", name)
+	}
+	return group, nil
+}
+
+func (s *DeploymentSvc) listClusterGroups(ctx context.Context, activeProjectID string) ([]deploymentv1beta1.ClusterGroup, error) {
+	groups, err := s.crClient.ClusterGroups(activeProjectID).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{string(deploymentv1beta1.AppOrchActiveProjectID): activeProjectID}.String(),
+	})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+	return groups.Items, nil
+}
+
+func (s *DeploymentSvc) listProjectClusters(ctx context.Context, activeProjectID string) ([]deploymentv1beta1.Cluster, error) {
+	clusters, err := s.crClient.Clusters(activeProjectID).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set{string(deploymentv1beta1.AppOrchActiveProjectID): activeProjectID}.String(),
+	})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+	return clusters.Items, nil
+}
+
+// filterClusters returns the clusters with the given sorted ids
+func filterClusters(clusters []deploymentv1beta1.Cluster, ids []string) []deploymentv1beta1.Cluster {
+	byID := make(map[string]deploymentv1beta1.Cluster, len(clusters))
+	for _, cluster := range clusters {
+		byID[cluster.Name] = cluster
+	}
+	members := make([]deploymentv1beta1.Cluster, 0, len(ids))
+	for _, id := range ids {
+		if cluster, ok := byID[id]; ok {
+			members = append(members, cluster)
+		}
+	}
+	return members
+}
+
+func clusterIDs(clusters []deploymentv1beta1.Cluster) []string {
+	ids := make([]string, 0, len(clusters))
+	for _, cluster := range clusters {
+		ids = append(ids, cluster.Name)
+	}
+	return ids
+}
+
+// clusterGroupCR returns the ClusterGroup CR of a cluster group of the API
+func clusterGroupCR(in *deploymentpb.ClusterGroup, activeProjectID string) *deploymentv1beta1.ClusterGroup {
+	return &deploymentv1beta1.ClusterGroup{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: deploymentv1beta1.GroupVersion.String(),
+			Kind:       "ClusterGroup",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      in.Name,
+			Namespace: activeProjectID,
+			Labels: map[string]string{
+				string(deploymentv1beta1.AppOrchActiveProjectID): activeProjectID,
+			},
+		},
+		Spec: deploymentv1beta1.ClusterGroupSpec{
+			DisplayName:  in.DisplayName,
+			Description:  in.Description,
+			Clusters:     in.Clusters,
+			Selector:     in.Selector,
+			Union:        in.Union,
+			Intersection: in.Intersection,
+			Exclude:      in.Exclude,
+		},
+	}
+}
+
+// createClusterGroupObject returns the cluster group of the API of a ClusterGroup CR
+func createClusterGroupObject(group *deploymentv1beta1.ClusterGroup) *deploymentpb.ClusterGroup {
+	setPbTime := group.ObjectMeta.CreationTimestamp.ProtoTime()
+	return &deploymentpb.ClusterGroup{
+		Name:         group.Name,
+		DisplayName:  group.Spec.DisplayName,
+		Description:  group.Spec.Description,
+		Clusters:     group.Spec.Clusters,
+		Selector:     group.Spec.Selector,
+		Union:        group.Spec.Union,
+		Intersection: group.Spec.Intersection,
+		Exclude:      group.Spec.Exclude,
+		Members:      group.Status.Clusters,
+		Message:      group.Status.Message,
+		CreateTime:   timestamppb.New(time.Unix(setPbTime.Seconds, 0)),
+	}
+}
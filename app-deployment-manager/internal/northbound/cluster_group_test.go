@@ -0,0 +1,285 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	nbmocks "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound/mocks"
+)
+
+var _ = Describe("Gateway API Cluster Groups", func() {
+	var (
+		deploymentServer *DeploymentSvc
+		crClient         *nbmocks.FakeDeploymentV1
+		clusterList      *deploymentv1beta1.ClusterList
+		groupList        *deploymentv1beta1.ClusterGroupList
+	)
+
+	newClusterGroup := func(name string, spec deploymentv1beta1.ClusterGroupSpec, members ...string) deploymentv1beta1.ClusterGroup {
+		return deploymentv1beta1.ClusterGroup{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       spec,
+			Status:     deploymentv1beta1.ClusterGroupStatus{Clusters: members},
+		}
+	}
+
+	BeforeEach(func() {
+		crClient = &nbmocks.FakeDeploymentV1{}
+		deploymentServer = NewDeploymentMustSucceed(crClient, nil, nil, nil, nil, nil, nil)
+
+		clusterList = &deploymentv1beta1.ClusterList{
+			Items: []deploymentv1beta1.Cluster{
+				{ObjectMeta: metav1.ObjectMeta{Name: "store-1", Labels: map[string]string{"region": "west", "pilot": "true"}}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "store-2", Labels: map[string]string{"region": "west"}}},
+			},
+		}
+		groupList = &deploymentv1beta1.ClusterGroupList{
+			Items: []deploymentv1beta1.ClusterGroup{
+				newClusterGroup("west", deploymentv1beta1.ClusterGroupSpec{
+					Selector: map[string]string{"region": "west"},
+				}, "store-1", "store-2"),
+				newClusterGroup("pilot", deploymentv1beta1.ClusterGroupSpec{
+					Selector: map[string]string{"pilot": "true"},
+				}, "store-1"),
+			},
+		}
+	})
+
+	Describe("CreateClusterGroup", func() {
+		It("successfully creates a cluster group with its resolved members", func() {
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+			crClient.On(
+				"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(clusterList, nil).Once()
+			crClient.On(
+				"CreateClusterGroup", context.Background(), mock.AnythingOfType("*v1beta1.ClusterGroup"), mock.AnythingOfType("v1.CreateOptions"),
+			).Return(&deploymentv1beta1.ClusterGroup{ObjectMeta: metav1.ObjectMeta{Name: "west-no-pilot"}}, nil).Once()
+
+			resp, err := deploymentServer.CreateClusterGroup(context.Background(), &deploymentpb.CreateClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{
+					Name:    "west-no-pilot",
+					Union:   []string{"west"},
+					Exclude: []string{"pilot"},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.ClusterGroup.Name).To(Equal("west-no-pilot"))
+			Expect(resp.ClusterGroup.Members).To(Equal([]string{"store-2"}))
+		})
+
+		It("fails due to missing membership rules", func() {
+			_, err := deploymentServer.CreateClusterGroup(context.Background(), &deploymentpb.CreateClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{
+					Name:    "empty",
+					Exclude: []string{"pilot"},
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(Equal("cluster group empty has neither clusters, selector, union nor intersection"))
+		})
+
+		It("fails due to unknown referred group", func() {
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+			crClient.On(
+				"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(clusterList, nil).Once()
+
+			_, err := deploymentServer.CreateClusterGroup(context.Background(), &deploymentpb.CreateClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{
+					Name:  "all",
+					Union: []string{"west", "east"},
+				},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(ContainSubstring("unknown cluster group east"))
+		})
+
+		It("fails due to missing cluster group", func() {
+			_, err := deploymentServer.CreateClusterGroup(context.Background(), &deploymentpb.CreateClusterGroupRequest{})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+	})
+
+	Describe("ListClusterGroups", func() {
+		It("successfully lists the cluster groups sorted by name", func() {
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+
+			resp, err := deploymentServer.ListClusterGroups(context.Background(), &deploymentpb.ListClusterGroupsRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.TotalElements).To(Equal(int32(2)))
+			Expect(resp.ClusterGroups[0].Name).To(Equal("pilot"))
+			Expect(resp.ClusterGroups[0].Members).To(Equal([]string{"store-1"}))
+			Expect(resp.ClusterGroups[1].Name).To(Equal("west"))
+		})
+	})
+
+	Describe("GetClusterGroup", func() {
+		It("fails due to unknown cluster group", func() {
+			crClient.On(
+				"GetClusterGroup", context.Background(), "east", mock.AnythingOfType("v1.GetOptions"),
+			).Return((*deploymentv1beta1.ClusterGroup)(nil),
+				apierrors.NewNotFound(schema.GroupResource{Resource: "clustergroups"}, "east")).Once()
+
+			_, err := deploymentServer.GetClusterGroup(context.Background(), &deploymentpb.GetClusterGroupRequest{
+				Name: "east",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.NotFound))
+		})
+	})
+
+	Describe("UpdateClusterGroup", func() {
+		It("fails due to mismatching name", func() {
+			_, err := deploymentServer.UpdateClusterGroup(context.Background(), &deploymentpb.UpdateClusterGroupRequest{
+				Name:         "west",
+				ClusterGroup: &deploymentpb.ClusterGroup{Name: "east", Clusters: []string{"store-1"}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(Equal("cluster group name east does not match west"))
+		})
+	})
+
+	Describe("DeleteClusterGroup", func() {
+		It("fails due to a group referring to it", func() {
+			groupList.Items = append(groupList.Items, newClusterGroup("west-no-pilot", deploymentv1beta1.ClusterGroupSpec{
+				Union:   []string{"west"},
+				Exclude: []string{"pilot"},
+			}, "store-2"))
+			crClient.On(
+				"GetClusterGroup", context.Background(), "pilot", mock.AnythingOfType("v1.GetOptions"),
+			).Return(&groupList.Items[1], nil).Once()
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+
+			_, err := deploymentServer.DeleteClusterGroup(context.Background(), &deploymentpb.DeleteClusterGroupRequest{
+				Name: "pilot",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.FailedPrecondition))
+			Expect(s.Message()).Should(Equal("cluster group pilot is referred to by cluster group west-no-pilot"))
+		})
+
+		It("fails due to a deployment targeting it", func() {
+			crClient.On(
+				"GetClusterGroup", context.Background(), "west", mock.AnythingOfType("v1.GetOptions"),
+			).Return(&groupList.Items[0], nil).Once()
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+			crClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				Items: []deploymentv1beta1.Deployment{{
+					Spec: deploymentv1beta1.DeploymentSpec{
+						DisplayName: "wordpress",
+						Applications: []deploymentv1beta1.Application{{
+							Name:    "wordpress",
+							Targets: []map[string]string{clusterGroupTarget("west", "")},
+						}},
+					},
+				}},
+			}, nil).Once()
+
+			_, err := deploymentServer.DeleteClusterGroup(context.Background(), &deploymentpb.DeleteClusterGroupRequest{
+				Name: "west",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.FailedPrecondition))
+			Expect(s.Message()).Should(Equal("cluster group west is targeted by deployment wordpress"))
+		})
+
+		It("successfully deletes an unused cluster group", func() {
+			crClient.On(
+				"GetClusterGroup", context.Background(), "pilot", mock.AnythingOfType("v1.GetOptions"),
+			).Return(&groupList.Items[1], nil).Once()
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+			crClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+			crClient.On(
+				"DeleteClusterGroup", context.Background(), "pilot", mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(nil).Once()
+
+			_, err := deploymentServer.DeleteClusterGroup(context.Background(), &deploymentpb.DeleteClusterGroupRequest{
+				Name: "pilot",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			crClient.AssertExpectations(GinkgoT())
+		})
+	})
+
+	Describe("PreviewClusterGroup", func() {
+		It("successfully previews a change of a stored group", func() {
+			crClient.On(
+				"ListClusterGroups", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(groupList, nil).Once()
+			crClient.On(
+				"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(clusterList, nil).Once()
+
+			resp, err := deploymentServer.PreviewClusterGroup(context.Background(), &deploymentpb.PreviewClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{
+					Name:         "west",
+					Selector:     map[string]string{"region": "west"},
+					Intersection: []string{"pilot"},
+				},
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.TotalElements).To(Equal(int32(1)))
+			Expect(resp.Clusters[0].Id).To(Equal("store-1"))
+		})
+	})
+})
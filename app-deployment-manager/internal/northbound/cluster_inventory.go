@@ -0,0 +1,268 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/dazl"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+const (
+	inventoryFormatJSON = "json"
+	inventoryFormatCSV  = "csv"
+)
+
+// inventoryColumns are the header of the CSV inventory export
+var inventoryColumns = []string{
+	"cluster_id", "cluster_name", "deployment_uid", "deployment_name", "deployment_display_name",
+	"app_name", "app_version", "app", "chart_name", "chart_version", "namespace",
+	"bundle_name", "bundle_state", "state", "update_time",
+}
+
+// GetClusterInventory returns the applications of every deployment placed on a cluster.
+func (s *DeploymentSvc) GetClusterInventory(ctx context.Context, in *deploymentpb.GetClusterInventoryRequest) (*deploymentpb.GetClusterInventoryResponse, error) {
+	if in == nil || in.ClusterId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot get cluster inventory: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot get cluster inventory: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	items, err := s.listInventory(ctx, activeProjectID, in.ClusterId)
+	if err != nil {
+		log.Warnf("cannot get cluster inventory: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	return &deploymentpb.GetClusterInventoryResponse{
+		Items:         items,
+		TotalElements: utils.ToInt32Clamped(len(items)),
+	}, nil
+}
+
+// ExportInventory returns the applications placed on all the clusters of the
+// project as a JSON or CSV file.
+func (s *DeploymentSvc) ExportInventory(ctx context.Context, in *deploymentpb.ExportInventoryRequest) (*deploymentpb.ExportInventoryResponse, error) {
+	if in == nil {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot export inventory: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot export inventory: %v", err)).Err()
+	}
+
+	log.Infow("Received ExportInventory Request", dazl.String("Format", in.Format))
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	items, err := s.listInventory(ctx, activeProjectID, "")
+	if err != nil {
+		log.Warnf("cannot export inventory: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	format := in.Format
+	if format == "" {
+		format = inventoryFormatJSON
+	}
+
+	var content []byte
+	if format == inventoryFormatCSV {
+		content, err = inventoryCSV(items)
+	} else {
+		content, err = inventoryJSON(items)
+	}
+	if err != nil {
+		log.Warnf("cannot export inventory: %v", err)
+		return nil, errors.Status(errors.NewInternal("cannot export inventory: %v", err)).Err()
+	}
+
+	utils.LogActivity(ctx, "export", "ADM", "inventory format "+format)
+	return &deploymentpb.ExportInventoryResponse{
+		Content:  content,
+		FileName: fmt.Sprintf("inventory-%s.%s", activeProjectID, format),
+	}, nil
+}
+
+// listInventory returns the applications of the deployments of the project
+// placed on a cluster, or on all clusters if clusterID is empty, sorted by
+// cluster, deployment and application
+func (s *DeploymentSvc) listInventory(ctx context.Context, projectID string, clusterID string) ([]*deploymentpb.InventoryItem, error) {
+	matchLabels := labels.Set{string(deploymentv1beta1.AppOrchActiveProjectID): projectID}
+	deployments, err := s.crClient.Deployments(projectID).List(ctx, metav1.ListOptions{
+		LabelSelector: matchLabels.String(),
+	})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+
+	deploymentMap := map[string]*deploymentv1beta1.Deployment{}
+	for i, d := range deployments.Items {
+		deploymentMap[string(d.UID)] = &deployments.Items[i]
+	}
+
+	if clusterID != "" {
+		matchLabels[string(deploymentv1beta1.ClusterName)] = clusterID
+	}
+	deploymentClusters, err := s.crClient.DeploymentClusters("").List(ctx, metav1.ListOptions{
+		LabelSelector: matchLabels.String(),
+	})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+
+	items := make([]*deploymentpb.InventoryItem, 0)
+	for _, dc := range deploymentClusters.Items {
+		d, ok := deploymentMap[dc.Spec.DeploymentID]
+		if !ok {
+			// Skip orphaned DeploymentCluster, cleaned up by its controller
+			continue
+		}
+		for _, app := range dc.Status.Apps {
+			items = append(items, inventoryItem(&dc, d, app))
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		if items[i].ClusterId != items[j].ClusterId {
+			return items[i].ClusterId < items[j].ClusterId
+		}
+		if items[i].DeploymentName != items[j].DeploymentName {
+			return items[i].DeploymentName < items[j].DeploymentName
+		}
+		return items[i].App < items[j].App
+	})
+	return items, nil
+}
+
+// inventoryItem returns the inventory item of an application of a deployment
+// placed on the cluster of the DeploymentCluster
+func inventoryItem(dc *deploymentv1beta1.DeploymentCluster, d *deploymentv1beta1.Deployment, app deploymentv1beta1.App) *deploymentpb.InventoryItem {
+	item := &deploymentpb.InventoryItem{
+		ClusterId:             dc.Spec.ClusterID,
+		ClusterName:           dc.Status.Name,
+		DeploymentUid:         string(d.UID),
+		DeploymentName:        d.Name,
+		DeploymentDisplayName: d.Spec.DisplayName,
+		AppName:               d.Spec.DeploymentPackageRef.Name,
+		AppVersion:            d.Spec.DeploymentPackageRef.Version,
+		App:                   app.Name,
+		BundleName:            app.Id,
+		BundleState:           app.BundleState,
+		State:                 deploymentState(string(app.Status.State)),
+	}
+	if app.LastUpdate != nil {
+		item.UpdateTime = timestamppb.New(app.LastUpdate.Time)
+	}
+
+	for _, a := range d.Spec.Applications {
+		if a.Name != app.Name {
+			continue
+		}
+		item.Namespace = a.Namespace
+		if a.HelmApp != nil {
+			item.ChartName = a.HelmApp.Chart
+			item.ChartVersion = a.HelmApp.Version
+		}
+		break
+	}
+	return item
+}
+
+// inventoryJSON returns the inventory items as a JSON array
+func inventoryJSON(items []*deploymentpb.InventoryItem) ([]byte, error) {
+	rows := make([]json.RawMessage, len(items))
+	for i, item := range items {
+		row, err := protojson.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		rows[i] = row
+	}
+	return json.Marshal(rows)
+}
+
+// inventoryCSV returns the inventory items as CSV rows following the
+// inventoryColumns header
+func inventoryCSV(items []*deploymentpb.InventoryItem) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(inventoryColumns); err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		updateTime := ""
+		if item.UpdateTime != nil {
+			updateTime = item.UpdateTime.AsTime().Format(time.RFC3339)
+		}
+		row := []string{
+			item.ClusterId, item.ClusterName, item.DeploymentUid, item.DeploymentName, item.DeploymentDisplayName,
+			item.AppName, item.AppVersion, item.App, item.ChartName, item.ChartVersion, item.Namespace,
+			item.BundleName, item.BundleState, item.State.String(), updateTime,
+		}
+		for i, cell := range row {
+			row[i] = escapeCSVCell(cell)
+		}
+		if err := w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// escapeCSVCell prefixes the cells starting like a formula with a quote, so
+// that the user-controlled names are not evaluated by spreadsheet applications
+func escapeCSVCell(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
@@ -0,0 +1,224 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	nbmocks "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound/mocks"
+)
+
+var _ = Describe("Gateway API Cluster Inventory", func() {
+	var (
+		deploymentServer *DeploymentSvc
+		crClient         *nbmocks.FakeDeploymentV1
+		updateTime       = time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+	)
+
+	newDeployment := func(uid, name string) deploymentv1beta1.Deployment {
+		return deploymentv1beta1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: name, UID: types.UID(uid)},
+			Spec: deploymentv1beta1.DeploymentSpec{
+				DisplayName:          name + "-display",
+				DeploymentPackageRef: deploymentv1beta1.DeploymentPackageRef{Name: "wordpress", Version: "0.1.0"},
+				Applications: []deploymentv1beta1.Application{
+					{
+						Name:      "wordpress",
+						Namespace: "apps",
+						HelmApp:   &deploymentv1beta1.HelmApp{Chart: "wordpress", Version: "15.2.42"},
+					},
+					{
+						Name:        "config",
+						Namespace:   "apps",
+						ManifestApp: &deploymentv1beta1.ManifestApp{},
+					},
+				},
+			},
+		}
+	}
+
+	newDeploymentCluster := func(deploymentUID, clusterID string, apps ...deploymentv1beta1.App) deploymentv1beta1.DeploymentCluster {
+		return deploymentv1beta1.DeploymentCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "dc-" + deploymentUID + "-" + clusterID},
+			Spec:       deploymentv1beta1.DeploymentClusterSpec{DeploymentID: deploymentUID, ClusterID: clusterID},
+			Status: deploymentv1beta1.DeploymentClusterStatus{
+				Name: clusterID + "-display",
+				Apps: apps,
+			},
+		}
+	}
+
+	newApp := func(name string, state deploymentv1beta1.StateType, bundleState string) deploymentv1beta1.App {
+		return deploymentv1beta1.App{
+			Name:        name,
+			Id:          "b-" + name,
+			Status:      deploymentv1beta1.Status{State: state},
+			BundleState: bundleState,
+			LastUpdate:  &metav1.Time{Time: updateTime},
+		}
+	}
+
+	// expectLists returns the deployments and deployment clusters once
+	expectLists := func(deployments []deploymentv1beta1.Deployment, dcs []deploymentv1beta1.DeploymentCluster) {
+		crClient.On(
+			"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(&deploymentv1beta1.DeploymentList{Items: deployments}, nil).Once()
+		crClient.On(
+			"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(&deploymentv1beta1.DeploymentClusterList{Items: dcs}, nil).Once()
+	}
+
+	BeforeEach(func() {
+		crClient = &nbmocks.FakeDeploymentV1{}
+		deploymentServer = NewDeploymentMustSucceed(crClient, nil, nil, nil, nil, nil, nil)
+	})
+
+	Describe("GetClusterInventory", func() {
+		It("returns the applications placed on the cluster", func() {
+			expectLists(
+				[]deploymentv1beta1.Deployment{newDeployment("uid-1", "wp-b"), newDeployment("uid-2", "wp-a")},
+				[]deploymentv1beta1.DeploymentCluster{
+					newDeploymentCluster("uid-1", "store-1234",
+						newApp("wordpress", deploymentv1beta1.Running, "Ready"),
+						newApp("config", deploymentv1beta1.Down, "ErrApplied")),
+					newDeploymentCluster("uid-2", "store-1234", newApp("wordpress", deploymentv1beta1.Running, "Ready")),
+					newDeploymentCluster("uid-orphan", "store-1234", newApp("wordpress", deploymentv1beta1.Running, "Ready")),
+				},
+			)
+
+			resp, err := deploymentServer.GetClusterInventory(context.Background(), &deploymentpb.GetClusterInventoryRequest{
+				ClusterId: "store-1234",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.TotalElements).To(Equal(int32(3)))
+			Expect(resp.Items).To(HaveLen(3))
+
+			Expect(resp.Items[0].DeploymentName).To(Equal("wp-a"))
+			Expect(resp.Items[1].DeploymentName).To(Equal("wp-b"))
+			Expect(resp.Items[1].App).To(Equal("config"))
+			Expect(resp.Items[1].ChartName).To(BeEmpty())
+			Expect(resp.Items[1].BundleState).To(Equal("ErrApplied"))
+			Expect(resp.Items[1].State).To(Equal(deploymentpb.State_DOWN))
+
+			item := resp.Items[2]
+			Expect(item.ClusterId).To(Equal("store-1234"))
+			Expect(item.ClusterName).To(Equal("store-1234-display"))
+			Expect(item.DeploymentUid).To(Equal("uid-1"))
+			Expect(item.DeploymentDisplayName).To(Equal("wp-b-display"))
+			Expect(item.AppName).To(Equal("wordpress"))
+			Expect(item.AppVersion).To(Equal("0.1.0"))
+			Expect(item.App).To(Equal("wordpress"))
+			Expect(item.ChartName).To(Equal("wordpress"))
+			Expect(item.ChartVersion).To(Equal("15.2.42"))
+			Expect(item.Namespace).To(Equal("apps"))
+			Expect(item.BundleName).To(Equal("b-wordpress"))
+			Expect(item.BundleState).To(Equal("Ready"))
+			Expect(item.State).To(Equal(deploymentpb.State_RUNNING))
+			Expect(item.UpdateTime.AsTime()).To(Equal(updateTime))
+		})
+
+		It("fails due to missing cluster id", func() {
+			_, err := deploymentServer.GetClusterInventory(context.Background(), &deploymentpb.GetClusterInventoryRequest{})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(ok).To(BeTrue())
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+	})
+
+	Describe("ExportInventory", func() {
+		BeforeEach(func() {
+			expectLists(
+				[]deploymentv1beta1.Deployment{newDeployment("uid-1", "wp")},
+				[]deploymentv1beta1.DeploymentCluster{
+					newDeploymentCluster("uid-1", "store-2", newApp("wordpress", deploymentv1beta1.Running, "Ready")),
+					newDeploymentCluster("uid-1", "store-1", newApp("wordpress", deploymentv1beta1.Running, "Modified")),
+				},
+			)
+		})
+
+		It("exports the inventory of all the clusters as JSON by default", func() {
+			resp, err := deploymentServer.ExportInventory(context.Background(), &deploymentpb.ExportInventoryRequest{})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.FileName).To(HaveSuffix(".json"))
+
+			var rows []map[string]any
+			Expect(json.Unmarshal(resp.Content, &rows)).To(Succeed())
+			Expect(rows).To(HaveLen(2))
+			Expect(rows[0]).To(HaveKeyWithValue("clusterId", "store-1"))
+			Expect(rows[0]).To(HaveKeyWithValue("bundleState", "Modified"))
+			Expect(rows[0]).To(HaveKeyWithValue("chartVersion", "15.2.42"))
+			Expect(rows[1]).To(HaveKeyWithValue("clusterId", "store-2"))
+		})
+
+		It("exports the inventory of all the clusters as CSV", func() {
+			resp, err := deploymentServer.ExportInventory(context.Background(), &deploymentpb.ExportInventoryRequest{
+				Format: "csv",
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.FileName).To(HaveSuffix(".csv"))
+
+			records, err := csv.NewReader(strings.NewReader(string(resp.Content))).ReadAll()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(3))
+			Expect(records[0]).To(Equal(inventoryColumns))
+			Expect(records[1]).To(Equal([]string{
+				"store-1", "store-1-display", "uid-1", "wp", "wp-display",
+				"wordpress", "0.1.0", "wordpress", "wordpress", "15.2.42", "apps",
+				"b-wordpress", "Modified", "RUNNING", "2025-03-01T10:00:00Z",
+			}))
+		})
+	})
+
+	It("ExportInventory: escapes the formulas in the CSV cells", func() {
+		content, err := inventoryCSV([]*deploymentpb.InventoryItem{{
+			ClusterId:             "store-1",
+			ClusterName:           "@SUM(A1:A2)",
+			DeploymentName:        "-wp",
+			DeploymentDisplayName: "=HYPERLINK(\"http://example.com\")",
+			AppName:               "+wordpress",
+			App:                   "word=press",
+		}})
+		Expect(err).ToNot(HaveOccurred())
+
+		records, err := csv.NewReader(strings.NewReader(string(content))).ReadAll()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(records).To(HaveLen(2))
+		Expect(records[1][:8]).To(Equal([]string{
+			"store-1", "'@SUM(A1:A2)", "", "'-wp", "'=HYPERLINK(\"http://example.com\")",
+			"'+wordpress", "", "word=press",
+		}))
+	})
+
+	It("ExportInventory: fails due to invalid format", func() {
+		_, err := deploymentServer.ExportInventory(context.Background(), &deploymentpb.ExportInventoryRequest{
+			Format: "xml",
+		})
+
+		Expect(err).To(HaveOccurred())
+		s, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(s.Code()).To(Equal(codes.InvalidArgument))
+	})
+})
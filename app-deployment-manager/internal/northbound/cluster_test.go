@@ -10,8 +10,14 @@ import (
 	nbmocks "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound/mocks"
 	"net/http"
 	"net/http/httptest"
+	"time"
 
+	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
 	"github.com/stretchr/testify/mock"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc/metadata"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -23,8 +29,23 @@ import (
 
 	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
 	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/kubeconfig"
 )
 
+// allowAllOpaMock returns an OPA client authorizing all requests
+func allowAllOpaMock() openpolicyagent.ClientWithResponsesInterface {
+	result := openpolicyagent.OpaResponse_Result{}
+	Expect(result.FromOpaResponseResult1(true)).To(Succeed())
+
+	opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(GinkgoT()))
+	opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+	).Return(&openpolicyagent.PostV1DataPackageRuleResponse{
+		JSON200: &openpolicyagent.OpaResponse{Result: result},
+	}, nil).AnyTimes()
+	return opaMock
+}
+
 const INVALID_CLUSTERID = "cluster-invalid"
 
 var _ = Describe("Gateway gRPC Service", func() {
@@ -53,7 +74,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}, "data": {"value": "YWRtaW4="}}`))
 				Expect(err).ToNot(HaveOccurred())
 			}))
 
@@ -67,14 +88,64 @@ var _ = Describe("Gateway gRPC Service", func() {
 				"Get", context.TODO(), mock.AnythingOfType("string"), mock.AnythingOfType("v1.GetOptions"),
 			).Return(clusterInstance, nil)
 
-			_, err = deploymentServer.GetKubeConfig(context.TODO(), &deploymentpb.GetKubeConfigRequest{
-				ClusterId: "123456",
+			expireTime := time.Now().Add(time.Hour).Truncate(time.Second)
+			var issued kubeconfig.Request
+			issueKubeConfigFn = func(_ context.Context, adminKubeConfig []byte, req kubeconfig.Request) ([]byte, time.Time, error) {
+				Expect(adminKubeConfig).To(Equal([]byte("admin")))
+				issued = req
+				return []byte("scoped"), expireTime, nil
+			}
+			DeferCleanup(func() {
+				issueKubeConfigFn = kubeconfig.NewIssuer().Issue
+			})
+
+			resp, err := deploymentServer.GetKubeConfig(context.TODO(), &deploymentpb.GetKubeConfigRequest{
+				ClusterId:  "123456",
+				Namespace:  "wordpress",
+				TtlSeconds: 3600,
 			})
 
 			Expect(err).ToNot(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.OK))
 			Expect(ok).To(BeTrue())
+			Expect(resp.KubeConfigInfo.KubeConfig).To(Equal([]byte("scoped")))
+			Expect(resp.KubeConfigInfo.ExpireTime.AsTime()).To(BeTemporally("==", expireTime))
+			Expect(issued).To(Equal(kubeconfig.Request{ClusterID: "123456", Namespace: "wordpress", TTL: time.Hour}))
+		})
+
+		It("fails due to missing namespace for a user", func() {
+			deploymentServer := NewDeploymentMustSucceed(crClient, allowAllOpaMock(), nil, nil, nil, nil, nil)
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+				"realm_access/roles", "project-1_ao-rw", "activeprojectid", "project-1"))
+
+			_, err = deploymentServer.GetKubeConfig(ctx, &deploymentpb.GetKubeConfigRequest{
+				ClusterId: "123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("namespace is required to get a kubeConfig"))
+		})
+
+		It("fails due to lifetime above the maximum of a user", func() {
+			deploymentServer := NewDeploymentMustSucceed(crClient, allowAllOpaMock(), nil, nil, nil, nil, nil)
+			ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs(
+				"realm_access/roles", "project-1_ao-rw", "activeprojectid", "project-1"))
+
+			_, err = deploymentServer.GetKubeConfig(ctx, &deploymentpb.GetKubeConfigRequest{
+				ClusterId:  "123456",
+				Namespace:  "wordpress",
+				TtlSeconds: 3600,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("kubeConfig lifetime 1h0m0s exceeds the maximum of 30m0s"))
 		})
 
 		It("fails due to secret", func() {
@@ -240,12 +311,46 @@ var _ = Describe("Gateway gRPC Service", func() {
 		})
 
 		It("successfully returns the deployment cluster", func() {
+			cluster := &deploymentv1beta1.Cluster{}
+			cluster.Status.State = deploymentv1beta1.Degraded
+			cluster.Status.FleetStatus.FleetAgentStatus.LastSeen = metav1.NewTime(time.Now().Add(-time.Minute))
+			cluster.Status.Health = &deploymentv1beta1.ClusterHealth{
+				ReadyNodes:        2,
+				TotalNodes:        3,
+				KubernetesVersion: "v1.30.6+rke2r1",
+				AgentVersion:      "v0.12.1",
+				Reasons:           []string{"1 of 3 nodes not ready: node-3"},
+			}
+			crClient.On(
+				"Get", context.Background(), VALID_CLUSTERID, mock.AnythingOfType("v1.GetOptions"),
+			).Return(cluster, nil).Once()
+
 			resp, err := deploymentServer.GetCluster(context.Background(), &deploymentpb.GetClusterRequest{
 				ClusterId: VALID_CLUSTERID,
 			})
 
 			Expect(err).Should(Succeed())
 			Expect(resp.Cluster.Name).To(Equal("test-cluster-displayname"))
+			Expect(resp.Cluster.Health.State).To(Equal("Degraded"))
+			Expect(resp.Cluster.Health.ReadyNodes).To(Equal(int32(2)))
+			Expect(resp.Cluster.Health.TotalNodes).To(Equal(int32(3)))
+			Expect(resp.Cluster.Health.KubernetesVersion).To(Equal("v1.30.6+rke2r1"))
+			Expect(resp.Cluster.Health.AgentVersion).To(Equal("v0.12.1"))
+			Expect(resp.Cluster.Health.LastSeenAgeSeconds).To(BeNumerically(">=", 60))
+			Expect(resp.Cluster.Health.Reasons).To(Equal([]string{"1 of 3 nodes not ready: node-3"}))
+		})
+
+		It("successfully returns the deployment cluster without health", func() {
+			crClient.On(
+				"Get", context.Background(), VALID_CLUSTERID, mock.AnythingOfType("v1.GetOptions"),
+			).Return((*deploymentv1beta1.Cluster)(nil), apierrors.NewNotFound(schema.GroupResource{Resource: "clusters"}, VALID_CLUSTERID)).Once()
+
+			resp, err := deploymentServer.GetCluster(context.Background(), &deploymentpb.GetClusterRequest{
+				ClusterId: VALID_CLUSTERID,
+			})
+
+			Expect(err).Should(Succeed())
+			Expect(resp.Cluster.Health).To(BeNil())
 		})
 
 		It("fails due to no cluster found", func() {
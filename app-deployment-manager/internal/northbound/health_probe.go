@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"strconv"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// validateHealthProbes checks that every health probe refers to an app of the
+// deployment package and that probe names are unique per app.
+func validateHealthProbes(d *Deployment) error {
+	apps := make(map[string]map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = make(map[string]bool)
+		}
+	}
+
+	for _, probe := range d.HealthProbes {
+		names, ok := apps[probe.AppName]
+		if !ok {
+			return errors.NewInvalid("healthProbes.appName %s not found in deployment package %s", probe.AppName, d.AppName)
+		}
+		if names[probe.Name] {
+			return errors.NewInvalid("duplicate healthProbes.name %s for app %s", probe.Name, probe.AppName)
+		}
+		names[probe.Name] = true
+	}
+	return nil
+}
+
+// healthProbes returns the health probes of the given app for the Deployment CR.
+func healthProbes(probes []*deploymentpb.HealthProbe, appName string) []deploymentv1beta1.HealthProbe {
+	var list []deploymentv1beta1.HealthProbe
+	for _, probe := range probes {
+		if probe.GetAppName() != appName {
+			continue
+		}
+
+		hp := deploymentv1beta1.HealthProbe{
+			Name:           probe.GetName(),
+			TimeoutSeconds: probe.GetTimeoutSeconds(),
+			Retries:        probe.GetRetries(),
+		}
+		switch {
+		case probe.GetHttp() != nil:
+			hp.Type = deploymentv1beta1.HealthProbeHTTP
+			hp.HTTP = &deploymentv1beta1.HTTPProbe{
+				Service:        probe.GetHttp().GetService(),
+				Namespace:      probe.GetHttp().GetNamespace(),
+				Port:           probe.GetHttp().GetPort(),
+				Path:           probe.GetHttp().GetPath(),
+				Scheme:         probe.GetHttp().GetScheme(),
+				ExpectedStatus: probe.GetHttp().GetExpectedStatus(),
+			}
+		case probe.GetJob() != nil:
+			hp.Type = deploymentv1beta1.HealthProbeJob
+			hp.Job = &deploymentv1beta1.JobProbe{
+				Image:   probe.GetJob().GetImage(),
+				Command: probe.GetJob().GetCommand(),
+				Args:    probe.GetJob().GetArgs(),
+			}
+		case probe.GetPrometheus() != nil:
+			hp.Type = deploymentv1beta1.HealthProbePrometheus
+			hp.Prometheus = &deploymentv1beta1.PrometheusProbe{
+				URL:       probe.GetPrometheus().GetUrl(),
+				Query:     probe.GetPrometheus().GetQuery(),
+				Operator:  probe.GetPrometheus().GetOperator(),
+				Threshold: strconv.FormatFloat(probe.GetPrometheus().GetThreshold(), 'g', -1, 64),
+			}
+		default:
+			continue
+		}
+		list = append(list, hp)
+	}
+	return list
+}
+
+// createHealthProbes returns the health probes of all apps of the Deployment CR.
+func createHealthProbes(apps []deploymentv1beta1.Application) []*deploymentpb.HealthProbe {
+	var list []*deploymentpb.HealthProbe
+	for _, app := range apps {
+		for _, hp := range app.HealthProbes {
+			probe := &deploymentpb.HealthProbe{
+				AppName:        app.Name,
+				Name:           hp.Name,
+				TimeoutSeconds: hp.TimeoutSeconds,
+				Retries:        hp.Retries,
+			}
+			switch {
+			case hp.HTTP != nil:
+				probe.Probe = &deploymentpb.HealthProbe_Http{
+					Http: &deploymentpb.HTTPProbe{
+						Service:        hp.HTTP.Service,
+						Namespace:      hp.HTTP.Namespace,
+						Port:           hp.HTTP.Port,
+						Path:           hp.HTTP.Path,
+						Scheme:         hp.HTTP.Scheme,
+						ExpectedStatus: hp.HTTP.ExpectedStatus,
+					},
+				}
+			case hp.Job != nil:
+				probe.Probe = &deploymentpb.HealthProbe_Job{
+					Job: &deploymentpb.JobProbe{
+						Image:   hp.Job.Image,
+						Command: hp.Job.Command,
+						Args:    hp.Job.Args,
+					},
+				}
+			case hp.Prometheus != nil:
+				threshold, _ := strconv.ParseFloat(hp.Prometheus.Threshold, 64)
+				probe.Probe = &deploymentpb.HealthProbe_Prometheus{
+					Prometheus: &deploymentpb.PrometheusProbe{
+						Url:       hp.Prometheus.URL,
+						Query:     hp.Prometheus.Query,
+						Operator:  hp.Prometheus.Operator,
+						Threshold: threshold,
+					},
+				}
+			}
+			list = append(list, probe)
+		}
+	}
+	return list
+}
+
+// createAppHealth returns the health probe results of an app, or nil if the app has no health probes.
+func createAppHealth(health *deploymentv1beta1.Health) *deploymentpb.AppHealth {
+	if health == nil {
+		return nil
+	}
+
+	appHealth := &deploymentpb.AppHealth{
+		State:  string(health.State),
+		Probes: make([]*deploymentpb.ProbeResult, len(health.Probes)),
+	}
+	for i, p := range health.Probes {
+		appHealth.Probes[i] = &deploymentpb.ProbeResult{
+			Name:    p.Name,
+			State:   string(p.State),
+			Message: p.Message,
+		}
+	}
+	return appHealth
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"google.golang.org/protobuf/types/known/structpb"
+	yaml2 "sigs.k8s.io/yaml"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/imagepolicy"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/offlinebundle"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+)
+
+// fetchChart downloads the chart tarball of an app, replaced in tests
+var fetchChart = offlinebundle.FetchChart
+
+// enforceImagePolicy checks the images of the values deployed by the Helm
+// apps, merged over the default values of their chart, against the image
+// policy and pins them to their digest in the override values. Apps whose
+// chart cannot be fetched and inspected are rejected. All violations are
+// reported at once.
+func enforceImagePolicy(ctx context.Context, d *Deployment) error {
+	policy, err := imagepolicy.FromEnv()
+	if err != nil {
+		return errors.NewInternal("%v", err)
+	}
+	if !policy.Enabled() || d.HelmApps == nil {
+		return nil
+	}
+
+	manifestApps := make(map[string]bool)
+	for _, app := range d.ManifestApps {
+		manifestApps[app.GetName()] = true
+	}
+
+	var violations []string
+	for _, app := range *d.HelmApps {
+		if manifestApps[app.Name] {
+			continue
+		}
+
+		values, err := deployedValues(app.Values, app.ImageRegistry, d.OverrideValues, app.Name)
+		if err != nil {
+			return errors.NewInvalid("invalid values of app %s: %v", app.Name, err)
+		}
+
+		chart, err := loadAppChart(ctx, app)
+		if err != nil {
+			violations = append(violations, fmt.Sprintf("app %s: chart %s cannot be inspected: %v", app.Name, app.Chart, err))
+			continue
+		}
+
+		pins, appViolations := policy.EnforceChart(ctx, chart, values, imagepolicy.Credential{
+			Registry: app.ImageRegistry,
+			Username: app.DockerCredential.Username,
+			Password: app.DockerCredential.Password,
+		})
+		for _, v := range appViolations {
+			violations = append(violations, fmt.Sprintf("app %s: %s", app.Name, v))
+		}
+
+		for _, pin := range pins {
+			for _, overrides := range []*[]*deploymentpb.OverrideValues{&d.OverrideValues, &d.OverrideValuesMasked} {
+				if err := setOverrideValue(overrides, app.Name, pin.Path, pin.Value); err != nil {
+					return errors.NewInternal("cannot pin images of app %s: %v", app.Name, err)
+				}
+			}
+		}
+	}
+
+	if len(violations) > 0 {
+		return errors.NewInvalid("image policy violations: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// loadAppChart fetches the chart of an app the way Fleet resolves it
+func loadAppChart(ctx context.Context, app catalogclient.HelmApp) (*imagepolicy.Chart, error) {
+	repo, chartURL := app.Repo, app.Chart
+	if u, err := url.Parse(repo); err == nil && u.Scheme == "oci" {
+		repo = ""
+		chartURL, _ = url.JoinPath(app.Repo, app.Chart)
+	}
+	data, err := fetchChart(ctx, repo, chartURL, app.Version, offlinebundle.ChartCredential{
+		Username: app.HelmCredential.Username,
+		Password: app.HelmCredential.Password,
+		CACerts:  app.HelmCredential.CaCerts,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return imagepolicy.LoadChart(data)
+}
+
+// deployedValues returns the profile values of an app merged with its
+// override values, as rendered by Fleet
+func deployedValues(profileValues string, imageRegistry string, overrides []*deploymentpb.OverrideValues, appName string) (map[string]interface{}, error) {
+	if u, err := url.Parse(imageRegistry); err == nil && u.Host != "" {
+		profileValues = strings.ReplaceAll(profileValues, fleet.ImageRegistryURL, u.Host+u.Path)
+	}
+
+	values := map[string]interface{}{}
+	if err := yaml2.Unmarshal([]byte(profileValues), &values); err != nil {
+		return nil, err
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	// Only the last override values of an app are deployed
+	var override *deploymentpb.OverrideValues
+	for _, o := range overrides {
+		if o.GetAppName() == appName {
+			override = o
+		}
+	}
+	if override.GetValues() != nil {
+		imagepolicy.MergeValues(values, override.GetValues().AsMap())
+	}
+	return values, nil
+}
+
+// setOverrideValue sets the value at the given key path in the override
+// values of an app, adding them if needed
+func setOverrideValue(overrides *[]*deploymentpb.OverrideValues, appName string, path []string, value interface{}) error {
+	v, err := structpb.NewValue(value)
+	if err != nil {
+		return err
+	}
+
+	var override *deploymentpb.OverrideValues
+	for _, o := range *overrides {
+		if o.GetAppName() == appName {
+			override = o
+		}
+	}
+	if override == nil {
+		override = &deploymentpb.OverrideValues{AppName: appName}
+		*overrides = append(*overrides, override)
+	}
+	if override.Values == nil {
+		override.Values = &structpb.Struct{}
+	}
+
+	s := override.Values
+	for _, k := range path[:len(path)-1] {
+		if s.Fields == nil {
+			s.Fields = map[string]*structpb.Value{}
+		}
+		next := s.Fields[k].GetStructValue()
+		if next == nil {
+			next = &structpb.Struct{}
+			s.Fields[k] = structpb.NewStructValue(next)
+		}
+		s = next
+	}
+	if s.Fields == nil {
+		s.Fields = map[string]*structpb.Value{}
+	}
+	s.Fields[path[len(path)-1]] = v
+	return nil
+}
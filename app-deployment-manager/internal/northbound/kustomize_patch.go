@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// JSON6902 operations supported by Kustomize
+var json6902Ops = map[string]bool{
+	"add":     true,
+	"remove":  true,
+	"replace": true,
+	"move":    true,
+	"copy":    true,
+	"test":    true,
+}
+
+// validateKustomizePatches checks that every patch refers to an app of the
+// deployment package, or to all apps, and that it can be applied by Kustomize.
+func validateKustomizePatches(d *Deployment) error {
+	apps := make(map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = true
+		}
+	}
+
+	for i, patch := range d.KustomizePatches {
+		if patch.GetAppName() != "" && !apps[patch.GetAppName()] {
+			return errors.NewInvalid("kustomizePatches.appName %s not found in deployment package %s", patch.GetAppName(), d.AppName)
+		}
+
+		var err error
+		switch patchType(patch.GetType()) {
+		case deploymentv1beta1.PatchJSON6902:
+			err = validateJSON6902Patch(patch)
+		default:
+			err = validateStrategicMergePatch(patch)
+		}
+		if err != nil {
+			return errors.NewInvalid("invalid kustomizePatches[%d]: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func validateStrategicMergePatch(patch *deploymentpb.KustomizePatch) error {
+	obj := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(patch.GetPatch()), &obj); err != nil {
+		return fmt.Errorf("strategic merge patch is not a YAML object: %v", err)
+	}
+	if patch.GetTarget() != nil {
+		return nil
+	}
+
+	// Without target the patch is applied to the resource it names
+	metadata, _ := obj["metadata"].(map[string]interface{})
+	if obj["apiVersion"] == nil || obj["kind"] == nil || metadata == nil || metadata["name"] == nil {
+		return fmt.Errorf("strategic merge patch without target must set apiVersion, kind and metadata.name")
+	}
+	return nil
+}
+
+func validateJSON6902Patch(patch *deploymentpb.KustomizePatch) error {
+	if patch.GetTarget().GetKind() == "" {
+		return fmt.Errorf("json6902 patch must set target.kind")
+	}
+
+	var ops []struct {
+		Op   string `json:"op"`
+		Path string `json:"path"`
+	}
+	if err := yaml.Unmarshal([]byte(patch.GetPatch()), &ops); err != nil {
+		return fmt.Errorf("json6902 patch is not a list of operations: %v", err)
+	}
+	if len(ops) == 0 {
+		return fmt.Errorf("json6902 patch has no operation")
+	}
+	for _, op := range ops {
+		if !json6902Ops[op.Op] {
+			return fmt.Errorf("json6902 patch has unsupported op %q", op.Op)
+		}
+		if !strings.HasPrefix(op.Path, "/") {
+			return fmt.Errorf("json6902 patch has invalid path %q", op.Path)
+		}
+	}
+	return nil
+}
+
+// patchType returns the patch type, defaults to strategic-merge if not set.
+func patchType(t string) deploymentv1beta1.PatchType {
+	if t == string(deploymentv1beta1.PatchJSON6902) {
+		return deploymentv1beta1.PatchJSON6902
+	}
+	return deploymentv1beta1.PatchStrategicMerge
+}
+
+// kustomizePatches returns the patches applied to the given app for the
+// Deployment CR, including the patches applied to all apps.
+func kustomizePatches(patches []*deploymentpb.KustomizePatch, appName string) []deploymentv1beta1.KustomizePatch {
+	var list []deploymentv1beta1.KustomizePatch
+	for _, patch := range patches {
+		if patch.GetAppName() != "" && patch.GetAppName() != appName {
+			continue
+		}
+
+		kp := deploymentv1beta1.KustomizePatch{
+			Type:  patchType(patch.GetType()),
+			Patch: patch.GetPatch(),
+		}
+		if t := patch.GetTarget(); t != nil {
+			kp.Target = &deploymentv1beta1.PatchTarget{
+				Group:         t.GetGroup(),
+				Version:       t.GetVersion(),
+				Kind:          t.GetKind(),
+				Name:          t.GetName(),
+				Namespace:     t.GetNamespace(),
+				LabelSelector: t.GetLabelSelector(),
+			}
+		}
+		list = append(list, kp)
+	}
+	return list
+}
+
+// createKustomizePatches returns the patches of all apps of the Deployment CR.
+// Patches applied to all apps are returned once per app.
+func createKustomizePatches(apps []deploymentv1beta1.Application) []*deploymentpb.KustomizePatch {
+	var list []*deploymentpb.KustomizePatch
+	for _, app := range apps {
+		for _, kp := range app.KustomizePatches {
+			patch := &deploymentpb.KustomizePatch{
+				AppName: app.Name,
+				Type:    string(kp.Type),
+				Patch:   kp.Patch,
+			}
+			if kp.Target != nil {
+				patch.Target = &deploymentpb.PatchTarget{
+					Group:         kp.Target.Group,
+					Version:       kp.Target.Version,
+					Kind:          kp.Target.Kind,
+					Name:          kp.Target.Name,
+					Namespace:     kp.Target.Namespace,
+					LabelSelector: kp.Target.LabelSelector,
+				}
+			}
+			list = append(list, patch)
+		}
+	}
+	return list
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+
+	catalog "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+)
+
+// validateManifestApps checks that manifest app names do not clash with the
+// apps of the deployment package, that their dependencies exist and that
+// their source can be fetched. The manifests of manifest apps referring to an
+// artifact of the deployment package are looked up and validated.
+func validateManifestApps(ctx context.Context, s *DeploymentSvc, d *Deployment, dp *catalog.DeploymentPackage) error {
+	if len(d.ManifestApps) == 0 {
+		return nil
+	}
+	// Manifest resources are not named after the release, so that they
+	// cannot be deployed twice to a cluster
+	if d.DeploymentType == string(deploymentv1beta1.BlueGreen) {
+		return errors.NewInvalid("manifestApps is not supported by deployment type %s", deploymentv1beta1.BlueGreen)
+	}
+	if d.Canary != nil {
+		return errors.NewInvalid("manifestApps is not supported by canary deployments")
+	}
+
+	apps := make(map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = true
+		}
+	}
+	manifestApps := make(map[string]bool)
+	for _, app := range d.ManifestApps {
+		if apps[app.GetName()] {
+			return errors.NewInvalid("manifestApps.name %s already used by deployment package %s", app.GetName(), d.AppName)
+		}
+		if manifestApps[app.GetName()] {
+			return errors.NewInvalid("duplicate manifestApps.name %s", app.GetName())
+		}
+		manifestApps[app.GetName()] = true
+	}
+
+	for _, override := range d.OverrideValues {
+		if manifestApps[override.GetAppName()] && override.GetValues() != nil {
+			return errors.NewInvalid("overrideValues.values is not supported by manifest app %s", override.GetAppName())
+		}
+	}
+
+	for i, app := range d.ManifestApps {
+		for _, dep := range app.GetDependsOn() {
+			if dep == app.GetName() || (!apps[dep] && !manifestApps[dep]) {
+				return errors.NewInvalid("manifestApps.dependsOn %s of app %s not found in deployment", dep, app.GetName())
+			}
+		}
+
+		if err := validateManifestSource(app); err != nil {
+			return errors.NewInvalid("invalid manifestApps[%d]: %v", i, err)
+		}
+
+		artifactName := app.GetArtifact()
+		if artifactName == "" {
+			continue
+		}
+		if _, ok := d.ManifestAppManifests[artifactName]; ok {
+			continue
+		}
+
+		artifact, err := catalogclient.CatalogLookupManifestArtifact(ctx, s.catalogClient, dp, artifactName)
+		if err != nil {
+			return errors.NewInvalid("manifestApps.artifact %s: %v", artifactName, err)
+		}
+		if _, err := fleet.ParseManifests(string(artifact.GetArtifact())); err != nil {
+			return errors.NewInvalid("manifestApps.artifact %s: %v", artifactName, err)
+		}
+		if d.ManifestAppManifests == nil {
+			d.ManifestAppManifests = make(map[string]string)
+		}
+		d.ManifestAppManifests[artifactName] = string(artifact.GetArtifact())
+	}
+	return nil
+}
+
+func validateManifestSource(app *deploymentpb.ManifestApp) error {
+	var srcPath string
+	switch {
+	case app.GetGit() != nil:
+		u, err := url.Parse(app.GetGit().GetUrl())
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") {
+			return fmt.Errorf("git url must use http or https")
+		}
+		srcPath = app.GetGit().GetPath()
+	case app.GetOci() != nil:
+		srcPath = app.GetOci().GetPath()
+	}
+
+	// The path must stay within the repository or the artifact
+	if path.IsAbs(srcPath) {
+		return fmt.Errorf("path %s must be relative", srcPath)
+	}
+	for _, elem := range strings.Split(srcPath, "/") {
+		if elem == ".." {
+			return fmt.Errorf("path %s must not leave the source root", srcPath)
+		}
+	}
+	return nil
+}
+
+// addManifestApps appends the manifest apps to the apps of the deployment
+// package, so that they are targeted and deployed like the other apps.
+func addManifestApps(d *Deployment) {
+	if len(d.ManifestApps) == 0 {
+		return
+	}
+	if d.HelmApps == nil {
+		d.HelmApps = &[]catalogclient.HelmApp{}
+	}
+	for _, app := range d.ManifestApps {
+		*d.HelmApps = append(*d.HelmApps, catalogclient.HelmApp{
+			Name:             app.GetName(),
+			Version:          d.AppVersion,
+			DependsOn:        app.GetDependsOn(),
+			DefaultNamespace: app.GetNamespace(),
+		})
+	}
+}
+
+// manifestApp returns the manifest app specification of the given app for the
+// Deployment CR, or nil if it is an app of the deployment package.
+func manifestApp(apps []*deploymentpb.ManifestApp, manifests map[string]string, appName string) *deploymentv1beta1.ManifestApp {
+	for _, app := range apps {
+		if app.GetName() != appName {
+			continue
+		}
+
+		ma := &deploymentv1beta1.ManifestApp{}
+		switch {
+		case app.GetGit() != nil:
+			ma.Git = &deploymentv1beta1.GitManifestSource{
+				Repo:     app.GetGit().GetUrl(),
+				Path:     app.GetGit().GetPath(),
+				Revision: app.GetGit().GetRevision(),
+			}
+		case app.GetOci() != nil:
+			ma.OCI = &deploymentv1beta1.OCIManifestSource{
+				URL:  app.GetOci().GetUrl(),
+				Path: app.GetOci().GetPath(),
+			}
+		default:
+			ma.Artifact = app.GetArtifact()
+			ma.Manifest = manifests[app.GetArtifact()]
+		}
+		return ma
+	}
+	return nil
+}
+
+// createManifestApps returns the manifest apps of the Deployment CR.
+func createManifestApps(apps []deploymentv1beta1.Application) []*deploymentpb.ManifestApp {
+	var list []*deploymentpb.ManifestApp
+	for _, app := range apps {
+		if app.ManifestApp == nil {
+			continue
+		}
+
+		ma := &deploymentpb.ManifestApp{
+			Name:      app.Name,
+			Namespace: app.Namespace,
+			DependsOn: app.DependsOn,
+		}
+		switch {
+		case app.ManifestApp.Git != nil:
+			ma.Source = &deploymentpb.ManifestApp_Git{
+				Git: &deploymentpb.GitManifestSource{
+					Url:      app.ManifestApp.Git.Repo,
+					Path:     app.ManifestApp.Git.Path,
+					Revision: app.ManifestApp.Git.Revision,
+				},
+			}
+		case app.ManifestApp.OCI != nil:
+			ma.Source = &deploymentpb.ManifestApp_Oci{
+				Oci: &deploymentpb.OCIManifestSource{
+					Url:  app.ManifestApp.OCI.URL,
+					Path: app.ManifestApp.OCI.Path,
+				},
+			}
+		default:
+			ma.Source = &deploymentpb.ManifestApp_Artifact{
+				Artifact: app.ManifestApp.Artifact,
+			}
+		}
+		list = append(list, ma)
+	}
+	return list
+}
@@ -20,6 +20,7 @@ import (
 	v1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
 	gomock "go.uber.org/mock/gomock"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -47,6 +48,26 @@ func (m *MockDeploymentServiceClient) EXPECT() *MockDeploymentServiceClientMockR
 	return m.recorder
 }
 
+// AbortDeployment mocks base method.
+func (m *MockDeploymentServiceClient) AbortDeployment(ctx context.Context, in *v1.AbortDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AbortDeployment", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortDeployment indicates an expected call of AbortDeployment.
+func (mr *MockDeploymentServiceClientMockRecorder) AbortDeployment(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortDeployment", reflect.TypeOf((*MockDeploymentServiceClient)(nil).AbortDeployment), varargs...)
+}
+
 // CreateDeployment mocks base method.
 func (m *MockDeploymentServiceClient) CreateDeployment(ctx context.Context, in *v1.CreateDeploymentRequest, opts ...grpc.CallOption) (*v1.CreateDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -87,6 +108,26 @@ func (mr *MockDeploymentServiceClientMockRecorder) DeleteDeployment(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployment", reflect.TypeOf((*MockDeploymentServiceClient)(nil).DeleteDeployment), varargs...)
 }
 
+// ExportOfflineBundle mocks base method.
+func (m *MockDeploymentServiceClient) ExportOfflineBundle(ctx context.Context, in *v1.ExportOfflineBundleRequest, opts ...grpc.CallOption) (v1.DeploymentService_ExportOfflineBundleClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportOfflineBundle", varargs...)
+	ret0, _ := ret[0].(v1.DeploymentService_ExportOfflineBundleClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportOfflineBundle indicates an expected call of ExportOfflineBundle.
+func (mr *MockDeploymentServiceClientMockRecorder) ExportOfflineBundle(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOfflineBundle", reflect.TypeOf((*MockDeploymentServiceClient)(nil).ExportOfflineBundle), varargs...)
+}
+
 // GetAppNamespace mocks base method.
 func (m *MockDeploymentServiceClient) GetAppNamespace(ctx context.Context, in *v1.GetAppNamespaceRequest, opts ...grpc.CallOption) (*v1.GetAppNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -107,6 +148,26 @@ func (mr *MockDeploymentServiceClientMockRecorder) GetAppNamespace(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppNamespace", reflect.TypeOf((*MockDeploymentServiceClient)(nil).GetAppNamespace), varargs...)
 }
 
+// GetDependencyGraph mocks base method.
+func (m *MockDeploymentServiceClient) GetDependencyGraph(ctx context.Context, in *v1.GetDependencyGraphRequest, opts ...grpc.CallOption) (*v1.GetDependencyGraphResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDependencyGraph", varargs...)
+	ret0, _ := ret[0].(*v1.GetDependencyGraphResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockDeploymentServiceClientMockRecorder) GetDependencyGraph(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockDeploymentServiceClient)(nil).GetDependencyGraph), varargs...)
+}
+
 // GetDeployment mocks base method.
 func (m *MockDeploymentServiceClient) GetDeployment(ctx context.Context, in *v1.GetDeploymentRequest, opts ...grpc.CallOption) (*v1.GetDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -127,6 +188,26 @@ func (mr *MockDeploymentServiceClientMockRecorder) GetDeployment(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockDeploymentServiceClient)(nil).GetDeployment), varargs...)
 }
 
+// GetDeploymentDrift mocks base method.
+func (m *MockDeploymentServiceClient) GetDeploymentDrift(ctx context.Context, in *v1.GetDeploymentDriftRequest, opts ...grpc.CallOption) (*v1.GetDeploymentDriftResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeploymentDrift", varargs...)
+	ret0, _ := ret[0].(*v1.GetDeploymentDriftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentDrift indicates an expected call of GetDeploymentDrift.
+func (mr *MockDeploymentServiceClientMockRecorder) GetDeploymentDrift(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentDrift", reflect.TypeOf((*MockDeploymentServiceClient)(nil).GetDeploymentDrift), varargs...)
+}
+
 // GetDeploymentsStatus mocks base method.
 func (m *MockDeploymentServiceClient) GetDeploymentsStatus(ctx context.Context, in *v1.GetDeploymentsStatusRequest, opts ...grpc.CallOption) (*v1.GetDeploymentsStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -207,6 +288,26 @@ func (mr *MockDeploymentServiceClientMockRecorder) ListDeploymentsPerCluster(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentsPerCluster", reflect.TypeOf((*MockDeploymentServiceClient)(nil).ListDeploymentsPerCluster), varargs...)
 }
 
+// PromoteDeployment mocks base method.
+func (m *MockDeploymentServiceClient) PromoteDeployment(ctx context.Context, in *v1.PromoteDeploymentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "PromoteDeployment", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteDeployment indicates an expected call of PromoteDeployment.
+func (mr *MockDeploymentServiceClientMockRecorder) PromoteDeployment(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteDeployment", reflect.TypeOf((*MockDeploymentServiceClient)(nil).PromoteDeployment), varargs...)
+}
+
 // UpdateDeployment mocks base method.
 func (m *MockDeploymentServiceClient) UpdateDeployment(ctx context.Context, in *v1.UpdateDeploymentRequest, opts ...grpc.CallOption) (*v1.UpdateDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -227,6 +328,130 @@ func (mr *MockDeploymentServiceClientMockRecorder) UpdateDeployment(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeployment", reflect.TypeOf((*MockDeploymentServiceClient)(nil).UpdateDeployment), varargs...)
 }
 
+// MockDeploymentService_ExportOfflineBundleClient is a mock of DeploymentService_ExportOfflineBundleClient interface.
+type MockDeploymentService_ExportOfflineBundleClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeploymentService_ExportOfflineBundleClientMockRecorder
+	isgomock struct{}
+}
+
+// MockDeploymentService_ExportOfflineBundleClientMockRecorder is the mock recorder for MockDeploymentService_ExportOfflineBundleClient.
+type MockDeploymentService_ExportOfflineBundleClientMockRecorder struct {
+	mock *MockDeploymentService_ExportOfflineBundleClient
+}
+
+// NewMockDeploymentService_ExportOfflineBundleClient creates a new mock instance.
+func NewMockDeploymentService_ExportOfflineBundleClient(ctrl *gomock.Controller) *MockDeploymentService_ExportOfflineBundleClient {
+	mock := &MockDeploymentService_ExportOfflineBundleClient{ctrl: ctrl}
+	mock.recorder = &MockDeploymentService_ExportOfflineBundleClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeploymentService_ExportOfflineBundleClient) EXPECT() *MockDeploymentService_ExportOfflineBundleClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleClient) Recv() (*v1.ExportOfflineBundleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*v1.ExportOfflineBundleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockDeploymentService_ExportOfflineBundleClient) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockDeploymentService_ExportOfflineBundleClient) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockDeploymentService_ExportOfflineBundleClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleClient)(nil).Trailer))
+}
+
 // MockDeploymentServiceServer is a mock of DeploymentServiceServer interface.
 type MockDeploymentServiceServer struct {
 	ctrl     *gomock.Controller
@@ -251,6 +476,21 @@ func (m *MockDeploymentServiceServer) EXPECT() *MockDeploymentServiceServerMockR
 	return m.recorder
 }
 
+// AbortDeployment mocks base method.
+func (m *MockDeploymentServiceServer) AbortDeployment(arg0 context.Context, arg1 *v1.AbortDeploymentRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AbortDeployment", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AbortDeployment indicates an expected call of AbortDeployment.
+func (mr *MockDeploymentServiceServerMockRecorder) AbortDeployment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AbortDeployment", reflect.TypeOf((*MockDeploymentServiceServer)(nil).AbortDeployment), arg0, arg1)
+}
+
 // CreateDeployment mocks base method.
 func (m *MockDeploymentServiceServer) CreateDeployment(arg0 context.Context, arg1 *v1.CreateDeploymentRequest) (*v1.CreateDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +521,20 @@ func (mr *MockDeploymentServiceServerMockRecorder) DeleteDeployment(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeployment", reflect.TypeOf((*MockDeploymentServiceServer)(nil).DeleteDeployment), arg0, arg1)
 }
 
+// ExportOfflineBundle mocks base method.
+func (m *MockDeploymentServiceServer) ExportOfflineBundle(arg0 *v1.ExportOfflineBundleRequest, arg1 v1.DeploymentService_ExportOfflineBundleServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportOfflineBundle", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExportOfflineBundle indicates an expected call of ExportOfflineBundle.
+func (mr *MockDeploymentServiceServerMockRecorder) ExportOfflineBundle(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportOfflineBundle", reflect.TypeOf((*MockDeploymentServiceServer)(nil).ExportOfflineBundle), arg0, arg1)
+}
+
 // GetAppNamespace mocks base method.
 func (m *MockDeploymentServiceServer) GetAppNamespace(arg0 context.Context, arg1 *v1.GetAppNamespaceRequest) (*v1.GetAppNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +550,21 @@ func (mr *MockDeploymentServiceServerMockRecorder) GetAppNamespace(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppNamespace", reflect.TypeOf((*MockDeploymentServiceServer)(nil).GetAppNamespace), arg0, arg1)
 }
 
+// GetDependencyGraph mocks base method.
+func (m *MockDeploymentServiceServer) GetDependencyGraph(arg0 context.Context, arg1 *v1.GetDependencyGraphRequest) (*v1.GetDependencyGraphResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDependencyGraph", arg0, arg1)
+	ret0, _ := ret[0].(*v1.GetDependencyGraphResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDependencyGraph indicates an expected call of GetDependencyGraph.
+func (mr *MockDeploymentServiceServerMockRecorder) GetDependencyGraph(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDependencyGraph", reflect.TypeOf((*MockDeploymentServiceServer)(nil).GetDependencyGraph), arg0, arg1)
+}
+
 // GetDeployment mocks base method.
 func (m *MockDeploymentServiceServer) GetDeployment(arg0 context.Context, arg1 *v1.GetDeploymentRequest) (*v1.GetDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -311,6 +580,21 @@ func (mr *MockDeploymentServiceServerMockRecorder) GetDeployment(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeployment", reflect.TypeOf((*MockDeploymentServiceServer)(nil).GetDeployment), arg0, arg1)
 }
 
+// GetDeploymentDrift mocks base method.
+func (m *MockDeploymentServiceServer) GetDeploymentDrift(arg0 context.Context, arg1 *v1.GetDeploymentDriftRequest) (*v1.GetDeploymentDriftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeploymentDrift", arg0, arg1)
+	ret0, _ := ret[0].(*v1.GetDeploymentDriftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeploymentDrift indicates an expected call of GetDeploymentDrift.
+func (mr *MockDeploymentServiceServerMockRecorder) GetDeploymentDrift(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeploymentDrift", reflect.TypeOf((*MockDeploymentServiceServer)(nil).GetDeploymentDrift), arg0, arg1)
+}
+
 // GetDeploymentsStatus mocks base method.
 func (m *MockDeploymentServiceServer) GetDeploymentsStatus(arg0 context.Context, arg1 *v1.GetDeploymentsStatusRequest) (*v1.GetDeploymentsStatusResponse, error) {
 	m.ctrl.T.Helper()
@@ -371,6 +655,21 @@ func (mr *MockDeploymentServiceServerMockRecorder) ListDeploymentsPerCluster(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentsPerCluster", reflect.TypeOf((*MockDeploymentServiceServer)(nil).ListDeploymentsPerCluster), arg0, arg1)
 }
 
+// PromoteDeployment mocks base method.
+func (m *MockDeploymentServiceServer) PromoteDeployment(arg0 context.Context, arg1 *v1.PromoteDeploymentRequest) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PromoteDeployment", arg0, arg1)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PromoteDeployment indicates an expected call of PromoteDeployment.
+func (mr *MockDeploymentServiceServerMockRecorder) PromoteDeployment(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PromoteDeployment", reflect.TypeOf((*MockDeploymentServiceServer)(nil).PromoteDeployment), arg0, arg1)
+}
+
 // UpdateDeployment mocks base method.
 func (m *MockDeploymentServiceServer) UpdateDeployment(arg0 context.Context, arg1 *v1.UpdateDeploymentRequest) (*v1.UpdateDeploymentResponse, error) {
 	m.ctrl.T.Helper()
@@ -386,18 +685,6 @@ func (mr *MockDeploymentServiceServerMockRecorder) UpdateDeployment(arg0, arg1 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeployment", reflect.TypeOf((*MockDeploymentServiceServer)(nil).UpdateDeployment), arg0, arg1)
 }
 
-// mustEmbedUnimplementedDeploymentServiceServer mocks base method.
-func (m *MockDeploymentServiceServer) mustEmbedUnimplementedDeploymentServiceServer() {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "mustEmbedUnimplementedDeploymentServiceServer")
-}
-
-// mustEmbedUnimplementedDeploymentServiceServer indicates an expected call of mustEmbedUnimplementedDeploymentServiceServer.
-func (mr *MockDeploymentServiceServerMockRecorder) mustEmbedUnimplementedDeploymentServiceServer() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedDeploymentServiceServer", reflect.TypeOf((*MockDeploymentServiceServer)(nil).mustEmbedUnimplementedDeploymentServiceServer))
-}
-
 // MockUnsafeDeploymentServiceServer is a mock of UnsafeDeploymentServiceServer interface.
 type MockUnsafeDeploymentServiceServer struct {
 	ctrl     *gomock.Controller
@@ -433,3 +720,123 @@ func (mr *MockUnsafeDeploymentServiceServerMockRecorder) mustEmbedUnimplementedD
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedDeploymentServiceServer", reflect.TypeOf((*MockUnsafeDeploymentServiceServer)(nil).mustEmbedUnimplementedDeploymentServiceServer))
 }
+
+// MockDeploymentService_ExportOfflineBundleServer is a mock of DeploymentService_ExportOfflineBundleServer interface.
+type MockDeploymentService_ExportOfflineBundleServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeploymentService_ExportOfflineBundleServerMockRecorder
+	isgomock struct{}
+}
+
+// MockDeploymentService_ExportOfflineBundleServerMockRecorder is the mock recorder for MockDeploymentService_ExportOfflineBundleServer.
+type MockDeploymentService_ExportOfflineBundleServerMockRecorder struct {
+	mock *MockDeploymentService_ExportOfflineBundleServer
+}
+
+// NewMockDeploymentService_ExportOfflineBundleServer creates a new mock instance.
+func NewMockDeploymentService_ExportOfflineBundleServer(ctrl *gomock.Controller) *MockDeploymentService_ExportOfflineBundleServer {
+	mock := &MockDeploymentService_ExportOfflineBundleServer{ctrl: ctrl}
+	mock.recorder = &MockDeploymentService_ExportOfflineBundleServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeploymentService_ExportOfflineBundleServer) EXPECT() *MockDeploymentService_ExportOfflineBundleServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockDeploymentService_ExportOfflineBundleServer) RecvMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) RecvMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleServer) Send(arg0 *v1.ExportOfflineBundleResponse) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) Send(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) SendHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockDeploymentService_ExportOfflineBundleServer) SendMsg(m any) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) SendMsg(m any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) SetHeader(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockDeploymentService_ExportOfflineBundleServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockDeploymentService_ExportOfflineBundleServerMockRecorder) SetTrailer(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockDeploymentService_ExportOfflineBundleServer)(nil).SetTrailer), arg0)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/appdeploymentclient/v1beta1"
 	"github.com/stretchr/testify/mock"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
@@ -37,6 +38,10 @@ func (c *FakeDeploymentV1) Clusters(namespace string) v1beta1.ClusterInterface {
 	return &FakeClusters{c, namespace}
 }
 
+func (c *FakeDeploymentV1) ClusterGroups(namespace string) v1beta1.ClusterGroupInterface {
+	return &FakeClusterGroups{c, namespace}
+}
+
 type FakeDeployments struct {
 	Fake *FakeDeploymentV1
 	ns   string
@@ -52,6 +57,11 @@ type FakeClusters struct {
 	ns   string
 }
 
+type FakeClusterGroups struct {
+	Fake *FakeDeploymentV1
+	ns   string
+}
+
 func (c *FakeDeployments) List(ctx context.Context, opts metav1.ListOptions) (*deploymentv1beta1.DeploymentList, error) {
 	args := c.Fake.MethodCalled("ListDeployments", ctx, opts)
 	return args.Get(0).(*deploymentv1beta1.DeploymentList), args.Error(1)
@@ -64,6 +74,7 @@ func (c *FakeDeployments) Get(ctx context.Context, name string, opts metav1.GetO
 
 func (c *FakeDeployments) Create(ctx context.Context, deployment *deploymentv1beta1.Deployment, opts metav1.CreateOptions) (*deploymentv1beta1.Deployment, error) {
 	// set constant values since func CreateDeployment will generate random values
+	generatedName := deployment.Name
 	deployment.Name = "test-deployment"
 	deployment.Namespace = ValidProjectID
 	deployment.Labels["app.kubernetes.io/instance"] = "test-deployment"
@@ -80,6 +91,16 @@ func (c *FakeDeployments) Create(ctx context.Context, deployment *deploymentv1be
 		deployment.Spec.Applications[0].EnableServiceExport = true
 	}
 
+	// Manifest apps are deployed next to the deployment package app
+	for i := 1; i < len(deployment.Spec.Applications); i++ {
+		app := &deployment.Spec.Applications[i]
+		app.ProfileSecretName = ""
+		app.ValueSecretName = ""
+		if app.Namespace == generatedName {
+			app.Namespace = "test-deployment"
+		}
+	}
+
 	deployment.Spec.NetworkRef = corev1.ObjectReference{
 		Name:       "network-1",
 		Kind:       "Network",
@@ -112,6 +133,11 @@ func (c *FakeDeployments) Update(ctx context.Context, name string, deployment *d
 	return args.Get(0).(*deploymentv1beta1.Deployment), args.Error(1)
 }
 
+func (c *FakeDeployments) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*deploymentv1beta1.Deployment, error) {
+	args := c.Fake.Called(ctx, name, pt, data, opts)
+	return args.Get(0).(*deploymentv1beta1.Deployment), args.Error(1)
+}
+
 func (c *FakeDeploymentClusters) Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentv1beta1.DeploymentCluster, error) {
 	args := c.Fake.Called(ctx, name, opts)
 	return args.Get(0).(*deploymentv1beta1.DeploymentCluster), args.Error(1)
@@ -132,6 +158,36 @@ func (c *FakeClusters) List(ctx context.Context, opts metav1.ListOptions) (*depl
 	return args.Get(0).(*deploymentv1beta1.ClusterList), args.Error(1)
 }
 
+func (c *FakeClusters) Update(ctx context.Context, name string, cluster *deploymentv1beta1.Cluster, opts metav1.UpdateOptions) (*deploymentv1beta1.Cluster, error) {
+	args := c.Fake.MethodCalled("UpdateCluster", ctx, name, cluster, opts)
+	return args.Get(0).(*deploymentv1beta1.Cluster), args.Error(1)
+}
+
+func (c *FakeClusterGroups) Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentv1beta1.ClusterGroup, error) {
+	args := c.Fake.MethodCalled("GetClusterGroup", ctx, name, opts)
+	return args.Get(0).(*deploymentv1beta1.ClusterGroup), args.Error(1)
+}
+
+func (c *FakeClusterGroups) List(ctx context.Context, opts metav1.ListOptions) (*deploymentv1beta1.ClusterGroupList, error) {
+	args := c.Fake.MethodCalled("ListClusterGroups", ctx, opts)
+	return args.Get(0).(*deploymentv1beta1.ClusterGroupList), args.Error(1)
+}
+
+func (c *FakeClusterGroups) Create(ctx context.Context, group *deploymentv1beta1.ClusterGroup, opts metav1.CreateOptions) (*deploymentv1beta1.ClusterGroup, error) {
+	args := c.Fake.MethodCalled("CreateClusterGroup", ctx, group, opts)
+	return args.Get(0).(*deploymentv1beta1.ClusterGroup), args.Error(1)
+}
+
+func (c *FakeClusterGroups) Update(ctx context.Context, name string, group *deploymentv1beta1.ClusterGroup, opts metav1.UpdateOptions) (*deploymentv1beta1.ClusterGroup, error) {
+	args := c.Fake.MethodCalled("UpdateClusterGroup", ctx, name, group, opts)
+	return args.Get(0).(*deploymentv1beta1.ClusterGroup), args.Error(1)
+}
+
+func (c *FakeClusterGroups) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	args := c.Fake.MethodCalled("DeleteClusterGroup", ctx, name, opts)
+	return args.Error(0)
+}
+
 type MockAuthService struct {
 	mock.Mock
 }
@@ -242,6 +298,45 @@ var DpRespGood = catalog.GetDeploymentPackageResponse{
 	},
 }
 
+var DpRespTestArtifact = catalog.GetDeploymentPackageResponse{
+	DeploymentPackage: &catalog.DeploymentPackage{
+		Name:    dpname,
+		Version: dpver,
+		ApplicationReferences: []*catalog.ApplicationReference{
+			&appRef,
+		},
+		Profiles: []*catalog.DeploymentProfile{
+			{
+				Name: dpprof,
+				ApplicationProfiles: map[string]string{
+					appname: appprof,
+				},
+			},
+		},
+		DefaultProfileName: dpprof,
+		ApplicationDependencies: []*catalog.ApplicationDependency{
+			{
+				Name:     appname,
+				Requires: "dependency",
+			},
+		},
+		Extensions: []*catalog.APIExtension{},
+		Artifacts: []*catalog.ArtifactReference{
+			{
+				Name:    "smoke-test",
+				Purpose: "test",
+			},
+			{
+				Name:    "backend",
+				Purpose: "manifest",
+			},
+		},
+		DefaultNamespaces: map[string]string{
+			appname: "test-deployment",
+		},
+	},
+}
+
 var DpAPIRespGood = catalog.GetDeploymentPackageResponse{
 	DeploymentPackage: &catalog.DeploymentPackage{
 		Name:    dpname,
@@ -436,3 +531,27 @@ var ArtiResp = catalog.ListArtifactsResponse{
 		},
 	},
 }
+
+var TestArtifactReq = &catalog.GetArtifactRequest{
+	ArtifactName: "smoke-test",
+}
+
+var TestArtifactResp = catalog.GetArtifactResponse{
+	Artifact: &catalog.Artifact{
+		Name:     "smoke-test",
+		MimeType: "application/yaml",
+		Artifact: []byte("apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: smoke-test\nspec:\n  template:\n    spec:\n      containers:\n      - name: smoke\n        image: busybox:1.36\n"),
+	},
+}
+
+var ManifestArtifactReq = &catalog.GetArtifactRequest{
+	ArtifactName: "backend",
+}
+
+var ManifestArtifactResp = catalog.GetArtifactResponse{
+	Artifact: &catalog.Artifact{
+		Name:     "backend",
+		MimeType: "application/yaml",
+		Artifact: []byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n---\napiVersion: v1\nkind: Service\nmetadata:\n  name: backend\nspec:\n  ports:\n  - port: 80\n"),
+	},
+}
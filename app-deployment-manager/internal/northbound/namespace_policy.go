@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"fmt"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// validateNamespacePolicies checks that every namespace policy refers to an
+// app of the deployment package, that there is at most one policy per app and
+// that its limits are valid Kubernetes quantities.
+func validateNamespacePolicies(d *Deployment) error {
+	apps := make(map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = false
+		}
+	}
+
+	for _, policy := range d.NamespacePolicies {
+		seen, ok := apps[policy.AppName]
+		if !ok {
+			return errors.NewInvalid("namespacePolicies.appName %s not found in deployment package %s", policy.AppName, d.AppName)
+		}
+		if seen {
+			return errors.NewInvalid("duplicate namespacePolicies for app %s", policy.AppName)
+		}
+		apps[policy.AppName] = true
+
+		for field, values := range map[string]map[string]string{
+			"resourceQuota":   policy.GetResourceQuota(),
+			"defaultLimits":   policy.GetDefaultLimits(),
+			"defaultRequests": policy.GetDefaultRequests(),
+			"maxLimits":       policy.GetMaxLimits(),
+		} {
+			if _, err := parseResourceList(values); err != nil {
+				return errors.NewInvalid("invalid namespacePolicies.%s for app %s: %v", field, policy.AppName, err)
+			}
+		}
+	}
+	return nil
+}
+
+func parseResourceList(values map[string]string) (corev1.ResourceList, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	list := corev1.ResourceList{}
+	for name, value := range values {
+		q, err := resource.ParseQuantity(value)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", name, err)
+		}
+		list[corev1.ResourceName(name)] = q
+	}
+	return list, nil
+}
+
+func formatResourceList(list corev1.ResourceList) map[string]string {
+	if len(list) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(list))
+	for name, q := range list {
+		values[string(name)] = q.String()
+	}
+	return values
+}
+
+// namespacePolicy returns the namespace policy of the given app for the
+// Deployment CR, nil if not set.
+func namespacePolicy(policies []*deploymentpb.NamespacePolicy, appName string) *deploymentv1beta1.NamespacePolicy {
+	for _, policy := range policies {
+		if policy.GetAppName() != appName {
+			continue
+		}
+
+		// Quantities were validated when the deployment was initialized
+		resourceQuota, _ := parseResourceList(policy.GetResourceQuota())
+		defaultLimits, _ := parseResourceList(policy.GetDefaultLimits())
+		defaultRequests, _ := parseResourceList(policy.GetDefaultRequests())
+		maxLimits, _ := parseResourceList(policy.GetMaxLimits())
+		return &deploymentv1beta1.NamespacePolicy{
+			ResourceQuota:    resourceQuota,
+			DefaultLimits:    defaultLimits,
+			DefaultRequests:  defaultRequests,
+			MaxLimits:        maxLimits,
+			PodSecurityLevel: policy.GetPodSecurityLevel(),
+		}
+	}
+	return nil
+}
+
+// createNamespacePolicies returns the namespace policies of all apps of the Deployment CR.
+func createNamespacePolicies(apps []deploymentv1beta1.Application) []*deploymentpb.NamespacePolicy {
+	var list []*deploymentpb.NamespacePolicy
+	for _, app := range apps {
+		if app.NamespacePolicy == nil {
+			continue
+		}
+		list = append(list, &deploymentpb.NamespacePolicy{
+			AppName:          app.Name,
+			ResourceQuota:    formatResourceList(app.NamespacePolicy.ResourceQuota),
+			DefaultLimits:    formatResourceList(app.NamespacePolicy.DefaultLimits),
+			DefaultRequests:  formatResourceList(app.NamespacePolicy.DefaultRequests),
+			MaxLimits:        formatResourceList(app.NamespacePolicy.MaxLimits),
+			PodSecurityLevel: app.NamespacePolicy.PodSecurityLevel,
+		})
+	}
+	return list
+}
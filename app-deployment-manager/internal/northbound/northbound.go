@@ -25,6 +25,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apiserver/pkg/storage/names"
+	"k8s.io/client-go/util/retry"
 	yaml2 "sigs.k8s.io/yaml"
 
 	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
@@ -44,11 +45,24 @@ type Deployment struct {
 	ProfileName                string                                             `yaml:"profileName"`
 	DeployID                   string                                             `yaml:"deployId"`
 	DeploymentType             string                                             `yaml:"deploymentType"`
+	DriftPolicy                string                                             `yaml:"driftPolicy"`
+	Priority                   string                                             `yaml:"priority"`
+	HealthProbes               []*deploymentpb.HealthProbe                        `yaml:"healthProbes"`
+	TestHooks                  []*deploymentpb.TestHook                           `yaml:"testHooks"`
+	BlueGreenServices          []*deploymentpb.BlueGreenService                   `yaml:"blueGreenServices"`
+	Canary                     *deploymentpb.Canary                               `yaml:"canary"`
+	ResourceRequests           *deploymentpb.ResourceRequests                     `yaml:"resourceRequests"`
+	NamespacePolicies          []*deploymentpb.NamespacePolicy                    `yaml:"namespacePolicies"`
+	KustomizePatches           []*deploymentpb.KustomizePatch                     `yaml:"kustomizePatches"`
+	ManifestApps               []*deploymentpb.ManifestApp                        `yaml:"manifestApps"`
+	ManifestAppManifests       map[string]string                                  `yaml:"manifestAppManifests"`
+	TestHookManifests          map[string]string                                  `yaml:"testHookManifests"`
 	Project                    string                                             `yaml:"project"`
 	ValueSecretName            map[string]string                                  `yaml:"valueSecretName"`
 	ProfileSecretName          map[string]string                                  `yaml:"profileSecretName"`
 	RepoSecretName             map[string]string                                  `yaml:"repoSecretName"`
 	ImageRegistrySecretName    map[string]string                                  `yaml:"imageRegistrySecretName"`
+	ParameterSecretName        map[string]string                                  `yaml:"parameterSecretName"`
 	Namespace                  string                                             `yaml:"namespace"`
 	HelmApps                   *[]catalogclient.HelmApp                           `yaml:"helmApps"`
 	Status                     []*deploymentpb.Deployment_Status                  `yaml:"status"`
@@ -65,6 +79,7 @@ type Deployment struct {
 	NetworkName                string                                             `yaml:"networkName"`
 	Namespaces                 []deploymentv1beta1.Namespace                      `yaml:"namespaces"`
 	ParameterTemplateSecrets   map[string]string                                  `yaml:"parameterTemplateSecret"`
+	RequestedBy                string                                             `yaml:"requestedBy"`
 }
 
 // formatAppNameValidationError creates a standardized error message for app name validation failures.
@@ -86,60 +101,29 @@ func initDeployment(ctx context.Context, s *DeploymentSvc, scenario string, in *
 	d.OverrideValues = in.GetOverrideValues()
 	d.TargetClusters = in.GetTargetClusters()
 	d.AllAppTargetClusters = in.GetAllAppTargetClusters()
+	d.HealthProbes = in.GetHealthProbes()
+	d.TestHooks = in.GetTestHooks()
+	d.BlueGreenServices = in.GetBlueGreenServices()
+	d.Canary = in.GetCanary()
+	d.ResourceRequests = in.GetResourceRequests()
+	d.NamespacePolicies = in.GetNamespacePolicies()
+	d.KustomizePatches = in.GetKustomizePatches()
+	d.ManifestApps = in.GetManifestApps()
 
 	// DeploymentType is optional as input but defaults to auto-scaling if omitted or if input is invalid
 	d.DeploymentType = string(deploymentType(in.GetDeploymentType()))
 
-	d.ActiveProjectID = activeProjectID
-
-	if (len(d.TargetClusters) == 0) && (d.AllAppTargetClusters == nil) {
-		return d, errors.NewInvalid("missing targetClusters in request")
-	}
-
-	for _, val := range d.TargetClusters {
-		if val.AppName == "" {
-			return d, errors.NewInvalid("missing targetClusters.appName in request")
-		}
-
-		if (val.Labels) == nil && val.ClusterId == "" {
-			return d, errors.NewInvalid("missing targetClusters.labels or targetClusters.clusterId in request")
-		}
+	// DriftPolicy is optional as input but defaults to alert-only if omitted
+	d.DriftPolicy = string(driftPolicy(in.GetDriftPolicy()))
 
-		if d.DeploymentType == string(deploymentv1beta1.AutoScaling) && val.Labels == nil {
-			return d, errors.NewInvalid("deployment type is auto-scaling but missing targetClusters.labels")
-		}
-
-		if d.DeploymentType == string(deploymentv1beta1.Targeted) && val.ClusterId == "" {
-			return d, errors.NewInvalid("deployment type is targeted but missing targetClusters.clusterId")
-		}
+	// Priority is optional as input but defaults to normal if omitted
+	d.Priority = string(priority(in.GetPriority()))
 
-		// Declare labels map if deployment type is targeted.
-		if d.DeploymentType == string(deploymentv1beta1.Targeted) {
-			val.Labels = make(map[string]string, 0)
-		}
-
-		val.Labels[deploymentv1beta1.ClusterOrchKeyProjectID] = d.ActiveProjectID
-	}
-
-	if d.AllAppTargetClusters != nil {
-		if (d.AllAppTargetClusters.Labels) == nil && d.AllAppTargetClusters.ClusterId == "" {
-			return d, errors.NewInvalid("missing allAppTargetClusters.labels or allAppTargetClusters.clusterId in request")
-		}
-
-		if d.DeploymentType == string(deploymentv1beta1.AutoScaling) && d.AllAppTargetClusters.Labels == nil {
-			return d, errors.NewInvalid("deployment type is auto-scaling but missing allAppTargetClusters.labels")
-		}
-
-		if d.DeploymentType == string(deploymentv1beta1.Targeted) && d.AllAppTargetClusters.ClusterId == "" {
-			return d, errors.NewInvalid("deployment type is targeted but missing allAppTargetClusters.clusterId")
-		}
-
-		// Declare labels map if deployment type is targeted.
-		if d.DeploymentType == string(deploymentv1beta1.Targeted) {
-			d.AllAppTargetClusters.Labels = make(map[string]string, 0)
-		}
+	d.ActiveProjectID = activeProjectID
+	d.RequestedBy = utils.GetRequestUser(ctx)
 
-		d.AllAppTargetClusters.Labels[deploymentv1beta1.ClusterOrchKeyProjectID] = d.ActiveProjectID
+	if err := s.initTargetClusters(ctx, d); err != nil {
+		return d, err
 	}
 
 	allOverrideKeys := make(map[string][]string)
@@ -148,7 +132,7 @@ func initDeployment(ctx context.Context, s *DeploymentSvc, scenario string, in *
 			if (val.AppName) == "" {
 				return d, errors.NewInvalid(formatAppNameValidationError(i))
 			}
-			if (val.Values) == nil && val.TargetNamespace == "" {
+			if (val.Values) == nil && val.TargetNamespace == "" && len(val.SecretRefs) == 0 {
 				return d, errors.NewInvalid("missing overrideValues.targetNamespace or overrideValues.values in request")
 			}
 
@@ -175,6 +159,44 @@ func initDeployment(ctx context.Context, s *DeploymentSvc, scenario string, in *
 
 	d.HelmApps = helmApps
 
+	// Manifest apps are validated and deployed like the apps of the deployment package
+	if err := validateManifestApps(ctx, s, d, dp); err != nil {
+		return d, err
+	}
+	addManifestApps(d)
+
+	if err := validateSecretRefs(d, allOverrideKeys); err != nil {
+		return d, err
+	}
+
+	if err := validateHealthProbes(d); err != nil {
+		return d, err
+	}
+
+	if err := validateBlueGreenServices(d); err != nil {
+		return d, err
+	}
+
+	if err := validateCanary(d); err != nil {
+		return d, err
+	}
+
+	if err := validateResourceRequests(d); err != nil {
+		return d, err
+	}
+
+	if err := validateNamespacePolicies(d); err != nil {
+		return d, err
+	}
+
+	if err := validateKustomizePatches(d); err != nil {
+		return d, err
+	}
+
+	if err := validateTestHooks(ctx, s, d, dp); err != nil {
+		return d, err
+	}
+
 	// Validate namespaces
 	if len(dp.Namespaces) > 0 {
 		nsNameRegex := regexp.MustCompile("^[a-z0-9][a-z0-9-]{0,38}[a-z0-9]{0,1}$")
@@ -264,6 +286,14 @@ func initDeployment(ctx context.Context, s *DeploymentSvc, scenario string, in *
 		return d, err
 	}
 
+	if err := checkSealingCert(d); err != nil {
+		return d, err
+	}
+
+	if err := enforceImagePolicy(ctx, d); err != nil {
+		return d, err
+	}
+
 	// if no profilename was provided, use default profilename from dp
 	if d.ProfileName == "" {
 		d.ProfileName = defaultProfileName
@@ -311,6 +341,76 @@ func initDeployment(ctx context.Context, s *DeploymentSvc, scenario string, in *
 	return d, nil
 }
 
+// initTargetClusters validates the target clusters of a deployment and sets the
+// cluster labels they match, according to the deployment type
+func (s *DeploymentSvc) initTargetClusters(ctx context.Context, d *Deployment) error {
+	if (len(d.TargetClusters) == 0) && (d.AllAppTargetClusters == nil) {
+		return errors.NewInvalid("missing targetClusters in request")
+	}
+
+	for _, val := range d.TargetClusters {
+		if val.AppName == "" {
+			return errors.NewInvalid("missing targetClusters.appName in request")
+		}
+
+		// Cluster groups are targeted the same way by all deployment types
+		if val.ClusterGroup != "" {
+			if err := s.checkClusterGroup(ctx, d.ActiveProjectID, val.ClusterGroup); err != nil {
+				return err
+			}
+			val.Labels = clusterGroupTarget(val.ClusterGroup, d.ActiveProjectID)
+			continue
+		}
+
+		if (val.Labels) == nil && val.ClusterId == "" {
+			return errors.NewInvalid("missing targetClusters.labels or targetClusters.clusterId in request")
+		}
+
+		if labelTargeted(d.DeploymentType) && val.Labels == nil {
+			return errors.NewInvalid("deployment type is %s but missing targetClusters.labels", d.DeploymentType)
+		}
+
+		if d.DeploymentType == string(deploymentv1beta1.Targeted) && val.ClusterId == "" {
+			return errors.NewInvalid("deployment type is targeted but missing targetClusters.clusterId")
+		}
+
+		// Declare labels map if deployment type is targeted.
+		if d.DeploymentType == string(deploymentv1beta1.Targeted) {
+			val.Labels = make(map[string]string, 0)
+		}
+
+		val.Labels[deploymentv1beta1.ClusterOrchKeyProjectID] = d.ActiveProjectID
+	}
+
+	if d.AllAppTargetClusters != nil && d.AllAppTargetClusters.ClusterGroup != "" {
+		if err := s.checkClusterGroup(ctx, d.ActiveProjectID, d.AllAppTargetClusters.ClusterGroup); err != nil {
+			return err
+		}
+		d.AllAppTargetClusters.Labels = clusterGroupTarget(d.AllAppTargetClusters.ClusterGroup, d.ActiveProjectID)
+	} else if d.AllAppTargetClusters != nil {
+		if (d.AllAppTargetClusters.Labels) == nil && d.AllAppTargetClusters.ClusterId == "" {
+			return errors.NewInvalid("missing allAppTargetClusters.labels or allAppTargetClusters.clusterId in request")
+		}
+
+		if labelTargeted(d.DeploymentType) && d.AllAppTargetClusters.Labels == nil {
+			return errors.NewInvalid("deployment type is %s but missing allAppTargetClusters.labels", d.DeploymentType)
+		}
+
+		if d.DeploymentType == string(deploymentv1beta1.Targeted) && d.AllAppTargetClusters.ClusterId == "" {
+			return errors.NewInvalid("deployment type is targeted but missing allAppTargetClusters.clusterId")
+		}
+
+		// Declare labels map if deployment type is targeted.
+		if d.DeploymentType == string(deploymentv1beta1.Targeted) {
+			d.AllAppTargetClusters.Labels = make(map[string]string, 0)
+		}
+
+		d.AllAppTargetClusters.Labels[deploymentv1beta1.ClusterOrchKeyProjectID] = d.ActiveProjectID
+	}
+
+	return nil
+}
+
 // Checks if label exists within Deployment.
 func (c *DeploymentInstance) checkFilter(labelCheck map[string]string) bool {
 	foundFilter := false
@@ -421,7 +521,7 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 	// For auto-scaling deployments, build a map of app names to their actual deployed cluster IDs
 	// from DeploymentCluster objects. This provides the actual cluster mapping after Fleet matching.
 	appClusterMap := make(map[string][]string)
-	if c.deployment.Spec.DeploymentType == deploymentv1beta1.AutoScaling && c.deploymentClusters != nil {
+	if labelTargeted(string(c.deployment.Spec.DeploymentType)) && c.deploymentClusters != nil {
 		currentDeploymentID := string(c.deployment.ObjectMeta.UID)
 		for _, dc := range c.deploymentClusters.Items {
 			// Only process deployment clusters that belong to THIS deployment
@@ -440,7 +540,7 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 	// For auto-scaling deployments, fetch actual cluster labels to determine which selector matched
 	// This map stores: clusterID -> actual cluster labels
 	clusterLabelsMap := make(map[string]map[string]string)
-	if c.deployment.Spec.DeploymentType == deploymentv1beta1.AutoScaling && c.deploymentClusters != nil && s.crClient != nil {
+	if labelTargeted(string(c.deployment.Spec.DeploymentType)) && c.deploymentClusters != nil && s.crClient != nil {
 		namespace := c.deployment.Namespace
 		currentDeploymentID := string(c.deployment.ObjectMeta.UID)
 
@@ -469,7 +569,18 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 	}
 
 	for _, app := range c.deployment.Spec.Applications {
-		labelCheckList := app.Targets
+		labelCheckList := make([]map[string]string, 0, len(app.Targets))
+		for _, l := range app.Targets {
+			// Cluster group targets are shown by name, their members being listed by the group
+			if group, ok := l[string(deploymentv1beta1.ClusterGroupName)]; ok {
+				targetClustersList = append(targetClustersList, &deploymentpb.TargetClusters{
+					AppName:      app.Name,
+					ClusterGroup: group,
+				})
+				continue
+			}
+			labelCheckList = append(labelCheckList, l)
+		}
 		for _, l := range labelCheckList {
 			if _, ok := l[string(deploymentv1beta1.ClusterName)]; ok {
 				// Targeted deployment - cluster ID is in the labels
@@ -478,7 +589,7 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 					ClusterId: l[string(deploymentv1beta1.ClusterName)],
 					Labels:    l,
 				})
-			} else if c.deployment.Spec.DeploymentType == deploymentv1beta1.AutoScaling {
+			} else if labelTargeted(string(c.deployment.Spec.DeploymentType)) {
 				// Auto-scaling deployment - show the selector that matched each cluster
 				if clusterIDs, found := appClusterMap[app.Name]; found && len(clusterIDs) > 0 {
 					// For auto-scaling, create one entry per matched cluster with its matching selector
@@ -543,6 +654,13 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 	for _, app := range c.deployment.Spec.Applications {
 		// App has no override values
 		if app.ValueSecretName == "" {
+			if len(app.SecretRefs) != 0 {
+				overrideValuesList = append(overrideValuesList, &deploymentpb.OverrideValues{
+					AppName:         app.Name,
+					TargetNamespace: app.Namespace,
+					SecretRefs:      createSecretRefs(app.SecretRefs),
+				})
+			}
 			continue
 		}
 
@@ -574,6 +692,7 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 			AppName:         app.Name,
 			TargetNamespace: app.Namespace,
 			Values:          valuesStrPb,
+			SecretRefs:      createSecretRefs(app.SecretRefs),
 		})
 	}
 
@@ -611,18 +730,30 @@ func (c *DeploymentInstance) createDeploymentObject(ctx context.Context, s *Depl
 
 	// Append to deployment object
 	deployResponse := &deploymentpb.Deployment{
-		Name:           c.deployment.ObjectMeta.Name,
-		DisplayName:    c.deployment.Spec.DisplayName,
-		AppName:        c.deployment.Spec.DeploymentPackageRef.Name,
-		AppVersion:     c.deployment.Spec.DeploymentPackageRef.Version,
-		ProfileName:    c.deployment.Spec.DeploymentPackageRef.ProfileName,
-		DeploymentType: string(c.deployment.Spec.DeploymentType),
-		CreateTime:     createTimePbUnix,
-		DeployId:       string(c.deployment.ObjectMeta.UID),
-		OverrideValues: overrideValuesList,
-		TargetClusters: targetClustersList,
-		Status:         status,
-		Apps:           appList,
+		Name:              c.deployment.ObjectMeta.Name,
+		DisplayName:       c.deployment.Spec.DisplayName,
+		AppName:           c.deployment.Spec.DeploymentPackageRef.Name,
+		AppVersion:        c.deployment.Spec.DeploymentPackageRef.Version,
+		ProfileName:       c.deployment.Spec.DeploymentPackageRef.ProfileName,
+		DeploymentType:    string(c.deployment.Spec.DeploymentType),
+		DriftPolicy:       string(driftPolicy(string(c.deployment.Spec.DriftPolicy))),
+		Priority:          string(priority(string(c.deployment.Spec.Priority))),
+		CreateTime:        createTimePbUnix,
+		DeployId:          string(c.deployment.ObjectMeta.UID),
+		OverrideValues:    overrideValuesList,
+		TargetClusters:    targetClustersList,
+		HealthProbes:      createHealthProbes(c.deployment.Spec.Applications),
+		TestHooks:         createTestHooks(c.deployment.Spec.Applications),
+		Status:            status,
+		Apps:              appList,
+		BlueGreenServices: createBlueGreenServices(c.deployment.Spec.Applications),
+		BlueGreenStatus:   createBlueGreenStatus(c.deployment.Status.BlueGreen),
+		Canary:            createCanary(c.deployment.Spec.Canary),
+		CanaryStatus:      createCanaryStatus(c.deployment.Status.Canary),
+		ResourceRequests:  createResourceRequests(c.deployment.Spec.ResourceRequests),
+		NamespacePolicies: createNamespacePolicies(c.deployment.Spec.Applications),
+		KustomizePatches:  createKustomizePatches(c.deployment.Spec.Applications),
+		ManifestApps:      createManifestApps(c.deployment.Spec.Applications),
 	}
 
 	return deployResponse, true
@@ -797,12 +928,6 @@ func (s *DeploymentSvc) GetDeploymentsStatus(ctx context.Context, in *deployment
 
 // Create a new deployment object and returns the new object.
 func (s *DeploymentSvc) CreateDeployment(ctx context.Context, in *deploymentpb.CreateDeploymentRequest) (*deploymentpb.CreateDeploymentResponse, error) {
-	// Handle concurrency of shared resources to allow parallel deployment creation requests with Mutex.
-	// This can prevent race conditions, creation of deployments with same deployId, data corruption and
-	// unpredictable program behaviour. Also prevents DoS on deployments created by other users, affecting availability.
-	s.apiMutex.Lock()
-	defer s.apiMutex.Unlock()
-
 	if in == nil || in.Deployment == nil {
 		log.Warnf("incomplete request")
 		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
@@ -826,6 +951,10 @@ func (s *DeploymentSvc) CreateDeployment(ctx context.Context, in *deploymentpb.C
 		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
 	}
 
+	// The deployments of a project share secrets and dependency relationships,
+	// and must have unique names. Deployments of other projects are created in parallel.
+	defer s.projectLocks.lock(activeProjectID)()
+
 	labelSelector := metav1.LabelSelector{
 		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
 	}
@@ -932,6 +1061,12 @@ func (s *DeploymentSvc) CreateDeployment(ctx context.Context, in *deploymentpb.C
 		}
 	}
 
+	insufficientCapacityClusters, err := s.checkCapacity(ctx, d)
+	if err != nil {
+		log.Warnf("cannot create deployment: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
 	d, err = createSecrets(ctx, s.k8sClient, d)
 	if err != nil {
 		log.Warnf("cannot create deployment: %v", err)
@@ -973,7 +1108,10 @@ func (s *DeploymentSvc) CreateDeployment(ctx context.Context, in *deploymentpb.C
 	}
 
 	utils.LogActivity(ctx, "create", "ADM", "deployment-name "+d.Name, "deploy-id "+d.DeployID, "deployment-app-version "+d.AppVersion)
-	return &deploymentpb.CreateDeploymentResponse{DeploymentId: d.DeployID}, nil
+	return &deploymentpb.CreateDeploymentResponse{
+		DeploymentId:                 d.DeployID,
+		InsufficientCapacityClusters: insufficientCapacityClusters,
+	}, nil
 }
 
 // Gets a deployment object and returns the object.
@@ -1190,6 +1328,8 @@ func createDeploymentInstanceCluster(dc *deploymentv1beta1.DeploymentCluster, de
 				Id:     app.Id,
 				Name:   app.Name,
 				Status: appStatus,
+				Health: createAppHealth(app.Health),
+				Tests:  createAppTests(app.Tests),
 			}
 		}
 	}
@@ -1283,12 +1423,6 @@ func (s *DeploymentSvc) ListDeployments(ctx context.Context, in *deploymentpb.Li
 
 // Deletes a deployment object and nothing is returned.
 func (s *DeploymentSvc) DeleteDeployment(ctx context.Context, in *deploymentpb.DeleteDeploymentRequest) (*emptypb.Empty, error) {
-	// Handle concurrency of shared resources to allow parallel deployment deletion requests with Mutex.
-	// This can prevent race conditions, deletion of deployments, data corruption and unpredictable
-	// program behaviour. Also prevents DoS on deployments being deleted by other users, affecting availability.
-	s.apiMutex.Lock()
-	defer s.apiMutex.Unlock()
-
 	if in == nil || in.DeplId == "" {
 		log.Warnf("incomplete request")
 		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
@@ -1317,6 +1451,9 @@ func (s *DeploymentSvc) DeleteDeployment(ctx context.Context, in *deploymentpb.D
 		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
 	}
 
+	// Deleting a deployment changes the dependency relationships of the project
+	defer s.projectLocks.lock(activeProjectID)()
+
 	labelSelector := metav1.LabelSelector{
 		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
 	}
@@ -1351,8 +1488,8 @@ func (s *DeploymentSvc) DeleteDeployment(ctx context.Context, in *deploymentpb.D
 
 	if in.DeleteType == deploymentpb.DeleteType_PARENT_ONLY {
 		// case 2
-		// delete this Deployment
-		err = s.crClient.Deployments(d.Namespace).Delete(ctx, d.Name, metav1.DeleteOptions{})
+		// delete this Deployment, unless it changed since its parents were checked
+		err = s.crClient.Deployments(d.Namespace).Delete(ctx, d.Name, deleteOptions(deployment))
 		if err != nil {
 			log.Warnf("cannot delete deployment: %v", err)
 			return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
@@ -1404,8 +1541,8 @@ func (s *DeploymentSvc) DeleteDeployment(ctx context.Context, in *deploymentpb.D
 			return nil, errors.Status(errors.NewNotFound(msg)).Err()
 		}
 
-		for k := range targetList {
-			err = s.crClient.Deployments(d.Namespace).Delete(ctx, k, metav1.DeleteOptions{})
+		for k, target := range targetList {
+			err = s.crClient.Deployments(d.Namespace).Delete(ctx, k, deleteOptions(target))
 			if err != nil {
 				log.Warnf("cannot delete deployment: %v", err)
 				return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
@@ -1419,12 +1556,6 @@ func (s *DeploymentSvc) DeleteDeployment(ctx context.Context, in *deploymentpb.D
 // Updates a Deployment object and returns the new object.
 // Deployment Name is required to update and cannot be changed.
 func (s *DeploymentSvc) UpdateDeployment(ctx context.Context, in *deploymentpb.UpdateDeploymentRequest) (*deploymentpb.UpdateDeploymentResponse, error) {
-	// Handle concurrency of shared resources to allow parallel deployment update requests with Mutex.
-	// This can prevent race conditions, updating secrets of deployments, data corruption and
-	// unpredictable program behaviour. Also prevents DoS on deployments updated by other users, affecting availability.
-	s.apiMutex.Lock()
-	defer s.apiMutex.Unlock()
-
 	if in == nil || in.Deployment == nil || in.DeplId == "" {
 		log.Warnf("incomplete request")
 		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
@@ -1448,6 +1579,9 @@ func (s *DeploymentSvc) UpdateDeployment(ctx context.Context, in *deploymentpb.U
 		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
 	}
 
+	// Updating a deployment changes its secrets and the targets of its dependencies
+	defer s.projectLocks.lock(activeProjectID)()
+
 	labelSelector := metav1.LabelSelector{
 		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
 	}
@@ -1704,54 +1838,254 @@ func (s *DeploymentSvc) ListDeploymentClusters(ctx context.Context, in *deployme
 	return resp, nil
 }
 
+// GetDeploymentDrift gets the drift of the deployed resources from the desired state, per cluster.
+func (s *DeploymentSvc) GetDeploymentDrift(ctx context.Context, in *deploymentpb.GetDeploymentDriftRequest) (*deploymentpb.GetDeploymentDriftResponse, error) {
+	if in == nil || in.DeplId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot get deployment drift: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot get deployment drift: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	activeProjectIDKey := string(deploymentv1beta1.AppOrchActiveProjectID)
+
+	labelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
+	}
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
+	}
+
+	UID := in.DeplId
+
+	deployment, err := matchUIDDeployment(ctx, UID, activeProjectID, s, listOpts)
+	if err != nil {
+		log.Warnf("cannot get deployment: %v", err)
+		return nil, errors.Status(err).Err()
+	} else if deployment.ObjectMeta.Name == "" {
+		log.Warnf("deployment id %v not found", UID)
+		return nil, errors.Status(errors.NewNotFound("deployment id %v not found", UID)).Err()
+	}
+
+	labelSelector.MatchLabels[string(deploymentv1beta1.DeploymentID)] = UID
+
+	listOpts = metav1.ListOptions{
+		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
+	}
+
+	deploymentClusters, err := s.crClient.DeploymentClusters("").List(ctx, listOpts)
+	if err != nil {
+		log.Warnf("cannot get deployment cluster: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	clusterList := make([]*deploymentpb.ClusterDrift, 0)
+	if deploymentClusters != nil {
+		for i := range deploymentClusters.Items {
+			dc := &deploymentClusters.Items[i]
+			if in.ClusterId != "" && dc.Spec.ClusterID != in.ClusterId {
+				continue
+			}
+			clusterList = append(clusterList, createClusterDrift(dc))
+		}
+	}
+
+	if in.ClusterId != "" && len(clusterList) == 0 {
+		log.Warnf("cluster id %v not found for deployment id %v", in.ClusterId, UID)
+		return nil, errors.Status(errors.NewNotFound("cluster id %v not found for deployment id %v", in.ClusterId, UID)).Err()
+	}
+
+	utils.LogActivity(ctx, "get", "Drift for A Deployment", "deployment name "+deployment.ObjectMeta.Name, "deploy id "+UID)
+	return &deploymentpb.GetDeploymentDriftResponse{
+		DriftPolicy: string(driftPolicy(string(deployment.Spec.DriftPolicy))),
+		Clusters:    clusterList,
+	}, nil
+}
+
+// GetDependencyGraph gets the deployments required by a deployment and the deployments requiring it, with their status.
+func (s *DeploymentSvc) GetDependencyGraph(ctx context.Context, in *deploymentpb.GetDependencyGraphRequest) (*deploymentpb.GetDependencyGraphResponse, error) {
+	if in == nil || in.DeplId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot get dependency graph: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot get dependency graph: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	activeProjectIDKey := string(deploymentv1beta1.AppOrchActiveProjectID)
+
+	labelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
+	}
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
+	}
+
+	UID := in.DeplId
+
+	deployment, err := matchUIDDeployment(ctx, UID, activeProjectID, s, listOpts)
+	if err != nil {
+		log.Warnf("cannot get deployment: %v", err)
+		return nil, errors.Status(err).Err()
+	} else if deployment.ObjectMeta.Name == "" {
+		log.Warnf("deployment id %v not found", UID)
+		return nil, errors.Status(errors.NewNotFound("deployment id %v not found", UID)).Err()
+	}
+
+	allDeployments, err := s.crClient.Deployments(activeProjectID).List(ctx, listOpts)
+	if err != nil {
+		log.Warnf("cannot list deployments: %v", err)
+		return nil, errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	allDeploymentMap := make(map[string]*deploymentv1beta1.Deployment)
+	for idx, depl := range allDeployments.Items {
+		allDeploymentMap[depl.Name] = &allDeployments.Items[idx]
+	}
+
+	nodes, edges, err := getDependencyGraph(deployment.Name, allDeploymentMap)
+	if err != nil {
+		log.Warnf("cannot get dependency graph of deployment id %v: %v", UID, err)
+		return nil, errors.Status(errors.NewInvalid("cannot get dependency graph of deployment id %v: %v", UID, err)).Err()
+	}
+
+	utils.LogActivity(ctx, "get", "Dependency Graph for A Deployment", "deployment name "+deployment.ObjectMeta.Name, "deploy id "+UID)
+	return &deploymentpb.GetDependencyGraphResponse{
+		Nodes: nodes,
+		Edges: edges,
+	}, nil
+}
+
+// PromoteDeployment promotes the canary stage of a deployment to all target clusters.
+func (s *DeploymentSvc) PromoteDeployment(ctx context.Context, in *deploymentpb.PromoteDeploymentRequest) (*emptypb.Empty, error) {
+	if in == nil || in.DeplId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot promote deployment: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot promote deployment: %v", err)).Err()
+	}
+
+	deployment, err := s.requestCanaryAction(ctx, in.DeplId, deploymentv1beta1.CanaryPromote)
+	if err != nil {
+		log.Warnf("cannot promote deployment: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "promote", "ADM", "deployment name "+deployment.Name, "deploy id "+in.DeplId)
+	return &emptypb.Empty{}, nil
+}
+
+// AbortDeployment aborts the canary stage of a deployment, rolling back the canary
+// clusters to the stable generation.
+func (s *DeploymentSvc) AbortDeployment(ctx context.Context, in *deploymentpb.AbortDeploymentRequest) (*emptypb.Empty, error) {
+	if in == nil || in.DeplId == "" {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot abort deployment: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot abort deployment: %v", err)).Err()
+	}
+
+	deployment, err := s.requestCanaryAction(ctx, in.DeplId, deploymentv1beta1.CanaryAbort)
+	if err != nil {
+		log.Warnf("cannot abort deployment: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "abort", "ADM", "deployment name "+deployment.Name, "deploy id "+in.DeplId)
+	return &emptypb.Empty{}, nil
+}
+
 // propagateTargetsToChildDeployments updates child deployments (dependencies) to ensure
 // they are deployed to the same clusters as the parent deployment
 func (s *DeploymentSvc) propagateTargetsToChildDeployments(ctx context.Context, parentDeployment *deploymentv1beta1.Deployment) error {
 	// For auto-scaling deployments, update child deployments with the same cluster labels/selectors
 	// For targeted deployments, collect explicit target clusters and propagate them
 
-	if parentDeployment.Spec.DeploymentType == deploymentv1beta1.AutoScaling {
-		// For auto-scaling: Update child deployments to use the same cluster selector labels
+	if labelTargeted(string(parentDeployment.Spec.DeploymentType)) {
+		// For auto-scaling and blue-green: Update child deployments to use the same cluster selector labels
 		log.Infof("Propagating cluster selector labels to child deployments (auto-scaling mode)")
 
 		for childName := range parentDeployment.Spec.ChildDeploymentList {
 			log.Infof("Updating child deployment (auto-scaling): %s", childName)
 
-			childDeployment, err := s.crClient.Deployments(parentDeployment.Namespace).Get(ctx, childName, metav1.GetOptions{})
-			if err != nil {
-				log.Warnf("Failed to get child deployment %s: %v", childName, err)
-				continue
-			}
-
-			// Update the deployment type and targets for each application in child deployment
-			updated := false
-			childDeployment.Spec.DeploymentType = deploymentv1beta1.AutoScaling
-
-			for i := range childDeployment.Spec.Applications {
-				app := &childDeployment.Spec.Applications[i]
-
-				// Get the parent app's targets (labels for auto-scaling)
-				if len(parentDeployment.Spec.Applications) > 0 {
-					parentTargets := parentDeployment.Spec.Applications[0].Targets
-
-					// For auto-scaling, targets contain cluster selector labels
-					// Replace child app targets with parent's targets to match cluster selection
-					if !reflect.DeepEqual(app.Targets, parentTargets) {
-						app.Targets = make([]map[string]string, len(parentTargets))
-						copy(app.Targets, parentTargets)
-						updated = true
-						log.Infof("Updated cluster selector labels for child app %s", app.Name)
+			err := s.updateChildDeployment(ctx, parentDeployment.Namespace, childName, func(childDeployment *deploymentv1beta1.Deployment) bool {
+				// Update the deployment type and targets for each application in child deployment
+				updated := false
+				childDeployment.Spec.DeploymentType = parentDeployment.Spec.DeploymentType
+
+				for i := range childDeployment.Spec.Applications {
+					app := &childDeployment.Spec.Applications[i]
+
+					// Get the parent app's targets (labels for auto-scaling)
+					if len(parentDeployment.Spec.Applications) > 0 {
+						parentTargets := parentDeployment.Spec.Applications[0].Targets
+
+						// For auto-scaling, targets contain cluster selector labels
+						// Replace child app targets with parent's targets to match cluster selection
+						if !reflect.DeepEqual(app.Targets, parentTargets) {
+							app.Targets = make([]map[string]string, len(parentTargets))
+							copy(app.Targets, parentTargets)
+							updated = true
+							log.Infof("Updated cluster selector labels for child app %s", app.Name)
+						}
 					}
 				}
-			}
 
-			if updated {
-				_, err = s.crClient.Deployments(parentDeployment.Namespace).Update(ctx, childName, childDeployment, metav1.UpdateOptions{})
-				if err != nil {
-					log.Warnf("Failed to update child deployment %s: %v", childName, err)
-				} else {
-					log.Infof("Successfully updated child deployment %s with cluster selector labels", childName)
-				}
+				return updated
+			})
+			if err != nil {
+				log.Warnf("Failed to update child deployment %s: %v", childName, err)
+			} else {
+				log.Infof("Successfully updated child deployment %s with cluster selector labels", childName)
 			}
 		}
 		return nil
@@ -1780,51 +2114,59 @@ func (s *DeploymentSvc) propagateTargetsToChildDeployments(ctx context.Context,
 	for childName := range parentDeployment.Spec.ChildDeploymentList {
 		log.Infof("Updating child deployment: %s", childName)
 
-		// Get the child deployment
-		childDeployment, err := s.crClient.Deployments(parentDeployment.Namespace).Get(ctx, childName, metav1.GetOptions{})
-		if err != nil {
-			log.Warnf("Failed to get child deployment %s: %v", childName, err)
-			continue
-		}
+		err := s.updateChildDeployment(ctx, parentDeployment.Namespace, childName, func(childDeployment *deploymentv1beta1.Deployment) bool {
+			// Update targets for all applications in the child deployment
+			updated := false
+			for i := range childDeployment.Spec.Applications {
+				app := &childDeployment.Spec.Applications[i]
 
-		// Update targets for all applications in the child deployment
-		updated := false
-		for i := range childDeployment.Spec.Applications {
-			app := &childDeployment.Spec.Applications[i]
-
-			// Add missing targets to the child application
-			for _, newTarget := range uniqueTargets {
-				targetExists := false
-				newClusterName := newTarget[string(deploymentv1beta1.ClusterName)]
-
-				// Check if this target already exists
-				for _, existingTarget := range app.Targets {
-					existingClusterName := existingTarget[string(deploymentv1beta1.ClusterName)]
-					if existingClusterName == newClusterName {
-						targetExists = true
-						break
+				// Add missing targets to the child application
+				for _, newTarget := range uniqueTargets {
+					targetExists := false
+					newClusterName := newTarget[string(deploymentv1beta1.ClusterName)]
+
+					// Check if this target already exists
+					for _, existingTarget := range app.Targets {
+						existingClusterName := existingTarget[string(deploymentv1beta1.ClusterName)]
+						if existingClusterName == newClusterName {
+							targetExists = true
+							break
+						}
 					}
-				}
 
-				// Add the target if it doesn't exist
-				if !targetExists {
-					app.Targets = append(app.Targets, newTarget)
-					updated = true
-					log.Infof("Added cluster %s to child app %s", newClusterName, app.Name)
+					// Add the target if it doesn't exist
+					if !targetExists {
+						app.Targets = append(app.Targets, newTarget)
+						updated = true
+						log.Infof("Added cluster %s to child app %s", newClusterName, app.Name)
+					}
 				}
 			}
-		}
-
-		// Update the child deployment if targets were modified
-		if updated {
-			_, err = s.crClient.Deployments(parentDeployment.Namespace).Update(ctx, childName, childDeployment, metav1.UpdateOptions{})
-			if err != nil {
-				log.Warnf("Failed to update child deployment %s: %v", childName, err)
-			} else {
-				log.Infof("Successfully updated child deployment %s with new targets", childName)
-			}
+			return updated
+		})
+		if err != nil {
+			log.Warnf("Failed to update child deployment %s: %v", childName, err)
+		} else {
+			log.Infof("Successfully updated child deployment %s with new targets", childName)
 		}
 	}
 
 	return nil
 }
+
+// updateChildDeployment applies update to the latest version of a child
+// deployment, retried on conflict with a concurrent update of the child.
+// update returns false if the child is unchanged.
+func (s *DeploymentSvc) updateChildDeployment(ctx context.Context, namespace string, childName string, update func(*deploymentv1beta1.Deployment) bool) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		childDeployment, err := s.crClient.Deployments(namespace).Get(ctx, childName, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		if !update(childDeployment) {
+			return nil
+		}
+		_, err = s.crClient.Deployments(namespace).Update(ctx, childName, childDeployment, metav1.UpdateOptions{})
+		return err
+	})
+}
@@ -5,13 +5,22 @@
 package northbound
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"path"
+	"reflect"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/openpolicyagent"
 	"go.uber.org/mock/gomock"
@@ -27,6 +36,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 
 	"google.golang.org/protobuf/types/known/structpb"
@@ -44,7 +54,9 @@ import (
 	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
 	mockerymock "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient/mockery"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/offlinebundle"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 const apiVersion = "v1.1"
@@ -75,6 +87,69 @@ type DeployServer struct {
 	ctx              context.Context
 }
 
+// matchParameterSecret matches the expected Deployment CR whose first app
+// refers to the secret holding its secret parameters, named after the
+// generated deployment name
+func matchParameterSecret(expected *deploymentv1beta1.Deployment) interface{} {
+	return mock.MatchedBy(func(d *deploymentv1beta1.Deployment) bool {
+		if len(d.Spec.Applications) == 0 || !strings.HasSuffix(d.Spec.Applications[0].ParameterSecretName, "-wordpress-default-secret") {
+			return false
+		}
+		want := expected.DeepCopy()
+		want.Spec.Applications[0].ParameterSecretName = d.Spec.Applications[0].ParameterSecretName
+		return reflect.DeepEqual(want, d)
+	})
+}
+
+// stubChart serves a chart tarball with the given files to the image policy
+// and returns the function restoring the chart fetcher
+func stubChart(files map[string]string) func() {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg})).To(Succeed())
+		_, err := tw.Write([]byte(content))
+		Expect(err).ToNot(HaveOccurred())
+	}
+	Expect(tw.Close()).To(Succeed())
+	Expect(gz.Close()).To(Succeed())
+
+	fetch := fetchChart
+	fetchChart = func(context.Context, string, string, string, offlinebundle.ChartCredential) ([]byte, error) {
+		return buf.Bytes(), nil
+	}
+	return func() { fetchChart = fetch }
+}
+
+// exportStream collects the messages streamed by ExportOfflineBundle
+type exportStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	sent []*deploymentpb.ExportOfflineBundleResponse
+	err  error
+}
+
+func (s *exportStream) Context() context.Context { return s.ctx }
+
+func (s *exportStream) Send(m *deploymentpb.ExportOfflineBundleResponse) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+// stubChartError fails the chart downloads of the image policy and returns
+// the function restoring the chart fetcher
+func stubChartError(err error) func() {
+	fetch := fetchChart
+	fetchChart = func(context.Context, string, string, string, offlinebundle.ChartCredential) ([]byte, error) {
+		return nil, err
+	}
+	return func() { fetchChart = fetch }
+}
+
 var _ = Describe("Gateway gRPC Service", func() {
 	var (
 		deploymentServer         *DeploymentSvc
@@ -213,6 +288,213 @@ var _ = Describe("Gateway gRPC Service", func() {
 			Expect(ok).To(BeTrue())
 			Expect(s.Message()).Should(Equal("cannot get deployment clusters: access denied (3)"))
 		})
+
+		It("GetDeploymentDrift: fails due to access denied", func() {
+			_, err := deploymentServer.GetDeploymentDrift(ctx, &deploymentpb.GetDeploymentDriftRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot get deployment drift: access denied (3)"))
+		})
+
+		It("ExportOfflineBundle: fails due to access denied", func() {
+			err := deploymentServer.ExportOfflineBundle(&deploymentpb.ExportOfflineBundleRequest{
+				DeplId:    VALID_UID,
+				ClusterId: VALID_CLUSTERID,
+			}, &exportStream{ctx: ctx})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot export offline bundle: access denied (3)"))
+		})
+
+		It("GetDependencyGraph: fails due to access denied", func() {
+			_, err := deploymentServer.GetDependencyGraph(ctx, &deploymentpb.GetDependencyGraphRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot get dependency graph: access denied (3)"))
+		})
+
+		It("PromoteDeployment: fails due to access denied", func() {
+			_, err := deploymentServer.PromoteDeployment(ctx, &deploymentpb.PromoteDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot promote deployment: access denied (3)"))
+		})
+
+		It("AbortDeployment: fails due to access denied", func() {
+			_, err := deploymentServer.AbortDeployment(ctx, &deploymentpb.AbortDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot abort deployment: access denied (3)"))
+		})
+
+		It("CreateClusterGroup: fails due to access denied", func() {
+			_, err := deploymentServer.CreateClusterGroup(ctx, &deploymentpb.CreateClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{Name: "west", Clusters: []string{VALID_CLUSTERID}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot create cluster group: access denied (3)"))
+		})
+
+		It("ListClusterGroups: fails due to access denied", func() {
+			_, err := deploymentServer.ListClusterGroups(ctx, &deploymentpb.ListClusterGroupsRequest{})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot list cluster groups: access denied (3)"))
+		})
+
+		It("GetClusterGroup: fails due to access denied", func() {
+			_, err := deploymentServer.GetClusterGroup(ctx, &deploymentpb.GetClusterGroupRequest{
+				Name: "west",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot get cluster group: access denied (3)"))
+		})
+
+		It("UpdateClusterGroup: fails due to access denied", func() {
+			_, err := deploymentServer.UpdateClusterGroup(ctx, &deploymentpb.UpdateClusterGroupRequest{
+				Name:         "west",
+				ClusterGroup: &deploymentpb.ClusterGroup{Name: "west", Clusters: []string{VALID_CLUSTERID}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot update cluster group: access denied (3)"))
+		})
+
+		It("DeleteClusterGroup: fails due to access denied", func() {
+			_, err := deploymentServer.DeleteClusterGroup(ctx, &deploymentpb.DeleteClusterGroupRequest{
+				Name: "west",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot delete cluster group: access denied (3)"))
+		})
+
+		It("CordonCluster: fails due to access denied", func() {
+			_, err := deploymentServer.CordonCluster(ctx, &deploymentpb.CordonClusterRequest{
+				ClusterId: VALID_CLUSTERID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot cordon cluster: access denied (3)"))
+		})
+
+		It("UncordonCluster: fails due to access denied", func() {
+			_, err := deploymentServer.UncordonCluster(ctx, &deploymentpb.UncordonClusterRequest{
+				ClusterId: VALID_CLUSTERID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot uncordon cluster: access denied (3)"))
+		})
+
+		It("DrainCluster: fails due to access denied", func() {
+			_, err := deploymentServer.DrainCluster(ctx, &deploymentpb.DrainClusterRequest{
+				ClusterId: VALID_CLUSTERID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot drain cluster: access denied (3)"))
+		})
+
+		It("GetClusterInventory: fails due to access denied", func() {
+			_, err := deploymentServer.GetClusterInventory(ctx, &deploymentpb.GetClusterInventoryRequest{
+				ClusterId: VALID_CLUSTERID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot get cluster inventory: access denied (3)"))
+		})
+
+		It("ExportInventory: fails due to access denied", func() {
+			_, err := deploymentServer.ExportInventory(ctx, &deploymentpb.ExportInventoryRequest{
+				Format: "csv",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot export inventory: access denied (3)"))
+		})
+
+		It("PreviewClusterGroup: fails due to access denied", func() {
+			_, err := deploymentServer.PreviewClusterGroup(ctx, &deploymentpb.PreviewClusterGroupRequest{
+				ClusterGroup: &deploymentpb.ClusterGroup{Name: "west", Clusters: []string{VALID_CLUSTERID}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot preview cluster group: access denied (3)"))
+		})
+
+		It("PreviewTargets: fails due to access denied", func() {
+			_, err := deploymentServer.PreviewTargets(ctx, &deploymentpb.PreviewTargetsRequest{
+				TargetClusters: []*deploymentpb.TargetClusters{{
+					AppName: "wordpress",
+					Labels:  map[string]string{"region": "west"},
+				}},
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.PermissionDenied))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot preview targets: access denied (3)"))
+		})
 	})
 
 	Describe("Gateway API ListDeploymentClusters", func() {
@@ -344,531 +626,537 @@ var _ = Describe("Gateway gRPC Service", func() {
 		})
 	})
 
-	Describe("Gateway API Deployment Status", func() {
+	Describe("Gateway API ExportOfflineBundle", func() {
 		BeforeEach(func() {
-			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
-
-			s.kc = mockK8Client(ts.URL)
-
-			// protovalidate Validator
-
-			s.k8sClient = &nbmocks.FakeDeploymentV1{}
-			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
 			// populates a mock deployment object
 			setDeploymentListObjects(&deploymentListSrc)
 			setDeploymentClusterListObject(&deploymentClusterListSrc)
+		})
 
-			s.k8sClient.On(
+		It("fails due to unknown cluster", func() {
+			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentListSrc, nil).Once()
 
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentClusterListSrc, nil).Once()
 
-			// Mock Cluster.Get() for selector matching in auto-scaling deployments
-			s.k8sClient.On(
-				"Get", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("v1.GetOptions"),
-			).Return(&deploymentv1beta1.Cluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: VALID_CLUSTERID,
-					Labels: map[string]string{
-						"app": "test",
-						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
-					},
-				},
-			}, nil).Maybe()
-		})
+			err := deploymentServer.ExportOfflineBundle(&deploymentpb.ExportOfflineBundleRequest{
+				DeplId:    VALID_UID,
+				ClusterId: "cluster-unknown",
+			}, &exportStream{ctx: context.Background()})
 
-		It("successfully returns all deployments status with no filter labels", func() {
-			deploymentListSrc.Items[0].Status.Summary.Total = 2
-			deploymentListSrc.Items[0].Status.Summary.Running = 2
-			deploymentListSrc.Items[0].Status.Summary.Down = 0
-			deploymentListSrc.Items[0].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[0].Status.State = "Running"
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cluster id cluster-unknown not found for deployment id 123456-123456-123456"))
+		})
 
-			deploymentListSrc.Items[1].Status.Summary.Total = 3
-			deploymentListSrc.Items[1].Status.Summary.Running = 2
-			deploymentListSrc.Items[1].Status.Summary.Down = 1
-			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[1].Status.State = "Down"
+		It("fails due to missing clusterId", func() {
+			err := deploymentServer.ExportOfflineBundle(&deploymentpb.ExportOfflineBundleRequest{
+				DeplId: VALID_UID,
+			}, &exportStream{ctx: context.Background()})
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 4
-			deploymentListSrc.Items[2].Status.Summary.Running = 3
-			deploymentListSrc.Items[2].Status.Summary.Down = 1
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Down"
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
 
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
-			})
+		It("fails due to invalid clusterId", func() {
+			err := deploymentServer.ExportOfflineBundle(&deploymentpb.ExportOfflineBundleRequest{
+				DeplId:    VALID_UID,
+				ClusterId: "Invalid_Cluster",
+			}, &exportStream{ctx: context.Background()})
 
-			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(9)))
-			Expect((resp.Running)).To(Equal(int32(7)))
-			Expect((resp.Down)).To(Equal(int32(2)))
-			Expect((resp.Unknown)).To(Equal(int32(0)))
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
 		})
 
-		It("successfully returns all deployments status with no filter labels 1 deployment", func() {
-			deploymentListSrc.Items[0].Status.Summary.Total = 2
-			deploymentListSrc.Items[0].Status.Summary.Running = 1
-			deploymentListSrc.Items[0].Status.Summary.Down = 0
-			deploymentListSrc.Items[0].Status.Summary.Unknown = 1
-			deploymentListSrc.Items[0].Status.State = "Unknown"
+		It("streams the archive in chunks", func() {
+			stream := &exportStream{ctx: context.Background()}
+			sender := &offlineBundleSender{stream: stream, fileName: "test-deployment-cluster-1-2.tar.gz", generation: 2}
 
-			deploymentListSrc.Items[1].Status.Summary.Total = 1
-			deploymentListSrc.Items[1].Status.Summary.Running = 1
-			deploymentListSrc.Items[1].Status.Summary.Down = 0
-			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[1].Status.State = "Running"
+			archive := bytes.Repeat([]byte("a"), 2*offlineBundleChunkSize+10)
+			n, err := sender.Write(archive[:10])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(10))
+			_, err = sender.Write(archive[10:])
+			Expect(err).ToNot(HaveOccurred())
+			Expect(sender.Flush()).To(Succeed())
+
+			Expect(stream.sent).To(HaveLen(3))
+			Expect(stream.sent[0].FileName).To(Equal("test-deployment-cluster-1-2.tar.gz"))
+			Expect(stream.sent[0].Generation).To(Equal(int32(2)))
+			var received []byte
+			for i, m := range stream.sent {
+				if i > 0 {
+					Expect(m.FileName).To(BeEmpty())
+				}
+				Expect(len(m.Archive)).To(BeNumerically("<=", offlineBundleChunkSize))
+				received = append(received, m.Archive...)
+			}
+			Expect(received).To(Equal(archive))
+		})
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 0
-			deploymentListSrc.Items[2].Status.Summary.Running = 0
-			deploymentListSrc.Items[2].Status.Summary.Down = 0
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Running"
+		It("fails when the archive cannot be streamed", func() {
+			stream := &exportStream{ctx: context.Background(), err: errors.New("stream closed")}
+			sender := &offlineBundleSender{stream: stream}
 
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
-			})
+			_, err := sender.Write(bytes.Repeat([]byte("a"), offlineBundleChunkSize))
+			Expect(errors.Is(err, errOfflineBundleStream)).To(BeTrue())
+		})
 
-			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(3)))
-			Expect((resp.Running)).To(Equal(int32(2)))
-			Expect((resp.Down)).To(Equal(int32(0)))
-			Expect((resp.Unknown)).To(Equal(int32(1)))
+		It("records the exported generation in the annotation only", func() {
+			deployment := &deploymentv1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-deployment",
+					Namespace:  VALID_PROJECT_ID,
+					Generation: 4,
+				},
+			}
+			latest := deployment.DeepCopy()
+			latest.ResourceVersion = "7"
+			latest.Generation = 5
+			latest.Annotations = map[string]string{string(deploymentv1beta1.OfflineBundles): `{"cluster-a":1}`}
+
+			k8sClient.On("Get", context.Background(), "test-deployment", metav1.GetOptions{}).Return(latest, nil).Once()
+			k8sClient.On("Patch", context.Background(), "test-deployment", types.MergePatchType,
+				[]byte(`{"metadata":{"annotations":{"app.edge-orchestrator.intel.com/offline-bundles":"{\"cluster-a\":1,\"cluster-b\":4}"},"resourceVersion":"7"}}`),
+				metav1.PatchOptions{}).Return(latest, nil).Once()
+
+			Expect(deploymentServer.recordOfflineBundle(context.Background(), deployment, "cluster-b")).To(Succeed())
+			k8sClient.AssertExpectations(GinkgoT())
+		})
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 1
-			deploymentListSrc.Items[2].Status.Summary.Running = 0
-			deploymentListSrc.Items[2].Status.Summary.Down = 1
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Deploying"
+		It("retries the record on conflict", func() {
+			deployment := &deploymentv1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-deployment",
+					Namespace:  VALID_PROJECT_ID,
+					Generation: 4,
+				},
+			}
+			conflict := apierrors.NewConflict(schema.GroupResource{Resource: "deployments"}, "test-deployment", errors.New("modified"))
 
-			s.k8sClient.On(
-				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			k8sClient.On("Get", context.Background(), "test-deployment", metav1.GetOptions{}).Return(deployment, nil).Twice()
+			k8sClient.On("Patch", context.Background(), "test-deployment", types.MergePatchType,
+				mock.Anything, metav1.PatchOptions{}).Return(deployment, conflict).Once()
+			k8sClient.On("Patch", context.Background(), "test-deployment", types.MergePatchType,
+				mock.Anything, metav1.PatchOptions{}).Return(deployment, nil).Once()
 
-			s.k8sClient.On(
-				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			Expect(deploymentServer.recordOfflineBundle(context.Background(), deployment, "cluster-b")).To(Succeed())
+			k8sClient.AssertExpectations(GinkgoT())
+		})
+	})
 
-			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
-			})
+	Describe("Gateway API GetDeploymentDrift", func() {
+		BeforeEach(func() {
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
-			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(4)))
-			Expect((resp.Running)).To(Equal(int32(2)))
-			Expect((resp.Down)).To(Equal(int32(0)))
-			Expect((resp.Unknown)).To(Equal(int32(1)))
-			Expect((resp.Deploying)).To(Equal(int32(1)))
+			// populates a mock deployment object
+			setDeploymentListObjects(&deploymentListSrc)
+			setDeploymentClusterListObject(&deploymentClusterListSrc)
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 1
-			deploymentListSrc.Items[2].Status.Summary.Running = 0
-			deploymentListSrc.Items[2].Status.Summary.Down = 1
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Updating"
+			deploymentClusterListSrc.Items[0].Status.Apps[1].Drift = &deploymentv1beta1.Drift{
+				Modified: 1,
+				Orphaned: 1,
+				Resources: []deploymentv1beta1.DriftedResource{
+					{
+						Kind:       "ConfigMap",
+						APIVersion: "v1",
+						Namespace:  "test-ns",
+						Name:       "test-cm",
+						Type:       deploymentv1beta1.DriftModified,
+						Patch:      `{"data":{"foo":"bar"}}`,
+					},
+					{
+						Kind:       "Service",
+						APIVersion: "v1",
+						Namespace:  "test-ns",
+						Name:       "test-svc",
+						Type:       deploymentv1beta1.DriftOrphaned,
+					},
+				},
+			}
+		})
 
-			s.k8sClient.On(
+		It("successfully returns drift of all clusters", func() {
+			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentListSrc, nil).Once()
 
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentClusterListSrc, nil).Once()
 
-			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			resp, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(4)))
-			Expect((resp.Running)).To(Equal(int32(2)))
-			Expect((resp.Down)).To(Equal(int32(0)))
-			Expect((resp.Unknown)).To(Equal(int32(1)))
-			Expect((resp.Updating)).To(Equal(int32(1)))
+			Expect(resp.DriftPolicy).To(Equal(string(deploymentv1beta1.AlertOnly)))
+			Expect(len(resp.Clusters)).To(Equal(3))
+			Expect(resp.Clusters[0].Id).To(Equal(VALID_CLUSTERID))
+			Expect(resp.Clusters[0].Drifted).To(BeTrue())
+			Expect(resp.Clusters[1].Drifted).To(BeFalse())
+
+			Expect(len(resp.Clusters[0].Apps)).To(Equal(1))
+			appDrift := resp.Clusters[0].Apps[0]
+			Expect(appDrift.Name).To(Equal("test1"))
+			Expect(appDrift.Modified).To(Equal(int32(1)))
+			Expect(appDrift.Missing).To(Equal(int32(0)))
+			Expect(appDrift.Orphaned).To(Equal(int32(1)))
+			Expect(len(appDrift.Resources)).To(Equal(2))
+			Expect(appDrift.Resources[0].Type).To(Equal("Modified"))
+			Expect(appDrift.Resources[0].Patch).To(Equal(`{"data":{"foo":"bar"}}`))
+			Expect(appDrift.Resources[1].Type).To(Equal("Orphaned"))
+			Expect(appDrift.Resources[1].Name).To(Equal("test-svc"))
+		})
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 1
-			deploymentListSrc.Items[2].Status.Summary.Running = 0
-			deploymentListSrc.Items[2].Status.Summary.Down = 1
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Terminating"
+		It("successfully returns drift of a single cluster with auto-correct policy", func() {
+			deploymentListSrc.Items[0].Spec.DriftPolicy = deploymentv1beta1.AutoCorrect
 
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentListSrc, nil).Once()
 
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentClusterListSrc, nil).Once()
 
-			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			resp, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId:    VALID_UID,
+				ClusterId: VALID_CLUSTERID,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(4)))
-			Expect((resp.Running)).To(Equal(int32(2)))
-			Expect((resp.Down)).To(Equal(int32(0)))
-			Expect((resp.Unknown)).To(Equal(int32(1)))
-			Expect((resp.Terminating)).To(Equal(int32(1)))
+			Expect(resp.DriftPolicy).To(Equal(string(deploymentv1beta1.AutoCorrect)))
+			Expect(len(resp.Clusters)).To(Equal(1))
+			Expect(resp.Clusters[0].Name).To(Equal("test-cluster-displayname"))
+			Expect(resp.Clusters[0].Drifted).To(BeTrue())
 		})
 
-		It("fails due to deployment LIST error", func() {
-			defer ts.Close()
-
-			k8sClient := &nbmocks.FakeDeploymentV1{}
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
-
+		It("fails due to unknown cluster", func() {
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, errors.New("mock err")).Once()
+			).Return(&deploymentListSrc, nil).Once()
 
 			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentClusterListSrc, nil).Once()
 
-			_, err := deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId:    VALID_UID,
+				ClusterId: "cluster-unknown",
 			})
+
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(s.Code()).To(Equal(codes.NotFound))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("mock err"))
+			Expect(s.Message()).Should(Equal("cluster id cluster-unknown not found for deployment id 123456-123456-123456"))
 		})
 
-		It("fails due to deployment cluster LIST error", func() {
-			defer ts.Close()
+		It("fails due to missing deplId", func() {
+			_, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId: "",
+			})
 
-			k8sClient := &nbmocks.FakeDeploymentV1{}
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+
+		It("fails due to invalid clusterId", func() {
+			_, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId:    VALID_UID,
+				ClusterId: "Invalid_Cluster",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
 
+		It("fails due to deployment cluster LIST error", func() {
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentListSrc, nil).Once()
 
 			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, errors.New("mock err deployment cluster")).Once()
+			).Return(&deploymentClusterListSrc, errors.New("mock deployment cluster list err")).Once()
 
-			_, err := deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.GetDeploymentDrift(context.Background(), &deploymentpb.GetDeploymentDriftRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.Unknown))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("mock err deployment cluster"))
+			Expect(s.Message()).Should(Equal("mock deployment cluster list err"))
 		})
-
 	})
 
-	Describe("Gateway API Deployment Status Filter", func() {
-		var doesMatch = make(map[string]string)
-		var doesNotMatch = make(map[string]string)
-
+	Describe("Gateway API GetDependencyGraph", func() {
 		BeforeEach(func() {
-			s.k8sClient = &nbmocks.FakeDeploymentV1{}
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
-			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
+			// populates a mock dependency chain: test-deployment -> platform -> base
+			setDeploymentListObjects(&deploymentListSrc)
 
-			s.kc = mockK8Client(ts.URL)
+			deploymentListSrc.Items[1].ObjectMeta.Name = "platform"
+			deploymentListSrc.Items[1].ObjectMeta.UID = types.UID("platform-uid")
+			deploymentListSrc.Items[1].Status.State = deploymentv1beta1.Running
+			deploymentListSrc.Items[2].ObjectMeta.Name = "base"
+			deploymentListSrc.Items[2].ObjectMeta.UID = types.UID("base-uid")
+			deploymentListSrc.Items[2].Status.State = deploymentv1beta1.Deploying
 
-			// protovalidate Validator
+			deploymentListSrc.Items[0].Spec.ChildDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"platform": {DeploymentName: "platform"},
+			}
+			deploymentListSrc.Items[1].Spec.ChildDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"base": {DeploymentName: "base"},
+			}
+			deploymentListSrc.Items[1].Status.ParentDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"test-deployment": {DeploymentName: "test-deployment"},
+			}
+			deploymentListSrc.Items[2].Status.ParentDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"platform": {DeploymentName: "platform"},
+			}
+		})
 
-			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
+		It("successfully returns the required deployments of the root deployment", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Twice()
 
-			// populates a mock deployment object
-			setDeploymentListObjects(&deploymentListSrc)
-			setDeploymentClusterListObject(&deploymentClusterListSrc)
+			resp, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: VALID_UID,
+			})
 
-			s.k8sClient.On(
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Nodes)).To(Equal(3))
+			Expect(resp.Nodes[0].Name).To(Equal("base"))
+			Expect(resp.Nodes[0].Status.State).To(Equal(deploymentpb.State_DEPLOYING))
+			Expect(resp.Nodes[1].Name).To(Equal("platform"))
+			Expect(resp.Nodes[1].Status.State).To(Equal(deploymentpb.State_RUNNING))
+			Expect(resp.Nodes[2].DeployId).To(Equal(VALID_UID))
+
+			Expect(len(resp.Edges)).To(Equal(2))
+			Expect(resp.Edges[0].From).To(Equal("platform-uid"))
+			Expect(resp.Edges[0].To).To(Equal("base-uid"))
+			Expect(resp.Edges[1].From).To(Equal(VALID_UID))
+			Expect(resp.Edges[1].To).To(Equal("platform-uid"))
+		})
+
+		It("successfully returns the deployments requiring the leaf deployment", func() {
+			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentListSrc, nil).Twice()
 
-			s.k8sClient.On(
-				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			resp, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: "base-uid",
+			})
 
-			// Mock Cluster.Get() for selector matching with filter labels
-			s.k8sClient.On(
-				"Get", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("v1.GetOptions"),
-			).Return(&deploymentv1beta1.Cluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: VALID_CLUSTERID,
-					Labels: map[string]string{
-						"hello": "world",
-						"test":  "foo",
-						"mock":  "go",
-						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
-					},
-				},
-			}, nil).Maybe()
-			matchingLabelList = append(matchingLabelList, "hello=world")
-			matchingLabelList = append(matchingLabelList, "test=foo")
-			matchingLabelList = append(matchingLabelList, "mock=go")
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Nodes)).To(Equal(3))
+			Expect(len(resp.Edges)).To(Equal(2))
 		})
 
-		It("Filter: successfully returns all deployments status with matching filter labels", func() {
-			deploymentListSrc.Items[0].Status.Summary.Total = 2
-			deploymentListSrc.Items[0].Status.Summary.Running = 1
-			deploymentListSrc.Items[0].Status.Summary.Down = 1
-			deploymentListSrc.Items[0].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[0].Status.State = "Running"
+		It("successfully returns a single node without dependencies", func() {
+			deploymentListSrc.Items[0].Spec.ChildDeploymentList = nil
+			deploymentListSrc.Items[1].Status.ParentDeploymentList = nil
 
-			deploymentListSrc.Items[1].Status.Summary.Total = 3
-			deploymentListSrc.Items[1].Status.Summary.Running = 2
-			deploymentListSrc.Items[1].Status.Summary.Down = 1
-			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[1].Status.State = "Running"
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Twice()
 
-			deploymentListSrc.Items[2].Status.Summary.Total = 4
-			deploymentListSrc.Items[2].Status.Summary.Running = 3
-			deploymentListSrc.Items[2].Status.Summary.Down = 1
-			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
-			deploymentListSrc.Items[2].Status.State = "Running"
-
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			resp, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(9)))
-			Expect((resp.Running)).To(Equal(int32(6)))
-			Expect((resp.Down)).To(Equal(int32(3)))
-			Expect((resp.Unknown)).To(Equal(int32(0)))
+			Expect(len(resp.Nodes)).To(Equal(1))
+			Expect(len(resp.Edges)).To(Equal(0))
 		})
 
-		It("Filter: successfully returns 1 out of 3 deployments status in random order due matching target label", func() {
-			doesMatch["hello"] = "world"
-			doesNotMatch["foo"] = "world"
-
-			for i := 0; i < 3; i++ {
-				// 1st and 3rd deployments don't match
-				currLabel := []map[string]string{doesNotMatch}
-				if i == 1 {
-					currLabel = []map[string]string{doesMatch}
-				}
-
-				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
-				deploymentListSrc.Items[i].UID = types.UID(fmt.Sprintf("132456-56123-%d", i))
-				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
-
-				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
-				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
-				deploymentListSrc.Items[i].Status.Summary.Down = 1
-				deploymentListSrc.Items[i].Status.State = "Running"
-			}
-
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+		It("fails due to missing deplId", func() {
+			_, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: "",
 			})
 
-			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(3)))
-			Expect((resp.Running)).To(Equal(int32(2)))
-			Expect((resp.Down)).To(Equal(int32(1)))
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("incomplete request"))
 		})
 
-		It("Filter: successfully returns of 2 out of 3 deployments status in random order due matching target label", func() {
-			doesMatch["hello"] = "world"
-			doesMatch["not"] = "valid"
-			doesNotMatch["foo"] = "world"
+		It("fails due to deployment not found", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
 
-			for i := 0; i < 3; i++ {
-				// 2nd deployment doesn't match
-				currLabel := []map[string]string{doesNotMatch}
-				if i == 0 || i == 2 {
-					currLabel = []map[string]string{doesMatch}
-				}
+			_, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: "unknown-uid",
+			})
 
-				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
-				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("deployment id unknown-uid not found"))
+		})
 
-				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
-				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
-				deploymentListSrc.Items[i].Status.Summary.Down = 1
-				deploymentListSrc.Items[i].Status.State = "Running"
-			}
+		It("fails due to deployment LIST error", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, errors.New("mock deployment list err")).Once()
 
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.GetDependencyGraph(context.Background(), &deploymentpb.GetDependencyGraphRequest{
+				DeplId: VALID_UID,
 			})
 
-			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(6)))
-			Expect((resp.Running)).To(Equal(int32(4)))
-			Expect((resp.Down)).To(Equal(int32(2)))
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("mock deployment list err"))
 		})
+	})
 
-		It("Filter: successfully returns 2 match label out of 3 deployments status with some labels not matching", func() {
-			doesMatch["hello"] = "world"
-			doesMatch["not"] = "valid"
-			doesNotMatch["foo"] = "world"
-
-			for i := 0; i < 3; i++ {
-				// 3rd deployment doesn't match
-				currLabel := []map[string]string{doesNotMatch}
-				if i == 0 || i == 1 {
-					currLabel = []map[string]string{doesMatch}
-				}
+	Describe("Gateway API PromoteDeployment/AbortDeployment", func() {
+		canaryAction := func(action string) interface{} {
+			return mock.MatchedBy(func(d *deploymentv1beta1.Deployment) bool {
+				return d.Annotations[string(deploymentv1beta1.CanaryAction)] == action
+			})
+		}
 
-				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
-				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+		BeforeEach(func() {
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
-				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
-				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
-				deploymentListSrc.Items[i].Status.Summary.Down = 1
-				deploymentListSrc.Items[i].Status.State = "Running"
+			setDeploymentListObjects(&deploymentListSrc)
+			deploymentListSrc.Items[0].Status.Canary = &deploymentv1beta1.CanaryStatus{
+				Phase:    deploymentv1beta1.CanaryObserving,
+				Clusters: []string{"cluster-1"},
 			}
+		})
 
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+		It("successfully requests the promotion of the canary stage", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
+			k8sClient.On(
+				"Update", context.Background(), mock.AnythingOfType("string"), canaryAction(deploymentv1beta1.CanaryPromote), mock.AnythingOfType("v1.UpdateOptions"),
+			).Return(&deploymentListSrc.Items[0], nil).Once()
+
+			_, err := deploymentServer.PromoteDeployment(context.Background(), &deploymentpb.PromoteDeploymentRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(5)))
-			Expect((resp.Running)).To(Equal(int32(3)))
-			Expect((resp.Down)).To(Equal(int32(2)))
 		})
 
-		It("Filter: successfully returns 0 for all deployments status due no matching target label", func() {
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tester=foo")
+		It("successfully requests the abort of the canary stage", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
+			k8sClient.On(
+				"Update", context.Background(), mock.AnythingOfType("string"), canaryAction(deploymentv1beta1.CanaryAbort), mock.AnythingOfType("v1.UpdateOptions"),
+			).Return(&deploymentListSrc.Items[0], nil).Once()
 
-			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.AbortDeployment(context.Background(), &deploymentpb.AbortDeploymentRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect((resp.Total)).To(Equal(int32(0)))
-			Expect((resp.Running)).To(Equal(int32(0)))
-			Expect((resp.Down)).To(Equal(int32(0)))
 		})
 
-		It("Filter: fails due to invalid pattern uppercase char in target label", func() {
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tesTer=foo")
+		It("fails due to no canary stage in progress", func() {
+			deploymentListSrc.Items[0].Status.Canary.Phase = deploymentv1beta1.CanaryPromoted
 
-			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
+
+			_, err := deploymentServer.AbortDeployment(context.Background(), &deploymentpb.AbortDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Aborted))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("cannot abort deployment " + VALID_UID + " - no canary stage in progress"))
+		})
+
+		It("fails due to missing deplId", func() {
+			_, err := deploymentServer.PromoteDeployment(context.Background(), &deploymentpb.PromoteDeploymentRequest{
+				DeplId: "",
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
-				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+			Expect(s.Message()).Should(Equal("incomplete request"))
 		})
 
-		It("Filter: fails due to invalid pattern non alphanumeric in target label", func() {
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tes?er=foo")
+		It("fails due to deployment not found", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
 
-			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.PromoteDeployment(context.Background(), &deploymentpb.PromoteDeploymentRequest{
+				DeplId: "unknown-uid",
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Code()).To(Equal(codes.NotFound))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
-				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+			Expect(s.Message()).Should(Equal("deployment id unknown-uid not found"))
 		})
 
-		It("Filter: fails due to invalid pattern whitespace in target label", func() {
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tester=foo ")
+		It("fails due to deployment UPDATE error", func() {
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentListSrc, nil).Once()
+			k8sClient.On(
+				"Update", context.Background(), mock.AnythingOfType("string"), mock.Anything, mock.AnythingOfType("v1.UpdateOptions"),
+			).Return((*deploymentv1beta1.Deployment)(nil), errors.New("mock deployment update err")).Once()
 
-			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
-				Labels: matchingLabelList,
+			_, err := deploymentServer.PromoteDeployment(context.Background(), &deploymentpb.PromoteDeploymentRequest{
+				DeplId: VALID_UID,
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Code()).To(Equal(codes.Unknown))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
-				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+			Expect(s.Message()).Should(Equal("mock deployment update err"))
 		})
 	})
 
-	Describe("Gateway API List", func() {
+	Describe("Gateway API Deployment Status", func() {
 		BeforeEach(func() {
-			s.k8sClient = &nbmocks.FakeDeploymentV1{}
-
 			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
@@ -876,16 +1164,15 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Expect(err).ToNot(HaveOccurred())
 			}))
 
-			// defer ts.Close()
-
 			s.kc = mockK8Client(ts.URL)
 
 			// protovalidate Validator
 
+			s.k8sClient = &nbmocks.FakeDeploymentV1{}
 			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
 
 			// populates a mock deployment object
-			setDeploymentListObject(&deploymentListSrc)
+			setDeploymentListObjects(&deploymentListSrc)
 			setDeploymentClusterListObject(&deploymentClusterListSrc)
 
 			s.k8sClient.On(
@@ -904,73 +1191,94 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			// clear list
-			matchingLabelList = matchingLabelList[:0]
-		})
-
-		It("successfully returns a populated list with no filter labels", func() {
-			resp, err := s.deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
-				Labels: matchingLabelList,
-			})
-
-			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(1))
-			Expect(resp.Deployments[0].Name).To(Equal("test-deployment"))
+			// Mock Cluster.Get() for selector matching in auto-scaling deployments
+			s.k8sClient.On(
+				"Get", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("v1.GetOptions"),
+			).Return(&deploymentv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: VALID_CLUSTERID,
+					Labels: map[string]string{
+						"app": "test",
+						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
+					},
+				},
+			}, nil).Maybe()
 		})
 
-		It("successfully returns an empty list with no filter labels", func() {
-			k8sClient = &nbmocks.FakeDeploymentV1{}
-			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
+		It("successfully returns all deployments status with no filter labels", func() {
+			deploymentListSrc.Items[0].Status.Summary.Total = 2
+			deploymentListSrc.Items[0].Status.Summary.Running = 2
+			deploymentListSrc.Items[0].Status.Summary.Down = 0
+			deploymentListSrc.Items[0].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[0].Status.State = "Running"
 
-			k8sClient.On(
-				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+			deploymentListSrc.Items[1].Status.Summary.Total = 3
+			deploymentListSrc.Items[1].Status.Summary.Running = 2
+			deploymentListSrc.Items[1].Status.Summary.Down = 1
+			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[1].Status.State = "Down"
 
-			k8sClient.On(
-				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			deploymentListSrc.Items[2].Status.Summary.Total = 4
+			deploymentListSrc.Items[2].Status.Summary.Running = 3
+			deploymentListSrc.Items[2].Status.Summary.Down = 1
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Down"
 
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(0))
+			Expect((resp.Total)).To(Equal(int32(9)))
+			Expect((resp.Running)).To(Equal(int32(7)))
+			Expect((resp.Down)).To(Equal(int32(2)))
+			Expect((resp.Unknown)).To(Equal(int32(0)))
 		})
 
-		It("fails due to PageSize is over 500", func() {
-			_, err := s.deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
-				PageSize: 600,
-			})
-
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
-			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("validation error:\n - page_size: value must be greater than " +
-				"or equal to 0 and less than or equal to 500 [int32.gte_lte]"))
-		})
+		It("successfully returns all deployments status with no filter labels 1 deployment", func() {
+			deploymentListSrc.Items[0].Status.Summary.Total = 2
+			deploymentListSrc.Items[0].Status.Summary.Running = 1
+			deploymentListSrc.Items[0].Status.Summary.Down = 0
+			deploymentListSrc.Items[0].Status.Summary.Unknown = 1
+			deploymentListSrc.Items[0].Status.State = "Unknown"
 
-		It("fails due deployment LIST error", func() {
-			defer ts.Close()
+			deploymentListSrc.Items[1].Status.Summary.Total = 1
+			deploymentListSrc.Items[1].Status.Summary.Running = 1
+			deploymentListSrc.Items[1].Status.Summary.Down = 0
+			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[1].Status.State = "Running"
 
-			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentListSrc.Items[2].Status.Summary.Total = 0
+			deploymentListSrc.Items[2].Status.Summary.Running = 0
+			deploymentListSrc.Items[2].Status.Summary.Down = 0
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Running"
 
-			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
+				Labels: matchingLabelList,
+			})
 
-			k8sClient.On(
+			Expect(err).Should(Succeed())
+			Expect((resp.Total)).To(Equal(int32(3)))
+			Expect((resp.Running)).To(Equal(int32(2)))
+			Expect((resp.Down)).To(Equal(int32(0)))
+			Expect((resp.Unknown)).To(Equal(int32(1)))
+
+			deploymentListSrc.Items[2].Status.Summary.Total = 1
+			deploymentListSrc.Items[2].Status.Summary.Running = 0
+			deploymentListSrc.Items[2].Status.Summary.Down = 1
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Deploying"
+
+			s.k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
 				TypeMeta: deploymentListSrc.TypeMeta,
 				Items:    deploymentListSrc.Items,
-			}, errors.New("mock deployment err")).Once()
+			}, nil).Once()
 
-			k8sClient.On(
+			s.k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentClusterList{
 				ListMeta: deploymentClusterListSrc.ListMeta,
@@ -978,29 +1286,24 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.Unknown))
-			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("mock deployment err"))
-		})
-	})
-
-	Describe("Gateway API Filter List", func() {
-		BeforeEach(func() {
-			k8sClient = &nbmocks.FakeDeploymentV1{}
-			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
-
-			// populates a mock deployment object
-			setDeploymentListObject(&deploymentListSrc)
+			Expect(err).Should(Succeed())
+			Expect((resp.Total)).To(Equal(int32(4)))
+			Expect((resp.Running)).To(Equal(int32(2)))
+			Expect((resp.Down)).To(Equal(int32(0)))
+			Expect((resp.Unknown)).To(Equal(int32(1)))
+			Expect((resp.Deploying)).To(Equal(int32(1)))
 
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[2].Status.Summary.Total = 1
+			deploymentListSrc.Items[2].Status.Summary.Running = 0
+			deploymentListSrc.Items[2].Status.Summary.Down = 1
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Updating"
 
-			k8sClient.On(
+			s.k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
@@ -1008,27 +1311,32 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentListSrc.Items,
 			}, nil).Once()
 
-			matchingLabelList = append(matchingLabelList, "hello=world")
-			matchingLabelList = append(matchingLabelList, "test=foo")
-			matchingLabelList = append(matchingLabelList, "mock=go")
-		})
-
-		It("Filter: successfully returns a list with matching target label", func() {
-			k8sClient := &nbmocks.FakeDeploymentV1{}
+			s.k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
 
-			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
+			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
+				Labels: matchingLabelList,
+			})
 
-			defer ts.Close()
+			Expect(err).Should(Succeed())
+			Expect((resp.Total)).To(Equal(int32(4)))
+			Expect((resp.Running)).To(Equal(int32(2)))
+			Expect((resp.Down)).To(Equal(int32(0)))
+			Expect((resp.Unknown)).To(Equal(int32(1)))
+			Expect((resp.Updating)).To(Equal(int32(1)))
 
-			kc := mockK8Client(ts.URL)
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kc, nil, nil, nil, nil)
+			deploymentListSrc.Items[2].Status.Summary.Total = 1
+			deploymentListSrc.Items[2].Status.Summary.Running = 0
+			deploymentListSrc.Items[2].Status.Summary.Down = 1
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Terminating"
 
-			k8sClient.On(
+			s.k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
@@ -1036,7 +1344,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentListSrc.Items,
 			}, nil).Once()
 
-			k8sClient.On(
+			s.k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentClusterList{
 				ListMeta: deploymentClusterListSrc.ListMeta,
@@ -1044,20 +1352,23 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "test=foo")
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err = s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(1))
-			Expect(resp.Deployments[0].Name).To(Equal("test-deployment"))
+			Expect((resp.Total)).To(Equal(int32(4)))
+			Expect((resp.Running)).To(Equal(int32(2)))
+			Expect((resp.Down)).To(Equal(int32(0)))
+			Expect((resp.Unknown)).To(Equal(int32(1)))
+			Expect((resp.Terminating)).To(Equal(int32(1)))
 		})
 
-		It("Filter: successfully returns an empty list due no matching target label", func() {
+		It("fails due to deployment LIST error", func() {
+			defer ts.Close()
+
 			k8sClient := &nbmocks.FakeDeploymentV1{}
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
 
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1065,7 +1376,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 				ListMeta: deploymentListSrc.ListMeta,
 				TypeMeta: deploymentListSrc.TypeMeta,
 				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			}, errors.New("mock err")).Once()
 
 			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1075,38 +1386,21 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tester=foo")
-
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			_, err := deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
-
-			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(0))
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("mock err"))
 		})
 
-		It("Filter: successfully returns a list of 1 out of 3 in random order due matching target label", func() {
-			// populates a mock deployment object
-			setDeploymentListObjects(&deploymentListSrc)
-
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-
-			k8sClient := &nbmocks.FakeDeploymentV1{}
-
-			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
-
+		It("fails due to deployment cluster LIST error", func() {
 			defer ts.Close()
 
-			kc := mockK8Client(ts.URL)
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kc, nil, nil, nil, nil)
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
 
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1122,57 +1416,46 @@ var _ = Describe("Gateway gRPC Service", func() {
 				ListMeta: deploymentClusterListSrc.ListMeta,
 				TypeMeta: deploymentClusterListSrc.TypeMeta,
 				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
-
-			var doesMatch = make(map[string]string)
-			doesMatch["hello"] = "world"
-
-			var doesNotMatch = make(map[string]string)
-			doesNotMatch["foo"] = "world"
-
-			for i := 0; i < 3; i++ {
-				// 1st and 3rd deployments don't match
-				currLabel := []map[string]string{doesNotMatch}
-				if i == 1 {
-					currLabel = []map[string]string{doesMatch}
-				}
-
-				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
-				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
-			}
+			}, errors.New("mock err deployment cluster")).Once()
 
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			_, err := deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
-			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(1))
-			Expect(resp.Deployments[0].Name).To(Equal("test1"))
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("mock err deployment cluster"))
 		})
 
-		It("Filter: successfully returns a list of 2 out of 3 in random order due matching target label", func() {
-			// populates a mock deployment object
-			setDeploymentListObjects(&deploymentListSrc)
+	})
 
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+	Describe("Gateway API Deployment Status Filter", func() {
+		var doesMatch = make(map[string]string)
+		var doesNotMatch = make(map[string]string)
 
-			k8sClient := &nbmocks.FakeDeploymentV1{}
+		BeforeEach(func() {
+			s.k8sClient = &nbmocks.FakeDeploymentV1{}
 
-			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
 				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
 				Expect(err).ToNot(HaveOccurred())
 			}))
 
-			defer ts.Close()
+			s.kc = mockK8Client(ts.URL)
 
-			kClient := mockK8Client(ts.URL)
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
+			// protovalidate Validator
 
-			k8sClient.On(
+			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
+
+			// populates a mock deployment object
+			setDeploymentListObjects(&deploymentListSrc)
+			setDeploymentClusterListObject(&deploymentClusterListSrc)
+
+			s.k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
@@ -1180,7 +1463,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentListSrc.Items,
 			}, nil).Once()
 
-			k8sClient.On(
+			s.k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentClusterList{
 				ListMeta: deploymentClusterListSrc.ListMeta,
@@ -1188,145 +1471,120 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			var doesMatch = make(map[string]string)
-			doesMatch["hello"] = "world"
-			doesMatch["not"] = "valid"
-
-			var doesNotMatch = make(map[string]string)
-			doesNotMatch["foo"] = "world"
+			// Mock Cluster.Get() for selector matching with filter labels
+			s.k8sClient.On(
+				"Get", mock.Anything, mock.AnythingOfType("string"), mock.AnythingOfType("v1.GetOptions"),
+			).Return(&deploymentv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: VALID_CLUSTERID,
+					Labels: map[string]string{
+						"hello": "world",
+						"test":  "foo",
+						"mock":  "go",
+						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
+					},
+				},
+			}, nil).Maybe()
+			matchingLabelList = append(matchingLabelList, "hello=world")
+			matchingLabelList = append(matchingLabelList, "test=foo")
+			matchingLabelList = append(matchingLabelList, "mock=go")
+		})
 
-			for i := 0; i < 3; i++ {
-				// 2nd deployment doesn't match
-				currLabel := []map[string]string{doesNotMatch}
-				if i == 0 || i == 2 {
-					currLabel = []map[string]string{doesMatch}
-				}
+		It("Filter: successfully returns all deployments status with matching filter labels", func() {
+			deploymentListSrc.Items[0].Status.Summary.Total = 2
+			deploymentListSrc.Items[0].Status.Summary.Running = 1
+			deploymentListSrc.Items[0].Status.Summary.Down = 1
+			deploymentListSrc.Items[0].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[0].Status.State = "Running"
 
-				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
-				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
-			}
+			deploymentListSrc.Items[1].Status.Summary.Total = 3
+			deploymentListSrc.Items[1].Status.Summary.Running = 2
+			deploymentListSrc.Items[1].Status.Summary.Down = 1
+			deploymentListSrc.Items[1].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[1].Status.State = "Running"
 
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			deploymentListSrc.Items[2].Status.Summary.Total = 4
+			deploymentListSrc.Items[2].Status.Summary.Running = 3
+			deploymentListSrc.Items[2].Status.Summary.Down = 1
+			deploymentListSrc.Items[2].Status.Summary.Unknown = 0
+			deploymentListSrc.Items[2].Status.State = "Running"
+
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(2))
-			Expect(resp.Deployments[0].Name).To(Equal("test0"))
-			Expect(resp.Deployments[1].Name).To(Equal("test2"))
+			Expect((resp.Total)).To(Equal(int32(9)))
+			Expect((resp.Running)).To(Equal(int32(6)))
+			Expect((resp.Down)).To(Equal(int32(3)))
+			Expect((resp.Unknown)).To(Equal(int32(0)))
 		})
 
-		It("Filter: successfully returns a list of 2 out of 3 due matching target label", func() {
-			// populates a mock deployment object
-			setDeploymentListObjects(&deploymentListSrc)
-
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-
-			k8sClient := &nbmocks.FakeDeploymentV1{}
-
-			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
-
-			defer ts.Close()
-
-			kClient := mockK8Client(ts.URL)
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
-
-			k8sClient.On(
-				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
-
-			k8sClient.On(
-				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
-
-			var doesMatch = make(map[string]string)
+		It("Filter: successfully returns 1 out of 3 deployments status in random order due matching target label", func() {
 			doesMatch["hello"] = "world"
-
-			var doesNotMatch = make(map[string]string)
 			doesNotMatch["foo"] = "world"
 
 			for i := 0; i < 3; i++ {
-				// 3rd deployment doesn't match
+				// 1st and 3rd deployments don't match
 				currLabel := []map[string]string{doesNotMatch}
-				if i == 0 || i == 1 {
+				if i == 1 {
 					currLabel = []map[string]string{doesMatch}
 				}
 
 				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].UID = types.UID(fmt.Sprintf("132456-56123-%d", i))
 				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+
+				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
+				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
+				deploymentListSrc.Items[i].Status.Summary.Down = 1
+				deploymentListSrc.Items[i].Status.State = "Running"
 			}
 
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(2))
-			Expect(resp.Deployments[0].Name).To(Equal("test0"))
-			Expect(resp.Deployments[1].Name).To(Equal("test1"))
+			Expect((resp.Total)).To(Equal(int32(3)))
+			Expect((resp.Running)).To(Equal(int32(2)))
+			Expect((resp.Down)).To(Equal(int32(1)))
 		})
 
-		It("Filter: successfully returns 2 match label out of 3 with some labels not matching", func() {
-			// populates a mock deployment object
-			setDeploymentListObjects(&deploymentListSrc)
-
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-
-			k8sClient := &nbmocks.FakeDeploymentV1{}
-
-			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
-
-			defer ts.Close()
+		It("Filter: successfully returns of 2 out of 3 deployments status in random order due matching target label", func() {
+			doesMatch["hello"] = "world"
+			doesMatch["not"] = "valid"
+			doesNotMatch["foo"] = "world"
 
-			kClient := mockK8Client(ts.URL)
+			for i := 0; i < 3; i++ {
+				// 2nd deployment doesn't match
+				currLabel := []map[string]string{doesNotMatch}
+				if i == 0 || i == 2 {
+					currLabel = []map[string]string{doesMatch}
+				}
 
-			Expect(err).ToNot(HaveOccurred())
+				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
 
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
+				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
+				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
+				deploymentListSrc.Items[i].Status.Summary.Down = 1
+				deploymentListSrc.Items[i].Status.State = "Running"
+			}
 
-			k8sClient.On(
-				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
+				Labels: matchingLabelList,
+			})
 
-			k8sClient.On(
-				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentClusterList{
-				ListMeta: deploymentClusterListSrc.ListMeta,
-				TypeMeta: deploymentClusterListSrc.TypeMeta,
-				Items:    deploymentClusterListSrc.Items,
-			}, nil).Once()
+			Expect(err).Should(Succeed())
+			Expect((resp.Total)).To(Equal(int32(6)))
+			Expect((resp.Running)).To(Equal(int32(4)))
+			Expect((resp.Down)).To(Equal(int32(2)))
+		})
 
-			var doesMatch = make(map[string]string)
+		It("Filter: successfully returns 2 match label out of 3 deployments status with some labels not matching", func() {
 			doesMatch["hello"] = "world"
 			doesMatch["not"] = "valid"
-
-			var doesNotMatch = make(map[string]string)
 			doesNotMatch["foo"] = "world"
 
 			for i := 0; i < 3; i++ {
@@ -1338,23 +1596,42 @@ var _ = Describe("Gateway gRPC Service", func() {
 
 				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
 				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+
+				deploymentListSrc.Items[i].Status.Summary.Total = i + 2
+				deploymentListSrc.Items[i].Status.Summary.Running = i + 1
+				deploymentListSrc.Items[i].Status.Summary.Down = 1
+				deploymentListSrc.Items[i].Status.State = "Running"
 			}
 
-			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
 			Expect(err).Should(Succeed())
-			Expect(len(resp.Deployments)).To(Equal(2))
-			Expect(resp.Deployments[0].Name).To(Equal("test0"))
-			Expect(resp.Deployments[1].Name).To(Equal("test1"))
+			Expect((resp.Total)).To(Equal(int32(5)))
+			Expect((resp.Running)).To(Equal(int32(3)))
+			Expect((resp.Down)).To(Equal(int32(2)))
 		})
 
-		It("Filter: fails due to invalid pattern whitespace in target label", func() {
+		It("Filter: successfully returns 0 for all deployments status due no matching target label", func() {
 			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tester=foo ")
+			matchingLabelList = append(matchingLabelList, "tester=foo")
 
-			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			resp, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).Should(Succeed())
+			Expect((resp.Total)).To(Equal(int32(0)))
+			Expect((resp.Running)).To(Equal(int32(0)))
+			Expect((resp.Down)).To(Equal(int32(0)))
+		})
+
+		It("Filter: fails due to invalid pattern uppercase char in target label", func() {
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "tesTer=foo")
+
+			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
@@ -1370,7 +1647,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			matchingLabelList = matchingLabelList[:0]
 			matchingLabelList = append(matchingLabelList, "tes?er=foo")
 
-			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
@@ -1382,11 +1659,11 @@ var _ = Describe("Gateway gRPC Service", func() {
 				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
 		})
 
-		It("Filter: fails due to invalid pattern uppercase char in target label", func() {
+		It("Filter: fails due to invalid pattern whitespace in target label", func() {
 			matchingLabelList = matchingLabelList[:0]
-			matchingLabelList = append(matchingLabelList, "tesTer=foo")
+			matchingLabelList = append(matchingLabelList, "tester=foo ")
 
-			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+			_, err := s.deploymentServer.GetDeploymentsStatus(context.Background(), &deploymentpb.GetDeploymentsStatusRequest{
 				Labels: matchingLabelList,
 			})
 
@@ -1399,18 +1676,28 @@ var _ = Describe("Gateway gRPC Service", func() {
 		})
 	})
 
-	Describe("Gateway API Delete", func() {
+	Describe("Gateway API List", func() {
 		BeforeEach(func() {
 			s.k8sClient = &nbmocks.FakeDeploymentV1{}
 
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			// defer ts.Close()
+
+			s.kc = mockK8Client(ts.URL)
+
 			// protovalidate Validator
 
-			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, nil, nil, nil, nil, nil)
+			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
 
 			// populates a mock deployment object
 			setDeploymentListObject(&deploymentListSrc)
-
-			deployInstance = SetDeployInstance(&deploymentListSrc, "")
+			setDeploymentClusterListObject(&deploymentClusterListSrc)
 
 			s.k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1418,127 +1705,139 @@ var _ = Describe("Gateway gRPC Service", func() {
 				ListMeta: deploymentListSrc.ListMeta,
 				TypeMeta: deploymentListSrc.TypeMeta,
 				Items:    deploymentListSrc.Items,
-			}, nil)
+			}, nil).Once()
 
 			s.k8sClient.On(
-				"Delete", context.Background(), "test-deployment",
-				mock.AnythingOfType("v1.DeleteOptions"),
-			).Return(nil)
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			// clear list
+			matchingLabelList = matchingLabelList[:0]
 		})
 
-		It("successfully delete deployment", func() {
-			defer ts.Close()
+		It("successfully returns a populated list with no filter labels", func() {
+			resp, err := s.deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
 
-			k8sClient := &nbmocks.FakeDeploymentV1{}
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(1))
+			Expect(resp.Deployments[0].Name).To(Equal("test-deployment"))
+		})
 
-			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+		It("successfully returns an empty list with no filter labels", func() {
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{
-				ListMeta: deploymentListSrc.ListMeta,
-				TypeMeta: deploymentListSrc.TypeMeta,
-				Items:    deploymentListSrc.Items,
-			}, nil).Once()
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
 
 			k8sClient.On(
-				"Delete", context.Background(), "test-deployment",
-				mock.AnythingOfType("v1.DeleteOptions"),
-			).Return(nil)
-
-			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: VALID_UID,
-			})
-
-			Expect(err).ToNot(HaveOccurred())
-		})
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
 
-		It("fails to delete deployment due to invalid pattern uppercase char in deploy id", func() {
-			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: "123456-123456-123456F",
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
 			})
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
-			Expect(ok).To(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(0))
 		})
 
-		It("fails to delete deployment due to invalid pattern non alphanumeric in deploy id", func() {
-			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: "123456-1234?56-123456",
+		It("fails due to PageSize is over 500", func() {
+			_, err := s.deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				PageSize: 600,
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("validation error:\n - page_size: value must be greater than " +
+				"or equal to 0 and less than or equal to 500 [int32.gte_lte]"))
 		})
 
-		It("fails to delete deployment due to invalid pattern whitespace in deploy id", func() {
-			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: "123456-1234 56-123456",
-			})
+		It("fails due deployment LIST error", func() {
+			defer ts.Close()
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
-			Expect(ok).To(BeTrue())
-		})
+			k8sClient = &nbmocks.FakeDeploymentV1{}
 
-		It("fails due to missing depl-id in request body", func() {
-			_, err := s.deploymentServer.DeleteDeployment(context.Background(), nil)
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
-			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("incomplete request"))
-		})
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, errors.New("mock deployment err")).Once()
 
-		It("fails due to deployment not found", func() {
-			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: INVALID_UID,
+			k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
 			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(s.Code()).To(Equal(codes.Unknown))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal(fmt.Sprintf("deployment %v not found while deleting deployment", INVALID_UID)))
+			Expect(s.Message()).Should(Equal("mock deployment err"))
 		})
+	})
 
-		It("fails due to LIST error", func() {
-			k8sClient := &nbmocks.FakeDeploymentV1{}
+	Describe("Gateway API Filter List", func() {
+		BeforeEach(func() {
+			k8sClient = &nbmocks.FakeDeploymentV1{}
 			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
+			// populates a mock deployment object
+			setDeploymentListObject(&deploymentListSrc)
+
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
 				TypeMeta: deploymentListSrc.TypeMeta,
 				Items:    deploymentListSrc.Items,
-			}, errors.New("mock err"))
-
-			k8sClient.On(
-				"Delete", context.Background(), "test-deployment",
-				mock.AnythingOfType("v1.DeleteOptions"),
-			).Return(nil)
-
-			_, err = deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: VALID_UID,
-			})
+			}, nil).Once()
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.Unknown))
-			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("mock err"))
+			matchingLabelList = append(matchingLabelList, "hello=world")
+			matchingLabelList = append(matchingLabelList, "test=foo")
+			matchingLabelList = append(matchingLabelList, "mock=go")
 		})
 
-		It("fails due to DELETE error", func() {
+		It("Filter: successfully returns a list with matching target label", func() {
 			k8sClient := &nbmocks.FakeDeploymentV1{}
-			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			defer ts.Close()
+
+			kc := mockK8Client(ts.URL)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kc, nil, nil, nil, nil)
 
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1546,29 +1845,30 @@ var _ = Describe("Gateway gRPC Service", func() {
 				ListMeta: deploymentListSrc.ListMeta,
 				TypeMeta: deploymentListSrc.TypeMeta,
 				Items:    deploymentListSrc.Items,
-			}, nil)
+			}, nil).Once()
 
 			k8sClient.On(
-				"Delete", context.Background(), "test-deployment",
-				mock.AnythingOfType("v1.DeleteOptions"),
-			).Return(errors.New("mock DELETE err"))
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
 
-			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: VALID_UID,
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "test=foo")
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
 			})
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.Unknown))
-			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("mock DELETE err"))
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(1))
+			Expect(resp.Deployments[0].Name).To(Equal("test-deployment"))
 		})
 
-		It("successfully deletes deployment with error deleting secret", func() {
-			defer ts.Close()
-
+		It("Filter: successfully returns an empty list due no matching target label", func() {
 			k8sClient := &nbmocks.FakeDeploymentV1{}
-			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
 
 			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
@@ -1579,43 +1879,47 @@ var _ = Describe("Gateway gRPC Service", func() {
 			}, nil).Once()
 
 			k8sClient.On(
-				"Delete", context.Background(), "test-deployment",
-				mock.AnythingOfType("v1.DeleteOptions"),
-			).Return(nil)
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
 
-			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
-				DeplId: VALID_UID,
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "tester=foo")
+
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
 			})
 
-			Expect(err).ToNot(HaveOccurred())
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(0))
 		})
-	})
 
-	Describe("Gateway API Get", func() {
-		BeforeEach(func() {
+		It("Filter: successfully returns a list of 1 out of 3 in random order due matching target label", func() {
 			// populates a mock deployment object
-			setDeploymentListObject(&deploymentListSrc)
-
-			setDeploymentClusterListObject(&deploymentClusterListSrc)
+			setDeploymentListObjects(&deploymentListSrc)
 
-			deployInstance = SetDeployInstance(&deploymentListSrc, "")
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
 
-			s.k8sClient = &nbmocks.FakeDeploymentV1{}
+			k8sClient := &nbmocks.FakeDeploymentV1{}
 
-			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 				w.Header().Set("Content-Type", "application/json")
 				w.WriteHeader(http.StatusOK)
 				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
 				Expect(err).ToNot(HaveOccurred())
 			}))
 
-			s.kc = mockK8Client(ts.URL)
-
-			// protovalidate Validator
+			defer ts.Close()
 
-			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
+			kc := mockK8Client(ts.URL)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kc, nil, nil, nil, nil)
 
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{
 				ListMeta: deploymentListSrc.ListMeta,
@@ -1623,12 +1927,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentListSrc.Items,
 			}, nil).Once()
 
-			// Mock Deployment.Get() for VALID_UID specifically
-			s.k8sClient.On(
-				"Get", context.Background(), VALID_UID, mock.AnythingOfType("v1.GetOptions"),
-			).Return(deployInstance, nil)
-
-			s.k8sClient.On(
+			k8sClient.On(
 				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentClusterList{
 				ListMeta: deploymentClusterListSrc.ListMeta,
@@ -1636,150 +1935,1561 @@ var _ = Describe("Gateway gRPC Service", func() {
 				Items:    deploymentClusterListSrc.Items,
 			}, nil).Once()
 
-			// Mock Cluster.Get() for VALID_CLUSTERID
-			s.k8sClient.On(
-				"Get", mock.Anything, VALID_CLUSTERID, mock.AnythingOfType("v1.GetOptions"),
-			).Return(&deploymentv1beta1.Cluster{
-				ObjectMeta: metav1.ObjectMeta{
-					Name: VALID_CLUSTERID,
-					Labels: map[string]string{
-						"app": "test",
-						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
-					},
-				},
-			}, nil).Maybe()
-		})
+			var doesMatch = make(map[string]string)
+			doesMatch["hello"] = "world"
 
-		It("successfully get a deployment", func() {
-			resp, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: VALID_UID,
-			})
+			var doesNotMatch = make(map[string]string)
+			doesNotMatch["foo"] = "world"
 
-			Expect(err).ToNot(HaveOccurred())
-			Expect(resp.Deployment.Name).To(Equal("test-deployment"))
-		})
+			for i := 0; i < 3; i++ {
+				// 1st and 3rd deployments don't match
+				currLabel := []map[string]string{doesNotMatch}
+				if i == 1 {
+					currLabel = []map[string]string{doesMatch}
+				}
 
-		It("fails due to invalid pattern whitespace in deployment id", func() {
-			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: "123456-1234 56-123456",
+				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+			}
+
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
 			})
 
-			Expect(err).To(HaveOccurred())
-			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.InvalidArgument))
-			Expect(ok).To(BeTrue())
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(1))
+			Expect(resp.Deployments[0].Name).To(Equal("test1"))
 		})
 
-		It("fails due to invalid pattern non alphanumeric in deployment id", func() {
-			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: "123456-1234?56-123456",
-			})
+		It("Filter: successfully returns a list of 2 out of 3 in random order due matching target label", func() {
+			// populates a mock deployment object
+			setDeploymentListObjects(&deploymentListSrc)
+
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			defer ts.Close()
+
+			kClient := mockK8Client(ts.URL)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			var doesMatch = make(map[string]string)
+			doesMatch["hello"] = "world"
+			doesMatch["not"] = "valid"
+
+			var doesNotMatch = make(map[string]string)
+			doesNotMatch["foo"] = "world"
+
+			for i := 0; i < 3; i++ {
+				// 2nd deployment doesn't match
+				currLabel := []map[string]string{doesNotMatch}
+				if i == 0 || i == 2 {
+					currLabel = []map[string]string{doesMatch}
+				}
+
+				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+			}
+
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(2))
+			Expect(resp.Deployments[0].Name).To(Equal("test0"))
+			Expect(resp.Deployments[1].Name).To(Equal("test2"))
+		})
+
+		It("Filter: successfully returns a list of 2 out of 3 due matching target label", func() {
+			// populates a mock deployment object
+			setDeploymentListObjects(&deploymentListSrc)
+
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			defer ts.Close()
+
+			kClient := mockK8Client(ts.URL)
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			var doesMatch = make(map[string]string)
+			doesMatch["hello"] = "world"
+
+			var doesNotMatch = make(map[string]string)
+			doesNotMatch["foo"] = "world"
+
+			for i := 0; i < 3; i++ {
+				// 3rd deployment doesn't match
+				currLabel := []map[string]string{doesNotMatch}
+				if i == 0 || i == 1 {
+					currLabel = []map[string]string{doesMatch}
+				}
+
+				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+			}
+
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(2))
+			Expect(resp.Deployments[0].Name).To(Equal("test0"))
+			Expect(resp.Deployments[1].Name).To(Equal("test1"))
+		})
+
+		It("Filter: successfully returns 2 match label out of 3 with some labels not matching", func() {
+			// populates a mock deployment object
+			setDeploymentListObjects(&deploymentListSrc)
+
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[1].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[2].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+
+			ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			defer ts.Close()
+
+			kClient := mockK8Client(ts.URL)
+
+			Expect(err).ToNot(HaveOccurred())
+
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, kClient, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			var doesMatch = make(map[string]string)
+			doesMatch["hello"] = "world"
+			doesMatch["not"] = "valid"
+
+			var doesNotMatch = make(map[string]string)
+			doesNotMatch["foo"] = "world"
+
+			for i := 0; i < 3; i++ {
+				// 3rd deployment doesn't match
+				currLabel := []map[string]string{doesNotMatch}
+				if i == 0 || i == 1 {
+					currLabel = []map[string]string{doesMatch}
+				}
+
+				deploymentListSrc.Items[i].Name = fmt.Sprintf("test%d", i)
+				deploymentListSrc.Items[i].Spec.Applications[0].Targets = currLabel
+			}
+
+			resp, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).Should(Succeed())
+			Expect(len(resp.Deployments)).To(Equal(2))
+			Expect(resp.Deployments[0].Name).To(Equal("test0"))
+			Expect(resp.Deployments[1].Name).To(Equal("test1"))
+		})
+
+		It("Filter: fails due to invalid pattern whitespace in target label", func() {
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "tester=foo ")
+
+			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
+				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+		})
+
+		It("Filter: fails due to invalid pattern non alphanumeric in target label", func() {
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "tes?er=foo")
+
+			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
+				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+		})
+
+		It("Filter: fails due to invalid pattern uppercase char in target label", func() {
+			matchingLabelList = matchingLabelList[:0]
+			matchingLabelList = append(matchingLabelList, "tesTer=foo")
+
+			_, err := deploymentServer.ListDeployments(context.Background(), &deploymentpb.ListDeploymentsRequest{
+				Labels: matchingLabelList,
+			})
 
 			Expect(err).To(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("validation error:\n - labels[0]: value does not match regex " +
+				"pattern `(^$)|^[a-z0-9]([-_.=,/a-z0-9]{0,198}[a-z0-9])?$` [string.pattern]"))
+		})
+	})
+
+	Describe("Gateway API Delete", func() {
+		BeforeEach(func() {
+			s.k8sClient = &nbmocks.FakeDeploymentV1{}
+
+			// protovalidate Validator
+
+			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, nil, nil, nil, nil, nil)
+
+			// populates a mock deployment object
+			setDeploymentListObject(&deploymentListSrc)
+
+			deployInstance = SetDeployInstance(&deploymentListSrc, "")
+
+			s.k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil)
+
+			s.k8sClient.On(
+				"Delete", context.Background(), "test-deployment",
+				mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(nil)
+		})
+
+		It("successfully delete deployment", func() {
+			defer ts.Close()
+
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+
+			deploymentServer := NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			k8sClient.On(
+				"Delete", context.Background(), "test-deployment",
+				mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(nil)
+
+			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails to delete deployment due to invalid pattern uppercase char in deploy id", func() {
+			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: "123456-123456-123456F",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails to delete deployment due to invalid pattern non alphanumeric in deploy id", func() {
+			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: "123456-1234?56-123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails to delete deployment due to invalid pattern whitespace in deploy id", func() {
+			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: "123456-1234 56-123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails due to missing depl-id in request body", func() {
+			_, err := s.deploymentServer.DeleteDeployment(context.Background(), nil)
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+
+		It("fails due to deployment not found", func() {
+			_, err := s.deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: INVALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal(fmt.Sprintf("deployment %v not found while deleting deployment", INVALID_UID)))
+		})
+
+		It("fails due to LIST error", func() {
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, errors.New("mock err"))
+
+			k8sClient.On(
+				"Delete", context.Background(), "test-deployment",
+				mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(nil)
+
+			_, err = deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("mock err"))
+		})
+
+		It("fails due to DELETE error", func() {
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, nil, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil)
+
+			k8sClient.On(
+				"Delete", context.Background(), "test-deployment",
+				mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(errors.New("mock DELETE err"))
+
+			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.Unknown))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("mock DELETE err"))
+		})
+
+		It("successfully deletes deployment with error deleting secret", func() {
+			defer ts.Close()
+
+			k8sClient := &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, nil, s.kc, nil, nil, nil, nil)
+
+			k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			k8sClient.On(
+				"Delete", context.Background(), "test-deployment",
+				mock.AnythingOfType("v1.DeleteOptions"),
+			).Return(nil)
+
+			_, err := deploymentServer.DeleteDeployment(context.Background(), &deploymentpb.DeleteDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	Describe("Gateway API Get", func() {
+		BeforeEach(func() {
+			// populates a mock deployment object
+			setDeploymentListObject(&deploymentListSrc)
+
+			setDeploymentClusterListObject(&deploymentClusterListSrc)
+
+			deployInstance = SetDeployInstance(&deploymentListSrc, "")
+
+			s.k8sClient = &nbmocks.FakeDeploymentV1{}
+
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			s.kc = mockK8Client(ts.URL)
+
+			// protovalidate Validator
+
+			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, nil, s.kc, nil, nil, nil, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{
+				ListMeta: deploymentListSrc.ListMeta,
+				TypeMeta: deploymentListSrc.TypeMeta,
+				Items:    deploymentListSrc.Items,
+			}, nil).Once()
+
+			// Mock Deployment.Get() for VALID_UID specifically
+			s.k8sClient.On(
+				"Get", context.Background(), VALID_UID, mock.AnythingOfType("v1.GetOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeploymentClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentClusterList{
+				ListMeta: deploymentClusterListSrc.ListMeta,
+				TypeMeta: deploymentClusterListSrc.TypeMeta,
+				Items:    deploymentClusterListSrc.Items,
+			}, nil).Once()
+
+			// Mock Cluster.Get() for VALID_CLUSTERID
+			s.k8sClient.On(
+				"Get", mock.Anything, VALID_CLUSTERID, mock.AnythingOfType("v1.GetOptions"),
+			).Return(&deploymentv1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: VALID_CLUSTERID,
+					Labels: map[string]string{
+						"app": "test",
+						string(deploymentv1beta1.AppOrchActiveProjectID): VALID_PROJECT_ID,
+					},
+				},
+			}, nil).Maybe()
+		})
+
+		It("successfully get a deployment", func() {
+			resp, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: VALID_UID,
+			})
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp.Deployment.Name).To(Equal("test-deployment"))
+		})
+
+		It("fails due to invalid pattern whitespace in deployment id", func() {
+			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: "123456-1234 56-123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails due to invalid pattern non alphanumeric in deployment id", func() {
+			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: "123456-1234?56-123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails due to invalid pattern uppercase char in deployment id", func() {
+			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: "123456-1234F56-123456",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("fails due to missing deployment id", func() {
+			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: "",
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("incomplete request"))
+		})
+
+		It("fails due to deployment not found", func() {
+			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
+				DeplId: INVALID_UID,
+			})
+
+			Expect(err).To(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("deployment id 023456-023456-023456 not found"))
+		})
+	})
+
+	Describe("Gateway API Create", func() {
+		BeforeEach(func() {
+			os.Setenv("USE_M2M_TOKEN", "true")
+			os.Setenv("SECRET_SERVICE_ENABLED", "false")
+			os.Setenv("SEALED_SECRET_TLS_CRT", "-----BEGIN CERTIFICATE-----")
+			DeferCleanup(os.Unsetenv, "SEALED_SECRET_TLS_CRT")
+
+			// populates a mock deployment object
+			setDeploymentListObject(&deploymentListSrc)
+
+			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
+			deploymentListSrc.Items[0].Spec.DisplayName = "test display name 2"
+
+			deployInstance = SetDeployInstance(&deploymentListSrc, "create")
+			deployInstanceResp = getDeployInstance(&deploymentListSrc)
+
+			s.k8sClient = &nbmocks.FakeDeploymentV1{}
+			s.catalogClient = mockerymock.NewMockeryCatalogClient(GinkgoT())
+
+			// protovalidate Validator
+
+			// M2M auth client mock
+			s.vaultAuthMock = &nbmocks.VaultAuth{}
+
+			mockController := gomock.NewController(t)
+			result := openpolicyagent.OpaResponse_Result{}
+			_ = result.FromOpaResponseResult1(true)
+			s.opaMock = openpolicyagent.NewMockClientWithResponsesInterface(mockController)
+			s.opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any(),
+				gomock.Any()).Return(
+				&openpolicyagent.PostV1DataPackageRuleResponse{
+					JSON200: &openpolicyagent.OpaResponse{
+						Result: result,
+					},
+				}, nil,
+			).AnyTimes()
+
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			s.kc = mockK8Client(ts.URL)
+
+			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, s.opaMock, s.kc, nil, s.catalogClient, s.vaultAuthMock, nil)
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContextValue, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			md := metadata.Pairs("activeprojectid", VALID_PROJECT_ID, "authorization", "test-token")
+			s.ctx = metadata.NewIncomingContext(context.Background(), md)
+			s.vaultAuthMock.On("GetM2MToken", s.ctx).Return("test-m2m-token", nil).Once()
+		})
+
+		It("successfully create deployment with auto-scaling deployment type", func() {
+			defer ts.Close()
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(string(deployInstance.Spec.DeploymentType)).Should(Equal("auto-scaling"))
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("successfully create deployment with dp namespace name", func() {
+			defer ts.Close()
+
+			nbmocks.DpRespNsName.DeploymentPackage.Namespaces[0].Name = "test-test"
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("successfully create deployment with health probes", func() {
+			defer ts.Close()
+
+			deployInstanceResp.HealthProbes = []*deploymentpb.HealthProbe{
+				{
+					AppName: "wordpress",
+					Name:    "homepage",
+					Probe: &deploymentpb.HealthProbe_Http{
+						Http: &deploymentpb.HTTPProbe{
+							Service: "wordpress",
+							Port:    80,
+							Path:    "/wp-login.php",
+						},
+					},
+				},
+				{
+					AppName: "wordpress",
+					Name:    "error-rate",
+					Probe: &deploymentpb.HealthProbe_Prometheus{
+						Prometheus: &deploymentpb.PrometheusProbe{
+							Url:       "http://prometheus.monitoring:9090",
+							Query:     "sum(rate(http_requests_total{code=~\"5..\"}[5m]))",
+							Operator:  "<",
+							Threshold: 0.5,
+						},
+					},
+					Retries: 5,
+				},
+			}
+			deployInstance.Spec.Applications[0].HealthProbes = []deploymentv1beta1.HealthProbe{
+				{
+					Name: "homepage",
+					Type: deploymentv1beta1.HealthProbeHTTP,
+					HTTP: &deploymentv1beta1.HTTPProbe{
+						Service: "wordpress",
+						Port:    80,
+						Path:    "/wp-login.php",
+					},
+				},
+				{
+					Name: "error-rate",
+					Type: deploymentv1beta1.HealthProbePrometheus,
+					Prometheus: &deploymentv1beta1.PrometheusProbe{
+						URL:       "http://prometheus.monitoring:9090",
+						Query:     "sum(rate(http_requests_total{code=~\"5..\"}[5m]))",
+						Operator:  "<",
+						Threshold: "0.5",
+					},
+					Retries: 5,
+				},
+			}
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails due to health probe for an app not in the deployment package", func() {
+			defer ts.Close()
+
+			deployInstanceResp.HealthProbes = []*deploymentpb.HealthProbe{
+				{
+					AppName: "mysql",
+					Name:    "ready",
+					Probe: &deploymentpb.HealthProbe_Job{
+						Job: &deploymentpb.JobProbe{
+							Image: "mysql:8.0",
+						},
+					},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("healthProbes.appName mysql not found in deployment package wordpress"))
+		})
+
+		It("fails due to duplicate health probe name", func() {
+			defer ts.Close()
+
+			probe := &deploymentpb.HealthProbe{
+				AppName: "wordpress",
+				Name:    "ready",
+				Probe: &deploymentpb.HealthProbe_Job{
+					Job: &deploymentpb.JobProbe{
+						Image: "busybox:1.36",
+					},
+				},
+			}
+			deployInstanceResp.HealthProbes = []*deploymentpb.HealthProbe{probe, probe}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("duplicate healthProbes.name ready for app wordpress"))
+		})
+
+		It("fails due to health probe without a probe specification", func() {
+			defer ts.Close()
+
+			deployInstanceResp.HealthProbes = []*deploymentpb.HealthProbe{
+				{
+					AppName: "wordpress",
+					Name:    "ready",
+				},
+			}
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("successfully create deployment reporting target clusters without enough capacity", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ResourceRequests = &deploymentpb.ResourceRequests{
+				Cpu:    "2",
+				Memory: "1Gi",
+			}
+			deployInstance.Spec.ResourceRequests = &deploymentv1beta1.ResourceRequests{
+				Requests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("1Gi"),
+				},
+			}
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.k8sClient.On(
+				"ListClusters", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(getClusterCapacityList(), nil)
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).ShouldNot(HaveOccurred())
+			Expect(res.InsufficientCapacityClusters).To(Equal([]string{"cluster-small"}))
+		})
+
+		It("fails due to insufficient capacity with enforced resource requests", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ResourceRequests = &deploymentpb.ResourceRequests{
+				Cpu:     "2",
+				Memory:  "1Gi",
+				Enforce: true,
+			}
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.k8sClient.On(
+				"ListClusters", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(getClusterCapacityList(), nil)
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.FailedPrecondition))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("insufficient capacity on target clusters: cluster-small (cpu 1500m of 2 available)"))
+		})
+
+		It("fails due to invalid resource requests", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ResourceRequests = &deploymentpb.ResourceRequests{
+				Cpu: "two",
+			}
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("successfully create deployment with namespace policies", func() {
+			defer ts.Close()
+
+			deployInstanceResp.NamespacePolicies = []*deploymentpb.NamespacePolicy{
+				{
+					AppName: "wordpress",
+					ResourceQuota: map[string]string{
+						"requests.cpu": "2",
+						"pods":         "20",
+					},
+					DefaultLimits: map[string]string{
+						"memory": "256Mi",
+					},
+					PodSecurityLevel: "baseline",
+				},
+			}
+			deployInstance.Spec.Applications[0].NamespacePolicy = &deploymentv1beta1.NamespacePolicy{
+				ResourceQuota: corev1.ResourceList{
+					"requests.cpu": resource.MustParse("2"),
+					"pods":         resource.MustParse("20"),
+				},
+				DefaultLimits: corev1.ResourceList{
+					corev1.ResourceMemory: resource.MustParse("256Mi"),
+				},
+				PodSecurityLevel: "baseline",
+			}
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails due to namespace policy for an app not in the deployment package", func() {
+			defer ts.Close()
+
+			deployInstanceResp.NamespacePolicies = []*deploymentpb.NamespacePolicy{
+				{
+					AppName:          "mysql",
+					PodSecurityLevel: "restricted",
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("namespacePolicies.appName mysql not found in deployment package wordpress"))
+		})
+
+		It("fails due to duplicate namespace policy", func() {
+			defer ts.Close()
+
+			policy := &deploymentpb.NamespacePolicy{
+				AppName:          "wordpress",
+				PodSecurityLevel: "restricted",
+			}
+			deployInstanceResp.NamespacePolicies = []*deploymentpb.NamespacePolicy{policy, policy}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("duplicate namespacePolicies for app wordpress"))
+		})
+
+		It("successfully create deployment with kustomize patches", func() {
+			defer ts.Close()
+
+			tolerationPatch := "apiVersion: apps/v1\nkind: Deployment\nmetadata:\n  name: wordpress\n" +
+				"spec:\n  template:\n    spec:\n      tolerations:\n      - key: edge\n        operator: Exists\n"
+			labelPatch := `[{"op": "add", "path": "/metadata/labels/tier", "value": "frontend"}]`
+			deployInstanceResp.KustomizePatches = []*deploymentpb.KustomizePatch{
+				{
+					Patch: tolerationPatch,
+				},
+				{
+					AppName: "wordpress",
+					Type:    "json6902",
+					Patch:   labelPatch,
+					Target: &deploymentpb.PatchTarget{
+						Kind: "Service",
+					},
+				},
+			}
+			deployInstance.Spec.Applications[0].KustomizePatches = []deploymentv1beta1.KustomizePatch{
+				{
+					Type:  deploymentv1beta1.PatchStrategicMerge,
+					Patch: tolerationPatch,
+				},
+				{
+					Type:  deploymentv1beta1.PatchJSON6902,
+					Patch: labelPatch,
+					Target: &deploymentv1beta1.PatchTarget{
+						Kind: "Service",
+					},
+				},
+			}
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails due to kustomize patch for an app not in the deployment package", func() {
+			defer ts.Close()
+
+			deployInstanceResp.KustomizePatches = []*deploymentpb.KustomizePatch{
+				{
+					AppName: "mysql",
+					Patch:   "apiVersion: v1\nkind: Service\nmetadata:\n  name: mysql\n",
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("kustomizePatches.appName mysql not found in deployment package wordpress"))
+		})
+
+		It("fails due to strategic merge patch without target naming no resource", func() {
+			defer ts.Close()
+
+			deployInstanceResp.KustomizePatches = []*deploymentpb.KustomizePatch{
+				{
+					Patch: "spec:\n  replicas: 2\n",
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("invalid kustomizePatches[0]: strategic merge patch without target must set apiVersion, kind and metadata.name"))
+		})
+
+		It("fails due to json6902 patch with unsupported op", func() {
+			defer ts.Close()
+
+			deployInstanceResp.KustomizePatches = []*deploymentpb.KustomizePatch{
+				{
+					Type:  "json6902",
+					Patch: `[{"op": "merge", "path": "/spec/replicas", "value": 2}]`,
+					Target: &deploymentpb.PatchTarget{
+						Kind: "Deployment",
+					},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal(`invalid kustomizePatches[0]: json6902 patch has unsupported op "merge"`))
 		})
 
-		It("fails due to invalid pattern uppercase char in deployment id", func() {
-			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: "123456-1234F56-123456",
+		It("successfully create deployment with manifest apps", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ManifestApps = []*deploymentpb.ManifestApp{
+				{
+					Name: "backend",
+					Source: &deploymentpb.ManifestApp_Artifact{
+						Artifact: "backend",
+					},
+				},
+				{
+					Name:      "monitoring",
+					Namespace: "monitoring",
+					DependsOn: []string{"backend"},
+					Source: &deploymentpb.ManifestApp_Git{
+						Git: &deploymentpb.GitManifestSource{
+							Url:      "https://github.com/example/monitoring.git",
+							Path:     "deploy/overlays/edge",
+							Revision: "v1.2.0",
+						},
+					},
+				},
+			}
+			backend := deployInstance.Spec.Applications[0]
+			backend.Name = "backend"
+			backend.DependsOn = nil
+			backend.EnableServiceExport = false
+			backend.HelmApp = nil
+			backend.ManifestApp = &deploymentv1beta1.ManifestApp{
+				Artifact: "backend",
+				Manifest: string(nbmocks.ManifestArtifactResp.Artifact.Artifact),
+			}
+			monitoring := deployInstance.Spec.Applications[0]
+			monitoring.Name = "monitoring"
+			monitoring.Namespace = "monitoring"
+			monitoring.DependsOn = []string{"backend"}
+			monitoring.EnableServiceExport = false
+			monitoring.HelmApp = nil
+			monitoring.ManifestApp = &deploymentv1beta1.ManifestApp{
+				Git: &deploymentv1beta1.GitManifestSource{
+					Repo:     "https://github.com/example/monitoring.git",
+					Path:     "deploy/overlays/edge",
+					Revision: "v1.2.0",
+				},
+			}
+			deployInstance.Spec.Applications = append(deployInstance.Spec.Applications, backend, monitoring)
+
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespTestArtifact, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+			s.catalogClient.On("GetArtifact", nbmocks.AnyContext, nbmocks.ManifestArtifactReq).Return(&nbmocks.ManifestArtifactResp, nil).Once()
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
 			})
 
-			Expect(err).To(HaveOccurred())
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
+
+		It("fails due to manifest app named like an app of the deployment package", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ManifestApps = []*deploymentpb.ManifestApp{
+				{
+					Name: "wordpress",
+					Source: &deploymentpb.ManifestApp_Oci{
+						Oci: &deploymentpb.OCIManifestSource{
+							Url: "oci://registry.example.com/manifests/wordpress:1.0.0",
+						},
+					},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("manifestApps.name wordpress already used by deployment package wordpress"))
 		})
 
-		It("fails due to missing deployment id", func() {
-			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: "",
+		It("fails due to manifest app path leaving the repository", func() {
+			defer ts.Close()
+
+			deployInstanceResp.ManifestApps = []*deploymentpb.ManifestApp{
+				{
+					Name: "monitoring",
+					Source: &deploymentpb.ManifestApp_Git{
+						Git: &deploymentpb.GitManifestSource{
+							Url:  "https://github.com/example/monitoring.git",
+							Path: "deploy/../../etc",
+						},
+					},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
 			})
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).Should(HaveOccurred())
 			s, ok := status.FromError(err)
 			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("incomplete request"))
+			Expect(s.Message()).Should(Equal("invalid manifestApps[0]: path deploy/../../etc must not leave the source root"))
 		})
 
-		It("fails due to deployment not found", func() {
-			_, err := s.deploymentServer.GetDeployment(context.Background(), &deploymentpb.GetDeploymentRequest{
-				DeplId: INVALID_UID,
+		It("fails due to manifest apps in a blue-green deployment", func() {
+			defer ts.Close()
+
+			deployInstanceResp.DeploymentType = string(deploymentv1beta1.BlueGreen)
+			deployInstanceResp.ManifestApps = []*deploymentpb.ManifestApp{
+				{
+					Name: "backend",
+					Source: &deploymentpb.ManifestApp_Artifact{
+						Artifact: "backend",
+					},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
 			})
 
-			Expect(err).To(HaveOccurred())
+			Expect(err).Should(HaveOccurred())
 			s, ok := status.FromError(err)
-			Expect(s.Code()).To(Equal(codes.NotFound))
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
 			Expect(ok).To(BeTrue())
-			Expect(s.Message()).Should(Equal("deployment id 023456-023456-023456 not found"))
+			Expect(s.Message()).Should(Equal("manifestApps is not supported by deployment type blue-green"))
 		})
-	})
 
-	Describe("Gateway API Create", func() {
-		BeforeEach(func() {
-			os.Setenv("USE_M2M_TOKEN", "true")
-			os.Setenv("SECRET_SERVICE_ENABLED", "false")
+		It("successfully create deployment with secret references in override values", func() {
+			defer ts.Close()
 
-			// populates a mock deployment object
-			setDeploymentListObject(&deploymentListSrc)
+			deployInstanceResp.OverrideValues = append(deployInstanceResp.OverrideValues, &deploymentpb.OverrideValues{
+				AppName: "wordpress",
+				SecretRefs: []*deploymentpb.SecretRef{
+					{
+						Key: "mariadb.auth.password",
+						Source: &deploymentpb.SecretRef_External{
+							External: &deploymentpb.ExternalSecretRef{
+								Store:    "aws",
+								Key:      "wordpress/db",
+								Property: "password",
+							},
+						},
+					},
+				},
+			})
+			deployInstance.Spec.Applications[0].SecretRefs = []deploymentv1beta1.SecretRef{
+				{
+					Key: "mariadb.auth.password",
+					External: &deploymentv1beta1.ExternalSecretRef{
+						Store:     "aws",
+						StoreKind: "ClusterSecretStore",
+						Key:       "wordpress/db",
+						Property:  "password",
+					},
+				},
+			}
 
-			deploymentListSrc.Items[0].Spec.DeploymentType = deploymentv1beta1.AutoScaling
-			deploymentListSrc.Items[0].Spec.DisplayName = "test display name 2"
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
 
-			deployInstance = SetDeployInstance(&deploymentListSrc, "create")
-			deployInstanceResp = getDeployInstance(&deploymentListSrc)
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
 
-			s.k8sClient = &nbmocks.FakeDeploymentV1{}
-			s.catalogClient = mockerymock.NewMockeryCatalogClient(GinkgoT())
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
 
-			// protovalidate Validator
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
 
-			// M2M auth client mock
-			s.vaultAuthMock = &nbmocks.VaultAuth{}
+			Expect(res).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+		})
 
-			mockController := gomock.NewController(t)
-			result := openpolicyagent.OpaResponse_Result{}
-			_ = result.FromOpaResponseResult1(true)
-			s.opaMock = openpolicyagent.NewMockClientWithResponsesInterface(mockController)
-			s.opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(
-				gomock.Any(),
-				gomock.Any(),
-				gomock.Any(),
-				gomock.Any(),
-				gomock.Any(),
-				gomock.Any()).Return(
-				&openpolicyagent.PostV1DataPackageRuleResponse{
-					JSON200: &openpolicyagent.OpaResponse{
-						Result: result,
+		It("fails due to secret reference key also set in override values", func() {
+			defer ts.Close()
+
+			var valuesStrPb *structpb.Struct
+			_ = json.Unmarshal(json.RawMessage("{\"password\":\"foo\"}"), &valuesStrPb)
+			deployInstanceResp.OverrideValues = append(deployInstanceResp.OverrideValues, &deploymentpb.OverrideValues{
+				AppName: "wordpress",
+				Values:  valuesStrPb,
+				SecretRefs: []*deploymentpb.SecretRef{
+					{
+						Key: "password",
+						Source: &deploymentpb.SecretRef_External{
+							External: &deploymentpb.ExternalSecretRef{Store: "aws", Key: "password"},
+						},
 					},
-				}, nil,
-			).AnyTimes()
+				},
+			})
 
-			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-				w.Header().Set("Content-Type", "application/json")
-				w.WriteHeader(http.StatusOK)
-				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
-				Expect(err).ToNot(HaveOccurred())
-			}))
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
 
-			s.kc = mockK8Client(ts.URL)
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
 
-			s.deploymentServer = NewDeploymentMustSucceed(s.k8sClient, s.opaMock, s.kc, nil, s.catalogClient, s.vaultAuthMock, nil)
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("overrideValues.secretRefs.key password of app wordpress is also set in overrideValues.values"))
+		})
 
-			s.k8sClient.On(
-				"Create", nbmocks.AnyContextValue, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
-			).Return(deployInstance, nil)
+		It("fails due to Vault secret reference without secret service", func() {
+			defer ts.Close()
 
-			md := metadata.Pairs("activeprojectid", VALID_PROJECT_ID, "authorization", "test-token")
-			s.ctx = metadata.NewIncomingContext(context.Background(), md)
-			s.vaultAuthMock.On("GetM2MToken", s.ctx).Return("test-m2m-token", nil).Once()
+			deployInstanceResp.OverrideValues = append(deployInstanceResp.OverrideValues, &deploymentpb.OverrideValues{
+				AppName: "wordpress",
+				SecretRefs: []*deploymentpb.SecretRef{
+					{
+						Key: "mariadb.auth.password",
+						Source: &deploymentpb.SecretRef_Vault{
+							Vault: &deploymentpb.VaultSecretRef{Path: "wordpress/db", Key: "password"},
+						},
+					},
+				},
+			})
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("overrideValues.secretRefs.vault requires the secret service"))
 		})
 
-		It("successfully create deployment with auto-scaling deployment type", func() {
+		It("fails due to image policy violations", func() {
+			defer ts.Close()
+			os.Setenv("IMAGE_POLICY_ALLOWED_REGISTRIES", "registry.example.com")
+			os.Setenv("IMAGE_POLICY_DENY_LATEST_TAG", "true")
+			defer os.Unsetenv("IMAGE_POLICY_ALLOWED_REGISTRIES")
+			defer os.Unsetenv("IMAGE_POLICY_DENY_LATEST_TAG")
+			defer stubChart(map[string]string{
+				"wordpress/Chart.yaml":  "name: wordpress\nversion: 1.0.0\n",
+				"wordpress/values.yaml": "metrics:\n  image:\n    registry: docker.io\n    repository: bitnami/apache-exporter\n    tag: 1.0.9\n",
+			})()
+
+			var valuesStrPb *structpb.Struct
+			_ = json.Unmarshal(json.RawMessage(`{"image":{"registry":"registry.example.com","repository":"wordpress","tag":"latest"},"initImage":"busybox:1.36"}`), &valuesStrPb)
+			deployInstanceResp.OverrideValues = append(deployInstanceResp.OverrideValues, &deploymentpb.OverrideValues{
+				AppName: "wordpress",
+				Values:  valuesStrPb,
+			})
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("image policy violations: " +
+				"app wordpress: image registry.example.com/wordpress:latest uses the latest tag; " +
+				"app wordpress: image docker.io/library/busybox:1.36 is not pulled from an allowed registry; " +
+				"app wordpress: image docker.io/bitnami/apache-exporter:1.0.9 is not pulled from an allowed registry"))
+		})
+
+		It("fails due to image policy when the chart cannot be inspected", func() {
+			defer ts.Close()
+			os.Setenv("IMAGE_POLICY_ALLOWED_REGISTRIES", "registry.example.com")
+			defer os.Unsetenv("IMAGE_POLICY_ALLOWED_REGISTRIES")
+			defer stubChartError(fmt.Errorf("401 Unauthorized"))()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("image policy violations: " +
+				"app wordpress: chart wordpress cannot be inspected: 401 Unauthorized"))
+		})
+
+		It("fails due to invalid pod security level", func() {
+			defer ts.Close()
+
+			deployInstanceResp.NamespacePolicies = []*deploymentpb.NamespacePolicy{
+				{
+					AppName:          "wordpress",
+					PodSecurityLevel: "strict",
+				},
+			}
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+		})
+
+		It("successfully create deployment with test hooks", func() {
 			defer ts.Close()
 
+			deployInstanceResp.TestHooks = []*deploymentpb.TestHook{
+				{
+					AppName: "wordpress",
+					Name:    "login",
+					Test: &deploymentpb.TestHook_Job{
+						Job: &deploymentpb.JobProbe{
+							Image: "curlimages/curl:8.11.1",
+							Args:  []string{"-f", "http://wordpress/wp-login.php"},
+						},
+					},
+					BackoffLimit: 1,
+				},
+				{
+					AppName: "wordpress",
+					Name:    "smoke",
+					Test: &deploymentpb.TestHook_Artifact{
+						Artifact: "smoke-test",
+					},
+					TimeoutSeconds: 120,
+				},
+			}
+			deployInstance.Spec.Applications[0].TestHooks = []deploymentv1beta1.TestHook{
+				{
+					Name: "login",
+					Job: &deploymentv1beta1.JobProbe{
+						Image: "curlimages/curl:8.11.1",
+						Args:  []string{"-f", "http://wordpress/wp-login.php"},
+					},
+					BackoffLimit: 1,
+				},
+				{
+					Name:           "smoke",
+					Artifact:       "smoke-test",
+					Manifest:       string(nbmocks.TestArtifactResp.Artifact.Artifact),
+					TimeoutSeconds: 120,
+				},
+			}
+
 			s.k8sClient.On(
 				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
@@ -1788,42 +3498,74 @@ var _ = Describe("Gateway gRPC Service", func() {
 				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
 			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
 
-			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespTestArtifact, nil)
 			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
 			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+			s.catalogClient.On("GetArtifact", nbmocks.AnyContext, nbmocks.TestArtifactReq).Return(&nbmocks.TestArtifactResp, nil).Once()
 
 			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
 				Deployment: deployInstanceResp,
 			})
 
-			Expect(string(deployInstance.Spec.DeploymentType)).Should(Equal("auto-scaling"))
 			Expect(res).NotTo(BeNil())
 			Expect(err).ShouldNot(HaveOccurred())
 		})
 
-		It("successfully create deployment with dp namespace name", func() {
+		It("fails due to test hook artifact not in the deployment package", func() {
 			defer ts.Close()
 
-			nbmocks.DpRespNsName.DeploymentPackage.Namespaces[0].Name = "test-test"
+			deployInstanceResp.TestHooks = []*deploymentpb.TestHook{
+				{
+					AppName: "wordpress",
+					Name:    "smoke",
+					Test: &deploymentpb.TestHook_Artifact{
+						Artifact: "smoke-test",
+					},
+				},
+			}
 
-			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
-			).Return(deployInstance, nil)
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
 
-			s.k8sClient.On(
-				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
-			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("testHooks.artifact smoke-test: artifact smoke-test with purpose test not found in deployment package wordpress"))
+		})
+
+		It("fails due to duplicate test hook name", func() {
+			defer ts.Close()
+
+			test := &deploymentpb.TestHook{
+				AppName: "wordpress",
+				Name:    "smoke",
+				Test: &deploymentpb.TestHook_Job{
+					Job: &deploymentpb.JobProbe{
+						Image: "busybox:1.36",
+					},
+				},
+			}
+			deployInstanceResp.TestHooks = []*deploymentpb.TestHook{test, test}
 
 			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
 			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
 			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
 
-			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
 				Deployment: deployInstanceResp,
 			})
 
-			Expect(res).NotTo(BeNil())
-			Expect(err).ShouldNot(HaveOccurred())
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("duplicate testHooks.name smoke for app wordpress"))
 		})
 
 		It("fails due to dp namespace name has prefix kind-", func() {
@@ -2083,7 +3825,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Mandatory = true
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2121,7 +3863,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Mandatory = true
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2198,7 +3940,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Secret = true
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2229,13 +3971,44 @@ var _ = Describe("Gateway gRPC Service", func() {
 			Expect(s.Code()).To(Equal(codes.OK))
 		})
 
+		It("fails to create deployment with a parameter template secret when no sealed secrets certificate is configured", func() {
+			defer ts.Close()
+			os.Unsetenv("SEALED_SECRET_TLS_CRT")
+
+			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Default = ""
+			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Mandatory = true
+			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Secret = true
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmPtResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil).Maybe()
+
+			var valuesStrPb *structpb.Struct
+			rawMsg := json.RawMessage("{\"global.admin_password\":\"foo\"}")
+			_ = json.Unmarshal(rawMsg, &valuesStrPb)
+
+			deployInstanceResp.OverrideValues[0] = &deploymentpb.OverrideValues{
+				AppName: "wordpress",
+				Values:  valuesStrPb,
+			}
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).To(BeNil())
+			s, _ := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(s.Message()).To(ContainSubstring("sealed secrets certificate is not configured"))
+		})
+
 		It("successfully create deployment with 1 parameter template secret with 1level key", func() {
 			defer ts.Close()
 
 			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Name = "single_password"
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2272,7 +4045,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			nbmocks.AppHelmPtResp.Application.Profiles[0].ParameterTemplates[0].Name = "one.two.three.four.five.six.seven"
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2314,7 +4087,7 @@ var _ = Describe("Gateway gRPC Service", func() {
 			nbmocks.AppHelmPt2Resp.Application.Profiles[0].ParameterTemplates[1].Secret = true
 
 			s.k8sClient.On(
-				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+				"Create", nbmocks.AnyContext, matchParameterSecret(deployInstance), mock.AnythingOfType("v1.CreateOptions"),
 			).Return(deployInstance, nil)
 
 			s.k8sClient.On(
@@ -2398,6 +4171,138 @@ var _ = Describe("Gateway gRPC Service", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("successfully create deployment with critical priority", func() {
+			defer ts.Close()
+
+			deployInstanceResp.Priority = string(deploymentv1beta1.PriorityCritical)
+
+			deployInstance = SetDeployInstance(&deploymentListSrc, "create")
+			deployInstance.Spec.Priority = deploymentv1beta1.PriorityCritical
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("successfully create blue-green deployment with services", func() {
+			defer ts.Close()
+
+			deployInstanceResp.DeploymentType = string(deploymentv1beta1.BlueGreen)
+			deployInstanceResp.BlueGreenServices = []*deploymentpb.BlueGreenService{
+				{
+					AppName:  "wordpress",
+					Name:     "wordpress-web",
+					Selector: map[string]string{"app.kubernetes.io/name": "wordpress"},
+					Ports: []*deploymentpb.BlueGreenServicePort{
+						{
+							Port:       80,
+							TargetPort: 8080,
+						},
+					},
+				},
+			}
+
+			deployInstance = SetDeployInstance(&deploymentListSrc, "create")
+			deployInstance.Spec.DeploymentType = deploymentv1beta1.BlueGreen
+			deployInstance.Spec.Applications[0].BlueGreenServices = []deploymentv1beta1.BlueGreenService{
+				{
+					Name:     "wordpress-web",
+					Selector: map[string]string{"app.kubernetes.io/name": "wordpress"},
+					Ports: []deploymentv1beta1.BlueGreenServicePort{
+						{
+							Port:       80,
+							TargetPort: 8080,
+						},
+					},
+				},
+			}
+			s.k8sClient.On(
+				"Create", nbmocks.AnyContext, deployInstance, mock.AnythingOfType("v1.CreateOptions"),
+			).Return(deployInstance, nil)
+
+			s.k8sClient.On(
+				"ListDeployments", nbmocks.AnyContext, mock.AnythingOfType("v1.ListOptions"),
+			).Return(&deploymentv1beta1.DeploymentList{}, nil).Once()
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			res, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(res).NotTo(BeNil())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("fails due to blue-green services on an auto-scaling deployment", func() {
+			defer ts.Close()
+
+			deployInstanceResp.BlueGreenServices = []*deploymentpb.BlueGreenService{
+				{
+					AppName: "wordpress",
+					Name:    "wordpress-web",
+					Ports:   []*deploymentpb.BlueGreenServicePort{{Port: 80}},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("blueGreenServices requires deployment type blue-green"))
+		})
+
+		It("fails due to blue-green service for an app not in the deployment package", func() {
+			defer ts.Close()
+
+			deployInstanceResp.DeploymentType = string(deploymentv1beta1.BlueGreen)
+			deployInstanceResp.BlueGreenServices = []*deploymentpb.BlueGreenService{
+				{
+					AppName: "mysql",
+					Name:    "mysql",
+					Ports:   []*deploymentpb.BlueGreenServicePort{{Port: 3306}},
+				},
+			}
+
+			s.catalogClient.On("GetDeploymentPackage", nbmocks.AnyContext, nbmocks.AnyGetDpReq).Return(&nbmocks.DpRespGood, nil)
+			s.catalogClient.On("GetApplication", nbmocks.AnyContext, nbmocks.AnyGetAppReq).Return(&nbmocks.AppHelmResp, nil)
+			s.catalogClient.On("GetRegistry", nbmocks.AnyContext, nbmocks.AnyGetRegReq).Return(&nbmocks.HelmRegResp, nil)
+
+			_, err := s.deploymentServer.CreateDeployment(s.ctx, &deploymentpb.CreateDeploymentRequest{
+				Deployment: deployInstanceResp,
+			})
+
+			Expect(err).Should(HaveOccurred())
+			s, ok := status.FromError(err)
+			Expect(s.Code()).To(Equal(codes.InvalidArgument))
+			Expect(ok).To(BeTrue())
+			Expect(s.Message()).Should(Equal("blueGreenServices.appName mysql not found in deployment package wordpress"))
+		})
+
 		It("fails due to incomplete request", func() {
 			deployInstanceResp = nil
 
@@ -3268,6 +5173,280 @@ var _ = Describe("Gateway gRPC Service", func() {
 			Expect(len(resp.DeploymentInstancesCluster)).To(Equal(1))
 		})
 	})
+
+	Describe("Gateway API Concurrency", func() {
+		const (
+			projectA = "aaaa-1111-2222-3333-4444"
+			projectB = "bbbb-1111-2222-3333-4444"
+		)
+
+		var (
+			// events are the deployment CR and secret deletions, in order
+			eventsMu sync.Mutex
+			events   []string
+			opts     map[string]metav1.DeleteOptions
+		)
+
+		record := func(event string) {
+			eventsMu.Lock()
+			defer eventsMu.Unlock()
+			events = append(events, event)
+		}
+
+		newDeployment := func(project string, name string) deploymentv1beta1.Deployment {
+			return deploymentv1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            name,
+					Namespace:       project,
+					UID:             types.UID(name + "-uid"),
+					ResourceVersion: "7",
+				},
+				Spec: deploymentv1beta1.DeploymentSpec{
+					Applications: []deploymentv1beta1.Application{{
+						Name:              "app",
+						ProfileSecretName: name + "-profile",
+						ValueSecretName:   name + "-values",
+					}},
+				},
+			}
+		}
+
+		projectContext := func(project string) context.Context {
+			return metadata.NewIncomingContext(context.Background(), metadata.Pairs("activeprojectid", project))
+		}
+
+		// expectProject returns the deployments of the project and records their deletion
+		expectProject := func(project string, deployments []deploymentv1beta1.Deployment, onDelete func()) {
+			k8sClient.On(
+				"ListDeployments", mock.Anything, mock.MatchedBy(func(o metav1.ListOptions) bool {
+					return strings.Contains(o.LabelSelector, project)
+				}),
+			).Return(&deploymentv1beta1.DeploymentList{Items: deployments}, nil)
+
+			for _, d := range deployments {
+				k8sClient.On(
+					"Delete", mock.Anything, d.Name, mock.AnythingOfType("v1.DeleteOptions"),
+				).Run(func(args mock.Arguments) {
+					record(d.Name)
+					eventsMu.Lock()
+					opts[d.Name] = args.Get(2).(metav1.DeleteOptions)
+					eventsMu.Unlock()
+					onDelete()
+				}).Return(nil).Once()
+			}
+		}
+
+		// expectGrouped checks that the events of each group of deployments,
+		// including the deletion of their secrets, are not interleaved with the
+		// events of the other groups
+		expectGrouped := func(groups ...[]string) {
+			group := map[string]int{}
+			for i, g := range groups {
+				for _, name := range g {
+					group[name] = i
+				}
+			}
+
+			eventsMu.Lock()
+			defer eventsMu.Unlock()
+			seen := map[int]bool{}
+			current := -1
+			for _, event := range events {
+				// Secrets are prefixed with the deployment name
+				g, ok := group[strings.Split(event, "-")[0]]
+				Expect(ok).To(BeTrue(), "unexpected event %s", event)
+				if g != current {
+					Expect(seen[g]).To(BeFalse(), "events interleaved: %v", events)
+					seen[g] = true
+					current = g
+				}
+			}
+		}
+
+		run := func(calls ...func() error) {
+			var wg sync.WaitGroup
+			errs := make([]error, len(calls))
+			for i, call := range calls {
+				wg.Add(1)
+				go func() {
+					defer GinkgoRecover()
+					defer wg.Done()
+					errs[i] = call()
+				}()
+			}
+			wg.Wait()
+			for _, err := range errs {
+				Expect(err).ToNot(HaveOccurred())
+			}
+		}
+
+		deleteDeployment := func(project string, name string, deleteType deploymentpb.DeleteType) func() error {
+			return func() error {
+				_, err := deploymentServer.DeleteDeployment(projectContext(project), &deploymentpb.DeleteDeploymentRequest{
+					DeplId:     name + "-uid",
+					DeleteType: deleteType,
+				})
+				return err
+			}
+		}
+
+		BeforeEach(func() {
+			events = nil
+			opts = map[string]metav1.DeleteOptions{}
+
+			ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method == http.MethodDelete {
+					record(path.Base(r.URL.Path))
+				}
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusOK)
+				_, err := w.Write([]byte(`{"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "test-name"}}`))
+				Expect(err).ToNot(HaveOccurred())
+			}))
+
+			result := openpolicyagent.OpaResponse_Result{}
+			Expect(result.FromOpaResponseResult1(true)).To(Succeed())
+			opaMock := openpolicyagent.NewMockClientWithResponsesInterface(gomock.NewController(GinkgoT()))
+			opaMock.EXPECT().PostV1DataPackageRuleWithBodyWithResponse(
+				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+			).Return(&openpolicyagent.PostV1DataPackageRuleResponse{
+				JSON200: &openpolicyagent.OpaResponse{Result: result},
+			}, nil).AnyTimes()
+
+			k8sClient = &nbmocks.FakeDeploymentV1{}
+			deploymentServer = NewDeploymentMustSucceed(k8sClient, opaMock, mockK8Client(ts.URL), nil, nil, nil, nil)
+		})
+
+		AfterEach(func() {
+			ts.Close()
+		})
+
+		It("serializes the deletions of a project with their secrets", func() {
+			expectProject(projectA, []deploymentv1beta1.Deployment{
+				newDeployment(projectA, "wordpress"),
+				newDeployment(projectA, "mysql"),
+			}, func() { time.Sleep(10 * time.Millisecond) })
+
+			run(
+				deleteDeployment(projectA, "wordpress", deploymentpb.DeleteType_PARENT_ONLY),
+				deleteDeployment(projectA, "mysql", deploymentpb.DeleteType_PARENT_ONLY),
+			)
+
+			Expect(events).To(ConsistOf(
+				"wordpress", "wordpress-profile", "wordpress-values", "wordpress-values-masked", "wordpress-app--secret",
+				"mysql", "mysql-profile", "mysql-values", "mysql-values-masked", "mysql-app--secret",
+			))
+			expectGrouped([]string{"wordpress"}, []string{"mysql"})
+
+			// Deleted only if unchanged since the dependencies were checked
+			Expect(*opts["wordpress"].Preconditions.UID).To(Equal(types.UID("wordpress-uid")))
+			Expect(*opts["wordpress"].Preconditions.ResourceVersion).To(Equal("7"))
+		})
+
+		It("deletes a dependency graph without interleaving with the other deletions of the project", func() {
+			parent := newDeployment(projectA, "wordpress")
+			parent.Spec.ChildDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"mysql": {DeploymentName: "mysql"},
+			}
+			child := newDeployment(projectA, "mysql")
+			child.Status.ParentDeploymentList = map[string]deploymentv1beta1.DependentDeploymentRef{
+				"wordpress": {DeploymentName: "wordpress"},
+			}
+			expectProject(projectA, []deploymentv1beta1.Deployment{parent, child, newDeployment(projectA, "redis")},
+				func() { time.Sleep(10 * time.Millisecond) })
+
+			run(
+				deleteDeployment(projectA, "wordpress", deploymentpb.DeleteType_ALL),
+				deleteDeployment(projectA, "redis", deploymentpb.DeleteType_PARENT_ONLY),
+			)
+
+			Expect(events).To(ContainElements("wordpress", "mysql", "redis"))
+			expectGrouped([]string{"wordpress", "mysql"}, []string{"redis"})
+			Expect(*opts["mysql"].Preconditions.UID).To(Equal(types.UID("mysql-uid")))
+		})
+
+		It("serializes the canary actions with the other mutations of the project", func() {
+			canary := newDeployment(projectA, "wordpress")
+			canary.Labels = map[string]string{}
+			canary.Spec.Applications[0].HelmApp = &deploymentv1beta1.HelmApp{}
+			canary.Status.Canary = &deploymentv1beta1.CanaryStatus{Phase: deploymentv1beta1.CanaryObserving}
+			expectProject(projectA, []deploymentv1beta1.Deployment{canary, newDeployment(projectA, "mysql")},
+				func() { time.Sleep(10 * time.Millisecond) })
+			k8sClient.On(
+				"Update", mock.Anything, "wordpress", mock.Anything, mock.AnythingOfType("v1.UpdateOptions"),
+			).Run(func(mock.Arguments) {
+				record("wordpress")
+			}).Return(&canary, nil).Once()
+
+			run(
+				deleteDeployment(projectA, "mysql", deploymentpb.DeleteType_PARENT_ONLY),
+				func() error {
+					_, err := deploymentServer.PromoteDeployment(projectContext(projectA), &deploymentpb.PromoteDeploymentRequest{
+						DeplId: "wordpress-uid",
+					})
+					return err
+				},
+			)
+
+			Expect(events).To(ContainElements("wordpress", "mysql"))
+			expectGrouped([]string{"wordpress"}, []string{"mysql"})
+		})
+
+		It("mutates the deployments of different projects in parallel", func() {
+			// Each deletion waits for the deletion of the other project
+			var inFlight sync.WaitGroup
+			inFlight.Add(2)
+			parallel := make(chan bool, 2)
+			wait := func() {
+				inFlight.Done()
+				done := make(chan struct{})
+				go func() {
+					inFlight.Wait()
+					close(done)
+				}()
+				select {
+				case <-done:
+					parallel <- true
+				case <-time.After(5 * time.Second):
+					parallel <- false
+				}
+			}
+			expectProject(projectA, []deploymentv1beta1.Deployment{newDeployment(projectA, "wordpress")}, wait)
+			expectProject(projectB, []deploymentv1beta1.Deployment{newDeployment(projectB, "nginx")}, wait)
+
+			run(
+				deleteDeployment(projectA, "wordpress", deploymentpb.DeleteType_PARENT_ONLY),
+				deleteDeployment(projectB, "nginx", deploymentpb.DeleteType_PARENT_ONLY),
+			)
+
+			Expect(<-parallel).To(BeTrue())
+			Expect(<-parallel).To(BeTrue())
+		})
+
+		It("serializes the callers of a key only", func() {
+			var m keyedMutex
+			unlock := m.lock("project-1")
+
+			locked := make(chan struct{})
+			go func() {
+				defer m.lock("project-1")()
+				close(locked)
+			}()
+			Consistently(locked, 50*time.Millisecond).ShouldNot(BeClosed())
+
+			// Other keys are not blocked
+			m.lock("project-2")()
+
+			unlock()
+			Eventually(locked).Should(BeClosed())
+			Eventually(func() int {
+				m.mu.Lock()
+				defer m.mu.Unlock()
+				return len(m.locks)
+			}).Should(BeZero())
+		})
+	})
+
 })
 
 func setDeployment() *Deployment {
@@ -3545,6 +5724,8 @@ func SetDeployInstance(deploymentListSrc *deploymentv1beta1.DeploymentList, scen
 				DeploymentPackageRef: dpRef,
 				Applications:         app,
 				DeploymentType:       deploymentListSrc.Items[0].Spec.DeploymentType,
+				DriftPolicy:          deploymentv1beta1.AlertOnly,
+				Priority:             deploymentv1beta1.PriorityNormal,
 				ChildDeploymentList:  make(map[string]deploymentv1beta1.DependentDeploymentRef),
 			},
 		}
@@ -3588,6 +5769,8 @@ func SetDeployInstance(deploymentListSrc *deploymentv1beta1.DeploymentList, scen
 				DeploymentPackageRef: dpRef,
 				Applications:         app,
 				DeploymentType:       deploymentListSrc.Items[0].Spec.DeploymentType,
+				DriftPolicy:          deploymentv1beta1.AlertOnly,
+				Priority:             deploymentv1beta1.PriorityNormal,
 				NetworkRef:           deploymentListSrc.Items[0].Spec.NetworkRef,
 			},
 		}
@@ -3699,3 +5882,28 @@ func mockK8Client(tsUrl string) *kubernetes.Clientset {
 
 	return _kClient
 }
+
+func getClusterCapacityList() *deploymentv1beta1.ClusterList {
+	capacity := func(allocatableCPU string, requestedCPU string) *deploymentv1beta1.ClusterCapacity {
+		return &deploymentv1beta1.ClusterCapacity{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(allocatableCPU),
+				corev1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			Requested: corev1.ResourceList{
+				corev1.ResourceCPU:    resource.MustParse(requestedCPU),
+				corev1.ResourceMemory: resource.MustParse("4Gi"),
+			},
+		}
+	}
+
+	clusters := &deploymentv1beta1.ClusterList{
+		Items: make([]deploymentv1beta1.Cluster, 3),
+	}
+	clusters.Items[0].Name = "cluster-large"
+	clusters.Items[0].Status.Capacity = capacity("8", "1")
+	clusters.Items[1].Name = "cluster-new"
+	clusters.Items[2].Name = "cluster-small"
+	clusters.Items[2].Status.Capacity = capacity("4", "2500m")
+	return clusters
+}
@@ -0,0 +1,477 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	stdErr "errors"
+	"fmt"
+	"io"
+	"net/url"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	fleetv1alpha1 "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	yaml2 "sigs.k8s.io/yaml"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/imagepolicy"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/offlinebundle"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/k8serrors"
+)
+
+// offlineBundleChunkSize is the size of the archive chunks streamed, well
+// below the message size limit
+const offlineBundleChunkSize = 256 * 1024
+
+var errOfflineBundleStream = stdErr.New("cannot stream offline bundle")
+
+// offlineBundleSender streams the archive written to it in chunks, the first
+// one with the file name and generation of the archive
+type offlineBundleSender struct {
+	stream     deploymentpb.DeploymentService_ExportOfflineBundleServer
+	fileName   string
+	generation int32
+	chunk      []byte
+	sent       bool
+}
+
+func (w *offlineBundleSender) Write(p []byte) (int, error) {
+	n := len(p)
+	for len(p) > 0 {
+		m := min(len(p), offlineBundleChunkSize-len(w.chunk))
+		w.chunk = append(w.chunk, p[:m]...)
+		p = p[m:]
+		if len(w.chunk) == offlineBundleChunkSize {
+			if err := w.Flush(); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return n, nil
+}
+
+// Flush sends the pending chunk, or the first message if none was sent yet
+func (w *offlineBundleSender) Flush() error {
+	if len(w.chunk) == 0 && w.sent {
+		return nil
+	}
+	resp := &deploymentpb.ExportOfflineBundleResponse{Archive: w.chunk}
+	if !w.sent {
+		resp.FileName = w.fileName
+		resp.Generation = w.generation
+	}
+	if err := w.stream.Send(resp); err != nil {
+		return fmt.Errorf("%w: %v", errOfflineBundleStream, err)
+	}
+	w.sent = true
+	w.chunk = make([]byte, 0, offlineBundleChunkSize)
+	return nil
+}
+
+// ExportOfflineBundle streams an archive deploying a deployment on a disconnected cluster.
+func (s *DeploymentSvc) ExportOfflineBundle(in *deploymentpb.ExportOfflineBundleRequest, stream deploymentpb.DeploymentService_ExportOfflineBundleServer) error {
+	ctx := stream.Context()
+	if in == nil || in.DeplId == "" || in.ClusterId == "" {
+		log.Warnf("incomplete request")
+		return errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot export offline bundle: %v", err)
+		return errors.Status(errors.NewForbidden("cannot export offline bundle: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	activeProjectIDKey := string(deploymentv1beta1.AppOrchActiveProjectID)
+
+	labelSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{activeProjectIDKey: activeProjectID},
+	}
+
+	listOpts := metav1.ListOptions{
+		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
+	}
+
+	UID := in.DeplId
+
+	deployment, err := matchUIDDeployment(ctx, UID, activeProjectID, s, listOpts)
+	if err != nil {
+		log.Warnf("cannot get deployment: %v", err)
+		return errors.Status(err).Err()
+	} else if deployment.ObjectMeta.Name == "" {
+		log.Warnf("deployment id %v not found", UID)
+		return errors.Status(errors.NewNotFound("deployment id %v not found", UID)).Err()
+	}
+
+	labelSelector.MatchLabels[string(deploymentv1beta1.DeploymentID)] = UID
+
+	listOpts = metav1.ListOptions{
+		LabelSelector: labels.Set(labelSelector.MatchLabels).String(),
+	}
+
+	deploymentClusters, err := s.crClient.DeploymentClusters("").List(ctx, listOpts)
+	if err != nil {
+		log.Warnf("cannot get deployment cluster: %v", err)
+		return errors.Status(k8serrors.K8sToTypedError(err)).Err()
+	}
+
+	found := false
+	if deploymentClusters != nil {
+		for _, dc := range deploymentClusters.Items {
+			if dc.Spec.ClusterID == in.ClusterId {
+				found = true
+				break
+			}
+		}
+	}
+	if !found {
+		log.Warnf("cluster id %v not found for deployment id %v", in.ClusterId, UID)
+		return errors.Status(errors.NewNotFound("cluster id %v not found for deployment id %v", in.ClusterId, UID)).Err()
+	}
+
+	bundles, err := s.listAppBundles(ctx, deployment)
+	if err != nil {
+		log.Warnf("cannot export offline bundle: %v", err)
+		return errors.Status(err).Err()
+	}
+
+	sender := &offlineBundleSender{
+		stream:     stream,
+		fileName:   fmt.Sprintf("%s-%s-%d.tar.gz", deployment.Name, in.ClusterId, deployment.Generation),
+		generation: utils.ToInt32Clamped(int(deployment.Generation)),
+	}
+	if err := s.writeOfflineBundle(ctx, sender, deployment, bundles, in.ClusterId, in.IncludeImages); err != nil {
+		log.Warnf("cannot export offline bundle: %v", err)
+		return errors.Status(err).Err()
+	}
+	if err := sender.Flush(); err != nil {
+		log.Warnf("cannot export offline bundle: %v", err)
+		return errors.Status(err).Err()
+	}
+
+	if err := s.recordOfflineBundle(ctx, deployment, in.ClusterId); err != nil {
+		log.Warnf("cannot record offline bundle: %v", err)
+		return errors.Status(err).Err()
+	}
+
+	utils.LogActivity(ctx, "export", "Offline Bundle for A Deployment", "deployment name "+deployment.ObjectMeta.Name, "deploy id "+UID, "cluster id "+in.ClusterId)
+	return nil
+}
+
+// listAppBundles returns the Fleet bundles of the apps of the current
+// generation of the deployment, sorted by name
+func (s *DeploymentSvc) listAppBundles(ctx context.Context, deployment *deploymentv1beta1.Deployment) ([]fleetv1alpha1.Bundle, error) {
+	bundleList := &fleetv1alpha1.BundleList{}
+	err := s.fleetBundleClient.Client.List(ctx, deployment.Namespace, bundleList, metav1.ListOptions{
+		LabelSelector: labels.Set{
+			string(deploymentv1beta1.DeploymentID): string(deployment.UID),
+			string(deploymentv1beta1.BundleType):   fleet.BundleTypeApp.String(),
+		}.String(),
+	})
+	if err != nil {
+		return nil, k8serrors.K8sToTypedError(err)
+	}
+
+	generation := fmt.Sprint(deployment.Generation)
+	if len(bundleList.Items) == 0 {
+		return nil, errors.NewUnavailable("deployment id %v generation %s is not rendered yet", deployment.UID, generation)
+	}
+	for _, b := range bundleList.Items {
+		if b.Labels[string(deploymentv1beta1.DeploymentGeneration)] != generation {
+			return nil, errors.NewUnavailable("deployment id %v generation %s is not rendered yet", deployment.UID, generation)
+		}
+	}
+
+	sort.Slice(bundleList.Items, func(i, j int) bool {
+		return bundleList.Items[i].Name < bundleList.Items[j].Name
+	})
+	return bundleList.Items, nil
+}
+
+// writeOfflineBundle writes the archive of the app bundles of the deployment
+// for the given cluster: per app the bundle, the values and chart or the
+// manifests, then the images found in the values if requested.
+func (s *DeploymentSvc) writeOfflineBundle(ctx context.Context, out io.Writer, deployment *deploymentv1beta1.Deployment,
+	bundles []fleetv1alpha1.Bundle, clusterID string, includeImages bool) error {
+	apps := make(map[string]deploymentv1beta1.Application)
+	for _, app := range deployment.Spec.Applications {
+		apps[app.Name] = app
+	}
+	bundleApps := make(map[string]string)
+	for _, b := range bundles {
+		bundleApps[b.Name] = b.Labels[string(deploymentv1beta1.AppName)]
+	}
+
+	w := offlinebundle.NewWriter(out)
+	manifest := &offlinebundle.Manifest{
+		DeploymentID:   string(deployment.UID),
+		DeploymentName: deployment.Spec.DisplayName,
+		ClusterID:      clusterID,
+		Generation:     deployment.Generation,
+		ExportTime:     time.Now().UTC().Truncate(time.Second),
+	}
+	var images []string
+	seen := make(map[string]bool)
+	registryCreds := make(map[string]ociregistry.Credential)
+
+	for i := range bundles {
+		b := &bundles[i]
+		appName := bundleApps[b.Name]
+		app := apps[appName]
+		dir := path.Join(offlinebundle.AppsDir, appName)
+
+		entry := offlinebundle.App{
+			Name:      appName,
+			Namespace: b.Spec.TargetNamespace,
+			Bundle:    path.Join(dir, "bundle.yaml"),
+		}
+		if entry.Namespace == "" {
+			entry.Namespace = b.Spec.DefaultNamespace
+		}
+		for _, dep := range b.Spec.DependsOn {
+			if depApp, ok := bundleApps[dep.Name]; ok {
+				entry.DependsOn = append(entry.DependsOn, depApp)
+			}
+		}
+
+		data, err := bundleYAML(b)
+		if err != nil {
+			return errors.NewInternal("cannot export bundle of app %s: %v", appName, err)
+		}
+		if err := w.WriteFile(entry.Bundle, data); err != nil {
+			return err
+		}
+
+		if b.Spec.Helm == nil || b.Spec.Helm.Chart == "" {
+			entry.Manifests = path.Join(dir, "manifests")
+			if b.Spec.Kustomize != nil && b.Spec.Kustomize.Dir != "" {
+				entry.Manifests = path.Join(entry.Manifests, b.Spec.Kustomize.Dir)
+			}
+			for _, r := range b.Spec.Resources {
+				content, err := decodeBundleResource(r)
+				if err != nil {
+					return errors.NewInternal("cannot export resource %s of app %s: %v", r.Name, appName, err)
+				}
+				if err := w.WriteFile(path.Join(dir, "manifests", path.Clean("/"+r.Name)), content); err != nil {
+					return err
+				}
+			}
+			manifest.Apps = append(manifest.Apps, entry)
+			continue
+		}
+
+		values := clusterValues(b, clusterID)
+		data, err = yaml2.Marshal(values)
+		if err != nil {
+			return errors.NewInternal("cannot export values of app %s: %v", appName, err)
+		}
+		entry.ReleaseName = b.Spec.Helm.ReleaseName
+		entry.Values = path.Join(dir, "values.yaml")
+		if err := w.WriteFile(entry.Values, data); err != nil {
+			return err
+		}
+
+		chartCred, err := s.chartCredential(ctx, deployment.Namespace, app.HelmApp)
+		if err != nil {
+			return err
+		}
+		chart, err := offlinebundle.FetchChart(ctx, b.Spec.Helm.Repo, b.Spec.Helm.Chart, b.Spec.Helm.Version, chartCred)
+		if err != nil {
+			return errors.NewUnavailable("cannot fetch chart of app %s: %v", appName, err)
+		}
+		entry.Chart = path.Join(dir, "chart.tgz")
+		if err := w.WriteFile(entry.Chart, chart); err != nil {
+			return err
+		}
+
+		if includeImages {
+			entry.Images = imagepolicy.Images(values)
+			for _, image := range entry.Images {
+				if !seen[image] {
+					seen[image] = true
+					images = append(images, image)
+				}
+			}
+			if err := s.addRegistryCredential(ctx, registryCreds, deployment.Namespace, app.HelmApp); err != nil {
+				return err
+			}
+		}
+		manifest.Apps = append(manifest.Apps, entry)
+	}
+
+	if includeImages {
+		sort.Strings(images)
+		err := offlinebundle.WriteImages(ctx, w, images, func(registry string) ociregistry.Credential {
+			return registryCreds[registry]
+		})
+		if stdErr.Is(err, errOfflineBundleStream) {
+			return err
+		} else if err != nil {
+			return errors.NewUnavailable("cannot export images: %v", err)
+		}
+	}
+
+	if err := w.WriteManifest(manifest); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// recordOfflineBundle annotates the Deployment CR with the generation
+// exported for the cluster, reported in the status of the cluster while it is
+// disconnected. Only the annotation is patched, on the latest version of the
+// deployment for the concurrent exports to be merged, retried on conflict.
+func (s *DeploymentSvc) recordOfflineBundle(ctx context.Context, deployment *deploymentv1beta1.Deployment, clusterID string) error {
+	generation := deployment.Generation
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest, err := s.crClient.Deployments(deployment.Namespace).Get(ctx, deployment.Name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		exported, err := utils.GetOfflineBundles(latest)
+		if err != nil {
+			log.Warnf("ignoring invalid %s annotation: %v", deploymentv1beta1.OfflineBundles, err)
+		}
+		exported[clusterID] = generation
+		data, err := json.Marshal(exported)
+		if err != nil {
+			return err
+		}
+		patch, err := json.Marshal(map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"resourceVersion": latest.ResourceVersion,
+				"annotations": map[string]string{
+					string(deploymentv1beta1.OfflineBundles): string(data),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = s.crClient.Deployments(deployment.Namespace).Patch(ctx, deployment.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+	if err != nil {
+		return k8serrors.K8sToTypedError(err)
+	}
+	return nil
+}
+
+// chartCredential returns the credentials of the chart repository of an app
+func (s *DeploymentSvc) chartCredential(ctx context.Context, namespace string, helmApp *deploymentv1beta1.HelmApp) (offlinebundle.ChartCredential, error) {
+	if helmApp == nil || helmApp.RepoSecretName == "" {
+		return offlinebundle.ChartCredential{}, nil
+	}
+	secret, err := utils.GetSecretValue(ctx, s.k8sClient, namespace, helmApp.RepoSecretName)
+	if err != nil {
+		return offlinebundle.ChartCredential{}, k8serrors.K8sToTypedError(err)
+	}
+	return offlinebundle.ChartCredential{
+		Username: string(secret.Data["username"]),
+		Password: string(secret.Data["password"]),
+		CACerts:  string(secret.Data["cacerts"]),
+	}, nil
+}
+
+// addRegistryCredential adds the credentials of the image registry of an app
+// by registry host
+func (s *DeploymentSvc) addRegistryCredential(ctx context.Context, creds map[string]ociregistry.Credential, namespace string, helmApp *deploymentv1beta1.HelmApp) error {
+	if helmApp == nil || helmApp.ImageRegistry == "" || helmApp.ImageRegistrySecretName == "" {
+		return nil
+	}
+	u, err := url.Parse(helmApp.ImageRegistry)
+	if err != nil || u.Host == "" {
+		return nil
+	}
+	secret, err := utils.GetSecretValue(ctx, s.k8sClient, namespace, helmApp.ImageRegistrySecretName)
+	if err != nil {
+		return k8serrors.K8sToTypedError(err)
+	}
+	creds[u.Host] = ociregistry.Credential{
+		Username: string(secret.Data["username"]),
+		Password: string(secret.Data["password"]),
+	}
+	return nil
+}
+
+// clusterValues returns the Helm values of a bundle with the values of the
+// targets of the cluster merged
+func clusterValues(b *fleetv1alpha1.Bundle, clusterID string) map[string]interface{} {
+	values := map[string]interface{}{}
+	if b.Spec.Helm.Values != nil {
+		imagepolicy.MergeValues(values, b.Spec.Helm.Values.DeepCopy().Data)
+	}
+	for _, t := range b.Spec.Targets {
+		if t.ClusterName == clusterID && t.Helm != nil && t.Helm.Values != nil {
+			imagepolicy.MergeValues(values, t.Helm.Values.DeepCopy().Data)
+		}
+	}
+	return values
+}
+
+// bundleYAML returns the bundle as applied by Fleet, without status
+func bundleYAML(b *fleetv1alpha1.Bundle) ([]byte, error) {
+	bundle := &fleetv1alpha1.Bundle{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: fleetv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "Bundle",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        b.Name,
+			Namespace:   b.Namespace,
+			Labels:      b.Labels,
+			Annotations: b.Annotations,
+		},
+		Spec: b.Spec,
+	}
+	return yaml2.Marshal(bundle)
+}
+
+// decodeBundleResource returns the content of a resource of a bundle
+func decodeBundleResource(r fleetv1alpha1.BundleResource) ([]byte, error) {
+	switch r.Encoding {
+	case "":
+		return []byte(r.Content), nil
+	case "base64+gz":
+		data, err := base64.StdEncoding.DecodeString(r.Content)
+		if err != nil {
+			return nil, err
+		}
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		return io.ReadAll(io.LimitReader(gz, offlinebundle.MaxChartSize))
+	default:
+		return nil, fmt.Errorf("unsupported encoding %s", r.Encoding)
+	}
+}
@@ -0,0 +1,255 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/metrics"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/ratelimiter"
+)
+
+// RPC classes sharing the same rate limits
+const (
+	rpcClassRead       = "read"
+	rpcClassMutate     = "mutate"
+	rpcClassKubeConfig = "kubeconfig"
+	rpcClassExport     = "export"
+)
+
+const (
+	rateLimitProject = "project"
+	rateLimitUser    = "user"
+
+	// retryAfterHeader tells the throttled caller how many seconds to wait
+	retryAfterHeader = "retry-after"
+
+	// rateLimitIdleTimeout is how long the token buckets of an idle project or
+	// user are kept
+	rateLimitIdleTimeout = 10 * time.Minute
+)
+
+// defaultAPILimits are the rate limits of each RPC class when not set in the environment
+var defaultAPILimits = map[string]ratelimiter.APILimits{
+	rpcClassRead: {
+		Project: ratelimiter.Limit{QPS: 50, Burst: 100},
+		User:    ratelimiter.Limit{QPS: 20, Burst: 40},
+	},
+	rpcClassMutate: {
+		Project: ratelimiter.Limit{QPS: 10, Burst: 20},
+		User:    ratelimiter.Limit{QPS: 5, Burst: 10},
+	},
+	rpcClassKubeConfig: {
+		Project: ratelimiter.Limit{QPS: 2, Burst: 5},
+		User:    ratelimiter.Limit{QPS: 1, Burst: 3},
+	},
+	rpcClassExport: {
+		Project: ratelimiter.Limit{QPS: 1, Burst: 2},
+		User:    ratelimiter.Limit{QPS: 0.5, Burst: 1},
+	},
+}
+
+// rpcClass returns the rate limit class of a gRPC method
+func rpcClass(fullMethod string) string {
+	method := path.Base(fullMethod)
+	switch {
+	case method == "GetKubeConfig":
+		return rpcClassKubeConfig
+	case strings.HasPrefix(method, "Export"):
+		// The exports collect the charts or clusters of a whole project
+		return rpcClassExport
+	case strings.HasPrefix(method, "Get"), strings.HasPrefix(method, "List"),
+		strings.HasPrefix(method, "Preview"):
+		return rpcClassRead
+	default:
+		return rpcClassMutate
+	}
+}
+
+type tokenBucket struct {
+	limiter  *rate.Limiter
+	lastUsed time.Time
+}
+
+// RateLimiter throttles the API requests with token buckets per RPC class,
+// one for each active project and one for each user, so that a single tenant
+// or script cannot degrade the API for the others
+type RateLimiter struct {
+	limits map[string]ratelimiter.APILimits
+	now    func() time.Time
+
+	mu        sync.Mutex
+	buckets   map[string]*tokenBucket
+	lastSweep time.Time
+}
+
+// NewRateLimiter creates a rate limiter with the limits of each RPC class set
+// in the environment.
+func NewRateLimiter() (*RateLimiter, error) {
+	limits := map[string]ratelimiter.APILimits{}
+	for class, defaults := range defaultAPILimits {
+		l, err := ratelimiter.GetAPILimits(class, defaults)
+		if err != nil {
+			return nil, err
+		}
+		log.Infof("API rate limits of %s RPCs: project %v, user %v", class, l.Project, l.User)
+		limits[class] = l
+	}
+	return newRateLimiter(limits), nil
+}
+
+func newRateLimiter(limits map[string]ratelimiter.APILimits) *RateLimiter {
+	return &RateLimiter{
+		limits:  limits,
+		now:     time.Now,
+		buckets: map[string]*tokenBucket{},
+	}
+}
+
+// UnaryServerInterceptor rejects the requests exceeding the rate limits with
+// ResourceExhausted, setting the retry-after header in seconds.
+func (l *RateLimiter) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := l.limit(ctx, info.FullMethod, func(md metadata.MD) error { return grpc.SetHeader(ctx, md) }); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor rejects the streams exceeding the rate limits like
+// UnaryServerInterceptor, a stream taking a single token when opened.
+func (l *RateLimiter) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := l.limit(ss.Context(), info.FullMethod, ss.SetHeader); err != nil {
+			return err
+		}
+		return handler(srv, ss)
+	}
+}
+
+// limit returns ResourceExhausted if the request exceeds the rate limits,
+// setting the retry-after header with setHeader.
+func (l *RateLimiter) limit(ctx context.Context, fullMethod string, setHeader func(metadata.MD) error) error {
+	class := rpcClass(fullMethod)
+	projectID := requestProjectID(ctx)
+
+	// The orchestrator services share a single token for all the
+	// projects, they are only limited per project
+	user := utils.GetRequestUser(ctx)
+	if hasServiceRole(ctx) {
+		user = ""
+	}
+
+	delay, limit := l.reserve(class, projectID, user)
+	if delay == 0 {
+		return nil
+	}
+
+	metrics.APIRequestsThrottled.WithLabelValues(projectID, class, limit).Inc()
+
+	retryAfter := strconv.Itoa(int(math.Ceil(delay.Seconds())))
+	if err := setHeader(metadata.Pairs(retryAfterHeader, retryAfter)); err != nil {
+		log.Warnf("cannot set %s header: %v", retryAfterHeader, err)
+	}
+	log.Warnf("%s rate limit of %s RPCs exceeded by %s, project %s", limit, class, fullMethod, projectID)
+	return status.Errorf(codes.ResourceExhausted, "%s rate limit exceeded, retry after %ss", limit, retryAfter)
+}
+
+// reserve takes a token from the project and user buckets of the RPC class.
+// If either is empty no token is taken, and the time until both have one is
+// returned with the name of the exhausted limit.
+func (l *RateLimiter) reserve(class string, projectID string, user string) (time.Duration, string) {
+	limits, ok := l.limits[class]
+	if !ok {
+		return 0, ""
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.sweep(now)
+
+	keys := []struct {
+		name  string
+		id    string
+		limit ratelimiter.Limit
+	}{
+		{name: rateLimitProject, id: projectID, limit: limits.Project},
+		{name: rateLimitUser, id: user, limit: limits.User},
+	}
+
+	var delay time.Duration
+	exhausted := ""
+	reservations := make([]*rate.Reservation, 0, len(keys))
+	for _, k := range keys {
+		if k.id == "" || k.limit.QPS == 0 {
+			continue
+		}
+		r := l.bucket(class+"/"+k.name+"/"+k.id, k.limit, now).ReserveN(now, 1)
+		reservations = append(reservations, r)
+		if d := r.DelayFrom(now); d > delay {
+			delay = d
+			exhausted = k.name
+		}
+	}
+
+	if delay > 0 {
+		for _, r := range reservations {
+			r.CancelAt(now)
+		}
+	}
+	return delay, exhausted
+}
+
+// bucket returns the token bucket of a key, created full
+func (l *RateLimiter) bucket(key string, limit ratelimiter.Limit, now time.Time) *rate.Limiter {
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &tokenBucket{limiter: rate.NewLimiter(rate.Limit(limit.QPS), max(limit.Burst, 1))}
+		l.buckets[key] = b
+	}
+	b.lastUsed = now
+	return b.limiter
+}
+
+// sweep drops the buckets of the projects and users idle for rateLimitIdleTimeout
+func (l *RateLimiter) sweep(now time.Time) {
+	if now.Sub(l.lastSweep) < rateLimitIdleTimeout {
+		return
+	}
+	l.lastSweep = now
+	for key, b := range l.buckets {
+		if now.Sub(b.lastUsed) >= rateLimitIdleTimeout {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// requestProjectID returns the active project ID of the request, if any
+func requestProjectID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if ids := md.Get("activeprojectid"); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
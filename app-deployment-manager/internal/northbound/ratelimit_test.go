@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils/ratelimiter"
+)
+
+// headerStream records the headers set by the interceptor
+type headerStream struct {
+	header metadata.MD
+}
+
+func (s *headerStream) Method() string { return "" }
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerStream) SendHeader(md metadata.MD) error { return s.SetHeader(md) }
+
+func (s *headerStream) SetTrailer(metadata.MD) error { return nil }
+
+// serverStream is the stream of a streaming RPC recording the headers set by
+// the interceptor
+type serverStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	header metadata.MD
+}
+
+func (s *serverStream) Context() context.Context { return s.ctx }
+
+func (s *serverStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+var _ = Describe("Gateway API Rate Limiter", func() {
+	const (
+		createMethod     = "/deployment.v1.DeploymentService/CreateDeployment"
+		listMethod       = "/deployment.v1.DeploymentService/ListDeployments"
+		kubeConfigMethod = "/deployment.v1.ClusterService/GetKubeConfig"
+		exportMethod     = "/deployment.v1.DeploymentService/ExportOfflineBundle"
+	)
+
+	var (
+		limiter *RateLimiter
+		now     time.Time
+		stream  *headerStream
+	)
+
+	BeforeEach(func() {
+		now = time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC)
+		limiter = newRateLimiter(map[string]ratelimiter.APILimits{
+			rpcClassMutate: {
+				Project: ratelimiter.Limit{QPS: 1, Burst: 3},
+				User:    ratelimiter.Limit{QPS: 1, Burst: 2},
+			},
+			rpcClassRead: {
+				Project: ratelimiter.Limit{QPS: 1, Burst: 1},
+			},
+			rpcClassExport: {
+				Project: ratelimiter.Limit{QPS: 1, Burst: 1},
+			},
+		})
+		limiter.now = func() time.Time { return now }
+		stream = &headerStream{}
+	})
+
+	callWithRoles := func(method string, projectID string, user string, roles ...string) error {
+		md := metadata.Pairs("activeprojectid", projectID, "name", user)
+		for _, role := range roles {
+			md.Append("realm_access/roles", role)
+		}
+		ctx := metadata.NewIncomingContext(context.Background(), md)
+		ctx = grpc.NewContextWithServerTransportStream(ctx, stream)
+		_, err := limiter.UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method},
+			func(context.Context, any) (any, error) { return nil, nil })
+		return err
+	}
+
+	call := func(method string, projectID string, user string) error {
+		return callWithRoles(method, projectID, user)
+	}
+
+	expectThrottled := func(err error, limit string) {
+		Expect(err).To(HaveOccurred())
+		s, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(s.Code()).To(Equal(codes.ResourceExhausted))
+		Expect(s.Message()).To(HavePrefix(limit + " rate limit exceeded"))
+	}
+
+	It("classifies the RPCs", func() {
+		Expect(rpcClass(createMethod)).To(Equal(rpcClassMutate))
+		Expect(rpcClass("/deployment.v1.ClusterService/DrainCluster")).To(Equal(rpcClassMutate))
+		Expect(rpcClass(listMethod)).To(Equal(rpcClassRead))
+		Expect(rpcClass("/deployment.v1.ClusterService/ExportInventory")).To(Equal(rpcClassExport))
+		Expect(rpcClass(exportMethod)).To(Equal(rpcClassExport))
+		Expect(rpcClass("/deployment.v1.ClusterService/PreviewTargets")).To(Equal(rpcClassRead))
+		Expect(rpcClass(kubeConfigMethod)).To(Equal(rpcClassKubeConfig))
+	})
+
+	It("throttles a user with retry-after", func() {
+		Expect(call(createMethod, "project-1", "alice")).To(Succeed())
+		Expect(call(createMethod, "project-1", "alice")).To(Succeed())
+
+		expectThrottled(call(createMethod, "project-1", "alice"), rateLimitUser)
+		Expect(stream.header.Get(retryAfterHeader)).To(Equal([]string{"1"}))
+
+		// Other users of the project keep the remaining project token
+		Expect(call(createMethod, "project-1", "bob")).To(Succeed())
+		expectThrottled(call(createMethod, "project-1", "bob"), rateLimitProject)
+
+		now = now.Add(time.Second)
+		Expect(call(createMethod, "project-1", "alice")).To(Succeed())
+	})
+
+	It("isolates the projects and the RPC classes", func() {
+		Expect(call(listMethod, "project-1", "alice")).To(Succeed())
+		expectThrottled(call(listMethod, "project-1", "alice"), rateLimitProject)
+
+		Expect(call(listMethod, "project-2", "alice")).To(Succeed())
+		Expect(call(createMethod, "project-1", "alice")).To(Succeed())
+	})
+
+	It("limits the service clients per project only", func() {
+		// The resource manager and the service proxy share a single token
+		// for all the projects
+		for _, projectID := range []string{"project-1", "project-2"} {
+			for range 3 {
+				Expect(callWithRoles(createMethod, projectID, "service-account-app-resource-manager", serviceRole)).To(Succeed())
+			}
+		}
+		expectThrottled(callWithRoles(createMethod, "project-1", "service-account-app-resource-manager", serviceRole), rateLimitProject)
+		Expect(limiter.buckets).ToNot(HaveKey(HavePrefix("mutate/user/")))
+	})
+
+	It("throttles the streams with retry-after", func() {
+		ss := &serverStream{
+			ctx: metadata.NewIncomingContext(context.Background(), metadata.Pairs("activeprojectid", "project-1", "name", "alice")),
+		}
+		handled := 0
+		callStream := func() error {
+			return limiter.StreamServerInterceptor()(nil, ss, &grpc.StreamServerInfo{FullMethod: exportMethod, IsServerStream: true},
+				func(any, grpc.ServerStream) error {
+					handled++
+					return nil
+				})
+		}
+
+		Expect(callStream()).To(Succeed())
+		expectThrottled(callStream(), rateLimitProject)
+		Expect(ss.header.Get(retryAfterHeader)).To(Equal([]string{"1"}))
+		Expect(handled).To(Equal(1))
+
+		now = now.Add(time.Second)
+		Expect(callStream()).To(Succeed())
+	})
+
+	It("does not limit the RPC classes without limits", func() {
+		for range 10 {
+			Expect(call(kubeConfigMethod, "project-1", "alice")).To(Succeed())
+		}
+	})
+
+	It("drops the buckets of idle projects and users", func() {
+		Expect(call(createMethod, "project-1", "alice")).To(Succeed())
+		Expect(limiter.buckets).To(HaveLen(2))
+
+		now = now.Add(rateLimitIdleTimeout)
+		Expect(call(createMethod, "project-2", "bob")).To(Succeed())
+		Expect(limiter.buckets).To(HaveLen(2))
+		Expect(limiter.buckets).To(HaveKey("mutate/project/project-2"))
+	})
+})
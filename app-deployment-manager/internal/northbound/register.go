@@ -6,7 +6,6 @@ package northbound
 
 import (
 	"fmt"
-	"sync"
 
 	"buf.build/go/protovalidate"
 	clientv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/appdeploymentclient/v1beta1"
@@ -81,7 +80,10 @@ type DeploymentSvc struct {
 	catalogClient     catalogclient.CatalogClient
 	vaultAuthClient   auth.VaultAuth
 	protoValidator    protovalidate.Validator
-	apiMutex          sync.Mutex
+
+	// projectLocks serialize the mutations of the deployments of a project,
+	// which share secrets and dependency relationships
+	projectLocks keyedMutex
 }
 
 // NewDeploymentMustSucceed is a convenience wrapper for tests that panics on validator creation failure.
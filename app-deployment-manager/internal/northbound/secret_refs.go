@@ -0,0 +1,186 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"strings"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const defaultSecretStoreKind = "ClusterSecretStore"
+
+// validateSecretRefs checks that the secret references of the override values
+// refer to Helm apps of the deployment, that their keys are not also set in
+// plain values and that Vault paths stay within the deployment values of the
+// project.
+func validateSecretRefs(d *Deployment, allOverrideKeys map[string][]string) error {
+	apps := make(map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = true
+		}
+	}
+	manifestApps := make(map[string]bool)
+	for _, app := range d.ManifestApps {
+		manifestApps[app.GetName()] = true
+	}
+
+	for _, override := range d.OverrideValues {
+		appName := override.GetAppName()
+		if len(override.GetSecretRefs()) == 0 {
+			continue
+		}
+		if !apps[appName] {
+			return errors.NewInvalid("overrideValues.appName %s not found in deployment package %s", appName, d.AppName)
+		}
+		if manifestApps[appName] {
+			return errors.NewInvalid("overrideValues.secretRefs is not supported by manifest app %s", appName)
+		}
+
+		for i, ref := range override.GetSecretRefs() {
+			for _, k := range allOverrideKeys[appName] {
+				if k == ref.GetKey() {
+					return errors.NewInvalid("overrideValues.secretRefs.key %s of app %s is also set in overrideValues.values", k, appName)
+				}
+			}
+			for _, other := range override.GetSecretRefs()[:i] {
+				if keysOverlap(ref.GetKey(), other.GetKey()) {
+					return errors.NewInvalid("overrideValues.secretRefs.key %s of app %s overlaps %s", ref.GetKey(), appName, other.GetKey())
+				}
+			}
+
+			if ref.GetVault() == nil {
+				continue
+			}
+			if enabled, _ := utils.IsSecretServiceEnabled(); !enabled {
+				return errors.NewInvalid("overrideValues.secretRefs.vault requires the secret service")
+			}
+			for _, elem := range strings.Split(ref.GetVault().GetPath(), "/") {
+				if elem == ".." {
+					return errors.NewInvalid("overrideValues.secretRefs.vault.path %s must not leave the deployment values", ref.GetVault().GetPath())
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkSealingCert checks that the values of the secret parameters and the
+// values read from Vault can be sealed for the edge clusters, as they are
+// never written in plain text to the repositories
+func checkSealingCert(d *Deployment) error {
+	if utils.GetSealedSecretCert() != "" {
+		return nil
+	}
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			if d.ParameterTemplateSecrets[app.Name] != "" {
+				return errors.NewInvalid("secret parameters of app %s cannot be deployed, the sealed secrets certificate is not configured", app.Name)
+			}
+		}
+	}
+	for _, override := range d.OverrideValues {
+		for _, ref := range override.GetSecretRefs() {
+			if ref.GetVault() != nil {
+				return errors.NewInvalid("overrideValues.secretRefs.vault of app %s cannot be deployed, the sealed secrets certificate is not configured", override.GetAppName())
+			}
+		}
+	}
+	return nil
+}
+
+// keysOverlap returns true if the dotted keys are equal or one is nested in
+// the other
+func keysOverlap(a string, b string) bool {
+	return a == b || strings.HasPrefix(a, b+".") || strings.HasPrefix(b, a+".")
+}
+
+// hasSecretRef returns true if the value of the given key of an app is read
+// from a secret store
+func hasSecretRef(overrides []*deploymentpb.OverrideValues, appName string, key string) bool {
+	for _, override := range overrides {
+		if override.GetAppName() != appName {
+			continue
+		}
+		for _, ref := range override.GetSecretRefs() {
+			if ref.GetKey() == key {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// secretRefs returns the secret references of the given app for the
+// Deployment CR.
+func secretRefs(overrides []*deploymentpb.OverrideValues, appName string) []deploymentv1beta1.SecretRef {
+	var list []deploymentv1beta1.SecretRef
+	for _, override := range overrides {
+		if override.GetAppName() != appName {
+			continue
+		}
+		for _, ref := range override.GetSecretRefs() {
+			secretRef := deploymentv1beta1.SecretRef{
+				Key: ref.GetKey(),
+			}
+			switch {
+			case ref.GetVault() != nil:
+				secretRef.Vault = &deploymentv1beta1.VaultSecretRef{
+					Path: ref.GetVault().GetPath(),
+					Key:  ref.GetVault().GetKey(),
+				}
+			case ref.GetExternal() != nil:
+				storeKind := ref.GetExternal().GetStoreKind()
+				if storeKind == "" {
+					storeKind = defaultSecretStoreKind
+				}
+				secretRef.External = &deploymentv1beta1.ExternalSecretRef{
+					Store:     ref.GetExternal().GetStore(),
+					StoreKind: storeKind,
+					Key:       ref.GetExternal().GetKey(),
+					Property:  ref.GetExternal().GetProperty(),
+				}
+			}
+			list = append(list, secretRef)
+		}
+	}
+	return list
+}
+
+// createSecretRefs returns the secret references of an app of the Deployment
+// CR.
+func createSecretRefs(refs []deploymentv1beta1.SecretRef) []*deploymentpb.SecretRef {
+	var list []*deploymentpb.SecretRef
+	for _, ref := range refs {
+		secretRef := &deploymentpb.SecretRef{
+			Key: ref.Key,
+		}
+		switch {
+		case ref.Vault != nil:
+			secretRef.Source = &deploymentpb.SecretRef_Vault{
+				Vault: &deploymentpb.VaultSecretRef{
+					Path: ref.Vault.Path,
+					Key:  ref.Vault.Key,
+				},
+			}
+		case ref.External != nil:
+			secretRef.Source = &deploymentpb.SecretRef_External{
+				External: &deploymentpb.ExternalSecretRef{
+					Store:     ref.External.Store,
+					StoreKind: ref.External.StoreKind,
+					Key:       ref.External.Key,
+					Property:  ref.External.Property,
+				},
+			}
+		}
+		list = append(list, secretRef)
+	}
+	return list
+}
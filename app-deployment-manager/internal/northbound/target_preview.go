@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+// PreviewTargets returns the clusters each application of a deployment would be
+// deployed to. The targets are validated and merged like CreateDeployment does
+// and matched against the cluster labels like the Fleet cluster selectors of
+// the GitRepos are.
+func (s *DeploymentSvc) PreviewTargets(ctx context.Context, in *deploymentpb.PreviewTargetsRequest) (*deploymentpb.PreviewTargetsResponse, error) {
+	if in == nil {
+		log.Warnf("incomplete request")
+		return nil, errors.Status(errors.NewInvalid("incomplete request")).Err()
+	}
+
+	if err := s.protoValidator.Validate(in); err != nil {
+		log.Warnf("%v", err)
+		return nil, errors.Status(errors.NewInvalid("%v", err)).Err()
+	}
+
+	// RBAC auth
+	if err := s.AuthCheckAllowed(ctx, in); err != nil {
+		log.Warnf("cannot preview targets: %v", err)
+		return nil, errors.Status(errors.NewForbidden("cannot preview targets: %v", err)).Err()
+	}
+
+	activeProjectID, err := s.GetActiveProjectID(ctx)
+	if err != nil {
+		msg := fmt.Sprintf("failed to get tenant project ID %s", err.Error())
+		return nil, errors.Status(errors.NewUnavailable(msg)).Err()
+	}
+
+	d := &Deployment{
+		AppName:              in.GetAppName(),
+		AppVersion:           in.GetAppVersion(),
+		TargetClusters:       in.GetTargetClusters(),
+		AllAppTargetClusters: in.GetAllAppTargetClusters(),
+		DeploymentType:       string(deploymentType(in.GetDeploymentType())),
+		ActiveProjectID:      activeProjectID,
+	}
+	if err := s.initTargetClusters(ctx, d); err != nil {
+		log.Warnf("cannot preview targets: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	// The applications of the deployment package are only needed to apply
+	// the targets of all the applications
+	if d.AllAppTargetClusters != nil {
+		if d.AppName == "" || d.AppVersion == "" {
+			return nil, errors.Status(errors.NewInvalid("missing appName or appVersion for allAppTargetClusters in request")).Err()
+		}
+		_, helmApps, _, err := catalogclient.CatalogLookupDPAndHelmApps(ctx, s.catalogClient, d.AppName, d.AppVersion, "")
+		if err != nil {
+			log.Warnf("cannot preview targets: %v", err)
+			return nil, errors.Status(errors.NewNotFound("%v", err)).Err()
+		}
+		d.HelmApps = helmApps
+		if err := mergeAllAppTargetClusters(ctx, d); err != nil {
+			return nil, errors.Status(err).Err()
+		}
+	}
+
+	clusters, err := s.listProjectClusters(ctx, activeProjectID)
+	if err != nil {
+		log.Warnf("cannot preview targets: %v", err)
+		return nil, errors.Status(err).Err()
+	}
+
+	appNames := previewAppNames(d)
+	matched := make(map[string]int, len(clusters))
+	apps := make([]*deploymentpb.AppTargetClusters, 0, len(appNames))
+	for _, appName := range appNames {
+		appClusters, err := s.matchTargetClusters(ctx, d, appName, clusters)
+		if err != nil {
+			log.Warnf("cannot preview targets: %v", err)
+			return nil, errors.Status(err).Err()
+		}
+
+		infos := make([]*deploymentpb.ClusterInfo, 0, len(appClusters))
+		for i := range appClusters {
+			matched[appClusters[i].Name]++
+			infos = append(infos, clusterInfo(&appClusters[i]))
+		}
+		apps = append(apps, &deploymentpb.AppTargetClusters{
+			AppName:       appName,
+			Clusters:      infos,
+			TotalElements: utils.ToInt32Clamped(len(infos)),
+		})
+	}
+
+	partialClusters := make([]*deploymentpb.ClusterInfo, 0)
+	for i := range clusters {
+		if count := matched[clusters[i].Name]; count > 0 && count < len(appNames) {
+			partialClusters = append(partialClusters, clusterInfo(&clusters[i]))
+		}
+	}
+
+	utils.LogActivity(ctx, "preview", "ADM", "targets of deployment package "+d.AppName)
+
+	return &deploymentpb.PreviewTargetsResponse{
+		Apps:            apps,
+		PartialClusters: partialClusters,
+		TotalElements:   utils.ToInt32Clamped(len(matched)),
+	}, nil
+}
+
+// previewAppNames returns the applications of the deployment package if known,
+// else the applications of the target clusters
+func previewAppNames(d *Deployment) []string {
+	var appNames []string
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			appNames = append(appNames, app.Name)
+		}
+		return appNames
+	}
+
+	seen := make(map[string]bool)
+	for _, target := range d.TargetClusters {
+		if !seen[target.AppName] {
+			seen[target.AppName] = true
+			appNames = append(appNames, target.AppName)
+		}
+	}
+	return appNames
+}
+
+// matchTargetClusters returns the clusters matched by any target of the
+// application, the cluster groups being resolved to their current members.
+// Cordoned and drained clusters are left out, as the deployment controller
+// does not target them.
+func (s *DeploymentSvc) matchTargetClusters(ctx context.Context, d *Deployment, appName string, clusters []deploymentv1beta1.Cluster) ([]deploymentv1beta1.Cluster, error) {
+	found := make(map[string]bool)
+	for _, target := range d.TargetClusters {
+		if target.AppName != appName {
+			continue
+		}
+
+		if target.ClusterGroup != "" {
+			group, err := s.getClusterGroup(ctx, d.ActiveProjectID, target.ClusterGroup)
+			if err != nil {
+				return nil, err
+			}
+			for _, id := range group.Status.Clusters {
+				found[id] = true
+			}
+			continue
+		}
+
+		matchLabels := make(map[string]string, len(target.Labels)+1)
+		for k, v := range target.Labels {
+			matchLabels[k] = v
+		}
+		if d.DeploymentType == string(deploymentv1beta1.Targeted) {
+			matchLabels[string(deploymentv1beta1.ClusterName)] = target.ClusterId
+		}
+
+		// Same labels as the GitRepo target generated by the deployment
+		// controller, which also excludes the cordoned clusters
+		selector, err := metav1.LabelSelectorAsSelector(&metav1.LabelSelector{MatchLabels: matchLabels})
+		if err != nil {
+			return nil, errors.NewInvalid("%v", err)
+		}
+		for _, cluster := range clusters {
+			if selector.Matches(labels.Set(cluster.Labels)) {
+				found[cluster.Name] = true
+			}
+		}
+	}
+
+	appClusters := make([]deploymentv1beta1.Cluster, 0, len(found))
+	for _, cluster := range clusters {
+		if found[cluster.Name] && cluster.Spec.Cordon == "" {
+			appClusters = append(appClusters, cluster)
+		}
+	}
+	return appClusters, nil
+}
+
+func clusterInfo(cluster *deploymentv1beta1.Cluster) *deploymentpb.ClusterInfo {
+	setPbTime := cluster.ObjectMeta.CreationTimestamp.ProtoTime()
+	return &deploymentpb.ClusterInfo{
+		Id:         cluster.Name,
+		Labels:     cluster.Labels,
+		CreateTime: timestamppb.New(time.Unix(setPbTime.Seconds, 0)),
+		Name:       cluster.Spec.DisplayName,
+		Health:     clusterHealth(cluster),
+		Cordon:     string(cluster.Spec.Cordon),
+	}
+}
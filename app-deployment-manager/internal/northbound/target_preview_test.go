@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	catalog "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	nbmocks "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/northbound/mocks"
+)
+
+var _ = Describe("Gateway API PreviewTargets", func() {
+	var (
+		deploymentServer *DeploymentSvc
+		crClient         *nbmocks.FakeDeploymentV1
+		clusterList      *deploymentv1beta1.ClusterList
+	)
+
+	newCluster := func(id string, clusterLabels map[string]string) deploymentv1beta1.Cluster {
+		clusterLabels[deploymentv1beta1.ClusterOrchKeyProjectID] = ""
+		return deploymentv1beta1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: id, Labels: clusterLabels}}
+	}
+
+	appIDs := func(app *deploymentpb.AppTargetClusters) []string {
+		var ids []string
+		for _, c := range app.Clusters {
+			ids = append(ids, c.Id)
+		}
+		return ids
+	}
+
+	BeforeEach(func() {
+		crClient = &nbmocks.FakeDeploymentV1{}
+		deploymentServer = NewDeploymentMustSucceed(crClient, nil, nil, nil, nil, nil, nil)
+
+		clusterList = &deploymentv1beta1.ClusterList{
+			Items: []deploymentv1beta1.Cluster{
+				newCluster("store-1", map[string]string{"region": "west", "pilot": "true", string(deploymentv1beta1.ClusterName): "store-1"}),
+				newCluster("store-2", map[string]string{"region": "west", string(deploymentv1beta1.ClusterName): "store-2"}),
+				newCluster("store-3", map[string]string{"region": "east", string(deploymentv1beta1.ClusterName): "store-3"}),
+			},
+		}
+	})
+
+	It("successfully matches the label targets of each app", func() {
+		crClient.On(
+			"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(clusterList, nil).Once()
+
+		resp, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{
+			TargetClusters: []*deploymentpb.TargetClusters{
+				{AppName: "wordpress", Labels: map[string]string{"region": "west"}},
+				{AppName: "mariadb", Labels: map[string]string{"region": "west", "pilot": "true"}},
+				{AppName: "mariadb", Labels: map[string]string{"region": "east"}},
+			},
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Apps).To(HaveLen(2))
+		Expect(resp.Apps[0].AppName).To(Equal("wordpress"))
+		Expect(appIDs(resp.Apps[0])).To(Equal([]string{"store-1", "store-2"}))
+		Expect(resp.Apps[1].AppName).To(Equal("mariadb"))
+		Expect(appIDs(resp.Apps[1])).To(Equal([]string{"store-1", "store-3"}))
+		Expect(resp.Apps[1].TotalElements).To(Equal(int32(2)))
+		Expect(resp.TotalElements).To(Equal(int32(3)))
+		Expect(resp.PartialClusters).To(HaveLen(2))
+		Expect(resp.PartialClusters[0].Id).To(Equal("store-2"))
+		Expect(resp.PartialClusters[1].Id).To(Equal("store-3"))
+	})
+
+	It("successfully matches the cluster ids of a targeted deployment", func() {
+		crClient.On(
+			"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(clusterList, nil).Once()
+
+		resp, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{
+			DeploymentType: "targeted",
+			TargetClusters: []*deploymentpb.TargetClusters{
+				{AppName: "wordpress", ClusterId: "store-3"},
+			},
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appIDs(resp.Apps[0])).To(Equal([]string{"store-3"}))
+		Expect(resp.PartialClusters).To(BeEmpty())
+	})
+
+	It("successfully leaves out the cordoned and drained clusters", func() {
+		clusterList.Items[1].Spec.Cordon = deploymentv1beta1.Cordoned
+		clusterList.Items[2].Spec.Cordon = deploymentv1beta1.Drained
+		crClient.On(
+			"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(clusterList, nil).Once()
+
+		resp, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{
+			TargetClusters: []*deploymentpb.TargetClusters{
+				{AppName: "wordpress", Labels: map[string]string{"region": "west"}},
+				{AppName: "mariadb", Labels: map[string]string{"region": "east"}},
+			},
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(appIDs(resp.Apps[0])).To(Equal([]string{"store-1"}))
+		Expect(resp.Apps[1].Clusters).To(BeEmpty())
+		Expect(resp.TotalElements).To(Equal(int32(1)))
+		Expect(resp.PartialClusters).To(HaveLen(1))
+		Expect(resp.PartialClusters[0].Id).To(Equal("store-1"))
+	})
+
+	It("successfully applies the targets of all the apps of the deployment package", func() {
+		lookup := catalogclient.CatalogLookupDPAndHelmApps
+		DeferCleanup(func() {
+			catalogclient.CatalogLookupDPAndHelmApps = lookup
+		})
+		catalogclient.CatalogLookupDPAndHelmApps = func(_ context.Context, _ catalogclient.CatalogClient, _ string, _ string, _ string) (*catalog.DeploymentPackage, *[]catalogclient.HelmApp, string, error) {
+			return &catalog.DeploymentPackage{}, &[]catalogclient.HelmApp{{Name: "wordpress"}, {Name: "mariadb"}}, "default", nil
+		}
+		crClient.On(
+			"ListClusters", context.Background(), mock.AnythingOfType("v1.ListOptions"),
+		).Return(clusterList, nil).Once()
+
+		resp, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{
+			AppName:              "wordpress",
+			AppVersion:           "0.1.0",
+			AllAppTargetClusters: &deploymentpb.TargetClusters{Labels: map[string]string{"pilot": "true"}},
+		})
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Apps).To(HaveLen(2))
+		Expect(appIDs(resp.Apps[0])).To(Equal([]string{"store-1"}))
+		Expect(appIDs(resp.Apps[1])).To(Equal([]string{"store-1"}))
+		Expect(resp.TotalElements).To(Equal(int32(1)))
+	})
+
+	It("fails due to missing deployment package for all the apps", func() {
+		_, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{
+			AllAppTargetClusters: &deploymentpb.TargetClusters{Labels: map[string]string{"pilot": "true"}},
+		})
+
+		Expect(err).To(HaveOccurred())
+		s, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(s.Code()).To(Equal(codes.InvalidArgument))
+		Expect(s.Message()).Should(Equal("missing appName or appVersion for allAppTargetClusters in request"))
+	})
+
+	It("fails due to missing targets", func() {
+		_, err := deploymentServer.PreviewTargets(context.Background(), &deploymentpb.PreviewTargetsRequest{})
+
+		Expect(err).To(HaveOccurred())
+		s, ok := status.FromError(err)
+		Expect(ok).To(BeTrue())
+		Expect(s.Code()).To(Equal(codes.InvalidArgument))
+		Expect(s.Message()).Should(Equal("missing targetClusters in request"))
+	})
+})
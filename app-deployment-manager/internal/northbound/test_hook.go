@@ -0,0 +1,144 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package northbound
+
+import (
+	"context"
+
+	catalog "github.com/open-edge-platform/app-orch-catalog/pkg/api/catalog/v3"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+
+	deploymentpb "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	deploymentv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/catalogclient"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/fleet"
+)
+
+// validateTestHooks checks that every test hook refers to an app of the deployment package and
+// that test names are unique per app. The Job manifests of test hooks referring to an artifact of
+// the deployment package are looked up and validated.
+func validateTestHooks(ctx context.Context, s *DeploymentSvc, d *Deployment, dp *catalog.DeploymentPackage) error {
+	apps := make(map[string]map[string]bool)
+	if d.HelmApps != nil {
+		for _, app := range *d.HelmApps {
+			apps[app.Name] = make(map[string]bool)
+		}
+	}
+
+	for _, test := range d.TestHooks {
+		names, ok := apps[test.AppName]
+		if !ok {
+			return errors.NewInvalid("testHooks.appName %s not found in deployment package %s", test.AppName, d.AppName)
+		}
+		if names[test.Name] {
+			return errors.NewInvalid("duplicate testHooks.name %s for app %s", test.Name, test.AppName)
+		}
+		names[test.Name] = true
+
+		artifactName := test.GetArtifact()
+		if artifactName == "" {
+			continue
+		}
+		if _, ok := d.TestHookManifests[artifactName]; ok {
+			continue
+		}
+
+		artifact, err := catalogclient.CatalogLookupTestArtifact(ctx, s.catalogClient, dp, artifactName)
+		if err != nil {
+			return errors.NewInvalid("testHooks.artifact %s: %v", artifactName, err)
+		}
+		if _, err := fleet.ParseTestJobManifest(string(artifact.GetArtifact())); err != nil {
+			return errors.NewInvalid("testHooks.artifact %s: %v", artifactName, err)
+		}
+		if d.TestHookManifests == nil {
+			d.TestHookManifests = make(map[string]string)
+		}
+		d.TestHookManifests[artifactName] = string(artifact.GetArtifact())
+	}
+	return nil
+}
+
+// testHooks returns the test hooks of the given app for the Deployment CR.
+func testHooks(tests []*deploymentpb.TestHook, manifests map[string]string, appName string) []deploymentv1beta1.TestHook {
+	var list []deploymentv1beta1.TestHook
+	for _, test := range tests {
+		if test.GetAppName() != appName {
+			continue
+		}
+
+		th := deploymentv1beta1.TestHook{
+			Name:           test.GetName(),
+			TimeoutSeconds: test.GetTimeoutSeconds(),
+			BackoffLimit:   test.GetBackoffLimit(),
+		}
+		switch {
+		case test.GetJob() != nil:
+			th.Job = &deploymentv1beta1.JobProbe{
+				Image:   test.GetJob().GetImage(),
+				Command: test.GetJob().GetCommand(),
+				Args:    test.GetJob().GetArgs(),
+			}
+		case test.GetArtifact() != "":
+			th.Artifact = test.GetArtifact()
+			th.Manifest = manifests[test.GetArtifact()]
+		default:
+			continue
+		}
+		list = append(list, th)
+	}
+	return list
+}
+
+// createTestHooks returns the test hooks of all apps of the Deployment CR.
+func createTestHooks(apps []deploymentv1beta1.Application) []*deploymentpb.TestHook {
+	var list []*deploymentpb.TestHook
+	for _, app := range apps {
+		for _, th := range app.TestHooks {
+			test := &deploymentpb.TestHook{
+				AppName:        app.Name,
+				Name:           th.Name,
+				TimeoutSeconds: th.TimeoutSeconds,
+				BackoffLimit:   th.BackoffLimit,
+			}
+			switch {
+			case th.Job != nil:
+				test.Test = &deploymentpb.TestHook_Job{
+					Job: &deploymentpb.JobProbe{
+						Image:   th.Job.Image,
+						Command: th.Job.Command,
+						Args:    th.Job.Args,
+					},
+				}
+			case th.Artifact != "":
+				test.Test = &deploymentpb.TestHook_Artifact{
+					Artifact: th.Artifact,
+				}
+			}
+			list = append(list, test)
+		}
+	}
+	return list
+}
+
+// createAppTests returns the test hook results of an app, or nil if the app has no test hooks.
+func createAppTests(tests *deploymentv1beta1.Tests) *deploymentpb.AppTests {
+	if tests == nil {
+		return nil
+	}
+
+	appTests := &deploymentpb.AppTests{
+		State:   string(tests.State),
+		Results: make([]*deploymentpb.TestResult, len(tests.Results)),
+	}
+	for i, r := range tests.Results {
+		appTests.Results[i] = &deploymentpb.TestResult{
+			Name:    r.Name,
+			State:   string(r.State),
+			Message: r.Message,
+			Logs:    r.Logs,
+		}
+	}
+	return appTests
+}
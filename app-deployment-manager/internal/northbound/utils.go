@@ -43,11 +43,31 @@ func deploymentType(s string) deploymentv1beta1.DeploymentType {
 		return deploymentv1beta1.AutoScaling
 	case deploymentv1beta1.Targeted:
 		return deploymentv1beta1.Targeted
+	case deploymentv1beta1.BlueGreen:
+		return deploymentv1beta1.BlueGreen
 	default:
 		return deploymentv1beta1.AutoScaling
 	}
 }
 
+func driftPolicy(s string) deploymentv1beta1.DriftPolicyType {
+	switch s := deploymentv1beta1.DriftPolicyType(s); s {
+	case deploymentv1beta1.AutoCorrect:
+		return deploymentv1beta1.AutoCorrect
+	default:
+		return deploymentv1beta1.AlertOnly
+	}
+}
+
+func priority(s string) deploymentv1beta1.PriorityType {
+	switch s := deploymentv1beta1.PriorityType(s); s {
+	case deploymentv1beta1.PriorityCritical, deploymentv1beta1.PriorityHigh, deploymentv1beta1.PriorityLow:
+		return s
+	default:
+		return deploymentv1beta1.PriorityNormal
+	}
+}
+
 func deploymentState(s string) deploymentpb.State {
 	switch s {
 	case "True", "Running":
@@ -101,6 +121,21 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 
 	labelList[activeProjectIDKey] = d.ActiveProjectID
 
+	// Keep existing annotations on update and record who requested the change
+	var annotationList map[string]string
+	if existingDeployment != nil && len(existingDeployment.Annotations) > 0 {
+		annotationList = make(map[string]string, len(existingDeployment.Annotations)+1)
+		for k, v := range existingDeployment.Annotations {
+			annotationList[k] = v
+		}
+	}
+	if d.RequestedBy != "" {
+		if annotationList == nil {
+			annotationList = make(map[string]string, 1)
+		}
+		annotationList[string(deploymentv1beta1.RequestedBy)] = d.RequestedBy
+	}
+
 	// For update scenario, preserve NetworkRef if not provided in the request
 	if scenario == "update" && existingDeployment != nil {
 		if d.NetworkName == "" && existingDeployment.Spec.NetworkRef.Name != "" {
@@ -131,7 +166,7 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 			// For update scenario:
 			// - For AutoScaling: accumulate targets (UI sends one label set at a time)
 			// - For Targeted: replacement logic (UI sends complete desired state)
-			if scenario == "update" && existingDeployment != nil && d.DeploymentType == string(deploymentv1beta1.AutoScaling) {
+			if scenario == "update" && existingDeployment != nil && labelTargeted(d.DeploymentType) {
 				for _, existingApp := range existingDeployment.Spec.Applications {
 					if existingApp.Name == app.Name {
 						// Start with existing targets for AutoScaling only
@@ -144,7 +179,7 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 			// Add or update targets from the request
 			for _, target := range d.TargetClusters {
 				if app.Name == target.AppName {
-					if d.DeploymentType == string(deploymentv1beta1.Targeted) {
+					if d.DeploymentType == string(deploymentv1beta1.Targeted) && target.ClusterGroup == "" {
 						target.Labels[string(deploymentv1beta1.ClusterName)] = target.ClusterId
 					}
 
@@ -311,6 +346,8 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 				EnableServiceExport: enabledServiceExport,
 				ProfileSecretName:   d.ProfileSecretName[app.Name],
 				ValueSecretName:     d.ValueSecretName[app.Name],
+				ParameterSecretName: d.ParameterSecretName[app.Name],
+				SecretRefs:          secretRefs(d.OverrideValues, app.Name),
 				DependsOn:           app.DependsOn,
 				RedeployAfterUpdate: app.RedeployAfterUpdate,
 				IgnoreResources:     ignoreResource,
@@ -323,6 +360,17 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 					ImageRegistrySecretName: d.ImageRegistrySecretName[app.Name],
 				},
 				DependentDeploymentPackages: dependentDeploymentPackages,
+				HealthProbes:                healthProbes(d.HealthProbes, app.Name),
+				TestHooks:                   testHooks(d.TestHooks, d.TestHookManifests, app.Name),
+				BlueGreenServices:           blueGreenServices(d.BlueGreenServices, app.Name),
+				NamespacePolicy:             namespacePolicy(d.NamespacePolicies, app.Name),
+				KustomizePatches:            kustomizePatches(d.KustomizePatches, app.Name),
+			}
+
+			// Manifest apps are deployed without Helm chart
+			if ma := manifestApp(d.ManifestApps, d.ManifestAppManifests, app.Name); ma != nil {
+				applicationsList[appsIndex].HelmApp = nil
+				applicationsList[appsIndex].ManifestApp = ma
 			}
 		}
 	}
@@ -340,9 +388,10 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 
 		setInstance := &deploymentv1beta1.Deployment{
 			ObjectMeta: metav1.ObjectMeta{
-				Name:      d.Name,
-				Namespace: d.Namespace,
-				Labels:    labelList,
+				Name:        d.Name,
+				Namespace:   d.Namespace,
+				Labels:      labelList,
+				Annotations: annotationList,
 			},
 			Spec: deploymentv1beta1.DeploymentSpec{
 				DisplayName:          d.DisplayName,
@@ -350,6 +399,10 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 				DeploymentPackageRef: dpRef,
 				Applications:         applicationsList,
 				DeploymentType:       deploymentType(d.DeploymentType),
+				DriftPolicy:          driftPolicy(d.DriftPolicy),
+				Priority:             priority(d.Priority),
+				Canary:               canarySpec(d.Canary),
+				ResourceRequests:     resourceRequestsSpec(d.ResourceRequests),
 				NetworkRef: corev1.ObjectReference{
 					Name:       d.NetworkName,
 					Kind:       "Network",
@@ -365,6 +418,7 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 				Name:            d.Name,
 				Namespace:       d.Namespace,
 				Labels:          labelList,
+				Annotations:     annotationList,
 				ResourceVersion: resourceVersion,
 			},
 			Spec: deploymentv1beta1.DeploymentSpec{
@@ -373,6 +427,10 @@ func createDeploymentCr(d *Deployment, scenario string, resourceVersion string,
 				DeploymentPackageRef: dpRef,
 				Applications:         applicationsList,
 				DeploymentType:       deploymentType(d.DeploymentType),
+				DriftPolicy:          driftPolicy(d.DriftPolicy),
+				Priority:             priority(d.Priority),
+				Canary:               canarySpec(d.Canary),
+				ResourceRequests:     resourceRequestsSpec(d.ResourceRequests),
 				NetworkRef: corev1.ObjectReference{
 					Name:       d.NetworkName,
 					Kind:       "Network",
@@ -416,6 +474,8 @@ func createDeploymentClusterCr(dc *deploymentv1beta1.DeploymentCluster) *deploym
 				Id:     app.Id,
 				Name:   app.Name,
 				Status: appStatus,
+				Health: createAppHealth(app.Health),
+				Tests:  createAppTests(app.Tests),
 			}
 		}
 	}
@@ -446,6 +506,45 @@ func createDeploymentClusterCr(dc *deploymentv1beta1.DeploymentCluster) *deploym
 	return cluster
 }
 
+// Set the drift details of the deployment cluster and return the instance.
+func createClusterDrift(dc *deploymentv1beta1.DeploymentCluster) *deploymentpb.ClusterDrift {
+	clusterDrift := &deploymentpb.ClusterDrift{
+		Name: dc.Status.Name,
+		Id:   dc.Spec.ClusterID,
+		Apps: make([]*deploymentpb.AppDrift, 0),
+	}
+
+	for _, app := range dc.Status.Apps {
+		if app.Drift == nil {
+			continue
+		}
+
+		resources := make([]*deploymentpb.DriftedResource, len(app.Drift.Resources))
+		for i, r := range app.Drift.Resources {
+			resources[i] = &deploymentpb.DriftedResource{
+				Kind:       r.Kind,
+				ApiVersion: r.APIVersion,
+				Namespace:  r.Namespace,
+				Name:       r.Name,
+				Type:       string(r.Type),
+				Patch:      r.Patch,
+			}
+		}
+
+		clusterDrift.Apps = append(clusterDrift.Apps, &deploymentpb.AppDrift{
+			Name:      app.Name,
+			Id:        app.Id,
+			Modified:  utils.ToInt32Clamped(app.Drift.Modified),
+			Missing:   utils.ToInt32Clamped(app.Drift.Missing),
+			Orphaned:  utils.ToInt32Clamped(app.Drift.Orphaned),
+			Resources: resources,
+		})
+	}
+	clusterDrift.Drifted = len(clusterDrift.Apps) != 0
+
+	return clusterDrift
+}
+
 // Return the matching deployment object.
 func matchUIDDeployment(ctx context.Context, UID string, namespace string, s *DeploymentSvc, listOpts metav1.ListOptions) (*deploymentv1beta1.Deployment, error) {
 	var deployment deploymentv1beta1.Deployment
@@ -470,6 +569,19 @@ func matchUIDDeployment(ctx context.Context, UID string, namespace string, s *De
 	return &deployment, nil
 }
 
+// deleteOptions returns the options deleting a deployment only if it is still
+// the version read, so that the dependency checks done on it still hold
+func deleteOptions(deployment *deploymentv1beta1.Deployment) metav1.DeleteOptions {
+	preconditions := &metav1.Preconditions{}
+	if deployment.UID != "" {
+		preconditions.UID = &deployment.UID
+	}
+	if deployment.ResourceVersion != "" {
+		preconditions.ResourceVersion = &deployment.ResourceVersion
+	}
+	return metav1.DeleteOptions{Preconditions: preconditions}
+}
+
 // Create all secrets.
 func createSecrets(ctx context.Context, k8sClient *kubernetes.Clientset, d *Deployment) (*Deployment, error) {
 	overrideValues := map[string]string{}
@@ -506,6 +618,7 @@ func createSecrets(ctx context.Context, k8sClient *kubernetes.Clientset, d *Depl
 	d.ValueSecretName = make(map[string]string)
 	d.RepoSecretName = make(map[string]string)
 	d.ImageRegistrySecretName = make(map[string]string)
+	d.ParameterSecretName = make(map[string]string)
 
 	for _, app := range *d.HelmApps {
 		contents = ""
@@ -587,6 +700,7 @@ func createSecrets(ctx context.Context, k8sClient *kubernetes.Clientset, d *Depl
 		// Handle parameter template secrets - these contain the real secret values
 		if d.ParameterTemplateSecrets[app.Name] != "" {
 			secretName := fmt.Sprintf("%s-%s-%s-secret", d.Name, app.Name, d.ProfileName)
+			d.ParameterSecretName[app.Name] = secretName
 
 			data := map[string]string{}
 			data["values"] = d.ParameterTemplateSecrets[app.Name]
@@ -771,23 +885,25 @@ func deleteSecrets(ctx context.Context, k8sClient *kubernetes.Clientset, deploym
 			}
 		}
 
-		secretName = app.HelmApp.RepoSecretName
-		if secretName != "" && secretName != os.Getenv("RS_PROXY_REPO_SECRET") {
-			err = utils.DeleteSecret(ctx, k8sClient, namespace, secretName)
-			if errors.IsNotFound(err) {
-				log.Warnf("Secret %s not found, continuing", secretName)
-			} else if err != nil {
-				return err
+		if app.HelmApp != nil {
+			secretName = app.HelmApp.RepoSecretName
+			if secretName != "" && secretName != os.Getenv("RS_PROXY_REPO_SECRET") {
+				err = utils.DeleteSecret(ctx, k8sClient, namespace, secretName)
+				if errors.IsNotFound(err) {
+					log.Warnf("Secret %s not found, continuing", secretName)
+				} else if err != nil {
+					return err
+				}
 			}
-		}
 
-		secretName = app.HelmApp.ImageRegistrySecretName
-		if secretName != "" {
-			err = utils.DeleteSecret(ctx, k8sClient, namespace, secretName)
-			if errors.IsNotFound(err) {
-				log.Warnf("Secret %s not found, continuing", secretName)
-			} else if err != nil {
-				return err
+			secretName = app.HelmApp.ImageRegistrySecretName
+			if secretName != "" {
+				err = utils.DeleteSecret(ctx, k8sClient, namespace, secretName)
+				if errors.IsNotFound(err) {
+					log.Warnf("Secret %s not found, continuing", secretName)
+				} else if err != nil {
+					return err
+				}
 			}
 		}
 
@@ -989,6 +1105,7 @@ func checkParameterTemplate(d *Deployment, allOverrideKeys map[string][]string)
 			AppName:         oVal.AppName,
 			TargetNamespace: oVal.TargetNamespace,
 			Values:          notMaskedValues,
+			SecretRefs:      oVal.SecretRefs,
 		})
 	}
 	d.OverrideValues = OverrideValuesNotMasked
@@ -1021,6 +1138,10 @@ func checkParameterTemplate(d *Deployment, allOverrideKeys map[string][]string)
 						foundMandatory = true
 					}
 				}
+				// Values read from a secret store are provided as well
+				if hasSecretRef(d.OverrideValues, app.Name, val.Name) {
+					foundMandatory = true
+				}
 				// Mandatory value not found in override values so append which app has missing value
 				// Prevent duplicate in notFoundApp slice by setting addedToNotFoundApp
 				if !(foundMandatory) && !(addedToNotFoundApp) {
@@ -1085,9 +1206,12 @@ func mergeAllAppTargetClusters(ctx context.Context, d *Deployment) error {
 				targetClusterItem := &deploymentpb.TargetClusters{
 					AppName: app.Name,
 				}
-				if d.DeploymentType == string(deploymentv1beta1.Targeted) {
+				if target.ClusterGroup != "" {
+					targetClusterItem.ClusterGroup = target.ClusterGroup
+					targetClusterItem.Labels = target.Labels
+				} else if d.DeploymentType == string(deploymentv1beta1.Targeted) {
 					targetClusterItem.ClusterId = target.ClusterId
-				} else if d.DeploymentType == string(deploymentv1beta1.AutoScaling) {
+				} else if labelTargeted(d.DeploymentType) {
 					targetClusterItem.Labels = make(map[string]string, 0)
 					targetClusterItem.Labels = target.Labels
 				}
@@ -1104,7 +1228,9 @@ func mergeAllAppTargetClusters(ctx context.Context, d *Deployment) error {
 				target := d.AllAppTargetClusters
 				for _, tc := range d.TargetClusters {
 					if tc.AppName == app.Name {
-						if d.DeploymentType == string(deploymentv1beta1.AutoScaling) {
+						if tc.ClusterGroup != "" || target.ClusterGroup != "" {
+							utils.LogActivity(ctx, "create", "ADM", "Target cluster group not merged with the targets of app "+app.Name)
+						} else if labelTargeted(d.DeploymentType) {
 							for key, val := range target.Labels {
 								if _, exists := tc.Labels[key]; !exists {
 									utils.LogActivity(ctx, "create", "ADM", "label added with key "+key+" and value "+val+" for app "+app.Name)
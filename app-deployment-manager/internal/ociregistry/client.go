@@ -0,0 +1,309 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ociregistry provides a minimal client of the OCI distribution API,
+// reading manifests and blobs with the anonymous or basic credentials of the
+// image and chart registries.
+package ociregistry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const (
+	// DockerHubRegistry is the registry of image references without host
+	DockerHubRegistry = "docker.io"
+	dockerHubHost     = "registry-1.docker.io"
+
+	maxManifestSize = 4 << 20
+	maxTokenSize    = 1 << 20
+)
+
+var (
+	// ManifestMediaTypes are the accepted manifest media types, image indexes first
+	ManifestMediaTypes = []string{
+		"application/vnd.oci.image.index.v1+json",
+		"application/vnd.docker.distribution.manifest.list.v2+json",
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}
+
+	// ImageManifestMediaTypes are the accepted media types of single platform manifests
+	ImageManifestMediaTypes = ManifestMediaTypes[2:]
+
+	// HTTPClient sends the requests to the registries
+	HTTPClient = &http.Client{Timeout: 5 * time.Minute}
+
+	digestRegexp = regexp.MustCompile(`^sha256:[a-f0-9]{64}$`)
+)
+
+// Credential authenticates to a registry.
+type Credential struct {
+	Username string
+	Password string
+}
+
+// Descriptor describes a manifest or blob.
+type Descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	Platform    *Platform         `json:"platform,omitempty"`
+}
+
+// Platform is the platform of a manifest of an image index.
+type Platform struct {
+	Architecture string `json:"architecture"`
+	OS           string `json:"os"`
+	Variant      string `json:"variant,omitempty"`
+}
+
+// Manifest is an image manifest or an image index.
+type Manifest struct {
+	SchemaVersion int          `json:"schemaVersion"`
+	MediaType     string       `json:"mediaType,omitempty"`
+	Config        *Descriptor  `json:"config,omitempty"`
+	Layers        []Descriptor `json:"layers,omitempty"`
+	Manifests     []Descriptor `json:"manifests,omitempty"`
+}
+
+// Client reads a repository of a registry.
+type Client struct {
+	host   string
+	repo   string
+	cred   Credential
+	token  string
+	basic  bool
+	client *http.Client
+}
+
+// New returns a client of the given repository, docker.io being mapped to its
+// API host.
+func New(registry string, repository string, cred Credential) *Client {
+	c := &Client{
+		host: registry,
+		repo: repository,
+		cred: cred,
+	}
+	if c.host == DockerHubRegistry {
+		c.host = dockerHubHost
+	}
+	return c
+}
+
+// WithHTTPClient sets the HTTP client sending the requests, HTTPClient by
+// default.
+func (c *Client) WithHTTPClient(client *http.Client) *Client {
+	c.client = client
+	return c
+}
+
+func (c *Client) httpClient() *http.Client {
+	if c.client != nil {
+		return c.client
+	}
+	return HTTPClient
+}
+
+// IsDigest returns true if the reference is a sha256 digest.
+func IsDigest(ref string) bool {
+	return digestRegexp.MatchString(ref)
+}
+
+// Digest returns the sha256 digest of the data.
+func Digest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// Resolve returns the digest of the manifest of the given tag.
+func (c *Client) Resolve(ctx context.Context, tag string) (string, error) {
+	resp, err := c.Do(ctx, http.MethodHead, "manifests/"+tag, ManifestMediaTypes...)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("manifest %s: %s", tag, resp.Status)
+	}
+	if digest := resp.Header.Get("Docker-Content-Digest"); IsDigest(digest) {
+		return digest, nil
+	}
+
+	// Registries are not required to return the digest
+	data, _, err := c.Manifest(ctx, tag, ManifestMediaTypes...)
+	if err != nil {
+		return "", err
+	}
+	return Digest(data), nil
+}
+
+// Manifest returns the manifest of the given tag or digest with its media type.
+func (c *Client) Manifest(ctx context.Context, ref string, accept ...string) ([]byte, string, error) {
+	resp, err := c.Do(ctx, http.MethodGet, "manifests/"+ref, accept...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("manifest %s: %s", ref, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxManifestSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if len(data) > maxManifestSize {
+		return nil, "", fmt.Errorf("manifest %s: too large", ref)
+	}
+	if IsDigest(ref) && Digest(data) != ref {
+		return nil, "", fmt.Errorf("manifest %s: digest mismatch", ref)
+	}
+
+	mediaType := resp.Header.Get("Content-Type")
+	if m := (&Manifest{}); json.Unmarshal(data, m) == nil && m.MediaType != "" {
+		mediaType = m.MediaType
+	}
+	return data, mediaType, nil
+}
+
+// Blob opens the blob with the given digest, the caller closes it.
+func (c *Client) Blob(ctx context.Context, digest string) (io.ReadCloser, error) {
+	resp, err := c.Do(ctx, http.MethodGet, "blobs/"+digest)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("blob %s: %s", digest, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// ReadBlob reads the blob with the given digest, up to the given size.
+func (c *Client) ReadBlob(ctx context.Context, digest string, maxSize int64) ([]byte, error) {
+	blob, err := c.Blob(ctx, digest)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	data, err := io.ReadAll(io.LimitReader(blob, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("blob %s: too large", digest)
+	}
+	if Digest(data) != digest {
+		return nil, fmt.Errorf("blob %s: digest mismatch", digest)
+	}
+	return data, nil
+}
+
+// Do sends a request for the given path of the repository, authenticating
+// once the registry challenges it.
+func (c *Client) Do(ctx context.Context, method string, path string, accept ...string) (*http.Response, error) {
+	resp, err := c.send(ctx, method, path, accept)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized || c.token != "" || c.basic {
+		return resp, err
+	}
+
+	challenge := resp.Header.Get("WWW-Authenticate")
+	resp.Body.Close()
+	switch {
+	case strings.HasPrefix(challenge, "Basic "):
+		if c.cred.Username == "" {
+			return nil, fmt.Errorf("registry %s requires credentials", c.host)
+		}
+		c.basic = true
+	case strings.HasPrefix(challenge, "Bearer "):
+		if c.token, err = c.requestToken(ctx, challenge); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+	return c.send(ctx, method, path, accept)
+}
+
+func (c *Client) send(ctx context.Context, method string, path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("https://%s/v2/%s/%s", c.host, c.repo, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	switch {
+	case c.token != "":
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	case c.basic:
+		req.SetBasicAuth(c.cred.Username, c.cred.Password)
+	}
+	return c.httpClient().Do(req)
+}
+
+// requestToken requests a pull token from the realm of a Bearer challenge
+func (c *Client) requestToken(ctx context.Context, challenge string) (string, error) {
+	params := map[string]string{}
+	for _, p := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(p), "="); ok {
+			params[k] = strings.Trim(v, `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme != "https" {
+		return "", fmt.Errorf("invalid authentication realm %q", params["realm"])
+	}
+	q := realm.Query()
+	for _, k := range []string{"service", "scope"} {
+		if params[k] != "" {
+			q.Set(k, params[k])
+		}
+	}
+	if q.Get("scope") == "" {
+		q.Set("scope", "repository:"+c.repo+":pull")
+	}
+	realm.RawQuery = q.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	if c.cred.Username != "" {
+		req.SetBasicAuth(c.cred.Username, c.cred.Password)
+	}
+	resp, err := c.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot get token: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxTokenSize)).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return token.Token, nil
+}
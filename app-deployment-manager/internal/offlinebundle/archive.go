@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package offlinebundle writes and reads the archives deploying a deployment
+// on a disconnected cluster: the rendered Fleet bundles with their Helm charts
+// and values and optionally the container images as an OCI layout.
+package offlinebundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	yaml2 "sigs.k8s.io/yaml"
+)
+
+const (
+	// ManifestFile describes the content of the archive
+	ManifestFile = "bundle.yaml"
+	// AppsDir holds a directory per app of the deployment
+	AppsDir = "apps"
+	// ImagesDir holds the container images as an OCI layout
+	ImagesDir = "images"
+
+	fileMode = 0o644
+	dirMode  = 0o755
+)
+
+// Manifest describes the deployment exported in an archive.
+type Manifest struct {
+	DeploymentID   string    `json:"deploymentId"`
+	DeploymentName string    `json:"deploymentName"`
+	ClusterID      string    `json:"clusterId"`
+	Generation     int64     `json:"generation"`
+	ExportTime     time.Time `json:"exportTime"`
+	Apps           []App     `json:"apps"`
+}
+
+// App describes an app of the exported deployment, the paths being relative
+// to the root of the archive.
+type App struct {
+	Name        string   `json:"name"`
+	Namespace   string   `json:"namespace"`
+	ReleaseName string   `json:"releaseName,omitempty"`
+	Bundle      string   `json:"bundle"`
+	Chart       string   `json:"chart,omitempty"`
+	Values      string   `json:"values,omitempty"`
+	Manifests   string   `json:"manifests,omitempty"`
+	DependsOn   []string `json:"dependsOn,omitempty"`
+	Images      []string `json:"images,omitempty"`
+}
+
+// Writer writes the files of an archive as a gzipped tar.
+type Writer struct {
+	gz    *gzip.Writer
+	tar   *tar.Writer
+	files map[string]bool
+	mtime time.Time
+}
+
+// NewWriter returns a writer of an archive to w, the caller closes it.
+func NewWriter(w io.Writer) *Writer {
+	gz := gzip.NewWriter(w)
+	return &Writer{
+		gz:    gz,
+		tar:   tar.NewWriter(gz),
+		files: map[string]bool{},
+		mtime: time.Now().UTC().Truncate(time.Second),
+	}
+}
+
+// Has returns true if the file was already written.
+func (w *Writer) Has(name string) bool {
+	return w.files[name]
+}
+
+// WriteFile writes a file to the archive.
+func (w *Writer) WriteFile(name string, data []byte) error {
+	return w.WriteStream(name, int64(len(data)), bytes.NewReader(data))
+}
+
+// WriteStream writes a file of the given size read from r to the archive.
+func (w *Writer) WriteStream(name string, size int64, r io.Reader) error {
+	name = path.Clean(name)
+	if w.files[name] {
+		return fmt.Errorf("duplicate file %s", name)
+	}
+	if err := w.tar.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     fileMode,
+		ModTime:  w.mtime,
+	}); err != nil {
+		return err
+	}
+	n, err := io.Copy(w.tar, io.LimitReader(r, size))
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf("file %s: read %d bytes out of %d", name, n, size)
+	}
+	w.files[name] = true
+	return nil
+}
+
+// WriteManifest writes the manifest describing the archive.
+func (w *Writer) WriteManifest(m *Manifest) error {
+	data, err := yaml2.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return w.WriteFile(ManifestFile, data)
+}
+
+// Close flushes the archive.
+func (w *Writer) Close() error {
+	if err := w.tar.Close(); err != nil {
+		return err
+	}
+	return w.gz.Close()
+}
+
+// Extract extracts an archive into dir, up to maxSize bytes of files, and
+// returns its manifest.
+func Extract(r io.Reader, dir string, maxSize int64) (*Manifest, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		name := path.Clean(hdr.Name)
+		if path.IsAbs(name) || name == ".." || strings.HasPrefix(name, "../") {
+			return nil, fmt.Errorf("invalid file name %s", hdr.Name)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return nil, fmt.Errorf("unsupported file type of %s", hdr.Name)
+		}
+
+		maxSize -= hdr.Size
+		if maxSize < 0 {
+			return nil, fmt.Errorf("archive exceeds the maximum size")
+		}
+		if err := extractFile(tr, filepath.Join(dir, filepath.FromSlash(name))); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, ManifestFile))
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+	m := &Manifest{}
+	if err := yaml2.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", ManifestFile, err)
+	}
+	return m, nil
+}
+
+func extractFile(r io.Reader, name string) error {
+	if err := os.MkdirAll(filepath.Dir(name), dirMode); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(name, os.O_CREATE|os.O_EXCL|os.O_WRONLY, fileMode)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,178 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	yaml2 "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+const (
+	// MaxChartSize is the maximum size of a Helm chart tarball
+	MaxChartSize = 32 << 20
+
+	maxRepoIndexSize = 32 << 20
+
+	helmChartLayerMediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// ChartCredential authenticates to a Helm chart repository.
+type ChartCredential struct {
+	Username string
+	Password string
+	// CACerts are the PEM encoded certificates of the repository CAs
+	CACerts string
+}
+
+// FetchChart downloads the tarball of a chart the way Fleet resolves it: an
+// oci:// chart URL, a chart name in a Helm repository or a tarball URL.
+func FetchChart(ctx context.Context, repo string, chart string, version string, cred ChartCredential) ([]byte, error) {
+	client, err := chartHTTPClient(cred.CACerts)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case strings.HasPrefix(chart, "oci://"):
+		return fetchOCIChart(ctx, client, chart, version, cred)
+	case repo != "":
+		chartURL, err := resolveRepoChart(ctx, client, repo, chart, version, cred)
+		if err != nil {
+			return nil, err
+		}
+		// Like Helm, the credentials are not passed to other hosts
+		if repoURL, _ := url.Parse(repo); repoURL == nil || repoURL.Host != chartURL.Host {
+			cred = ChartCredential{}
+		}
+		return download(ctx, client, chartURL.String(), cred, MaxChartSize)
+	default:
+		return download(ctx, client, chart, cred, MaxChartSize)
+	}
+}
+
+// chartHTTPClient returns ociregistry.HTTPClient trusting the given CAs too
+func chartHTTPClient(caCerts string) (*http.Client, error) {
+	if caCerts == "" {
+		return ociregistry.HTTPClient, nil
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM([]byte(caCerts)) {
+		return nil, fmt.Errorf("invalid repository CA certificates")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if t, ok := ociregistry.HTTPClient.Transport.(*http.Transport); ok {
+		transport = t.Clone()
+	}
+	if transport.TLSClientConfig == nil {
+		transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	transport.TLSClientConfig.RootCAs = pool
+	return &http.Client{Timeout: ociregistry.HTTPClient.Timeout, Transport: transport}, nil
+}
+
+// fetchOCIChart pulls the chart layer of an OCI chart, Helm storing the
+// versions as tags with _ in place of +
+func fetchOCIChart(ctx context.Context, client *http.Client, chart string, version string, cred ChartCredential) ([]byte, error) {
+	u, err := url.Parse(chart)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("invalid chart %s", chart)
+	}
+	c := ociregistry.New(u.Host, strings.Trim(u.Path, "/"), ociregistry.Credential{
+		Username: cred.Username,
+		Password: cred.Password,
+	}).WithHTTPClient(client)
+
+	data, _, err := c.Manifest(ctx, strings.ReplaceAll(version, "+", "_"), ociregistry.ImageManifestMediaTypes...)
+	if err != nil {
+		return nil, fmt.Errorf("chart %s: %w", chart, err)
+	}
+	manifest := &ociregistry.Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("chart %s: invalid manifest: %w", chart, err)
+	}
+	for _, layer := range manifest.Layers {
+		if layer.MediaType == helmChartLayerMediaType {
+			return c.ReadBlob(ctx, layer.Digest, MaxChartSize)
+		}
+	}
+	return nil, fmt.Errorf("chart %s: no chart layer in version %s", chart, version)
+}
+
+// resolveRepoChart returns the URL of a chart version from the index of a
+// Helm repository
+func resolveRepoChart(ctx context.Context, client *http.Client, repo string, chart string, version string, cred ChartCredential) (*url.URL, error) {
+	repoURL, err := url.Parse(strings.TrimSuffix(repo, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository %s", repo)
+	}
+	data, err := download(ctx, client, repoURL.JoinPath("index.yaml").String(), cred, maxRepoIndexSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var index struct {
+		Entries map[string][]struct {
+			Version string   `json:"version"`
+			URLs    []string `json:"urls"`
+		} `json:"entries"`
+	}
+	if err := yaml2.Unmarshal(data, &index); err != nil {
+		return nil, fmt.Errorf("invalid index of repository %s: %w", repo, err)
+	}
+	for _, entry := range index.Entries[chart] {
+		if entry.Version != version || len(entry.URLs) == 0 {
+			continue
+		}
+		chartURL, err := repoURL.Parse(entry.URLs[0])
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL of chart %s: %w", chart, err)
+		}
+		return chartURL, nil
+	}
+	return nil, fmt.Errorf("chart %s version %s not found in repository %s", chart, version, repo)
+}
+
+// download reads the content at the given URL, up to maxSize bytes
+func download(ctx context.Context, client *http.Client, u string, cred ChartCredential, maxSize int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	if cred.Username != "" {
+		req.SetBasicAuth(cred.Username, cred.Password)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("cannot download %s: %s", u, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("cannot download %s: too large", u)
+	}
+	return data, nil
+}
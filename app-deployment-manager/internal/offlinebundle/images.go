@@ -0,0 +1,155 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/imagepolicy"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+const (
+	// PlatformOS and PlatformArchitecture select the image exported from
+	// multi-platform images
+	PlatformOS           = "linux"
+	PlatformArchitecture = "amd64"
+
+	// RefNameAnnotation names the images in the index of the OCI layout
+	RefNameAnnotation = "org.opencontainers.image.ref.name"
+	// containerdNameAnnotation names the images imported by containerd
+	containerdNameAnnotation = "io.containerd.image.name"
+
+	ociLayoutFile = "oci-layout"
+	ociIndexFile  = "index.json"
+	ociLayout     = `{"imageLayoutVersion":"1.0.0"}`
+)
+
+// WriteImages writes the given images to the images directory of the archive
+// as an OCI layout, with the credentials returned for their registry.
+func WriteImages(ctx context.Context, w *Writer, images []string, creds func(registry string) ociregistry.Credential) error {
+	index := ociregistry.Manifest{
+		SchemaVersion: 2,
+		MediaType:     ociregistry.ManifestMediaTypes[0],
+		Manifests:     []ociregistry.Descriptor{},
+	}
+	for _, image := range images {
+		registry, repository, ref, err := imagepolicy.SplitReference(image)
+		if err != nil {
+			return fmt.Errorf("image %s: %w", image, err)
+		}
+		desc, err := writeImage(ctx, w, ociregistry.New(registry, repository, creds(registry)), ref)
+		if err != nil {
+			return fmt.Errorf("image %s: %w", image, err)
+		}
+		desc.Annotations = map[string]string{
+			RefNameAnnotation:        image,
+			containerdNameAnnotation: image,
+		}
+		index.Manifests = append(index.Manifests, desc)
+	}
+
+	data, err := json.Marshal(index)
+	if err != nil {
+		return err
+	}
+	if err := w.WriteFile(path.Join(ImagesDir, ociLayoutFile), []byte(ociLayout)); err != nil {
+		return err
+	}
+	return w.WriteFile(path.Join(ImagesDir, ociIndexFile), data)
+}
+
+// writeImage writes the manifest, config and layers of an image, selecting
+// the exported platform of multi-platform images, and returns its descriptor
+func writeImage(ctx context.Context, w *Writer, c *ociregistry.Client, ref string) (ociregistry.Descriptor, error) {
+	data, mediaType, err := c.Manifest(ctx, ref, ociregistry.ManifestMediaTypes...)
+	if err != nil {
+		return ociregistry.Descriptor{}, err
+	}
+	manifest := &ociregistry.Manifest{}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return ociregistry.Descriptor{}, fmt.Errorf("invalid manifest: %w", err)
+	}
+
+	if len(manifest.Manifests) > 0 {
+		var platformDigest string
+		for _, m := range manifest.Manifests {
+			if m.Platform != nil && m.Platform.OS == PlatformOS && m.Platform.Architecture == PlatformArchitecture {
+				platformDigest = m.Digest
+				break
+			}
+		}
+		if platformDigest == "" {
+			return ociregistry.Descriptor{}, fmt.Errorf("no %s/%s image", PlatformOS, PlatformArchitecture)
+		}
+		data, mediaType, err = c.Manifest(ctx, platformDigest, ociregistry.ImageManifestMediaTypes...)
+		if err != nil {
+			return ociregistry.Descriptor{}, err
+		}
+		manifest = &ociregistry.Manifest{}
+		if err := json.Unmarshal(data, manifest); err != nil {
+			return ociregistry.Descriptor{}, fmt.Errorf("invalid manifest: %w", err)
+		}
+	}
+	if manifest.Config == nil {
+		return ociregistry.Descriptor{}, fmt.Errorf("manifest without config")
+	}
+
+	for _, blob := range append([]ociregistry.Descriptor{*manifest.Config}, manifest.Layers...) {
+		if err := writeBlob(ctx, w, c, blob); err != nil {
+			return ociregistry.Descriptor{}, err
+		}
+	}
+
+	digest := ociregistry.Digest(data)
+	if name := blobPath(digest); !w.Has(name) {
+		if err := w.WriteFile(name, data); err != nil {
+			return ociregistry.Descriptor{}, err
+		}
+	}
+	return ociregistry.Descriptor{
+		MediaType: mediaType,
+		Digest:    digest,
+		Size:      int64(len(data)),
+	}, nil
+}
+
+// writeBlob streams a blob to the archive once, checking its digest
+func writeBlob(ctx context.Context, w *Writer, c *ociregistry.Client, desc ociregistry.Descriptor) error {
+	if !ociregistry.IsDigest(desc.Digest) {
+		return fmt.Errorf("unsupported digest %s", desc.Digest)
+	}
+	name := blobPath(desc.Digest)
+	if w.Has(name) {
+		return nil
+	}
+
+	blob, err := c.Blob(ctx, desc.Digest)
+	if err != nil {
+		return err
+	}
+	defer blob.Close()
+
+	h := sha256.New()
+	if err := w.WriteStream(name, desc.Size, io.TeeReader(blob, h)); err != nil {
+		return fmt.Errorf("blob %s: %w", desc.Digest, err)
+	}
+	if "sha256:"+hex.EncodeToString(h.Sum(nil)) != desc.Digest {
+		return fmt.Errorf("blob %s: digest mismatch", desc.Digest)
+	}
+	return nil
+}
+
+// blobPath returns the path of a blob in the OCI layout
+func blobPath(digest string) string {
+	return path.Join(ImagesDir, "blobs", strings.Replace(digest, ":", "/", 1))
+}
@@ -0,0 +1,180 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	yaml2 "sigs.k8s.io/yaml"
+)
+
+const (
+	// RecordNamePrefix prefixes the name of the ConfigMap recording the
+	// imported generation of a deployment at the site
+	RecordNamePrefix = "app-orch-offline-bundle-"
+
+	deploymentIDLabel = "app.edge-orchestrator.intel.com/deployment-id"
+)
+
+// Runner runs a command, writing stdin to it if not nil.
+type Runner func(ctx context.Context, stdin []byte, name string, args ...string) error
+
+// ImportOptions configures the import of an archive at the site.
+type ImportOptions struct {
+	// Kubeconfig of the cluster, the default one of helm and kubectl if empty
+	Kubeconfig string
+	// RecordNamespace holds the ConfigMap recording the imported generation
+	RecordNamespace string
+	// Helm and Kubectl are the binaries installing the apps
+	Helm    string
+	Kubectl string
+	// Run runs the commands, ExecRunner if nil
+	Run Runner
+}
+
+// ExecRunner runs the command with its output sent to the standard output.
+func ExecRunner(ctx context.Context, stdin []byte, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if stdin != nil {
+		cmd.Stdin = bytes.NewReader(stdin)
+	}
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Import installs the apps of an archive extracted into dir in dependency
+// order, then records the imported generation in a ConfigMap.
+func Import(ctx context.Context, dir string, m *Manifest, opts ImportOptions) error {
+	if opts.Helm == "" {
+		opts.Helm = "helm"
+	}
+	if opts.Kubectl == "" {
+		opts.Kubectl = "kubectl"
+	}
+	if opts.Run == nil {
+		opts.Run = ExecRunner
+	}
+	var kubeconfig []string
+	if opts.Kubeconfig != "" {
+		kubeconfig = []string{"--kubeconfig", opts.Kubeconfig}
+	}
+
+	apps, err := InstallOrder(m.Apps)
+	if err != nil {
+		return err
+	}
+	for _, app := range apps {
+		var args []string
+		name := opts.Helm
+		switch {
+		case app.Chart != "":
+			release := app.ReleaseName
+			if release == "" {
+				release = app.Name
+			}
+			args = []string{"upgrade", "--install", release, filepath.Join(dir, app.Chart),
+				"--namespace", app.Namespace, "--create-namespace", "--wait"}
+			if app.Values != "" {
+				args = append(args, "--values", filepath.Join(dir, app.Values))
+			}
+		case app.Manifests != "":
+			name = opts.Kubectl
+			manifests := filepath.Join(dir, app.Manifests)
+			args = []string{"apply", "--namespace", app.Namespace, "--recursive", "--filename", manifests}
+			if _, err := os.Stat(filepath.Join(manifests, "kustomization.yaml")); err == nil {
+				args = []string{"apply", "--namespace", app.Namespace, "--kustomize", manifests}
+			}
+		default:
+			return fmt.Errorf("app %s has neither chart nor manifests", app.Name)
+		}
+		if err := opts.Run(ctx, nil, name, append(args, kubeconfig...)...); err != nil {
+			return fmt.Errorf("cannot install app %s: %w", app.Name, err)
+		}
+	}
+
+	record, err := recordConfigMap(m, opts.RecordNamespace)
+	if err != nil {
+		return err
+	}
+	if err := opts.Run(ctx, record, opts.Kubectl, append([]string{"apply", "--filename", "-"}, kubeconfig...)...); err != nil {
+		return fmt.Errorf("cannot record the imported generation: %w", err)
+	}
+	return nil
+}
+
+// InstallOrder returns the apps sorted so that every app follows the apps it
+// depends on, keeping the order of the archive otherwise.
+func InstallOrder(apps []App) ([]App, error) {
+	byName := make(map[string]App)
+	for _, app := range apps {
+		byName[app.Name] = app
+	}
+
+	var ordered []App
+	state := make(map[string]int) // 1 visiting, 2 done
+	var visit func(app App) error
+	visit = func(app App) error {
+		switch state[app.Name] {
+		case 1:
+			return fmt.Errorf("dependency cycle on app %s", app.Name)
+		case 2:
+			return nil
+		}
+		state[app.Name] = 1
+		for _, dep := range app.DependsOn {
+			depApp, ok := byName[dep]
+			if !ok {
+				return fmt.Errorf("app %s depends on unknown app %s", app.Name, dep)
+			}
+			if err := visit(depApp); err != nil {
+				return err
+			}
+		}
+		state[app.Name] = 2
+		ordered = append(ordered, app)
+		return nil
+	}
+	for _, app := range apps {
+		if err := visit(app); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// recordConfigMap returns the ConfigMap recording the imported generation,
+// for ADM to reconcile the status of the deployment once the cluster reconnects
+func recordConfigMap(m *Manifest, namespace string) ([]byte, error) {
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      RecordNamePrefix + m.DeploymentID,
+			Namespace: namespace,
+			Labels:    map[string]string{deploymentIDLabel: m.DeploymentID},
+		},
+		Data: map[string]string{
+			"deploymentId":   m.DeploymentID,
+			"deploymentName": m.DeploymentName,
+			"clusterId":      m.ClusterID,
+			"generation":     strconv.FormatInt(m.Generation, 10),
+			"exportTime":     m.ExportTime.UTC().Format(time.RFC3339),
+			"importTime":     time.Now().UTC().Format(time.RFC3339),
+		},
+	}
+	return yaml2.Marshal(cm)
+}
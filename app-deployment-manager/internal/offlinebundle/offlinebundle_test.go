@@ -0,0 +1,302 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package offlinebundle
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/internal/ociregistry"
+)
+
+// newServer starts a TLS server trusted by the registry client
+func newServer(t *testing.T, handler http.Handler) (*httptest.Server, string) {
+	srv := httptest.NewTLSServer(handler)
+	t.Cleanup(srv.Close)
+
+	client := ociregistry.HTTPClient
+	t.Cleanup(func() { ociregistry.HTTPClient = client })
+	ociregistry.HTTPClient = srv.Client()
+	return srv, strings.TrimPrefix(srv.URL, "https://")
+}
+
+// fakeRegistry serves manifests by tag or digest and blobs by digest
+type fakeRegistry map[string][]byte
+
+func (r fakeRegistry) add(repo string, ref string, v interface{}) string {
+	data, ok := v.([]byte)
+	if !ok {
+		data, _ = json.Marshal(v)
+	}
+	digest := ociregistry.Digest(data)
+	r[repo+"/"+ref] = data
+	r[repo+"/"+digest] = data
+	return digest
+}
+
+func (r fakeRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	p := strings.TrimPrefix(req.URL.Path, "/v2/")
+	p = strings.Replace(strings.Replace(p, "/manifests/", "/", 1), "/blobs/", "/", 1)
+	if data, ok := r[p]; ok {
+		_, _ = w.Write(data)
+		return
+	}
+	w.WriteHeader(http.StatusNotFound)
+}
+
+func readArchive(t *testing.T, data []byte) map[string]string {
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string]string{}
+	for {
+		hdr, err := tr.Next()
+		if err != nil {
+			break
+		}
+		var buf bytes.Buffer
+		_, err = buf.ReadFrom(tr)
+		require.NoError(t, err)
+		files[hdr.Name] = buf.String()
+	}
+	return files
+}
+
+func TestWriteAndExtract(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	m := &Manifest{
+		DeploymentID:   "f7d0b9a3-2ae4-4c9c-a1f4-3c6f0a8f2e11",
+		DeploymentName: "wordpress",
+		ClusterID:      "cluster-1",
+		Generation:     3,
+		ExportTime:     time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC),
+		Apps: []App{{
+			Name:      "wordpress",
+			Namespace: "apps",
+			Bundle:    "apps/wordpress/bundle.yaml",
+			Values:    "apps/wordpress/values.yaml",
+			DependsOn: []string{"mariadb"},
+		}},
+	}
+	require.NoError(t, w.WriteFile("apps/wordpress/values.yaml", []byte("replicaCount: 2\n")))
+	require.NoError(t, w.WriteFile("apps/wordpress/bundle.yaml", []byte("kind: Bundle\n")))
+	assert.EqualError(t, w.WriteFile("apps/wordpress/../wordpress/values.yaml", nil), "duplicate file apps/wordpress/values.yaml")
+	require.NoError(t, w.WriteManifest(m))
+	require.NoError(t, w.Close())
+
+	dir := t.TempDir()
+	extracted, err := Extract(bytes.NewReader(buf.Bytes()), dir, 1<<20)
+	require.NoError(t, err)
+	assert.Equal(t, m, extracted)
+	values, err := os.ReadFile(filepath.Join(dir, "apps", "wordpress", "values.yaml"))
+	require.NoError(t, err)
+	assert.Equal(t, "replicaCount: 2\n", string(values))
+
+	_, err = Extract(bytes.NewReader(buf.Bytes()), t.TempDir(), 16)
+	assert.EqualError(t, err, "archive exceeds the maximum size")
+}
+
+func TestExtractRejectsUnsafeFiles(t *testing.T) {
+	for _, hdr := range []*tar.Header{
+		{Name: "../escape", Typeflag: tar.TypeReg},
+		{Name: "/etc/passwd", Typeflag: tar.TypeReg},
+		{Name: "link", Typeflag: tar.TypeSymlink, Linkname: "/etc"},
+	} {
+		var buf bytes.Buffer
+		gz := gzip.NewWriter(&buf)
+		tw := tar.NewWriter(gz)
+		require.NoError(t, tw.WriteHeader(hdr))
+		require.NoError(t, tw.Close())
+		require.NoError(t, gz.Close())
+
+		_, err := Extract(&buf, t.TempDir(), 1<<20)
+		assert.Error(t, err, hdr.Name)
+	}
+}
+
+func TestFetchChart(t *testing.T) {
+	chart := []byte("chart tarball")
+	srv, _ := newServer(t, http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if user, pass, ok := req.BasicAuth(); !ok || user != "robot" || pass != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch req.URL.Path {
+		case "/charts/index.yaml":
+			_, _ = w.Write([]byte("apiVersion: v1\nentries:\n  wordpress:\n  - version: 1.2.0\n    urls:\n    - wordpress-1.2.0.tgz\n"))
+		case "/charts/wordpress-1.2.0.tgz":
+			_, _ = w.Write(chart)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	cred := ChartCredential{Username: "robot", Password: "s3cr3t"}
+
+	data, err := FetchChart(context.Background(), srv.URL+"/charts", "wordpress", "1.2.0", cred)
+	require.NoError(t, err)
+	assert.Equal(t, chart, data)
+
+	data, err = FetchChart(context.Background(), "", srv.URL+"/charts/wordpress-1.2.0.tgz", "", cred)
+	require.NoError(t, err)
+	assert.Equal(t, chart, data)
+
+	_, err = FetchChart(context.Background(), srv.URL+"/charts", "wordpress", "2.0.0", cred)
+	assert.EqualError(t, err, "chart wordpress version 2.0.0 not found in repository "+srv.URL+"/charts")
+
+	_, err = FetchChart(context.Background(), srv.URL+"/charts", "wordpress", "1.2.0", ChartCredential{})
+	assert.EqualError(t, err, "cannot download "+srv.URL+"/charts/index.yaml: 401 Unauthorized")
+
+	_, err = FetchChart(context.Background(), srv.URL+"/charts", "wordpress", "1.2.0", ChartCredential{CACerts: "invalid"})
+	assert.EqualError(t, err, "invalid repository CA certificates")
+}
+
+func TestFetchOCIChart(t *testing.T) {
+	r := fakeRegistry{}
+	_, host := newServer(t, r)
+	chart := []byte("oci chart tarball")
+	chartDigest := r.add("charts/nginx", "blob", chart)
+	r.add("charts/nginx", "1.0.0_build.1", ociregistry.Manifest{
+		SchemaVersion: 2,
+		Config:        &ociregistry.Descriptor{MediaType: "application/vnd.cncf.helm.config.v1+json"},
+		Layers:        []ociregistry.Descriptor{{MediaType: helmChartLayerMediaType, Digest: chartDigest, Size: int64(len(chart))}},
+	})
+
+	data, err := FetchChart(context.Background(), "", "oci://"+host+"/charts/nginx", "1.0.0+build.1", ChartCredential{})
+	require.NoError(t, err)
+	assert.Equal(t, chart, data)
+
+	_, err = FetchChart(context.Background(), "", "oci://"+host+"/charts/nginx", "2.0.0", ChartCredential{})
+	assert.EqualError(t, err, "chart oci://"+host+"/charts/nginx: manifest 2.0.0: 404 Not Found")
+}
+
+func TestWriteImages(t *testing.T) {
+	r := fakeRegistry{}
+	_, host := newServer(t, r)
+
+	config := []byte(`{"architecture":"amd64","os":"linux"}`)
+	layer := []byte("layer")
+	configDigest := r.add("app/api", "config", config)
+	layerDigest := r.add("app/api", "layer", layer)
+	image := ociregistry.Manifest{
+		SchemaVersion: 2,
+		MediaType:     "application/vnd.oci.image.manifest.v1+json",
+		Config:        &ociregistry.Descriptor{MediaType: "application/vnd.oci.image.config.v1+json", Digest: configDigest, Size: int64(len(config))},
+		Layers:        []ociregistry.Descriptor{{MediaType: "application/vnd.oci.image.layer.v1.tar+gzip", Digest: layerDigest, Size: int64(len(layer))}},
+	}
+	imageDigest := r.add("app/api", "amd64", image)
+	r.add("app/api", "1.0.0", ociregistry.Manifest{
+		SchemaVersion: 2,
+		MediaType:     "application/vnd.oci.image.index.v1+json",
+		Manifests: []ociregistry.Descriptor{
+			{Digest: ociregistry.Digest([]byte("arm64")), Platform: &ociregistry.Platform{OS: "linux", Architecture: "arm64"}},
+			{Digest: imageDigest, Platform: &ociregistry.Platform{OS: "linux", Architecture: "amd64"}},
+		},
+	})
+	r.add("app/worker", "1.0.0", image)
+
+	var buf bytes.Buffer
+	w := NewWriter(&buf)
+	images := []string{host + "/app/api:1.0.0", host + "/app/worker:1.0.0"}
+	var registries []string
+	require.NoError(t, WriteImages(context.Background(), w, images, func(registry string) ociregistry.Credential {
+		registries = append(registries, registry)
+		return ociregistry.Credential{}
+	}))
+	require.NoError(t, w.Close())
+	assert.Equal(t, []string{host, host}, registries)
+
+	files := readArchive(t, buf.Bytes())
+	assert.Len(t, files, 5)
+	assert.Equal(t, ociLayout, files["images/oci-layout"])
+	assert.Equal(t, string(layer), files["images/blobs/sha256/"+strings.TrimPrefix(layerDigest, "sha256:")])
+	assert.Equal(t, string(config), files["images/blobs/sha256/"+strings.TrimPrefix(configDigest, "sha256:")])
+
+	index := ociregistry.Manifest{}
+	require.NoError(t, json.Unmarshal([]byte(files["images/index.json"]), &index))
+	require.Len(t, index.Manifests, 2)
+	for i, m := range index.Manifests {
+		assert.Equal(t, imageDigest, m.Digest)
+		assert.Equal(t, images[i], m.Annotations[RefNameAnnotation])
+	}
+
+	var missing bytes.Buffer
+	err := WriteImages(context.Background(), NewWriter(&missing), []string{host + "/app/db:1.0.0"}, func(string) ociregistry.Credential {
+		return ociregistry.Credential{}
+	})
+	assert.EqualError(t, err, "image "+host+"/app/db:1.0.0: manifest 1.0.0: 404 Not Found")
+}
+
+func TestInstallOrder(t *testing.T) {
+	apps, err := InstallOrder([]App{
+		{Name: "wordpress", DependsOn: []string{"mariadb", "redis"}},
+		{Name: "redis"},
+		{Name: "mariadb", DependsOn: []string{"redis"}},
+	})
+	require.NoError(t, err)
+	var names []string
+	for _, app := range apps {
+		names = append(names, app.Name)
+	}
+	assert.Equal(t, []string{"redis", "mariadb", "wordpress"}, names)
+
+	_, err = InstallOrder([]App{{Name: "a", DependsOn: []string{"b"}}, {Name: "b", DependsOn: []string{"a"}}})
+	assert.EqualError(t, err, "dependency cycle on app a")
+	_, err = InstallOrder([]App{{Name: "a", DependsOn: []string{"c"}}})
+	assert.EqualError(t, err, "app a depends on unknown app c")
+}
+
+func TestImport(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "apps", "config", "manifests"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "apps", "config", "manifests", "kustomization.yaml"), nil, 0o644))
+
+	m := &Manifest{
+		DeploymentID: "f7d0b9a3-2ae4-4c9c-a1f4-3c6f0a8f2e11",
+		ClusterID:    "cluster-1",
+		Generation:   3,
+		Apps: []App{
+			{Name: "wordpress", Namespace: "apps", ReleaseName: "b-wordpress", Chart: "apps/wordpress/chart.tgz",
+				Values: "apps/wordpress/values.yaml", DependsOn: []string{"config"}},
+			{Name: "config", Namespace: "apps", Manifests: "apps/config/manifests"},
+		},
+	}
+
+	var commands []string
+	var record string
+	err := Import(context.Background(), dir, m, ImportOptions{
+		Kubeconfig:      "/site/kubeconfig",
+		RecordNamespace: "app-orch",
+		Run: func(_ context.Context, stdin []byte, name string, args ...string) error {
+			commands = append(commands, name+" "+strings.Join(args, " "))
+			record = string(stdin)
+			return nil
+		},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"kubectl apply --namespace apps --kustomize " + filepath.Join(dir, "apps/config/manifests") + " --kubeconfig /site/kubeconfig",
+		"helm upgrade --install b-wordpress " + filepath.Join(dir, "apps/wordpress/chart.tgz") +
+			" --namespace apps --create-namespace --wait --values " + filepath.Join(dir, "apps/wordpress/values.yaml") + " --kubeconfig /site/kubeconfig",
+		"kubectl apply --filename - --kubeconfig /site/kubeconfig",
+	}, commands)
+	assert.Contains(t, record, "name: "+RecordNamePrefix+m.DeploymentID)
+	assert.Contains(t, record, "namespace: app-orch")
+	assert.Contains(t, record, `generation: "3"`)
+	assert.Contains(t, record, "clusterId: cluster-1")
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/open-edge-platform/orch-library/go/pkg/middleware/projectcontext"
 	openapiutils "github.com/open-edge-platform/orch-library/go/pkg/openapi"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
@@ -32,6 +33,7 @@ var log = dazl.GetPackageLogger()
 
 var allowedHeaders = map[string]struct{}{
 	"x-request-id": {},
+	"retry-after":  {},
 }
 
 const ActiveProjectID = "ActiveProjectID"
@@ -39,6 +41,12 @@ const ActiveProjectID = "ActiveProjectID"
 // errorHandler provides enhanced error handling for gRPC-Gateway responses
 func errorHandler(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 	if grpcStatus, ok := status.FromError(err); ok {
+		// Throttled requests have no typed error, kept as is to return 429
+		if grpcStatus.Code() == codes.ResourceExhausted {
+			runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
 		typedErr := orcherror.FromStatus(grpcStatus)
 
 		// Convert back to gRPC status to get the proper HTTP status code
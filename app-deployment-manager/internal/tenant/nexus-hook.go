@@ -7,15 +7,17 @@ package tenant
 import (
 	"context"
 	"fmt"
-	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
-	clientv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/appdeploymentclient/v1beta1"
+	"sync"
+	"time"
+
 	"github.com/open-edge-platform/orch-library/go/dazl"
 	projectActiveWatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectactivewatcher.edge-orchestrator.intel.com/v1"
 	projectwatcherv1 "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/apis/projectwatcher.edge-orchestrator.intel.com/v1"
 	nexus "github.com/open-edge-platform/orch-utils/tenancy-datamodel/build/nexus-client"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
-	"time"
 )
 
 var log = dazl.GetPackageLogger()
@@ -27,13 +29,17 @@ const (
 )
 
 type NexusHook struct {
-	crClient    clientv1beta1.AppDeploymentClientInterface
+	k8sClient   dynamic.Interface
 	nexusClient *nexus.Clientset
+
+	// teardowns are the projects being torn down
+	mu        sync.Mutex
+	teardowns map[string]bool
 }
 
-func NewNexusHook(crClient clientv1beta1.AppDeploymentClientInterface) *NexusHook {
+func NewNexusHook() *NexusHook {
 	return &NexusHook{
-		crClient: crClient,
+		teardowns: map[string]bool{},
 	}
 }
 
@@ -52,6 +58,12 @@ func (h *NexusHook) Subscribe() error {
 		return err
 	}
 
+	h.k8sClient, err = dynamic.NewForConfig(cfg)
+	if err != nil {
+		log.Errorf("Failed to create k8s client: %v", err)
+		return err
+	}
+
 	h.nexusClient, err = nexus.NewForConfig(cfg)
 	if err != nil {
 		log.Errorf("Failed to create nexus client: %v", err)
@@ -155,59 +167,71 @@ func (h *NexusHook) projectUpdated(_, project *nexus.RuntimeprojectRuntimeProjec
 		return
 	}
 
-	watcherObj, err := project.GetActiveWatchers(context.Background(), appName)
-	if err != nil {
-		log.Errorf("Failed to get active watcher for project %s: %v", project.DisplayName(), err)
+	projectUID := string(project.UID)
+	h.mu.Lock()
+	if h.teardowns[projectUID] {
+		h.mu.Unlock()
+		log.Infof("Project %s is already being deleted", project.DisplayName())
 		return
 	}
+	h.teardowns[projectUID] = true
+	h.mu.Unlock()
+
+	go func() {
+		defer func() {
+			h.mu.Lock()
+			delete(h.teardowns, projectUID)
+			h.mu.Unlock()
+		}()
+		h.deleteProject(project)
+	}()
+}
 
-	err = h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationInProgress, "Deleting")
+// deleteProject tears down the resources of a deleted project, reporting its
+// progress to the active watcher of the project, and deregisters the watcher
+// once no resource of the project is left
+func (h *NexusHook) deleteProject(project *nexus.RuntimeprojectRuntimeProject) {
+	watcherObj, err := project.GetActiveWatchers(context.Background(), appName)
 	if err != nil {
-		log.Errorf("Failed to update status of ProjectActiveWatcher object: %v", err)
-		// even though it is failed, keep trying to delete the project watcher
-		// return
+		log.Errorf("Failed to get active watcher for project %s: %v", project.DisplayName(), err)
+		return
 	}
 
-	projectUID := string(project.UID)
-	namespace := projectUID
-
-	labelSelector := metav1.LabelSelector{
-		MatchLabels: map[string]string{
-			string(v1beta1.AppOrchActiveProjectID): projectUID,
-		},
+	message := ""
+	report := func(status string) {
+		if status == message {
+			return
+		}
+		message = status
+		if err := h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationInProgress, status); err != nil {
+			log.Errorf("Failed to update status of ProjectActiveWatcher object: %v", err)
+		}
 	}
 
-	listOptions := metav1.ListOptions{
-		LabelSelector: metav1.FormatLabelSelector(&labelSelector),
-	}
+	ctx, cancel := context.WithTimeout(context.Background(), teardownTimeout)
+	defer cancel()
 
-	deployments, err := h.crClient.Deployments(namespace).List(context.Background(), listOptions)
-	if err != nil {
-		log.Warnf("cannot list deployments: %v", err)
-		err := h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationError, "Failed to get deployments")
+	if err := newTeardown(h.k8sClient, string(project.UID)).run(ctx, report); err != nil {
+		log.Errorf("Failed to delete project %s: %v", project.DisplayName(), err)
+		err := h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationError, err.Error())
 		if err != nil {
 			log.Errorf("Failed to update status of ProjectActiveWatcher object: %v", err)
 		}
-	}
-	for _, d := range deployments.Items {
-		err = h.crClient.Deployments(namespace).Delete(context.Background(), d.Name, metav1.DeleteOptions{})
-		if err != nil {
-			log.Warnf("cannot delete deployment, it could be stale resources: %v", err)
-			err := h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationError, "Failed to delete deployments")
-			if err != nil {
-				log.Errorf("Failed to update status of ProjectActiveWatcher object: %v", err)
-			}
-		}
+		return
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), nexusTimeout)
-	defer cancel()
-
-	err = project.DeleteActiveWatchers(ctx, appName)
-	if nexus.IsChildNotFound(err) {
-		log.Warnf("App %s does not watch project %s", appName, project.DisplayName())
-		return
-	} else if err != nil {
+	err = wait.ExponentialBackoffWithContext(ctx, teardownBackoff, func(ctx context.Context) (bool, error) {
+		err := project.DeleteActiveWatchers(ctx, appName)
+		if nexus.IsChildNotFound(err) {
+			log.Warnf("App %s does not watch project %s", appName, project.DisplayName())
+			return true, nil
+		} else if err != nil {
+			log.Warnf("App %s failed to delete active watchers for project %s: %v", appName, project.DisplayName(), err)
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
 		log.Errorf("App %s failed to delete active watchers for project %s: %v", appName, project.DisplayName(), err)
 		err := h.setProjWatcherStatus(watcherObj, projectActiveWatcherv1.StatusIndicationError, "Failed to delete project")
 		if err != nil {
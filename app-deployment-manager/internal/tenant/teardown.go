@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+const (
+	// teardownTimeout bounds the teardown of a deleted project
+	teardownTimeout = 30 * time.Minute
+)
+
+var (
+	deploymentsGVR        = v1beta1.GroupVersion.WithResource("deployments")
+	clusterGroupsGVR      = v1beta1.GroupVersion.WithResource("clustergroups")
+	deploymentClustersGVR = v1beta1.GroupVersion.WithResource("deploymentclusters")
+	gitReposGVR           = schema.GroupVersionResource{Group: "fleet.cattle.io", Version: "v1alpha1", Resource: "gitrepos"}
+	secretsGVR            = schema.GroupVersionResource{Version: "v1", Resource: "secrets"}
+
+	// teardownBackoff spaces the teardown steps, reset each time a stage
+	// completes
+	teardownBackoff = wait.Backoff{
+		Duration: 2 * time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    10,
+		Cap:      time.Minute,
+	}
+)
+
+// projectResource is a kind of resource ADM creates for a project
+type projectResource struct {
+	gvr schema.GroupVersionResource
+	// clusterWide resources are listed in all namespaces instead of the
+	// project namespace
+	clusterWide bool
+	// name selects a single resource instead of the resources labeled with
+	// the project ID
+	name string
+	// owned selects the resources owned by an ADM resource instead of the
+	// resources labeled with the project ID
+	owned bool
+}
+
+// teardownStage deletes some kinds of resources of a project, a stage is
+// complete once all of its resources are gone
+type teardownStage struct {
+	name      string
+	resources []projectResource
+}
+
+// teardownStages are run in order: the deployments first as their finalizers
+// remove their Gitea repositories, catalog references and metrics, then the
+// cluster groups they may have targeted, and last anything the deployments
+// left behind, including the secrets of their profiles, values and
+// repositories which are garbage collected once the deployments are gone.
+//
+// The clusters of the project are not torn down, they mirror the Fleet
+// clusters managed by the cluster manager.
+var teardownStages = []teardownStage{
+	{
+		name:      "deployments",
+		resources: []projectResource{{gvr: deploymentsGVR}},
+	},
+	{
+		name:      "cluster groups",
+		resources: []projectResource{{gvr: clusterGroupsGVR}},
+	},
+	{
+		name: "residual resources",
+		resources: []projectResource{
+			{gvr: deploymentClustersGVR, clusterWide: true},
+			{gvr: gitReposGVR},
+			{gvr: secretsGVR, name: v1beta1.FleetGitSecretName},
+			{gvr: secretsGVR, owned: true},
+		},
+	},
+}
+
+// teardown deletes the resources of a deleted project stage by stage
+type teardown struct {
+	client    dynamic.Interface
+	projectID string
+	backoff   wait.Backoff
+
+	stage int
+}
+
+func newTeardown(client dynamic.Interface, projectID string) *teardown {
+	return &teardown{
+		client:    client,
+		projectID: projectID,
+		backoff:   teardownBackoff,
+	}
+}
+
+// done returns true once all the stages completed
+func (t *teardown) done() bool {
+	return t.stage >= len(teardownStages)
+}
+
+// progress returns the percentage of completed stages
+func (t *teardown) progress() int {
+	return t.stage * 100 / len(teardownStages)
+}
+
+// status describes the current stage of the teardown
+func (t *teardown) status() string {
+	if t.done() {
+		return fmt.Sprintf("Deleted (%d%%)", t.progress())
+	}
+	return fmt.Sprintf("Deleting %s (%d%%)", teardownStages[t.stage].name, t.progress())
+}
+
+// run steps through the teardown until all the stages completed and no
+// resource of the project is left, reporting the status after each step. The
+// project namespace is then empty of the resources ADM created or owns, and
+// the watchers of the project can be deregistered.
+func (t *teardown) run(ctx context.Context, report func(status string)) error {
+	backoff := t.backoff
+	for {
+		stage := t.stage
+		if err := t.step(ctx); err != nil {
+			log.Warnf("Project %s teardown: %v", t.projectID, err)
+		} else if t.done() {
+			// The earlier stages are checked again, a deployment may have
+			// been created while deleting the residual resources
+			remaining, err := t.remaining(ctx)
+			switch {
+			case err != nil:
+				log.Warnf("Project %s teardown: %v", t.projectID, err)
+			case len(remaining) == 0:
+				report(t.status())
+				return nil
+			default:
+				log.Warnf("Project %s teardown: resources left %s", t.projectID, strings.Join(remaining, ", "))
+				t.stage = 0
+			}
+		}
+		if t.stage != stage {
+			backoff = t.backoff
+		}
+		report(t.status())
+
+		select {
+		case <-ctx.Done():
+			remaining, _ := t.remaining(context.Background())
+			return fmt.Errorf("project %s teardown did not complete, resources left: %s", t.projectID, strings.Join(remaining, ", "))
+		case <-time.After(backoff.Step()):
+		}
+	}
+}
+
+// step deletes the resources of the current stage and moves to the next
+// stage once they are gone
+func (t *teardown) step(ctx context.Context) error {
+	for !t.done() {
+		left := 0
+		for _, r := range teardownStages[t.stage].resources {
+			n, err := t.deleteAll(ctx, r)
+			if err != nil {
+				return err
+			}
+			left += n
+		}
+		if left > 0 {
+			// Waiting for finalizers
+			return nil
+		}
+		t.stage++
+	}
+	return nil
+}
+
+// deleteAll deletes the resources of the project of a kind and returns the
+// number of them still present, including those being finalized
+func (t *teardown) deleteAll(ctx context.Context, r projectResource) (int, error) {
+	items, err := t.list(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+
+	deleted := 0
+	for _, item := range items {
+		if item.GetDeletionTimestamp() != nil {
+			continue
+		}
+		err := t.resource(r, item.GetNamespace()).Delete(ctx, item.GetName(), metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return 0, fmt.Errorf("cannot delete %s %s/%s: %w", r.gvr.Resource, item.GetNamespace(), item.GetName(), err)
+		}
+		deleted++
+	}
+	if deleted == 0 {
+		return len(items), nil
+	}
+
+	// Resources without finalizers are gone already
+	items, err = t.list(ctx, r)
+	if err != nil {
+		return 0, err
+	}
+	return len(items), nil
+}
+
+// remaining returns the resources of the project still present
+func (t *teardown) remaining(ctx context.Context) ([]string, error) {
+	var remaining []string
+	for _, stage := range teardownStages {
+		for _, r := range stage.resources {
+			items, err := t.list(ctx, r)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				remaining = append(remaining, fmt.Sprintf("%s %s/%s", r.gvr.Resource, item.GetNamespace(), item.GetName()))
+			}
+		}
+	}
+	return remaining, nil
+}
+
+// list returns the resources of the project of a kind
+func (t *teardown) list(ctx context.Context, r projectResource) ([]metav1.Object, error) {
+	namespace := t.projectID
+	if r.clusterWide {
+		namespace = ""
+	}
+
+	if r.name != "" {
+		item, err := t.resource(r, namespace).Get(ctx, r.name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("cannot get %s %s: %w", r.gvr.Resource, r.name, err)
+		}
+		return []metav1.Object{item}, nil
+	}
+
+	opts := metav1.ListOptions{
+		LabelSelector: labels.Set{string(v1beta1.AppOrchActiveProjectID): t.projectID}.String(),
+	}
+	if r.owned {
+		opts = metav1.ListOptions{}
+	}
+	list, err := t.resource(r, namespace).List(ctx, opts)
+	if apierrors.IsNotFound(err) {
+		// Kind not installed
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("cannot list %s: %w", r.gvr.Resource, err)
+	}
+
+	items := make([]metav1.Object, 0, len(list.Items))
+	for i := range list.Items {
+		if r.owned && !ownedByADM(&list.Items[i]) {
+			continue
+		}
+		items = append(items, &list.Items[i])
+	}
+	return items, nil
+}
+
+// ownedByADM returns true if a resource is owned by an ADM resource
+func ownedByADM(obj metav1.Object) bool {
+	for _, ref := range obj.GetOwnerReferences() {
+		if gv, err := schema.ParseGroupVersion(ref.APIVersion); err == nil && gv.Group == v1beta1.GroupVersion.Group {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *teardown) resource(r projectResource, namespace string) dynamic.ResourceInterface {
+	if namespace == "" {
+		return t.client.Resource(r.gvr)
+	}
+	return t.client.Resource(r.gvr).Namespace(namespace)
+}
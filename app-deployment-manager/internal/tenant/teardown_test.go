@@ -0,0 +1,201 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package tenant
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+const (
+	projectID      = "0000-1111-2222-3333-4444"
+	otherProjectID = "5555-6666-7777-8888-9999"
+)
+
+var _ = Describe("Tenant Teardown", func() {
+	var client *fake.FakeDynamicClient
+
+	newObject := func(gvr schema.GroupVersionResource, kind, namespace, name, project string) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{}
+		obj.SetAPIVersion(gvr.GroupVersion().String())
+		obj.SetKind(kind)
+		obj.SetNamespace(namespace)
+		obj.SetName(name)
+		if project != "" {
+			obj.SetLabels(map[string]string{string(v1beta1.AppOrchActiveProjectID): project})
+		}
+		return obj
+	}
+
+	newClient := func(objects ...runtime.Object) *fake.FakeDynamicClient {
+		return fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+			deploymentsGVR:        "DeploymentList",
+			clusterGroupsGVR:      "ClusterGroupList",
+			deploymentClustersGVR: "DeploymentClusterList",
+			gitReposGVR:           "GitRepoList",
+			secretsGVR:            "SecretList",
+		}, objects...)
+	}
+
+	ownedByDeployment := func(obj *unstructured.Unstructured) *unstructured.Unstructured {
+		obj.SetOwnerReferences([]metav1.OwnerReference{{
+			APIVersion: v1beta1.GroupVersion.String(),
+			Kind:       "Deployment",
+			Name:       "wordpress",
+			UID:        "1234",
+		}})
+		return obj
+	}
+
+	exists := func(gvr schema.GroupVersionResource, namespace, name string) bool {
+		_, err := client.Resource(gvr).Namespace(namespace).Get(context.Background(), name, metav1.GetOptions{})
+		return err == nil
+	}
+
+	Describe("step", func() {
+		It("deletes all the resources of the project", func() {
+			client = newClient(
+				newObject(deploymentsGVR, "Deployment", projectID, "wordpress", projectID),
+				newObject(clusterGroupsGVR, "ClusterGroup", projectID, "stores", projectID),
+				newObject(deploymentClustersGVR, "DeploymentCluster", "cluster-1", "dc-1", projectID),
+				newObject(gitReposGVR, "GitRepo", projectID, "wordpress-repo", projectID),
+				newObject(secretsGVR, "Secret", projectID, v1beta1.FleetGitSecretName, ""),
+			)
+			t := newTeardown(client, projectID)
+
+			Expect(t.step(context.Background())).To(Succeed())
+
+			Expect(t.done()).To(BeTrue())
+			Expect(t.status()).To(Equal("Deleted (100%)"))
+			remaining, err := t.remaining(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(remaining).To(BeEmpty())
+		})
+
+		It("leaves the resources of other projects", func() {
+			client = newClient(
+				newObject(deploymentsGVR, "Deployment", otherProjectID, "wordpress", otherProjectID),
+				newObject(deploymentClustersGVR, "DeploymentCluster", "cluster-1", "dc-1", otherProjectID),
+				newObject(secretsGVR, "Secret", otherProjectID, v1beta1.FleetGitSecretName, ""),
+			)
+			t := newTeardown(client, projectID)
+
+			Expect(t.step(context.Background())).To(Succeed())
+
+			Expect(t.done()).To(BeTrue())
+			Expect(exists(deploymentsGVR, otherProjectID, "wordpress")).To(BeTrue())
+			Expect(exists(deploymentClustersGVR, "cluster-1", "dc-1")).To(BeTrue())
+			Expect(exists(secretsGVR, otherProjectID, v1beta1.FleetGitSecretName)).To(BeTrue())
+		})
+
+		It("deletes the secrets owned by the deployments of the project", func() {
+			client = newClient(
+				ownedByDeployment(newObject(secretsGVR, "Secret", projectID, "wordpress-profile", "")),
+				newObject(secretsGVR, "Secret", projectID, "user-secret", ""),
+				ownedByDeployment(newObject(secretsGVR, "Secret", otherProjectID, "wordpress-profile", "")),
+			)
+			t := newTeardown(client, projectID)
+
+			Expect(t.step(context.Background())).To(Succeed())
+
+			Expect(t.done()).To(BeTrue())
+			Expect(exists(secretsGVR, projectID, "wordpress-profile")).To(BeFalse())
+			Expect(exists(secretsGVR, projectID, "user-secret")).To(BeTrue())
+			Expect(exists(secretsGVR, otherProjectID, "wordpress-profile")).To(BeTrue())
+		})
+
+		It("waits for the resources being finalized", func() {
+			deployment := newObject(deploymentsGVR, "Deployment", projectID, "wordpress", projectID)
+			deployment.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			deployment.SetFinalizers([]string{"app.edge-orchestrator.intel.com/finalizer"})
+			client = newClient(
+				deployment,
+				newObject(clusterGroupsGVR, "ClusterGroup", projectID, "stores", projectID),
+			)
+			t := newTeardown(client, projectID)
+
+			Expect(t.step(context.Background())).To(Succeed())
+
+			Expect(t.done()).To(BeFalse())
+			Expect(t.status()).To(Equal("Deleting deployments (0%)"))
+			Expect(exists(clusterGroupsGVR, projectID, "stores")).To(BeTrue())
+
+			// Finalizer removed
+			Expect(client.Resource(deploymentsGVR).Namespace(projectID).Delete(
+				context.Background(), "wordpress", metav1.DeleteOptions{})).To(Succeed())
+			Expect(t.step(context.Background())).To(Succeed())
+
+			Expect(t.done()).To(BeTrue())
+			Expect(exists(clusterGroupsGVR, projectID, "stores")).To(BeFalse())
+		})
+	})
+
+	Describe("run", func() {
+		It("reports the progress until the project is deleted", func() {
+			client = newClient(
+				newObject(deploymentsGVR, "Deployment", projectID, "wordpress", projectID),
+				newObject(deploymentClustersGVR, "DeploymentCluster", "cluster-1", "dc-1", projectID),
+			)
+			t := newTeardown(client, projectID)
+
+			var reports []string
+			Expect(t.run(context.Background(), func(status string) {
+				reports = append(reports, status)
+			})).To(Succeed())
+
+			Expect(reports).To(Equal([]string{"Deleted (100%)"}))
+		})
+
+		It("fails listing the resources left on timeout", func() {
+			deployment := newObject(deploymentsGVR, "Deployment", projectID, "wordpress", projectID)
+			deployment.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			deployment.SetFinalizers([]string{"app.edge-orchestrator.intel.com/finalizer"})
+			client = newClient(deployment)
+			t := newTeardown(client, projectID)
+			t.backoff = wait.Backoff{Duration: 10 * time.Millisecond, Steps: 1}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			var reports []string
+			err := t.run(ctx, func(status string) {
+				reports = append(reports, status)
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("deployments " + projectID + "/wordpress")))
+			Expect(reports).ToNot(BeEmpty())
+			Expect(reports).To(HaveEach("Deleting deployments (0%)"))
+		})
+
+		It("completes only once the project namespace has no secret owned by a deployment", func() {
+			secret := ownedByDeployment(newObject(secretsGVR, "Secret", projectID, "wordpress-profile", ""))
+			secret.SetDeletionTimestamp(&metav1.Time{Time: time.Now()})
+			secret.SetFinalizers([]string{"foregroundDeletion"})
+			client = newClient(secret)
+			t := newTeardown(client, projectID)
+			t.backoff = wait.Backoff{Duration: 10 * time.Millisecond, Steps: 1}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+			defer cancel()
+			var reports []string
+			err := t.run(ctx, func(status string) {
+				reports = append(reports, status)
+			})
+
+			Expect(err).To(MatchError(ContainSubstring("secrets " + projectID + "/wordpress-profile")))
+			Expect(reports).To(HaveEach("Deleting residual resources (66%)"))
+		})
+	})
+})
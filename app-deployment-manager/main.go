@@ -29,6 +29,7 @@ import (
 
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers/cluster"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers/clustergroup"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers/deployment"
 	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/controllers/deploymentcluster"
 	deploymentwebhook "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/webhooks/deployment"
@@ -126,18 +127,28 @@ func main() {
 	}
 
 	if err = (&deploymentcluster.Reconciler{
-		Client: mgr.GetClient(),
-		Scheme: mgr.GetScheme(),
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		APIReader: mgr.GetAPIReader(),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "DeploymentCluster")
 		os.Exit(1)
 	}
 
 	if err = (&cluster.Reconciler{
+		Client:    mgr.GetClient(),
+		Scheme:    mgr.GetScheme(),
+		APIReader: mgr.GetAPIReader(),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
+		os.Exit(1)
+	}
+
+	if err = (&clustergroup.Reconciler{
 		Client: mgr.GetClient(),
 		Scheme: mgr.GetScheme(),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterGroup")
 		os.Exit(1)
 	}
 
@@ -16,6 +16,7 @@ import (
 type AppDeploymentClientInterface interface {
 	Deployments(namespace string) DeploymentInterface
 	Clusters(namespace string) ClusterInterface
+	ClusterGroups(namespace string) ClusterGroupInterface
 	DeploymentClusters(namespace string) DeploymentClusterInterface
 }
 
@@ -53,6 +54,14 @@ func (a *AppDeploymentClient) Clusters(namespace string) ClusterInterface {
 	}
 }
 
+// ClusterGroups returns a clusterGroupClient
+func (a *AppDeploymentClient) ClusterGroups(namespace string) ClusterGroupInterface {
+	return &clusterGroupClient{
+		crClient: a.appDeploymentClient,
+		ns:       namespace,
+	}
+}
+
 func NewForConfig(c *rest.Config) (*AppDeploymentClient, error) {
 	err := deploymentsv1beta1.AddToScheme(scheme.Scheme)
 	if err != nil {
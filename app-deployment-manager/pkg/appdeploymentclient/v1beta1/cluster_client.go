@@ -18,6 +18,7 @@ import (
 type ClusterInterface interface {
 	List(ctx context.Context, opts metav1.ListOptions) (*deploymentsv1beta1.ClusterList, error)
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentsv1beta1.Cluster, error)
+	Update(ctx context.Context, name string, cluster *deploymentsv1beta1.Cluster, opts metav1.UpdateOptions) (*deploymentsv1beta1.Cluster, error)
 }
 
 // clusterClient implements ClusterInterface
@@ -51,3 +52,18 @@ func (c *clusterClient) Get(ctx context.Context, name string, opts metav1.GetOpt
 
 	return &result, err
 }
+
+func (c *clusterClient) Update(ctx context.Context, name string, cluster *deploymentsv1beta1.Cluster, opts metav1.UpdateOptions) (*deploymentsv1beta1.Cluster, error) {
+	result := deploymentsv1beta1.Cluster{}
+	err := c.crClient.
+		Put().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClustersResource).
+		Name(name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(cluster).
+		Do(ctx).
+		Into(&result)
+
+	return &result, err
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1beta1
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+
+	deploymentsv1beta1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// ClusterGroupInterface has methods to work with clusterGroupClient resources.
+type ClusterGroupInterface interface {
+	List(ctx context.Context, opts metav1.ListOptions) (*deploymentsv1beta1.ClusterGroupList, error)
+	Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentsv1beta1.ClusterGroup, error)
+	Create(ctx context.Context, group *deploymentsv1beta1.ClusterGroup, opts metav1.CreateOptions) (*deploymentsv1beta1.ClusterGroup, error)
+	Update(ctx context.Context, name string, group *deploymentsv1beta1.ClusterGroup, opts metav1.UpdateOptions) (*deploymentsv1beta1.ClusterGroup, error)
+	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
+}
+
+// clusterGroupClient implements ClusterGroupInterface
+type clusterGroupClient struct {
+	crClient rest.Interface
+	ns       string
+}
+
+func (c *clusterGroupClient) List(ctx context.Context, opts metav1.ListOptions) (*deploymentsv1beta1.ClusterGroupList, error) {
+	result := deploymentsv1beta1.ClusterGroupList{}
+	err := c.crClient.
+		Get().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClusterGroupsResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(&result)
+	return &result, err
+}
+
+func (c *clusterGroupClient) Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentsv1beta1.ClusterGroup, error) {
+	result := deploymentsv1beta1.ClusterGroup{}
+	err := c.crClient.
+		Get().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClusterGroupsResource).
+		Name(name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Do(ctx).
+		Into(&result)
+
+	return &result, err
+}
+
+func (c *clusterGroupClient) Create(ctx context.Context, group *deploymentsv1beta1.ClusterGroup, opts metav1.CreateOptions) (*deploymentsv1beta1.ClusterGroup, error) {
+	result := deploymentsv1beta1.ClusterGroup{}
+	err := c.crClient.
+		Post().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClusterGroupsResource).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(group).
+		Do(ctx).
+		Into(&result)
+
+	return &result, err
+}
+
+// Update updates ClusterGroup CR object. Returns the updated ClusterGroup CR object.
+func (c *clusterGroupClient) Update(ctx context.Context, name string, group *deploymentsv1beta1.ClusterGroup, opts metav1.UpdateOptions) (*deploymentsv1beta1.ClusterGroup, error) {
+	result := deploymentsv1beta1.ClusterGroup{}
+	err := c.crClient.
+		Put().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClusterGroupsResource).
+		Name(name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(group).
+		Do(ctx).
+		Into(&result)
+
+	return &result, err
+}
+
+// Delete deletes ClusterGroup based on name.
+func (c *clusterGroupClient) Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error {
+	return c.crClient.
+		Delete().
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.ClusterGroupsResource).
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
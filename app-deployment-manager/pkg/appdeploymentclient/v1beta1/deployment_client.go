@@ -8,6 +8,7 @@ import (
 	"context"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 
@@ -20,6 +21,7 @@ type DeploymentInterface interface {
 	Get(ctx context.Context, name string, opts metav1.GetOptions) (*deploymentsv1beta1.Deployment, error)
 	Create(ctx context.Context, deployment *deploymentsv1beta1.Deployment, opts metav1.CreateOptions) (*deploymentsv1beta1.Deployment, error)
 	Update(ctx context.Context, name string, deployment *deploymentsv1beta1.Deployment, opts metav1.UpdateOptions) (*deploymentsv1beta1.Deployment, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (*deploymentsv1beta1.Deployment, error)
 	Delete(ctx context.Context, name string, opts metav1.DeleteOptions) error
 }
 
@@ -93,3 +95,17 @@ func (c *deploymentClient) Update(ctx context.Context, name string, deployment *
 		Into(result)
 	return
 }
+
+// Patch applies the patch to the deployment CR object. Returns the patched deployment CR object.
+func (c *deploymentClient) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts metav1.PatchOptions) (result *deploymentsv1beta1.Deployment, err error) {
+	result = &deploymentsv1beta1.Deployment{}
+	err = c.apporchClient.Patch(pt).
+		Namespace(c.ns).
+		Resource(deploymentsv1beta1.DeploymentsResource).
+		Name(name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -10,6 +10,7 @@ import (
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/rest/fake"
 
@@ -97,6 +98,18 @@ var _ = Describe("Test Deployment client", func() {
 			defer cancel()
 		})
 
+		It("successfully patch deployments object", func() {
+			var opts metav1.PatchOptions
+
+			deployments := adClient.Deployments("test_namespace")
+			Expect(deployments).NotTo(BeNil())
+
+			resp, err := deployments.Patch(ctx, "test", types.MergePatchType, []byte(`{"metadata":{"annotations":{"a":"b"}}}`), opts)
+			Expect(resp).NotTo(BeNil())
+			Expect(err).ShouldNot(HaveOccurred())
+			defer cancel()
+		})
+
 		It("successfully delete deployments object", func() {
 			var opts metav1.DeleteOptions
 
@@ -0,0 +1,222 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	kustomize "sigs.k8s.io/kustomize/api/types"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	// HelmInstanceLabel is set by Helm charts on their resources with the release name
+	HelmInstanceLabel = "app.kubernetes.io/instance"
+)
+
+// blueGreenSlots are the slots of a blue-green app in the order of generation
+type blueGreenSlots struct {
+	// deploy is the slot the current generation is written to
+	deploy v1beta1.BlueGreenSlot
+	// standby is the slot kept running until the services switched, if any
+	standby v1beta1.BlueGreenSlot
+	// target is the slot the services select
+	target v1beta1.BlueGreenSlot
+}
+
+// SlotBundleName returns the name of the bundle and Helm release of an app bundle in a blue-green slot
+func SlotBundleName(bundleName string, slot v1beta1.BlueGreenSlot) string {
+	return bundleName + "-" + string(slot)
+}
+
+// getBlueGreenSlots returns the slots of the deployment for its current blue-green phase.
+func getBlueGreenSlots(d *v1beta1.Deployment) blueGreenSlots {
+	bg := d.Status.BlueGreen
+	if bg == nil || bg.ActiveSlot == "" {
+		return blueGreenSlots{deploy: v1beta1.SlotBlue, target: v1beta1.SlotBlue}
+	}
+
+	switch bg.Phase {
+	case v1beta1.BlueGreenDeploying:
+		return blueGreenSlots{deploy: bg.PreviewSlot, standby: bg.ActiveSlot, target: bg.ActiveSlot}
+	case v1beta1.BlueGreenSwitching:
+		return blueGreenSlots{deploy: bg.PreviewSlot, standby: bg.ActiveSlot, target: bg.PreviewSlot}
+	default:
+		return blueGreenSlots{deploy: bg.ActiveSlot, target: bg.ActiveSlot}
+	}
+}
+
+// prepareBlueGreenDir removes everything from the app directory but the slots
+// still in use, so that the app bundle of a slot no longer in use is removed
+// from the clusters. The standby slot is kept as is but relabeled, so that it
+// keeps running without being reported as part of the deployment.
+func prepareBlueGreenDir(appPath string, slots blueGreenSlots) error {
+	entries, err := os.ReadDir(appPath)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	for _, e := range entries {
+		if e.Name() == string(slots.deploy) || e.Name() == string(slots.standby) {
+			continue
+		}
+		if err := os.RemoveAll(filepath.Join(appPath, e.Name())); err != nil {
+			return err
+		}
+	}
+
+	if slots.standby == "" {
+		return nil
+	}
+	return relabelStandbySlot(filepath.Join(appPath, string(slots.standby)))
+}
+
+// relabelStandbySlot sets the standby bundle type on all bundles of a slot
+func relabelStandbySlot(slotPath string) error {
+	return filepath.WalkDir(slotPath, func(path string, e os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if e.IsDir() || e.Name() != "fleet.yaml" {
+			return nil
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		conf := Config{}
+		if err := yaml.Unmarshal(data, &conf); err != nil {
+			return err
+		}
+		if conf.Labels.BundleType == "" || conf.Labels.BundleType == BundleTypeStandby.String() {
+			return nil
+		}
+		conf.Labels.BundleType = BundleTypeStandby.String()
+		return WriteFleetConfig(filepath.Dir(path), conf)
+	})
+}
+
+// removeBlueGreenSlots removes the slots of an app which is no longer
+// deployed blue-green, along with the switch bundle files.
+func removeBlueGreenSlots(appPath string) error {
+	found := false
+	for _, slot := range []v1beta1.BlueGreenSlot{v1beta1.SlotBlue, v1beta1.SlotGreen} {
+		if _, err := os.Stat(filepath.Join(appPath, string(slot))); err == nil {
+			found = true
+		}
+	}
+	if !found {
+		return nil
+	}
+	return os.RemoveAll(appPath)
+}
+
+// injectBlueGreenSwitch generates the switch bundle of a blue-green app in the
+// app directory. It holds the Services selecting the pods of the target slot
+// and depends on the target slot bundle, so that the Services are only
+// switched once the new release is ready. The network policies of the app
+// are also part of it as they are shared by both slots.
+func injectBlueGreenSwitch(app v1beta1.Application, depID string, generation int64, bundleName string,
+	namespace string, appPath string, target v1beta1.BlueGreenSlot, policies []string) error {
+	switchConf := Config{
+		Name:             bundleName,
+		DefaultNamespace: namespace,
+		Labels: DeployLabels{
+			AppName:              app.Name,
+			BundleType:           BundleTypeSwitch.String(),
+			DeploymentID:         depID,
+			DeploymentGeneration: fmt.Sprint(generation),
+			Slot:                 string(target),
+		},
+		DependsOn: []DependsOnItem{
+			{
+				Name: SlotBundleName(bundleName, target),
+			},
+		},
+	}
+	switchConf.Kustomize.Dir = "./kustomize"
+
+	kustomizePath := filepath.Join(appPath, "kustomize")
+	resources := append([]string{}, policies...)
+	for _, s := range app.BlueGreenServices {
+		svc := newBlueGreenService(s, namespace, SlotBundleName(bundleName, target))
+		data, err := k8syaml.Marshal(svc)
+		if err != nil {
+			return err
+		}
+		name := fmt.Sprintf("service-%s.yaml", s.Name)
+		if err := utils.WriteFile(kustomizePath, name, data); err != nil {
+			return err
+		}
+		resources = append(resources, name)
+	}
+
+	k := &kustomize.Kustomization{
+		TypeMeta: kustomize.TypeMeta{
+			Kind:       kustomize.KustomizationKind,
+			APIVersion: kustomize.KustomizationVersion,
+		},
+		Resources: resources,
+	}
+	if err := WriteKustomization(kustomizePath, k); err != nil {
+		return err
+	}
+
+	// Generate fleet.yaml from Config
+	return WriteFleetConfig(appPath, switchConf)
+}
+
+func newBlueGreenService(s v1beta1.BlueGreenService, namespace string, release string) *corev1.Service {
+	selector := map[string]string{}
+	for k, v := range s.Selector {
+		selector[k] = v
+	}
+	selector[HelmInstanceLabel] = release
+
+	ports := []corev1.ServicePort{}
+	for _, p := range s.Ports {
+		targetPort := p.TargetPort
+		if targetPort == 0 {
+			targetPort = p.Port
+		}
+		protocol := corev1.Protocol(p.Protocol)
+		if protocol == "" {
+			protocol = corev1.ProtocolTCP
+		}
+		ports = append(ports, corev1.ServicePort{
+			Name:       p.Name,
+			Port:       p.Port,
+			TargetPort: intstr.FromInt32(targetPort),
+			Protocol:   protocol,
+		})
+	}
+
+	return &corev1.Service{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Service",
+			APIVersion: "v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      s.Name,
+			Namespace: namespace,
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: selector,
+			Ports:    ports,
+		},
+	}
+}
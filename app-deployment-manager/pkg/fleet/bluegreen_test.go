@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	yamlv3 "gopkg.in/yaml.v3"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Blue-green", func() {
+	const (
+		bundleName = "b-0123456789abcdef"
+		depID      = "a563356a-b4df-47bb-b620-aae2d74c5129"
+		namespace  = "apps"
+	)
+
+	var (
+		app      v1beta1.Application
+		basePath string
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name: "wordpress",
+			BlueGreenServices: []v1beta1.BlueGreenService{
+				{
+					Name:     "wordpress-web",
+					Selector: map[string]string{"app.kubernetes.io/name": "wordpress"},
+					Ports: []v1beta1.BlueGreenServicePort{
+						{
+							Port:       80,
+							TargetPort: 8080,
+						},
+					},
+				},
+			},
+		}
+		basePath = GinkgoT().TempDir()
+	})
+
+	readConfig := func(dir string) *Config {
+		data, err := os.ReadFile(filepath.Join(dir, "fleet.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		conf := &Config{}
+		Expect(yamlv3.Unmarshal(data, conf)).To(Succeed())
+		return conf
+	}
+
+	deployment := func(bg *v1beta1.BlueGreenStatus) *v1beta1.Deployment {
+		return &v1beta1.Deployment{Status: v1beta1.DeploymentStatus{BlueGreen: bg}}
+	}
+
+	It("selects the slots of each phase", func() {
+		Expect(getBlueGreenSlots(deployment(nil))).To(Equal(
+			blueGreenSlots{deploy: v1beta1.SlotBlue, target: v1beta1.SlotBlue}))
+		Expect(getBlueGreenSlots(deployment(&v1beta1.BlueGreenStatus{
+			ActiveSlot: v1beta1.SlotGreen,
+		}))).To(Equal(blueGreenSlots{deploy: v1beta1.SlotGreen, target: v1beta1.SlotGreen}))
+		Expect(getBlueGreenSlots(deployment(&v1beta1.BlueGreenStatus{
+			ActiveSlot:  v1beta1.SlotBlue,
+			PreviewSlot: v1beta1.SlotGreen,
+			Phase:       v1beta1.BlueGreenDeploying,
+		}))).To(Equal(blueGreenSlots{deploy: v1beta1.SlotGreen, standby: v1beta1.SlotBlue, target: v1beta1.SlotBlue}))
+		Expect(getBlueGreenSlots(deployment(&v1beta1.BlueGreenStatus{
+			ActiveSlot:  v1beta1.SlotBlue,
+			PreviewSlot: v1beta1.SlotGreen,
+			Phase:       v1beta1.BlueGreenSwitching,
+		}))).To(Equal(blueGreenSlots{deploy: v1beta1.SlotGreen, standby: v1beta1.SlotBlue, target: v1beta1.SlotGreen}))
+	})
+
+	It("generates a switch bundle with services selecting the target slot", func() {
+		Expect(injectBlueGreenSwitch(app, depID, 4, bundleName, namespace, basePath, v1beta1.SlotGreen,
+			[]string{"network-policy-ingress.yaml"})).To(Succeed())
+
+		conf := readConfig(basePath)
+		Expect(conf.Name).To(Equal(bundleName))
+		Expect(conf.Labels.BundleType).To(Equal(BundleTypeSwitchString))
+		Expect(conf.Labels.Slot).To(Equal("green"))
+		Expect(conf.Labels.DeploymentGeneration).To(Equal("4"))
+		Expect(conf.DependsOn).To(Equal([]DependsOnItem{{Name: bundleName + "-green"}}))
+		Expect(conf.Kustomize.Dir).To(Equal("./kustomize"))
+
+		data, err := os.ReadFile(filepath.Join(basePath, "kustomize", "service-wordpress-web.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		svc := &corev1.Service{}
+		Expect(k8syaml.Unmarshal(data, svc)).To(Succeed())
+		Expect(svc.Namespace).To(Equal(namespace))
+		Expect(svc.Spec.Selector).To(Equal(map[string]string{
+			"app.kubernetes.io/name": "wordpress",
+			HelmInstanceLabel:        bundleName + "-green",
+		}))
+		Expect(svc.Spec.Ports).To(Equal([]corev1.ServicePort{{
+			Port:       80,
+			TargetPort: intstr.FromInt32(8080),
+			Protocol:   corev1.ProtocolTCP,
+		}}))
+
+		data, err = os.ReadFile(filepath.Join(basePath, "kustomize", "kustomization.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(ContainSubstring("network-policy-ingress.yaml"))
+		Expect(string(data)).To(ContainSubstring("service-wordpress-web.yaml"))
+	})
+
+	It("relabels the standby slot and removes unused files", func() {
+		blue := filepath.Join(basePath, "blue")
+		Expect(WriteFleetConfig(blue, Config{
+			Name:   bundleName + "-blue",
+			Labels: DeployLabels{BundleType: BundleTypeAppString, DeploymentGeneration: "1"},
+		})).To(Succeed())
+		Expect(WriteFleetConfig(filepath.Join(blue, HealthProbeDir), Config{
+			Name:   HealthBundleName(bundleName + "-blue"),
+			Labels: DeployLabels{BundleType: BundleTypeHealthString},
+		})).To(Succeed())
+		Expect(WriteFleetConfig(basePath, Config{Name: bundleName})).To(Succeed())
+
+		Expect(prepareBlueGreenDir(basePath, blueGreenSlots{
+			deploy:  v1beta1.SlotGreen,
+			standby: v1beta1.SlotBlue,
+			target:  v1beta1.SlotBlue,
+		})).To(Succeed())
+
+		Expect(filepath.Join(basePath, "fleet.yaml")).ToNot(BeAnExistingFile())
+		conf := readConfig(blue)
+		Expect(conf.Labels.BundleType).To(Equal(BundleTypeStandbyString))
+		Expect(conf.Labels.DeploymentGeneration).To(Equal("1"))
+		Expect(readConfig(filepath.Join(blue, HealthProbeDir)).Labels.BundleType).To(Equal(BundleTypeStandbyString))
+
+		Expect(prepareBlueGreenDir(basePath, blueGreenSlots{
+			deploy: v1beta1.SlotGreen,
+			target: v1beta1.SlotGreen,
+		})).To(Succeed())
+		Expect(blue).ToNot(BeADirectory())
+	})
+
+	It("removes the slots of an app no longer deployed blue-green", func() {
+		Expect(WriteFleetConfig(filepath.Join(basePath, "green"), Config{Name: bundleName + "-green"})).To(Succeed())
+		Expect(removeBlueGreenSlots(basePath)).To(Succeed())
+		Expect(basePath).ToNot(BeADirectory())
+	})
+})
@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+const (
+	// CanaryDir is the subdirectory of an app holding its canary release
+	CanaryDir = "canary"
+
+	canaryCustomization = "canary"
+	stableCustomization = "stable"
+)
+
+type TargetCustomization struct {
+	Name            string           `yaml:"name"`
+	ClusterSelector *ClusterSelector `yaml:"clusterSelector,omitempty"`
+	DoNotDeploy     bool             `yaml:"doNotDeploy,omitempty"`
+}
+
+type ClusterSelector struct {
+	MatchExpressions []MatchExpression `yaml:"matchExpressions,omitempty"`
+}
+
+type MatchExpression struct {
+	Key      string   `yaml:"key"`
+	Operator string   `yaml:"operator"`
+	Values   []string `yaml:"values,omitempty"`
+}
+
+// CanaryBundleName returns the name of the bundle and Helm release of an app bundle in the canary release
+func CanaryBundleName(bundleName string) string {
+	return bundleName + "-" + CanaryDir
+}
+
+// CanaryActive returns true while the canary release of the deployment is
+// rolled out to or observed on the canary clusters.
+func CanaryActive(d *v1beta1.Deployment) bool {
+	c := d.Status.Canary
+	return c != nil && (c.Phase == v1beta1.CanaryDeploying || c.Phase == v1beta1.CanaryObserving)
+}
+
+// canaryAborted returns true if the canary release of the current generation was aborted
+func canaryAborted(d *v1beta1.Deployment) bool {
+	c := d.Status.Canary
+	return c != nil && c.Phase == v1beta1.CanaryAborted && c.Generation == d.GetGeneration()
+}
+
+// hasStableRelease returns true if the app has a release deployed to the
+// clusters other than the canary clusters, i.e. it was not added by the canary
+// release.
+func hasStableRelease(appPath string) (bool, error) {
+	conf, err := readFleetConfig(appPath)
+	if err != nil || conf == nil {
+		return false, err
+	}
+	for _, tc := range conf.TargetCustomizations {
+		if tc.Name == stableCustomization {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// canaryTargetCustomizations returns the target customizations of the bundles
+// of either the canary or the stable release, so that each is only deployed to
+// its own clusters.
+func canaryTargetCustomizations(clusters []string, canary bool) []TargetCustomization {
+	if canary {
+		return []TargetCustomization{
+			{
+				Name: stableCustomization,
+				ClusterSelector: &ClusterSelector{
+					MatchExpressions: []MatchExpression{
+						{
+							Key:      string(v1beta1.ClusterName),
+							Operator: "NotIn",
+							Values:   clusters,
+						},
+					},
+				},
+				DoNotDeploy: true,
+			},
+		}
+	}
+	return []TargetCustomization{
+		{
+			Name: canaryCustomization,
+			ClusterSelector: &ClusterSelector{
+				MatchExpressions: []MatchExpression{
+					{
+						Key:      string(v1beta1.ClusterName),
+						Operator: "In",
+						Values:   clusters,
+					},
+				},
+			},
+			DoNotDeploy: true,
+		},
+	}
+}
+
+// injectCanaryCustomizations restricts the bundles of the canary release in
+// the canary directory to the canary clusters and the bundles of the stable
+// release to the other clusters. All bundles of an app without stable release
+// belong to the canary release.
+func injectCanaryCustomizations(appPath string, clusters []string, stable bool) error {
+	return updateFleetConfigs(appPath, func(path string, conf *Config) bool {
+		canary := !stable || strings.HasPrefix(path, filepath.Join(appPath, CanaryDir)+string(filepath.Separator))
+		conf.TargetCustomizations = canaryTargetCustomizations(clusters, canary)
+		return true
+	})
+}
+
+// removeCanary removes the canary release of an app and the target
+// customizations of its stable release, so that the stable release is
+// deployed to all target clusters again.
+func removeCanary(appPath string) error {
+	if err := os.RemoveAll(filepath.Join(appPath, CanaryDir)); err != nil {
+		return err
+	}
+	return updateFleetConfigs(appPath, func(_ string, conf *Config) bool {
+		if len(conf.TargetCustomizations) == 0 {
+			return false
+		}
+		conf.TargetCustomizations = nil
+		return true
+	})
+}
+
+// abortCanary restores the stable release of an app whose canary release was
+// aborted. An app without stable release is removed altogether.
+func abortCanary(appPath string) error {
+	stable, err := hasStableRelease(appPath)
+	if err != nil {
+		return err
+	}
+	if !stable {
+		return os.RemoveAll(appPath)
+	}
+	return removeCanary(appPath)
+}
+
+// updateFleetConfigs calls update on the fleet.yaml of all deployment bundles
+// under the given path and writes them back if update returns true.
+func updateFleetConfigs(basePath string, update func(path string, conf *Config) bool) error {
+	return filepath.WalkDir(basePath, func(path string, e os.DirEntry, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if e.IsDir() || e.Name() != "fleet.yaml" {
+			return nil
+		}
+
+		conf, err := readFleetConfig(filepath.Dir(path))
+		if err != nil {
+			return err
+		}
+		// Bundles without bundle type, e.g. namespaces, are shared by all clusters
+		if conf.Labels.BundleType == "" || !update(path, conf) {
+			return nil
+		}
+		return WriteFleetConfig(filepath.Dir(path), *conf)
+	})
+}
+
+// readFleetConfig reads the fleet.yaml of a directory, nil if it has none
+func readFleetConfig(dir string) (*Config, error) {
+	data, err := os.ReadFile(filepath.Join(dir, "fleet.yaml"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	conf := &Config{}
+	if err := yaml.Unmarshal(data, conf); err != nil {
+		return nil, err
+	}
+	return conf, nil
+}
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Canary", func() {
+	const bundleName = "b-0123456789abcdef"
+
+	var (
+		appPath  string
+		clusters = []string{"cluster-1", "cluster-2"}
+	)
+
+	BeforeEach(func() {
+		appPath = filepath.Join(GinkgoT().TempDir(), "wordpress")
+		Expect(WriteFleetConfig(appPath, Config{
+			Name:   bundleName,
+			Labels: DeployLabels{BundleType: BundleTypeAppString, DeploymentGeneration: "1"},
+		})).To(Succeed())
+		Expect(WriteFleetConfig(filepath.Join(appPath, "ns"), Config{Name: "ns-bundle"})).To(Succeed())
+		Expect(WriteFleetConfig(filepath.Join(appPath, CanaryDir), Config{
+			Name:   CanaryBundleName(bundleName),
+			Labels: DeployLabels{BundleType: BundleTypeAppString, DeploymentGeneration: "2"},
+		})).To(Succeed())
+		Expect(WriteFleetConfig(filepath.Join(appPath, CanaryDir, HealthProbeDir), Config{
+			Name:   HealthBundleName(CanaryBundleName(bundleName)),
+			Labels: DeployLabels{BundleType: BundleTypeHealthString, DeploymentGeneration: "2"},
+		})).To(Succeed())
+	})
+
+	readConfig := func(dir string) *Config {
+		conf, err := readFleetConfig(dir)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(conf).ToNot(BeNil())
+		return conf
+	}
+
+	It("tells whether the canary stage is active", func() {
+		d := &v1beta1.Deployment{}
+		d.Generation = 2
+		Expect(CanaryActive(d)).To(BeFalse())
+		d.Status.Canary = &v1beta1.CanaryStatus{Phase: v1beta1.CanaryObserving, Generation: 2}
+		Expect(CanaryActive(d)).To(BeTrue())
+		Expect(canaryAborted(d)).To(BeFalse())
+		d.Status.Canary.Phase = v1beta1.CanaryAborted
+		Expect(CanaryActive(d)).To(BeFalse())
+		Expect(canaryAborted(d)).To(BeTrue())
+		d.Generation = 3
+		Expect(canaryAborted(d)).To(BeFalse())
+	})
+
+	It("restricts the canary and stable releases to their clusters", func() {
+		Expect(hasStableRelease(appPath)).To(BeTrue())
+		Expect(injectCanaryCustomizations(appPath, clusters, true)).To(Succeed())
+
+		Expect(readConfig(appPath).TargetCustomizations).To(Equal([]TargetCustomization{{
+			Name: canaryCustomization,
+			ClusterSelector: &ClusterSelector{MatchExpressions: []MatchExpression{{
+				Key:      string(v1beta1.ClusterName),
+				Operator: "In",
+				Values:   clusters,
+			}}},
+			DoNotDeploy: true,
+		}}))
+		stableOnly := []TargetCustomization{{
+			Name: stableCustomization,
+			ClusterSelector: &ClusterSelector{MatchExpressions: []MatchExpression{{
+				Key:      string(v1beta1.ClusterName),
+				Operator: "NotIn",
+				Values:   clusters,
+			}}},
+			DoNotDeploy: true,
+		}}
+		Expect(readConfig(filepath.Join(appPath, CanaryDir)).TargetCustomizations).To(Equal(stableOnly))
+		Expect(readConfig(filepath.Join(appPath, CanaryDir, HealthProbeDir)).TargetCustomizations).To(Equal(stableOnly))
+		Expect(readConfig(filepath.Join(appPath, "ns")).TargetCustomizations).To(BeEmpty())
+		Expect(hasStableRelease(appPath)).To(BeTrue())
+	})
+
+	It("restores the stable release once the canary stage is over", func() {
+		Expect(injectCanaryCustomizations(appPath, clusters, true)).To(Succeed())
+		Expect(abortCanary(appPath)).To(Succeed())
+
+		Expect(filepath.Join(appPath, CanaryDir)).ToNot(BeADirectory())
+		conf := readConfig(appPath)
+		Expect(conf.TargetCustomizations).To(BeEmpty())
+		Expect(conf.Labels.DeploymentGeneration).To(Equal("1"))
+	})
+
+	It("removes an app added by an aborted canary release", func() {
+		Expect(injectCanaryCustomizations(appPath, clusters, false)).To(Succeed())
+		Expect(readConfig(appPath).TargetCustomizations[0].Name).To(Equal(stableCustomization))
+		Expect(hasStableRelease(appPath)).To(BeFalse())
+
+		Expect(abortCanary(appPath)).To(Succeed())
+		Expect(appPath).ToNot(BeADirectory())
+	})
+})
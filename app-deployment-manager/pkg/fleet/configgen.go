@@ -44,10 +44,18 @@ const (
 	BundleTypeUnknown BundleType = 0
 	BundleTypeInit    BundleType = 1
 	BundleTypeApp     BundleType = 2
+	BundleTypeHealth  BundleType = 3
+	BundleTypeTest    BundleType = 4
+	BundleTypeSwitch  BundleType = 5
+	BundleTypeStandby BundleType = 6
 
 	BundleTypeUnknownString = "unknown"
 	BundleTypeInitString    = "init"
 	BundleTypeAppString     = "app"
+	BundleTypeHealthString  = "health"
+	BundleTypeTestString    = "test"
+	BundleTypeSwitchString  = "switch"
+	BundleTypeStandbyString = "standby"
 
 	NexusOrgLabel = "runtimeorgs.runtimeorg.edge-orchestrator.intel.com"
 )
@@ -87,7 +95,8 @@ var (
 )
 
 func (b BundleType) String() string {
-	return [...]string{BundleTypeUnknownString, BundleTypeInitString, BundleTypeAppString}[b]
+	return [...]string{BundleTypeUnknownString, BundleTypeInitString, BundleTypeAppString, BundleTypeHealthString, BundleTypeTestString,
+		BundleTypeSwitchString, BundleTypeStandbyString}[b]
 }
 
 type DiffOptions struct {
@@ -130,16 +139,17 @@ type ComparePatch struct {
 type Config struct {
 	Name               string
 	Labels             DeployLabels
-	DefaultNamespace   string `yaml:"defaultNamespace"`
-	DeleteCRDResources bool   `yaml:"deleteCRDResources"`
-	Helm               HelmApp
+	DefaultNamespace   string  `yaml:"defaultNamespace"`
+	DeleteCRDResources bool    `yaml:"deleteCRDResources"`
+	Helm               HelmApp `yaml:"helm,omitempty"`
 	Kustomize          struct {
 		Dir string
 	} `yaml:"kustomize,omitempty"`
-	DependsOn            []DependsOnItem   `yaml:"dependsOn"`
-	Diff                 *DiffOptions      `yaml:"diff,omitempty"`
-	NamespaceLabels      map[string]string `yaml:"namespaceLabels,omitempty"`
-	NamespaceAnnotations map[string]string `yaml:"namespaceAnnotations,omitempty"`
+	DependsOn            []DependsOnItem       `yaml:"dependsOn"`
+	Diff                 *DiffOptions          `yaml:"diff,omitempty"`
+	NamespaceLabels      map[string]string     `yaml:"namespaceLabels,omitempty"`
+	NamespaceAnnotations map[string]string     `yaml:"namespaceAnnotations,omitempty"`
+	TargetCustomizations []TargetCustomization `yaml:"targetCustomizations,omitempty"`
 }
 
 type DeployLabels struct {
@@ -147,6 +157,7 @@ type DeployLabels struct {
 	DeploymentGeneration string `yaml:"deploymentGeneration,omitempty"`
 	AppName              string `yaml:"app.edge-orchestrator.intel.com/app-name,omitempty"`
 	BundleType           string `yaml:"app.edge-orchestrator.intel.com/bundle-type,omitempty"`
+	Slot                 string `yaml:"app.edge-orchestrator.intel.com/blue-green-slot,omitempty"`
 }
 
 type PodSelector struct {
@@ -192,7 +203,8 @@ type HelmApp struct {
 	Repo        string
 	Chart       string
 	Version     string
-	ValuesFiles []string `yaml:"valuesFiles"`
+	ValuesFiles []string     `yaml:"valuesFiles"`
+	ValuesFrom  []ValuesFrom `yaml:"valuesFrom,omitempty"`
 }
 
 type DependsOnItem struct {
@@ -222,6 +234,17 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 	appMap := map[string]v1beta1.Application{}
 	var initNsBundleName string
 
+	// Once the canary release is aborted the stable release is kept as is
+	// until the next generation
+	if d.Spec.DeploymentType != v1beta1.BlueGreen && canaryAborted(d) {
+		for _, app := range d.Spec.Applications {
+			if err := abortCanary(filepath.Join(baseDir, app.Name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
 	for _, app := range d.Spec.Applications {
 		appMap[app.Name] = app
 	}
@@ -236,14 +259,46 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 		return err
 	}
 
+	// Apps are only rolled out on a cluster once the required deployments run on it
+	requiredDependsOn := []DependsOnItem{}
+	if len(d.Spec.ChildDeploymentList) > 0 {
+		required, err := getRequiredDeployments(d, kc)
+		if err != nil {
+			return err
+		}
+		requiredDependsOn = requiredDeploymentsDependsOn(required)
+	}
+
+	// During a canary stage the new generation of the apps already released
+	// is written to their canary directory, next to their stable release
+	blueGreen := d.Spec.DeploymentType == v1beta1.BlueGreen
+	canary := !blueGreen && CanaryActive(d)
+	stableApps := map[string]bool{}
+	if canary {
+		for _, app := range d.Spec.Applications {
+			stable, err := hasStableRelease(filepath.Join(baseDir, app.Name))
+			if err != nil {
+				return err
+			}
+			stableApps[app.Name] = stable
+		}
+	}
+
 	for _, app := range d.Spec.Applications {
 		// Get default namespace
 		namespace := app.Namespace
 
-		hasImageCreds := app.HelmApp.ImageRegistrySecretName != ""
+		// Manifest apps have no chart values and image registry
+		var imageRegistry, imageRegistrySecretName string
+		if app.HelmApp != nil {
+			imageRegistry = app.HelmApp.ImageRegistry
+			imageRegistrySecretName = app.HelmApp.ImageRegistrySecretName
+		}
+
+		hasImageCreds := imageRegistrySecretName != ""
 		hasPreHook := false
 		bundleName := BundleName(app, d.GetName())
-		imageRegistryURL, err := url.Parse(app.HelmApp.ImageRegistry)
+		imageRegistryURL, err := url.Parse(imageRegistry)
 		if err != nil {
 			return err
 		}
@@ -252,97 +307,151 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 		if err != nil {
 			return err
 		}
-		fleetPath := filepath.Join(baseDir, app.Name)
-
-		// Generate profile.yaml with profile contents
-		profileyaml := "profile.yaml"
-		contents, err := valuesFromSecret(app.ProfileSecretName, d.Namespace, kc)
-		if err != nil {
+		fleetConf.DependsOn = append(fleetConf.DependsOn, requiredDependsOn...)
+		appPath := filepath.Join(baseDir, app.Name)
+		fleetPath := appPath
+
+		// Blue-green apps are deployed to a slot subdirectory with a slot
+		// suffixed bundle and release name, next to the switch bundle
+		var slots blueGreenSlots
+		if blueGreen {
+			slots = getBlueGreenSlots(d)
+			if err := prepareBlueGreenDir(appPath, slots); err != nil {
+				return err
+			}
+			fleetPath = filepath.Join(appPath, string(slots.deploy))
+			bundleName = SlotBundleName(bundleName, slots.deploy)
+			fleetConf.Name = bundleName
+			fleetConf.Helm.ReleaseName = bundleName
+			fleetConf.Labels.Slot = string(slots.deploy)
+		} else if err := removeBlueGreenSlots(appPath); err != nil {
 			return err
 		}
-		if hasImageCreds {
-			contents = strings.Replace(contents, CredentialString, bundleName, -1)
-		}
 
-		if strings.Contains(contents, CredentialString) {
-			err := errors.New("token string present without Docker credentials")
-			return err
+		if stableApps[app.Name] {
+			if err := os.RemoveAll(filepath.Join(appPath, CanaryDir)); err != nil {
+				return err
+			}
+			fleetPath = filepath.Join(appPath, CanaryDir)
+			bundleName = CanaryBundleName(bundleName)
+			fleetConf.Name = bundleName
+			fleetConf.Helm.ReleaseName = bundleName
+			// Canary releases depend on the canary release of the other apps
+			for i, dep := range fleetConf.DependsOn {
+				for _, name := range app.DependsOn {
+					if stableApps[name] && dep.Name == BundleName(appMap[name], d.GetName()) {
+						fleetConf.DependsOn[i].Name = CanaryBundleName(dep.Name)
+					}
+				}
+			}
+		} else if !canary {
+			if err := removeCanary(appPath); err != nil {
+				return err
+			}
 		}
 
-		// Check if Prehook present and if it needs image pull.
-		if strings.Contains(contents, PreHookString) {
-			hasPreHook = true
-		}
+		// Only Helm apps are rendered with values files
+		var secretParameters map[string]interface{}
+		if app.HelmApp != nil {
+			// Generate profile.yaml with profile contents
+			profileyaml := "profile.yaml"
+			contents, err := valuesFromSecret(app.ProfileSecretName, d.Namespace, kc)
+			if err != nil {
+				return err
+			}
+			if hasImageCreds {
+				contents = strings.Replace(contents, CredentialString, bundleName, -1)
+			}
 
-		if strings.Contains(contents, ImageRegistryURL) {
-			if imageRegistryURL == nil || imageRegistryURL.Host == "" {
-				return fmt.Errorf("imageRegistry not set but '%s' tag is present", ImageRegistryURL)
+			if strings.Contains(contents, CredentialString) {
+				err := errors.New("token string present without Docker credentials")
+				return err
 			}
 
-			imageRegistryURLBare := fmt.Sprintf("%s%s", imageRegistryURL.Host, imageRegistryURL.Path)
-			log.Debugf("Replacing: %s in App %s with %s", ImageRegistryURL, app.Name, imageRegistryURLBare)
-			contents = strings.Replace(contents, ImageRegistryURL, imageRegistryURLBare, -1)
-		}
+			// Check if Prehook present and if it needs image pull.
+			if strings.Contains(contents, PreHookString) {
+				hasPreHook = true
+			}
 
-		if strings.Contains(contents, OrgName) {
-			log.Debugf("Replacing: %s in App %s with %s", OrgName, app.Name, orgName)
-			contents = strings.Replace(contents, OrgName, orgName, -1)
-		}
+			if strings.Contains(contents, ImageRegistryURL) {
+				if imageRegistryURL == nil || imageRegistryURL.Host == "" {
+					return fmt.Errorf("imageRegistry not set but '%s' tag is present", ImageRegistryURL)
+				}
 
-		if strings.Contains(contents, ProjectName) {
-			log.Debugf("Replacing: %s in App %s with %s", ProjectName, app.Name, projectName)
-			contents = strings.Replace(contents, ProjectName, projectName, -1)
-		}
+				imageRegistryURLBare := fmt.Sprintf("%s%s", imageRegistryURL.Host, imageRegistryURL.Path)
+				log.Debugf("Replacing: %s in App %s with %s", ImageRegistryURL, app.Name, imageRegistryURLBare)
+				contents = strings.Replace(contents, ImageRegistryURL, imageRegistryURLBare, -1)
+			}
 
-		err = utils.WriteFile(fleetPath, profileyaml, []byte(contents))
-		if err != nil {
-			return err
-		}
-		fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, profileyaml)
+			if strings.Contains(contents, OrgName) {
+				log.Debugf("Replacing: %s in App %s with %s", OrgName, app.Name, orgName)
+				contents = strings.Replace(contents, OrgName, orgName, -1)
+			}
 
-		// Generate overrides.yaml with deployment time overriding values
-		overridesyaml := "overrides.yaml"
-		contents, err = valuesFromSecret(app.ValueSecretName, d.Namespace, kc)
-		if err != nil {
-			return err
-		}
-		if hasImageCreds {
-			contents = strings.Replace(contents, CredentialString, bundleName, -1)
-		}
-		err = utils.WriteFile(fleetPath, overridesyaml, []byte(contents))
-		if err != nil {
-			return err
-		}
-		fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, overridesyaml)
-
-		// Generate fleet-globals.yaml. ADM Deployment controller relies on
-		// global.fleet.clusterLabels values being present in the
-		// BundleDeployment. Fleet v0.5.0 added them automatically but v0.6.0
-		// does not. We explicitly add them below for 0.6.0.
-		globalyaml := "fleet-globals.yaml"
-		extraValue := ExtraValues{
-			Global: GlobalValues{
-				Fleet: GlobalValuesFleet{
-					DeploymentGeneration: d.GetGeneration(),
+			if strings.Contains(contents, ProjectName) {
+				log.Debugf("Replacing: %s in App %s with %s", ProjectName, app.Name, projectName)
+				contents = strings.Replace(contents, ProjectName, projectName, -1)
+			}
+
+			err = utils.WriteFile(fleetPath, profileyaml, []byte(contents))
+			if err != nil {
+				return err
+			}
+			fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, profileyaml)
+
+			// Generate overrides.yaml with deployment time overriding values
+			overridesyaml := "overrides.yaml"
+			contents, err = valuesFromSecret(app.ValueSecretName, d.Namespace, kc)
+			if err != nil {
+				return err
+			}
+			if hasImageCreds {
+				contents = strings.Replace(contents, CredentialString, bundleName, -1)
+			}
+			// Secret parameters are delivered sealed with the secret override values
+			keys, err := secretParameterKeys(d, app, kc)
+			if err != nil {
+				return err
+			}
+			contents, secretParameters, err = splitSecretParameters(contents, keys)
+			if err != nil {
+				return err
+			}
+			err = utils.WriteFile(fleetPath, overridesyaml, []byte(contents))
+			if err != nil {
+				return err
+			}
+			fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, overridesyaml)
+
+			// Generate fleet-globals.yaml. ADM Deployment controller relies on
+			// global.fleet.clusterLabels values being present in the
+			// BundleDeployment. Fleet v0.5.0 added them automatically but v0.6.0
+			// does not. We explicitly add them below for 0.6.0.
+			globalyaml := "fleet-globals.yaml"
+			extraValue := ExtraValues{
+				Global: GlobalValues{
+					Fleet: GlobalValuesFleet{
+						DeploymentGeneration: d.GetGeneration(),
+					},
 				},
-			},
-		}
-		if g, ok := os.LookupEnv("FLEET_ADD_GLOBAL_VARS"); ok && g == "true" {
-			extraValue.Global.Fleet.ClusterLabels = map[string]string{
-				string(v1beta1.ClusterName): fmt.Sprintf("global.fleet.clusterLabels.%s",
-					v1beta1.ClusterName),
-				string(v1beta1.FleetProjectID): fmt.Sprintf("global.fleet.clusterLabels.%s",
-					v1beta1.FleetProjectID),
-				string(v1beta1.FleetHostUuid): fmt.Sprintf("global.fleet.%s",
-					v1beta1.FleetHostUuid),
 			}
-		}
-		err = WriteExtraValues(fleetPath, globalyaml, &extraValue)
-		if err != nil {
-			return err
-		}
+			if g, ok := os.LookupEnv("FLEET_ADD_GLOBAL_VARS"); ok && g == "true" {
+				extraValue.Global.Fleet.ClusterLabels = map[string]string{
+					string(v1beta1.ClusterName): fmt.Sprintf("global.fleet.clusterLabels.%s",
+						v1beta1.ClusterName),
+					string(v1beta1.FleetProjectID): fmt.Sprintf("global.fleet.clusterLabels.%s",
+						v1beta1.FleetProjectID),
+					string(v1beta1.FleetHostUuid): fmt.Sprintf("global.fleet.%s",
+						v1beta1.FleetHostUuid),
+				}
+			}
+			err = WriteExtraValues(fleetPath, globalyaml, &extraValue)
+			if err != nil {
+				return err
+			}
 
-		fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, globalyaml)
+			fleetConf.Helm.ValuesFiles = append(fleetConf.Helm.ValuesFiles, globalyaml)
+		}
 
 		ingressName := app.Name + "-" + app.Version + "-" + strings.ReplaceAll(d.Name, "deployment-", "") + "-ingress" // max length: 61
 		egressName := app.Name + "-" + app.Version + "-" + strings.ReplaceAll(d.Name, "deployment-", "") + "-egress"   // max length: 60
@@ -360,9 +469,15 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 			},
 		}
 
+		// Network and namespace policies of blue-green apps are shared by both slots
+		policyPath := fleetPath
+		if blueGreen {
+			policyPath = appPath
+		}
+
 		// Generate fleet.yaml from Config
 		err = WriteNetworkPolicyConfig(
-			filepath.Join(fleetPath, "kustomize"),
+			filepath.Join(policyPath, "kustomize"),
 			ingressPolicy, "network-policy-ingress.yaml")
 		if err != nil {
 			return err
@@ -383,7 +498,7 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 
 		// Generate fleet.yaml from Config
 		err = WriteNetworkPolicyConfig(
-			filepath.Join(fleetPath, "kustomize"),
+			filepath.Join(policyPath, "kustomize"),
 			egressPolicy, "network-policy-egress.yaml")
 		if err != nil {
 			return err
@@ -396,10 +511,21 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 			},
 		}
 
-		k.Resources = []string{
+		// Namespace policy resources are named like the network policies
+		namespacePolicies, err := injectNamespacePolicy(app.NamespacePolicy,
+			strings.TrimSuffix(ingressName, "-ingress"), filepath.Join(policyPath, "kustomize"), &fleetConf)
+		if err != nil {
+			return err
+		}
+
+		policies := []string{
 			"network-policy-ingress.yaml",
 			"network-policy-egress.yaml",
 		}
+		policies = append(policies, namespacePolicies...)
+		if !blueGreen {
+			k.Resources = policies
+		}
 
 		// If declared in dp, create namespace with labels and annotations
 		for _, ns := range d.Spec.DeploymentPackageRef.Namespaces {
@@ -421,7 +547,7 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 			// secret in separate bundle.
 			err := injectImageCredentialSecretToSubDir(
 				imageRegistryURL,
-				app.HelmApp.ImageRegistrySecretName,
+				imageRegistrySecretName,
 				d.Namespace, namespace, kc, bundleName, fleetPath)
 			if err != nil {
 				return err
@@ -436,25 +562,75 @@ func GenerateFleetConfigs(d *v1beta1.Deployment, baseDir string, kc client.Clien
 			// Generate kutomization for image credential
 			err := injectImageCredentialToKustomization(
 				imageRegistryURL,
-				app.HelmApp.ImageRegistrySecretName,
+				imageRegistrySecretName,
 				d.Namespace, namespace, kc, bundleName, k)
 			if err != nil {
 				return err
 			}
 		}
 
+		// Secret override values are delivered in a separate bundle
+		err = injectSecretValuesToSubDir(app, d.Labels[string(v1beta1.AppOrchActiveProjectID)],
+			bundleName, namespace, fleetPath, secretParameters, &fleetConf)
+		if err != nil {
+			return err
+		}
+
+		if app.ManifestApp != nil {
+			err = injectManifests(app, filepath.Join(fleetPath, "kustomize"), k)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = injectKustomizePatches(app, filepath.Join(fleetPath, "kustomize"), k)
+		if err != nil {
+			return err
+		}
+
 		err = WriteKustomization(filepath.Join(fleetPath, "kustomize"), k)
 		if err != nil {
 			return err
 		}
 		fleetConf.Kustomize.Dir = "./kustomize"
 
+		if len(app.HealthProbes) > 0 {
+			// Health probes run in a separate bundle once the app is ready
+			err = injectHealthProbesToSubDir(app, d.GetId(), d.GetGeneration(), bundleName, namespace, fleetPath)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(app.TestHooks) > 0 {
+			// Test hooks run in a separate bundle once the app is ready
+			err = injectTestHooksToSubDir(app, d.GetId(), d.GetGeneration(), bundleName, namespace, fleetPath)
+			if err != nil {
+				return err
+			}
+		}
+
 		// Generate fleet.yaml from Config
 		err = WriteFleetConfig(fleetPath, fleetConf)
 		if err != nil {
 			return err
 		}
 
+		if blueGreen {
+			err = injectBlueGreenSwitch(app, d.GetId(), d.GetGeneration(), BundleName(app, d.GetName()),
+				namespace, appPath, slots.target, policies)
+			if err != nil {
+				return err
+			}
+		}
+
+		if canary {
+			err = injectCanaryCustomizations(appPath, d.Status.Canary.Clusters, stableApps[app.Name])
+			if err != nil {
+				return err
+			}
+		}
+
 	}
 
 	return nil
@@ -464,19 +640,6 @@ func newFleetConfig(appName string, appMap map[string]v1beta1.Application, depID
 	app := appMap[appName]
 	bundlename := BundleName(app, depName)
 
-	repo := app.HelmApp.Repo
-	u, err := url.Parse(repo)
-	if err != nil {
-		return Config{}, err
-	}
-
-	repoURL := app.HelmApp.Repo
-	chartURL := app.HelmApp.Chart
-	if u.Scheme == "oci" {
-		repoURL = ""
-		chartURL, _ = url.JoinPath(app.HelmApp.Repo, app.HelmApp.Chart)
-	}
-
 	deleteCRDResources := utils.DeleteCRDResources()
 
 	// Create Config with the given application information
@@ -491,13 +654,30 @@ func newFleetConfig(appName string, appMap map[string]v1beta1.Application, depID
 			DeploymentID:         depID,
 			DeploymentGeneration: fmt.Sprint(generation),
 		},
-		Helm: HelmApp{
+	}
+
+	// Manifest apps are only rendered by the kustomization, without Helm section
+	if app.HelmApp != nil {
+		repo := app.HelmApp.Repo
+		u, err := url.Parse(repo)
+		if err != nil {
+			return Config{}, err
+		}
+
+		repoURL := app.HelmApp.Repo
+		chartURL := app.HelmApp.Chart
+		if u.Scheme == "oci" {
+			repoURL = ""
+			chartURL, _ = url.JoinPath(app.HelmApp.Repo, app.HelmApp.Chart)
+		}
+
+		fleetConf.Helm = HelmApp{
 			ReleaseName: bundlename,
 			Repo:        repoURL,
 			Chart:       chartURL,
 			Version:     app.HelmApp.Version,
 			ValuesFiles: []string{},
-		},
+		}
 	}
 
 	// Set depends on bundle names, all dependencies must exist in the same deployment package
@@ -159,6 +159,24 @@ var _ = Describe("Fleet config generator", func() {
 					"values": []byte(`{"imagePullPolicy": "%GeneratedDockerCredential%"}`),
 				},
 			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "valuessecretwithparameters",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"values": []byte(`{"over-key1":"over-value1","db":{"user":"admin","password":"s3cr3t"}}`),
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "parametersecret",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"values": []byte(`{"db.password":"s3cr3t"}`),
+				},
+			},
 			{
 				ObjectMeta: metav1.ObjectMeta{
 					Name:      "imageregcreds",
@@ -457,6 +475,93 @@ var _ = Describe("Fleet config generator", func() {
 			})
 		})
 
+		Context("ParameterSecretName is provided", func() {
+			It("should deliver the secret parameters sealed instead of in overrides.yaml", func() {
+				key := setSealingCert()
+				app := &deployment.Spec.Applications[0]
+				app.ValueSecretName = "valuessecretwithparameters"
+				app.ParameterSecretName = "parametersecret"
+
+				basedir := "/tmp/fleet0"
+				Expect(os.RemoveAll(basedir)).To(Succeed())
+				Expect(GenerateFleetConfigs(deployment, basedir, k8sClient, nexusClient.RuntimeprojectEdgeV1())).To(Succeed())
+
+				// Validate overrides.yaml holds no secret value
+				fleetdir := filepath.Join(basedir, app.Name)
+				contents, err := os.ReadFile(filepath.Join(fleetdir, "overrides.yaml"))
+				Expect(err).To(BeNil())
+				Expect(string(contents)).To(Equal("db:\n  user: admin\nover-key1: over-value1\n"))
+
+				// Validate the secret parameters are read from the sealed values
+				fleetConf := &Config{}
+				contents, err = os.ReadFile(filepath.Join(fleetdir, "fleet.yaml"))
+				Expect(err).To(BeNil())
+				Expect(yamlv3.Unmarshal(contents, fleetConf)).To(Succeed())
+				bundleName := BundleName(*app, deployment.GetName())
+				Expect(fleetConf.Helm.ValuesFrom).To(Equal([]ValuesFrom{{SecretKeyRef: &SecretKeyRef{
+					Name:      bundleName + "-secret-values",
+					Namespace: app.Namespace,
+					Key:       SecretValuesKey,
+				}}}))
+				Expect(fleetConf.DependsOn).To(ContainElement(DependsOnItem{Name: SecretValuesBundleName(bundleName)}))
+				Expect(unsealValues(key, filepath.Join(fleetdir, SecretValuesDir))).To(Equal("db:\n  password: s3cr3t\n"))
+
+				// No file of the repository holds the secret value
+				Expect(filepath.WalkDir(basedir, func(p string, entry os.DirEntry, err error) error {
+					if err != nil || entry.IsDir() {
+						return err
+					}
+					data, err := os.ReadFile(p)
+					Expect(err).To(BeNil())
+					Expect(string(data)).ToNot(ContainSubstring("s3cr3t"), p)
+					return nil
+				})).To(Succeed())
+			})
+
+			It("should find the secret parameters of the deployments created without ParameterSecretName", func() {
+				key := setSealingCert()
+				deployment.Spec.DeploymentPackageRef.ProfileName = "default"
+				app := &deployment.Spec.Applications[0]
+				app.ValueSecretName = "valuessecretwithparameters"
+
+				secret := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "wordpress-deployment-wordpress-default-secret",
+						Namespace: "default",
+					},
+					Data: map[string][]byte{
+						"values": []byte(`{"db.password":"s3cr3t"}`),
+					},
+				}
+				Expect(k8sClient.Create(ctx, secret)).To(Succeed())
+				DeferCleanup(func() {
+					Expect(k8sClient.Delete(ctx, secret)).To(Succeed())
+				})
+
+				basedir := "/tmp/fleet0"
+				Expect(os.RemoveAll(basedir)).To(Succeed())
+				Expect(GenerateFleetConfigs(deployment, basedir, k8sClient, nexusClient.RuntimeprojectEdgeV1())).To(Succeed())
+
+				fleetdir := filepath.Join(basedir, app.Name)
+				contents, err := os.ReadFile(filepath.Join(fleetdir, "overrides.yaml"))
+				Expect(err).To(BeNil())
+				Expect(string(contents)).To(Equal("db:\n  user: admin\nover-key1: over-value1\n"))
+				Expect(unsealValues(key, filepath.Join(fleetdir, SecretValuesDir))).To(Equal("db:\n  password: s3cr3t\n"))
+			})
+
+			It("should return error without sealing certificate", func() {
+				GinkgoT().Setenv("SEALED_SECRET_TLS_CRT", "")
+				app := &deployment.Spec.Applications[0]
+				app.ValueSecretName = "valuessecretwithparameters"
+				app.ParameterSecretName = "parametersecret"
+
+				basedir := "/tmp/fleet0"
+				Expect(os.RemoveAll(basedir)).To(Succeed())
+				Expect(GenerateFleetConfigs(deployment, basedir, k8sClient, nexusClient.RuntimeprojectEdgeV1())).To(
+					MatchError("sealed secrets certificate is not set, cannot deliver secret values"))
+			})
+		})
+
 		Context("PreHook image credential is provided", func() {
 			It("should create new fleet.yaml with image registry creds", func() {
 				app := &deployment.Spec.Applications[0]
@@ -0,0 +1,73 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+// getRequiredDeployments returns the deployments required by the given
+// deployment, sorted by name. An error is returned if any of them does not
+// exist yet.
+func getRequiredDeployments(d *v1beta1.Deployment, kc client.Client) ([]v1beta1.Deployment, error) {
+	names := make([]string, 0, len(d.Spec.ChildDeploymentList))
+	for name := range d.Spec.ChildDeploymentList {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	required := make([]v1beta1.Deployment, 0, len(names))
+	for _, name := range names {
+		rd := v1beta1.Deployment{}
+		if err := kc.Get(context.Background(), types.NamespacedName{Namespace: d.Namespace, Name: name}, &rd); err != nil {
+			return nil, fmt.Errorf("failed to get required deployment %s: %w", name, err)
+		}
+		required = append(required, rd)
+	}
+
+	return required, nil
+}
+
+// requiredDeploymentsDependsOn returns the bundles of the required deployments
+// that must be ready on a cluster before the app bundles of the dependent
+// deployment are rolled out on it. Fleet evaluates bundle dependencies per
+// cluster, so the dependent apps wait until the required apps run on the same
+// cluster, and until their health probes passed if any.
+func requiredDeploymentsDependsOn(required []v1beta1.Deployment) []DependsOnItem {
+	items := []DependsOnItem{}
+	for _, rd := range required {
+		items = append(items, DependsOnItem{
+			Selector: Selector{
+				MatchLabels: DeployLabels{
+					DeploymentID: rd.GetId(),
+					BundleType:   BundleTypeApp.String(),
+				},
+			},
+		})
+
+		for _, app := range rd.Spec.Applications {
+			if len(app.HealthProbes) > 0 {
+				items = append(items, DependsOnItem{
+					Selector: Selector{
+						MatchLabels: DeployLabels{
+							DeploymentID: rd.GetId(),
+							BundleType:   BundleTypeHealth.String(),
+						},
+					},
+				})
+				break
+			}
+		}
+	}
+
+	return items
+}
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Required deployments", func() {
+	var required []v1beta1.Deployment
+
+	BeforeEach(func() {
+		required = []v1beta1.Deployment{
+			{
+				Spec: v1beta1.DeploymentSpec{
+					Applications: []v1beta1.Application{{Name: "platform"}},
+				},
+			},
+			{
+				Spec: v1beta1.DeploymentSpec{
+					Applications: []v1beta1.Application{
+						{Name: "database"},
+						{
+							Name:         "cache",
+							HealthProbes: []v1beta1.HealthProbe{{Name: "ping"}},
+						},
+					},
+				},
+			},
+		}
+		required[0].UID = types.UID("a563356a-b4df-47bb-b620-aae2d74c5129")
+		required[1].UID = types.UID("0a07df38-9df6-4d91-8275-d907c27915b8")
+	})
+
+	It("should depend on the app bundles of the required deployments", func() {
+		items := requiredDeploymentsDependsOn(required[:1])
+		Expect(items).To(Equal([]DependsOnItem{
+			{
+				Selector: Selector{
+					MatchLabels: DeployLabels{
+						DeploymentID: "a563356a-b4df-47bb-b620-aae2d74c5129",
+						BundleType:   BundleTypeAppString,
+					},
+				},
+			},
+		}))
+	})
+
+	It("should depend on the health bundles of required deployments with health probes", func() {
+		items := requiredDeploymentsDependsOn(required)
+		Expect(items).To(HaveLen(3))
+		Expect(items[1].Selector.MatchLabels.DeploymentID).To(Equal("0a07df38-9df6-4d91-8275-d907c27915b8"))
+		Expect(items[1].Selector.MatchLabels.BundleType).To(Equal(BundleTypeAppString))
+		Expect(items[2].Selector.MatchLabels.DeploymentID).To(Equal("0a07df38-9df6-4d91-8275-d907c27915b8"))
+		Expect(items[2].Selector.MatchLabels.BundleType).To(Equal(BundleTypeHealthString))
+	})
+
+	It("should not depend on anything without required deployments", func() {
+		Expect(requiredDeploymentsDependsOn(nil)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	HealthProbeDir = "health-probes"
+
+	// HealthProbeLabel is set on the health probe Jobs with the probe name
+	HealthProbeLabel = "app.edge-orchestrator.intel.com/health-probe"
+
+	defaultProbeTimeoutSeconds = 300
+	defaultProbeRetries        = 3
+	defaultProbeExpectedStatus = 200
+
+	// minProbeGenerationHashLength is the shortest generation hash in a probe
+	// Job name, below it the whole name is truncated
+	minProbeGenerationHashLength = 4
+
+	// httpProbeScript checks the status code returned by PROBE_URL
+	httpProbeScript = `code=$(curl -sk -o /dev/null -w '%{http_code}' --max-time 10 "$PROBE_URL")
+echo "GET $PROBE_URL returned $code, expected $PROBE_EXPECTED_STATUS"
+[ "$code" = "$PROBE_EXPECTED_STATUS" ]`
+
+	// prometheusProbeScript runs an instant query and compares its single
+	// value with PROBE_THRESHOLD
+	prometheusProbeScript = `resp=$(curl -sfk --max-time 10 -G "$PROBE_URL/api/v1/query" --data-urlencode "query=$PROBE_QUERY") || { echo "query failed"; exit 1; }
+value=$(echo "$resp" | sed -n 's/.*"value":\[[^,]*,"\([^"]*\)"\].*/\1/p')
+[ -n "$value" ] || { echo "query returned no value"; exit 1; }
+echo "$PROBE_QUERY returned $value, expected $PROBE_OPERATOR $PROBE_THRESHOLD"
+awk -v v="$value" -v t="$PROBE_THRESHOLD" -v op="$PROBE_OPERATOR" 'BEGIN {
+  v += 0; t += 0
+  if (op == ">") r = v > t; else if (op == ">=") r = v >= t
+  else if (op == "<") r = v < t; else if (op == "<=") r = v <= t
+  else if (op == "==") r = v == t; else if (op == "!=") r = v != t
+  exit !r }'`
+)
+
+// HealthBundleName returns the name of the bundle running the health probes of an app bundle
+func HealthBundleName(bundleName string) string {
+	return "health-" + bundleName
+}
+
+// HealthProbeJobName returns the name of the Job running a health probe. The
+// deployment generation is part of the name so that the probes run again
+// after every update. The name is also the value of the job-name label, so
+// when it would exceed 63 characters the generation is replaced with a short
+// hash of it. If even that does not fit, the name is truncated and the probe
+// name cannot be read back from it.
+func HealthProbeJobName(bundleName string, probeName string, generation int64) string {
+	name := fmt.Sprintf("%s-%d-%s", bundleName, generation, probeName)
+	if len(name) <= validation.DNS1123LabelMaxLength {
+		return name
+	}
+
+	hashLength := validation.DNS1123LabelMaxLength - len(bundleName) - len(probeName) - 2
+	if hashLength >= minProbeGenerationHashLength {
+		return fmt.Sprintf("%s-%s-%s", bundleName, shortHash(fmt.Sprint(generation), hashLength), probeName)
+	}
+
+	hash := shortHash(name, 8)
+	return strings.TrimRight(name[:validation.DNS1123LabelMaxLength-len(hash)-1], "-") + "-" + hash
+}
+
+// HealthProbeNameFromJob returns the probe name of a health probe Job of the
+// given app bundle, or an empty string if the Job is not a health probe.
+func HealthProbeNameFromJob(bundleName string, jobName string) string {
+	rest, ok := strings.CutPrefix(jobName, bundleName+"-")
+	if !ok {
+		return ""
+	}
+	gen, probeName, ok := strings.Cut(rest, "-")
+	if !ok || gen == "" {
+		return ""
+	}
+	// The generation is either in decimal or hashed in hexadecimal
+	if strings.Trim(gen, "0123456789abcdef") != "" {
+		return ""
+	}
+	return probeName
+}
+
+// shortHash returns the first length hexadecimal characters of the SHA-256 of s
+func shortHash(s string, length int) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:min(length, 2*len(sum))]
+}
+
+// injectHealthProbesToSubDir generates a Job per health probe in a separate
+// bundle which depends on the app bundle, so that the probes only run once
+// the app is rolled out and ready.
+func injectHealthProbesToSubDir(app v1beta1.Application, depID string, generation int64,
+	bundleName string, namespace string, fleetPath string) error {
+	subFleetConf := Config{
+		Name:             HealthBundleName(bundleName),
+		DefaultNamespace: namespace,
+		Labels: DeployLabels{
+			AppName:              app.Name,
+			BundleType:           BundleTypeHealth.String(),
+			DeploymentID:         depID,
+			DeploymentGeneration: fmt.Sprint(generation),
+		},
+		DependsOn: []DependsOnItem{
+			{
+				Name: bundleName,
+			},
+		},
+		Diff: &DiffOptions{},
+	}
+
+	probeDir := filepath.Join(fleetPath, HealthProbeDir)
+	for _, probe := range app.HealthProbes {
+		job, err := newHealthProbeJob(probe, bundleName, namespace, generation)
+		if err != nil {
+			return err
+		}
+
+		data, err := k8syaml.Marshal(job)
+		if err != nil {
+			return err
+		}
+		err = utils.WriteFile(probeDir, fmt.Sprintf("probe-%s.yaml", probe.Name), data)
+		if err != nil {
+			return err
+		}
+
+		// Job selector and pod template labels are generated by Kubernetes
+		subFleetConf.Diff.ComparePatches = append(subFleetConf.Diff.ComparePatches, ComparePatch{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+			Namespace:  job.Namespace,
+			Name:       job.Name,
+			JSONPointers: []string{
+				"/spec/selector",
+				"/spec/template/metadata/labels",
+			},
+		})
+	}
+
+	// Generate fleet.yaml from Config
+	return WriteFleetConfig(probeDir, subFleetConf)
+}
+
+func newHealthProbeJob(probe v1beta1.HealthProbe, bundleName string, namespace string, generation int64) (*batchv1.Job, error) {
+	timeout := int64(probe.TimeoutSeconds)
+	if timeout <= 0 {
+		timeout = defaultProbeTimeoutSeconds
+	}
+	retries := probe.Retries
+	if retries <= 0 {
+		retries = defaultProbeRetries
+	}
+
+	container := corev1.Container{
+		Name: "probe",
+	}
+
+	switch probe.Type {
+	case v1beta1.HealthProbeHTTP:
+		if probe.HTTP == nil {
+			return nil, fmt.Errorf("health probe %s of type %s has no http specification", probe.Name, probe.Type)
+		}
+		ns := probe.HTTP.Namespace
+		if ns == "" {
+			ns = namespace
+		}
+		scheme := probe.HTTP.Scheme
+		if scheme == "" {
+			scheme = "http"
+		}
+		path := "/" + strings.TrimPrefix(probe.HTTP.Path, "/")
+		expected := probe.HTTP.ExpectedStatus
+		if expected == 0 {
+			expected = defaultProbeExpectedStatus
+		}
+		container.Image = utils.GetHealthProbeImage()
+		container.Command = []string{"sh", "-c", httpProbeScript}
+		container.Env = []corev1.EnvVar{
+			{Name: "PROBE_URL", Value: fmt.Sprintf("%s://%s.%s.svc:%d%s", scheme, probe.HTTP.Service, ns, probe.HTTP.Port, path)},
+			{Name: "PROBE_EXPECTED_STATUS", Value: fmt.Sprint(expected)},
+		}
+	case v1beta1.HealthProbeJob:
+		if probe.Job == nil {
+			return nil, fmt.Errorf("health probe %s of type %s has no job specification", probe.Name, probe.Type)
+		}
+		container.Image = probe.Job.Image
+		container.Command = probe.Job.Command
+		container.Args = probe.Job.Args
+	case v1beta1.HealthProbePrometheus:
+		if probe.Prometheus == nil {
+			return nil, fmt.Errorf("health probe %s of type %s has no prometheus specification", probe.Name, probe.Type)
+		}
+		container.Image = utils.GetHealthProbeImage()
+		container.Command = []string{"sh", "-c", prometheusProbeScript}
+		container.Env = []corev1.EnvVar{
+			{Name: "PROBE_URL", Value: strings.TrimSuffix(probe.Prometheus.URL, "/")},
+			{Name: "PROBE_QUERY", Value: probe.Prometheus.Query},
+			{Name: "PROBE_OPERATOR", Value: probe.Prometheus.Operator},
+			{Name: "PROBE_THRESHOLD", Value: probe.Prometheus.Threshold},
+		}
+	default:
+		return nil, fmt.Errorf("health probe %s has unsupported type %s", probe.Name, probe.Type)
+	}
+
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      HealthProbeJobName(bundleName, probe.Name, generation),
+			Namespace: namespace,
+			Labels: map[string]string{
+				HealthProbeLabel: probe.Name,
+			},
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          &retries,
+			ActiveDeadlineSeconds: &timeout,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{
+						HealthProbeLabel: probe.Name,
+					},
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+				},
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	yamlv3 "gopkg.in/yaml.v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Health probes", func() {
+	const (
+		bundleName = "b-0123456789abcdef"
+		depID      = "a563356a-b4df-47bb-b620-aae2d74c5129"
+		namespace  = "apps"
+	)
+
+	var (
+		app      v1beta1.Application
+		basePath string
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name: "wordpress",
+			HealthProbes: []v1beta1.HealthProbe{
+				{
+					Name: "homepage",
+					Type: v1beta1.HealthProbeHTTP,
+					HTTP: &v1beta1.HTTPProbe{
+						Service: "wordpress",
+						Port:    8080,
+						Path:    "wp-login.php",
+					},
+				},
+				{
+					Name: "smoke",
+					Type: v1beta1.HealthProbeJob,
+					Job: &v1beta1.JobProbe{
+						Image:   "busybox:1.36",
+						Command: []string{"true"},
+					},
+					TimeoutSeconds: 60,
+					Retries:        1,
+				},
+				{
+					Name: "error-rate",
+					Type: v1beta1.HealthProbePrometheus,
+					Prometheus: &v1beta1.PrometheusProbe{
+						URL:       "http://prometheus.monitoring:9090/",
+						Query:     "sum(rate(errors_total[5m]))",
+						Operator:  "<",
+						Threshold: "0.5",
+					},
+				},
+			},
+		}
+		basePath = GinkgoT().TempDir()
+	})
+
+	readJob := func(probeName string) *batchv1.Job {
+		data, err := os.ReadFile(filepath.Join(basePath, HealthProbeDir, "probe-"+probeName+".yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		job := &batchv1.Job{}
+		Expect(k8syaml.Unmarshal(data, job)).To(Succeed())
+		return job
+	}
+
+	It("generates a bundle depending on the app bundle with a Job per probe", func() {
+		Expect(injectHealthProbesToSubDir(app, depID, 3, bundleName, namespace, basePath)).To(Succeed())
+
+		data, err := os.ReadFile(filepath.Join(basePath, HealthProbeDir, "fleet.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		conf := &Config{}
+		Expect(yamlv3.Unmarshal(data, conf)).To(Succeed())
+		Expect(conf.Name).To(Equal("health-" + bundleName))
+		Expect(conf.DefaultNamespace).To(Equal(namespace))
+		Expect(conf.Labels.BundleType).To(Equal(BundleTypeHealthString))
+		Expect(conf.Labels.DeploymentGeneration).To(Equal("3"))
+		Expect(conf.DependsOn).To(Equal([]DependsOnItem{{Name: bundleName}}))
+		Expect(conf.Diff.ComparePatches).To(HaveLen(3))
+
+		job := readJob("homepage")
+		Expect(job.Name).To(Equal(bundleName + "-3-homepage"))
+		Expect(job.Namespace).To(Equal(namespace))
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(defaultProbeRetries)))
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(int64(defaultProbeTimeoutSeconds)))
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "PROBE_URL", Value: "http://wordpress.apps.svc:8080/wp-login.php"},
+			corev1.EnvVar{Name: "PROBE_EXPECTED_STATUS", Value: "200"},
+		))
+
+		job = readJob("smoke")
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(1)))
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(int64(60)))
+		Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:1.36"))
+		Expect(job.Spec.Template.Spec.Containers[0].Command).To(Equal([]string{"true"}))
+
+		job = readJob("error-rate")
+		Expect(job.Spec.Template.Spec.Containers[0].Env).To(ContainElements(
+			corev1.EnvVar{Name: "PROBE_URL", Value: "http://prometheus.monitoring:9090"},
+			corev1.EnvVar{Name: "PROBE_OPERATOR", Value: "<"},
+			corev1.EnvVar{Name: "PROBE_THRESHOLD", Value: "0.5"},
+		))
+	})
+
+	It("fails for a probe without its specification", func() {
+		app.HealthProbes[0].HTTP = nil
+		Expect(injectHealthProbesToSubDir(app, depID, 1, bundleName, namespace, basePath)).ToNot(Succeed())
+	})
+
+	It("maps probe Job names back to probe names", func() {
+		Expect(HealthProbeNameFromJob(bundleName, HealthProbeJobName(bundleName, "error-rate", 12))).To(Equal("error-rate"))
+		Expect(HealthProbeNameFromJob(bundleName, "b-other-12-error-rate")).To(BeEmpty())
+		Expect(HealthProbeNameFromJob(bundleName, bundleName+"-x-error-rate")).To(BeEmpty())
+	})
+
+	It("keeps the probe Job names within the label length limit", func() {
+		// Longest probe name accepted by the API
+		probeName := "probe-name-of-thirty-chars-abc"
+
+		for _, name := range []string{
+			CanaryBundleName(bundleName),
+			SlotBundleName(bundleName, v1beta1.SlotGreen),
+		} {
+			jobName := HealthProbeJobName(name, probeName, math.MaxInt64)
+			Expect(validation.IsDNS1123Label(jobName)).To(BeEmpty(), jobName)
+			Expect(HealthProbeNameFromJob(name, jobName)).To(Equal(probeName))
+			Expect(HealthProbeJobName(name, probeName, math.MaxInt64-1)).ToNot(Equal(jobName))
+		}
+
+		Expect(HealthProbeJobName(bundleName, "homepage", 12)).To(Equal(bundleName + "-12-homepage"))
+
+		longName := HealthProbeJobName("bw-8c6c40b5-3c4e-4a5f-9d39-3f0b5c0d8a11-canary", probeName, 12)
+		Expect(validation.IsDNS1123Label(longName)).To(BeEmpty(), longName)
+	})
+})
@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	kustomize "sigs.k8s.io/kustomize/api/types"
+	"sigs.k8s.io/kustomize/kyaml/resid"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	// KustomizePatchDir holds the patches of an app, relative to its kustomize directory
+	KustomizePatchDir = "patches"
+)
+
+// injectKustomizePatches writes the patches of the app to the kustomize
+// directory and references them from the kustomization. Fleet applies the
+// kustomization to the resources rendered from the Helm chart, so that the
+// patches are applied after the Helm render.
+func injectKustomizePatches(app v1beta1.Application, kustomizePath string, k *kustomize.Kustomization) error {
+	// Remove the patches of the previous generation
+	patchPath := filepath.Join(kustomizePath, KustomizePatchDir)
+	if err := os.RemoveAll(patchPath); err != nil {
+		return err
+	}
+
+	for i, patch := range app.KustomizePatches {
+		name := fmt.Sprintf("patch-%d.yaml", i)
+		if err := utils.WriteFile(patchPath, name, []byte(patch.Patch)); err != nil {
+			return err
+		}
+
+		p := kustomize.Patch{
+			Path: filepath.Join(KustomizePatchDir, name),
+		}
+		if t := patch.Target; t != nil {
+			p.Target = &kustomize.Selector{
+				ResId: resid.ResId{
+					Gvk: resid.Gvk{
+						Group:   t.Group,
+						Version: t.Version,
+						Kind:    t.Kind,
+					},
+					Name:      t.Name,
+					Namespace: t.Namespace,
+				},
+				LabelSelector: t.LabelSelector,
+			}
+		}
+		k.Patches = append(k.Patches, p)
+	}
+
+	return nil
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	kustomize "sigs.k8s.io/kustomize/api/types"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Kustomize patches", func() {
+	const (
+		tolerationPatch = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: wordpress
+spec:
+  template:
+    spec:
+      tolerations:
+      - key: edge
+        operator: Exists
+`
+		labelPatch = `- op: add
+  path: /metadata/labels/tier
+  value: frontend
+`
+	)
+
+	var (
+		app           v1beta1.Application
+		k             *kustomize.Kustomization
+		kustomizePath string
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name: "wordpress",
+			KustomizePatches: []v1beta1.KustomizePatch{
+				{
+					Type:  v1beta1.PatchStrategicMerge,
+					Patch: tolerationPatch,
+				},
+				{
+					Type:  v1beta1.PatchJSON6902,
+					Patch: labelPatch,
+					Target: &v1beta1.PatchTarget{
+						Group:         "apps",
+						Version:       "v1",
+						Kind:          "Deployment",
+						LabelSelector: "app=wordpress",
+					},
+				},
+			},
+		}
+		k = &kustomize.Kustomization{
+			Resources: []string{"network-policy-ingress.yaml"},
+		}
+		kustomizePath = filepath.Join(GinkgoT().TempDir(), "kustomize")
+	})
+
+	It("writes the patches and references them from the kustomization", func() {
+		Expect(injectKustomizePatches(app, kustomizePath, k)).To(Succeed())
+
+		Expect(k.Resources).To(Equal([]string{"network-policy-ingress.yaml"}))
+		Expect(k.Patches).To(HaveLen(2))
+		Expect(k.Patches[0].Path).To(Equal(filepath.Join(KustomizePatchDir, "patch-0.yaml")))
+		Expect(k.Patches[0].Target).To(BeNil())
+		Expect(k.Patches[1].Path).To(Equal(filepath.Join(KustomizePatchDir, "patch-1.yaml")))
+		Expect(k.Patches[1].Target).ToNot(BeNil())
+		Expect(k.Patches[1].Target.Group).To(Equal("apps"))
+		Expect(k.Patches[1].Target.Version).To(Equal("v1"))
+		Expect(k.Patches[1].Target.Kind).To(Equal("Deployment"))
+		Expect(k.Patches[1].Target.LabelSelector).To(Equal("app=wordpress"))
+
+		data, err := os.ReadFile(filepath.Join(kustomizePath, k.Patches[0].Path))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(tolerationPatch))
+		data, err = os.ReadFile(filepath.Join(kustomizePath, k.Patches[1].Path))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(labelPatch))
+	})
+
+	It("removes the patches of the previous generation", func() {
+		Expect(injectKustomizePatches(app, kustomizePath, k)).To(Succeed())
+
+		app.KustomizePatches = nil
+		k = &kustomize.Kustomization{}
+		Expect(injectKustomizePatches(app, kustomizePath, k)).To(Succeed())
+
+		Expect(k.Patches).To(BeEmpty())
+		Expect(filepath.Join(kustomizePath, KustomizePatchDir)).ToNot(BeADirectory())
+	})
+})
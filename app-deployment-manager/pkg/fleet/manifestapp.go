@@ -0,0 +1,589 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/osfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8syaml "k8s.io/apimachinery/pkg/util/yaml"
+	kustomize "sigs.k8s.io/kustomize/api/types"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	// ManifestDir holds the manifests of a manifest app, relative to its kustomize directory
+	ManifestDir = "manifests"
+
+	// ManifestFile holds the manifests of a manifest app read from a deployment package artifact
+	ManifestFile = "manifests.yaml"
+
+	// Size limits of the manifests fetched from a Git repository or an OCI registry
+	maxManifestFileSize  = 4 << 20
+	maxManifestTotalSize = 64 << 20
+
+	ociTitleAnnotation = "org.opencontainers.image.title"
+)
+
+var (
+	kustomizationFiles = []string{"kustomization.yaml", "kustomization.yml", "Kustomization"}
+
+	ociManifestMediaTypes = []string{
+		"application/vnd.oci.image.manifest.v1+json",
+		"application/vnd.docker.distribution.manifest.v2+json",
+	}
+
+	ociClient = &http.Client{Timeout: 2 * time.Minute}
+
+	// Limits of the clone of a Git source, the size counts the Git objects
+	// and the checked out files
+	gitCloneTimeout       = 2 * time.Minute
+	maxGitCloneSize int64 = 256 << 20
+
+	errManifestSizeLimit = errors.New("manifests exceed the size limit")
+)
+
+// fetchGitManifests copies the manifests of a Git source to the given directory
+var fetchGitManifests = func(src *v1beta1.GitManifestSource, dest string) error {
+	tmp, err := os.MkdirTemp("", "manifests-git-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	ctx, cancel := context.WithTimeout(context.Background(), gitCloneTimeout)
+	defer cancel()
+
+	r, err := cloneGitSource(ctx, src, tmp)
+	if err != nil {
+		return fmt.Errorf("cannot clone %s: %w", src.Repo, err)
+	}
+
+	if src.Revision != "" {
+		// Branches only exist as remote branches in the clone
+		hash, err := r.ResolveRevision(plumbing.Revision(src.Revision))
+		if err != nil {
+			hash, err = r.ResolveRevision(plumbing.Revision("origin/" + src.Revision))
+		}
+		if err != nil {
+			return fmt.Errorf("cannot resolve revision %s of %s: %w", src.Revision, src.Repo, err)
+		}
+
+		w, err := r.Worktree()
+		if err != nil {
+			return err
+		}
+		if err := w.Checkout(&git.CheckoutOptions{Hash: *hash}); err != nil {
+			return fmt.Errorf("cannot check out revision %s of %s: %w", src.Revision, src.Repo, err)
+		}
+	}
+
+	return copyManifests(tmp, src.Path, dest)
+}
+
+// cloneGitSource clones the revision of a Git source to the given directory
+// within the clone size limit. Branches and tags are cloned without history,
+// other revisions need the history of all branches.
+func cloneGitSource(ctx context.Context, src *v1beta1.GitManifestSource, dir string) (*git.Repository, error) {
+	refs := []plumbing.ReferenceName{""}
+	if src.Revision != "" {
+		refs = []plumbing.ReferenceName{
+			plumbing.NewBranchReferenceName(src.Revision),
+			plumbing.NewTagReferenceName(src.Revision),
+			"",
+		}
+	}
+
+	var err error
+	for _, ref := range refs {
+		opts := &git.CloneOptions{
+			URL:           src.Repo,
+			ReferenceName: ref,
+			SingleBranch:  true,
+			Depth:         1,
+			Tags:          git.NoTags,
+		}
+		if src.Revision != "" && ref == "" {
+			opts.SingleBranch = false
+			opts.Depth = 0
+		}
+
+		var r *git.Repository
+		if r, err = cloneGit(ctx, opts, dir); err == nil {
+			return r, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if errors.Is(err, errManifestSizeLimit) {
+			return nil, err
+		}
+	}
+	return nil, err
+}
+
+// cloneGit clones a repository to an emptied directory within the clone size limit
+func cloneGit(ctx context.Context, opts *git.CloneOptions, dir string) (*git.Repository, error) {
+	if err := cleanDir(dir); err != nil {
+		return nil, err
+	}
+
+	fs := &limitedFS{Filesystem: osfs.New(dir), size: &atomic.Int64{}, limit: maxGitCloneSize}
+	dot, err := fs.Chroot(git.GitDirName)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := git.CloneContext(ctx, filesystem.NewStorage(dot, cache.NewObjectLRUDefault()), fs, opts)
+	if err != nil && fs.size.Load() > fs.limit {
+		return nil, errManifestSizeLimit
+	}
+	return r, err
+}
+
+// cleanDir removes the content of a directory
+func cleanDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if err := os.RemoveAll(filepath.Join(dir, entry.Name())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// fetchOCIManifests copies the manifests of an OCI source to the given
+// directory. Tar layers are extracted, other layers are written to the file
+// named by their title annotation.
+var fetchOCIManifests = func(src *v1beta1.OCIManifestSource, dest string) error {
+	host, repo, ref, err := parseOCIReference(src.URL)
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.MkdirTemp("", "manifests-oci-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmp)
+
+	reg := &ociRegistry{host: host, repo: repo}
+	data, err := reg.get("manifests/"+ref, ociManifestMediaTypes...)
+	if err != nil {
+		return fmt.Errorf("cannot get manifest of %s: %w", src.URL, err)
+	}
+
+	var manifest struct {
+		Layers []struct {
+			MediaType   string            `json:"mediaType"`
+			Digest      string            `json:"digest"`
+			Annotations map[string]string `json:"annotations"`
+		} `json:"layers"`
+	}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return fmt.Errorf("invalid manifest of %s: %w", src.URL, err)
+	}
+	if len(manifest.Layers) == 0 {
+		return fmt.Errorf("no layer in %s", src.URL)
+	}
+
+	for _, layer := range manifest.Layers {
+		blob, err := reg.get("blobs/" + layer.Digest)
+		if err != nil {
+			return fmt.Errorf("cannot get layer %s of %s: %w", layer.Digest, src.URL, err)
+		}
+
+		switch {
+		case strings.Contains(layer.MediaType, "tar"):
+			if err := extractTar(blob, strings.Contains(layer.MediaType, "gzip"), tmp); err != nil {
+				return fmt.Errorf("cannot extract layer %s of %s: %w", layer.Digest, src.URL, err)
+			}
+		case layer.Annotations[ociTitleAnnotation] != "":
+			name := filepath.Join(tmp, filepath.Clean("/"+layer.Annotations[ociTitleAnnotation]))
+			if err := utils.WriteFile(filepath.Dir(name), filepath.Base(name), blob); err != nil {
+				return err
+			}
+		}
+	}
+
+	return copyManifests(tmp, src.Path, dest)
+}
+
+// ParseManifests returns the Kubernetes objects of a multi-document YAML stream
+func ParseManifests(manifest string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	decoder := k8syaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+	for {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("invalid manifest: %w", err)
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+			return nil, fmt.Errorf("invalid manifest: object %d has no apiVersion or kind", len(objs))
+		}
+		objs = append(objs, obj)
+	}
+	if len(objs) == 0 {
+		return nil, fmt.Errorf("invalid manifest: no object")
+	}
+	return objs, nil
+}
+
+// injectManifests writes the manifests of a manifest app to the kustomize
+// directory and adds them to the resources of the kustomization. A source
+// without kustomization gets one listing all of its YAML files.
+func injectManifests(app v1beta1.Application, kustomizePath string, k *kustomize.Kustomization) error {
+	// Remove the manifests of the previous generation
+	manifestPath := filepath.Join(kustomizePath, ManifestDir)
+	if err := os.RemoveAll(manifestPath); err != nil {
+		return err
+	}
+
+	m := app.ManifestApp
+	var err error
+	switch {
+	case m.Git != nil:
+		err = fetchGitManifests(m.Git, manifestPath)
+	case m.OCI != nil:
+		err = fetchOCIManifests(m.OCI, manifestPath)
+	default:
+		err = utils.WriteFile(manifestPath, ManifestFile, []byte(m.Manifest))
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := writeManifestKustomization(manifestPath); err != nil {
+		return err
+	}
+	k.Resources = append(k.Resources, ManifestDir)
+	return nil
+}
+
+// writeManifestKustomization generates a kustomization listing the YAML files
+// of the directory unless it already has one.
+func writeManifestKustomization(path string) error {
+	for _, name := range kustomizationFiles {
+		if _, err := os.Stat(filepath.Join(path, name)); err == nil {
+			return nil
+		}
+	}
+
+	k := &kustomize.Kustomization{
+		TypeMeta: kustomize.TypeMeta{
+			Kind:       kustomize.KustomizationKind,
+			APIVersion: kustomize.KustomizationVersion,
+		},
+	}
+	err := filepath.WalkDir(path, func(p string, entry os.DirEntry, err error) error {
+		if err != nil || entry.IsDir() {
+			return err
+		}
+		if ext := filepath.Ext(p); ext == ".yaml" || ext == ".yml" {
+			rel, err := filepath.Rel(path, p)
+			if err != nil {
+				return err
+			}
+			k.Resources = append(k.Resources, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	if len(k.Resources) == 0 {
+		return fmt.Errorf("no manifest found")
+	}
+	return WriteKustomization(path, k)
+}
+
+// copyManifests copies the regular files of a directory tree of the given
+// root, skipping the Git metadata. Symbolic links are not followed out of the
+// root.
+func copyManifests(root string, path string, dest string) error {
+	root, err := filepath.EvalSymlinks(root)
+	if err != nil {
+		return err
+	}
+	src, err := filepath.EvalSymlinks(filepath.Join(root, filepath.Clean("/"+path)))
+	if err != nil {
+		return fmt.Errorf("%s not found", path)
+	}
+	if rel, err := filepath.Rel(root, src); err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return fmt.Errorf("%s is outside of the source", path)
+	}
+
+	info, err := os.Stat(src)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", filepath.Base(src))
+	}
+
+	var total int64
+	return filepath.WalkDir(src, func(p string, entry os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		total += info.Size()
+		if info.Size() > maxManifestFileSize || total > maxManifestTotalSize {
+			return errManifestSizeLimit
+		}
+
+		rel, err := filepath.Rel(src, p)
+		if err != nil {
+			return err
+		}
+		data, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+		return utils.WriteFile(filepath.Join(dest, filepath.Dir(rel)), filepath.Base(rel), data)
+	})
+}
+
+// extractTar extracts the regular files of a tar archive to the given directory
+func extractTar(data []byte, gzipped bool, dest string) error {
+	var r io.Reader = bytes.NewReader(data)
+	if gzipped {
+		gz, err := gzip.NewReader(r)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	var total int64
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		total += hdr.Size
+		if hdr.Size > maxManifestFileSize || total > maxManifestTotalSize {
+			return errManifestSizeLimit
+		}
+		content, err := io.ReadAll(io.LimitReader(tr, maxManifestFileSize))
+		if err != nil {
+			return err
+		}
+
+		// Entries cannot be written outside of the directory
+		name := filepath.Join(dest, filepath.Clean("/"+hdr.Name))
+		if err := utils.WriteFile(filepath.Dir(name), filepath.Base(name), content); err != nil {
+			return err
+		}
+	}
+}
+
+// parseOCIReference splits oci://host/repository:tag or oci://host/repository@digest
+func parseOCIReference(ref string) (host string, repo string, tag string, err error) {
+	u, err := url.Parse(ref)
+	if err != nil || u.Scheme != "oci" || u.Host == "" || len(u.Path) < 2 {
+		return "", "", "", fmt.Errorf("invalid OCI reference %s", ref)
+	}
+
+	repo = strings.TrimPrefix(u.Path, "/")
+	tag = "latest"
+	if i := strings.LastIndex(repo, "@"); i > 0 {
+		repo, tag = repo[:i], repo[i+1:]
+	} else if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo, tag = repo[:i], repo[i+1:]
+	}
+	return u.Host, repo, tag, nil
+}
+
+// ociRegistry reads public artifacts from an OCI distribution registry
+type ociRegistry struct {
+	host  string
+	repo  string
+	token string
+}
+
+func (r *ociRegistry) get(path string, accept ...string) ([]byte, error) {
+	resp, err := r.do(path, accept)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode == http.StatusUnauthorized && r.token == "" {
+		// Registries serving public artifacts still require an anonymous token
+		challenge := resp.Header.Get("WWW-Authenticate")
+		resp.Body.Close()
+		if r.token, err = anonymousToken(challenge); err != nil {
+			return nil, err
+		}
+		if resp, err = r.do(path, accept); err != nil {
+			return nil, err
+		}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("%s: %s", path, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, maxManifestTotalSize))
+}
+
+func (r *ociRegistry) do(path string, accept []string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("https://%s/v2/%s/%s", r.host, r.repo, path), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(accept) > 0 {
+		req.Header.Set("Accept", strings.Join(accept, ", "))
+	}
+	if r.token != "" {
+		req.Header.Set("Authorization", "Bearer "+r.token)
+	}
+	return ociClient.Do(req)
+}
+
+// anonymousToken requests a token from the realm of a Bearer challenge
+func anonymousToken(challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	params := map[string]string{}
+	for _, p := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		if k, v, ok := strings.Cut(strings.TrimSpace(p), "="); ok {
+			params[k] = strings.Trim(v, `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Scheme != "https" {
+		return "", fmt.Errorf("invalid authentication realm %q", params["realm"])
+	}
+	q := realm.Query()
+	for _, k := range []string{"service", "scope"} {
+		if params[k] != "" {
+			q.Set(k, params[k])
+		}
+	}
+	realm.RawQuery = q.Encode()
+
+	resp, err := ociClient.Get(realm.String())
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("cannot get token: %s", resp.Status)
+	}
+
+	var token struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", err
+	}
+	if token.Token == "" {
+		token.Token = token.AccessToken
+	}
+	return token.Token, nil
+}
+
+// limitedFS fails the writes once the files written to the filesystem and
+// its chroots exceed the size limit
+type limitedFS struct {
+	billy.Filesystem
+	size  *atomic.Int64
+	limit int64
+}
+
+func (fs *limitedFS) Create(filename string) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.Create(filename))
+}
+
+func (fs *limitedFS) OpenFile(filename string, flag int, perm os.FileMode) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.OpenFile(filename, flag, perm))
+}
+
+func (fs *limitedFS) TempFile(dir string, prefix string) (billy.File, error) {
+	return fs.wrap(fs.Filesystem.TempFile(dir, prefix))
+}
+
+func (fs *limitedFS) Chroot(path string) (billy.Filesystem, error) {
+	chroot, err := fs.Filesystem.Chroot(path)
+	if err != nil {
+		return nil, err
+	}
+	return &limitedFS{Filesystem: chroot, size: fs.size, limit: fs.limit}, nil
+}
+
+func (fs *limitedFS) wrap(f billy.File, err error) (billy.File, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &limitedFile{File: f, fs: fs}, nil
+}
+
+type limitedFile struct {
+	billy.File
+	fs *limitedFS
+}
+
+func (f *limitedFile) Write(p []byte) (int, error) {
+	if f.fs.size.Add(int64(len(p))) > f.fs.limit {
+		return 0, errManifestSizeLimit
+	}
+	return f.File.Write(p)
+}
@@ -0,0 +1,294 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"gopkg.in/yaml.v2"
+	kustomize "sigs.k8s.io/kustomize/api/types"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+var _ = Describe("Manifest apps", func() {
+	const manifest = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+---
+apiVersion: v1
+kind: Service
+metadata:
+  name: backend
+spec:
+  ports:
+  - port: 80
+`
+
+	var (
+		app           v1beta1.Application
+		k             *kustomize.Kustomization
+		kustomizePath string
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name:      "backend",
+			Version:   "0.1.0",
+			Namespace: "backend",
+			ManifestApp: &v1beta1.ManifestApp{
+				Artifact: "backend",
+				Manifest: manifest,
+			},
+		}
+		k = &kustomize.Kustomization{
+			Resources: []string{"network-policy-ingress.yaml"},
+		}
+		kustomizePath = filepath.Join(GinkgoT().TempDir(), "kustomize")
+	})
+
+	It("generates a fleet.yaml without Helm section", func() {
+		appMap := map[string]v1beta1.Application{app.Name: app}
+		fleetConf, err := newFleetConfig(app.Name, appMap, "1234", "deployment-1234", 1, app.Namespace)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fleetConf.Helm).To(Equal(HelmApp{}))
+
+		data, err := yaml.Marshal(&fleetConf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("helm:"))
+	})
+
+	It("writes the manifests of an artifact with a generated kustomization", func() {
+		Expect(injectManifests(app, kustomizePath, k)).To(Succeed())
+		Expect(k.Resources).To(Equal([]string{"network-policy-ingress.yaml", ManifestDir}))
+
+		data, err := os.ReadFile(filepath.Join(kustomizePath, ManifestDir, ManifestFile))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal(manifest))
+
+		data, err = os.ReadFile(filepath.Join(kustomizePath, ManifestDir, "kustomization.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		generated := &kustomize.Kustomization{}
+		Expect(yaml.Unmarshal(data, generated)).To(Succeed())
+		Expect(generated.Resources).To(Equal([]string{ManifestFile}))
+	})
+
+	It("keeps the kustomization of a Git source", func() {
+		fetch := fetchGitManifests
+		DeferCleanup(func() { fetchGitManifests = fetch })
+		fetchGitManifests = func(src *v1beta1.GitManifestSource, dest string) error {
+			Expect(src.Repo).To(Equal("https://github.com/example/backend.git"))
+			Expect(utils.WriteFile(dest, "kustomization.yaml", []byte("resources:\n- ../base\n"))).To(Succeed())
+			return utils.WriteFile(filepath.Join(dest, "base"), "deployment.yaml", []byte(manifest))
+		}
+		app.ManifestApp = &v1beta1.ManifestApp{
+			Git: &v1beta1.GitManifestSource{
+				Repo: "https://github.com/example/backend.git",
+				Path: "overlays/edge",
+			},
+		}
+
+		Expect(injectManifests(app, kustomizePath, k)).To(Succeed())
+		Expect(k.Resources).To(ContainElement(ManifestDir))
+
+		data, err := os.ReadFile(filepath.Join(kustomizePath, ManifestDir, "kustomization.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("resources:\n- ../base\n"))
+	})
+
+	It("removes the manifests of the previous generation", func() {
+		Expect(injectManifests(app, kustomizePath, k)).To(Succeed())
+
+		fetch := fetchOCIManifests
+		DeferCleanup(func() { fetchOCIManifests = fetch })
+		fetchOCIManifests = func(src *v1beta1.OCIManifestSource, dest string) error {
+			return utils.WriteFile(dest, "service.yaml", []byte(manifest))
+		}
+		app.ManifestApp = &v1beta1.ManifestApp{
+			OCI: &v1beta1.OCIManifestSource{
+				URL: "oci://registry.example.com/manifests/backend:1.0.0",
+			},
+		}
+		k = &kustomize.Kustomization{}
+		Expect(injectManifests(app, kustomizePath, k)).To(Succeed())
+
+		Expect(filepath.Join(kustomizePath, ManifestDir, ManifestFile)).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(kustomizePath, ManifestDir, "service.yaml")).To(BeAnExistingFile())
+	})
+
+	It("fails without manifest", func() {
+		app.ManifestApp.Manifest = ""
+		fetch := fetchGitManifests
+		DeferCleanup(func() { fetchGitManifests = fetch })
+		fetchGitManifests = func(src *v1beta1.GitManifestSource, dest string) error {
+			return utils.WriteFile(dest, "README.md", []byte("# backend\n"))
+		}
+		app.ManifestApp.Git = &v1beta1.GitManifestSource{
+			Repo: "https://github.com/example/backend.git",
+		}
+
+		Expect(injectManifests(app, kustomizePath, k)).To(MatchError("no manifest found"))
+	})
+
+	It("parses the objects of a manifest", func() {
+		objs, err := ParseManifests(manifest)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(objs).To(HaveLen(2))
+		Expect(objs[0].GetKind()).To(Equal("ConfigMap"))
+		Expect(objs[1].GetName()).To(Equal("backend"))
+
+		_, err = ParseManifests("metadata:\n  name: settings\n")
+		Expect(err).To(MatchError("invalid manifest: object 0 has no apiVersion or kind"))
+
+		_, err = ParseManifests("---\n")
+		Expect(err).To(MatchError("invalid manifest: no object"))
+	})
+
+	It("extracts tar layers within the directory", func() {
+		var buf bytes.Buffer
+		tw := tar.NewWriter(&buf)
+		for _, name := range []string{"deploy/service.yaml", "../../escape.yaml"} {
+			Expect(tw.WriteHeader(&tar.Header{Name: name, Mode: 0600, Size: int64(len(manifest)), Typeflag: tar.TypeReg})).To(Succeed())
+			_, err := tw.Write([]byte(manifest))
+			Expect(err).ToNot(HaveOccurred())
+		}
+		Expect(tw.Close()).To(Succeed())
+
+		dest := filepath.Join(GinkgoT().TempDir(), "layer")
+		Expect(extractTar(buf.Bytes(), false, dest)).To(Succeed())
+		Expect(filepath.Join(dest, "deploy", "service.yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(dest, "escape.yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(dest, "..", "..", "escape.yaml")).ToNot(BeAnExistingFile())
+	})
+
+	It("does not follow symbolic links out of the source", func() {
+		root := GinkgoT().TempDir()
+		Expect(utils.WriteFile(filepath.Join(root, "deploy"), "service.yaml", []byte(manifest))).To(Succeed())
+		Expect(os.Symlink("/", filepath.Join(root, "a"))).To(Succeed())
+		Expect(os.Symlink("deploy", filepath.Join(root, "b"))).To(Succeed())
+		Expect(os.Symlink("/etc/hostname", filepath.Join(root, "deploy", "hostname.yaml"))).To(Succeed())
+
+		dest := filepath.Join(GinkgoT().TempDir(), "manifests")
+		Expect(copyManifests(root, "a/etc/ssl", dest)).To(MatchError("a/etc/ssl is outside of the source"))
+		Expect(copyManifests(root, "../a/etc", dest)).To(MatchError("../a/etc is outside of the source"))
+		Expect(dest).ToNot(BeAnExistingFile())
+
+		Expect(copyManifests(root, "b", dest)).To(Succeed())
+		Expect(filepath.Join(dest, "service.yaml")).To(BeAnExistingFile())
+		Expect(filepath.Join(dest, "hostname.yaml")).ToNot(BeAnExistingFile())
+	})
+
+	Context("with a Git source", func() {
+		var (
+			repoPath string
+			repo     *git.Repository
+			src      *v1beta1.GitManifestSource
+			dest     string
+		)
+
+		commit := func(name string, data []byte) plumbing.Hash {
+			Expect(utils.WriteFile(filepath.Join(repoPath, "deploy"), name, data)).To(Succeed())
+			w, err := repo.Worktree()
+			Expect(err).ToNot(HaveOccurred())
+			_, err = w.Add(filepath.Join("deploy", name))
+			Expect(err).ToNot(HaveOccurred())
+			hash, err := w.Commit("add "+name, &git.CommitOptions{
+				Author: &object.Signature{Name: "dev", Email: "dev@example.com", When: time.Now()},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			return hash
+		}
+
+		BeforeEach(func() {
+			repoPath = GinkgoT().TempDir()
+			var err error
+			repo, err = git.PlainInit(repoPath, false)
+			Expect(err).ToNot(HaveOccurred())
+			src = &v1beta1.GitManifestSource{Repo: "file://" + repoPath, Path: "deploy"}
+			dest = filepath.Join(GinkgoT().TempDir(), "manifests")
+		})
+
+		It("clones the branches and tags without history", func() {
+			first := commit("service.yaml", []byte(manifest))
+			_, err := repo.CreateTag("v1", first, nil)
+			Expect(err).ToNot(HaveOccurred())
+			commit("configmap.yaml", []byte(manifest))
+
+			r, err := cloneGitSource(context.Background(), src, GinkgoT().TempDir())
+			Expect(err).ToNot(HaveOccurred())
+			shallow, err := r.Storer.Shallow()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(shallow).To(HaveLen(1))
+
+			src.Revision = "v1"
+			Expect(fetchGitManifests(src, dest)).To(Succeed())
+			Expect(filepath.Join(dest, "service.yaml")).To(BeAnExistingFile())
+			Expect(filepath.Join(dest, "configmap.yaml")).ToNot(BeAnExistingFile())
+
+			// A commit is only found in the history
+			src.Revision = first.String()
+			dest = filepath.Join(GinkgoT().TempDir(), "manifests")
+			Expect(fetchGitManifests(src, dest)).To(Succeed())
+			Expect(filepath.Join(dest, "configmap.yaml")).ToNot(BeAnExistingFile())
+		})
+
+		It("fails when the clone exceeds the size limit", func() {
+			data := make([]byte, 64<<10)
+			_, err := rand.Read(data)
+			Expect(err).ToNot(HaveOccurred())
+			commit("blob.yaml", data)
+
+			limit := maxGitCloneSize
+			DeferCleanup(func() { maxGitCloneSize = limit })
+			maxGitCloneSize = 16 << 10
+
+			Expect(fetchGitManifests(src, dest)).To(MatchError(errManifestSizeLimit))
+		})
+
+		It("fails when the clone times out", func() {
+			commit("service.yaml", []byte(manifest))
+
+			timeout := gitCloneTimeout
+			DeferCleanup(func() { gitCloneTimeout = timeout })
+			gitCloneTimeout = time.Nanosecond
+
+			Expect(fetchGitManifests(src, dest)).To(MatchError(context.DeadlineExceeded))
+		})
+	})
+
+	It("parses OCI references", func() {
+		host, repo, ref, err := parseOCIReference("oci://registry.example.com:5000/manifests/backend:1.0.0")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(host).To(Equal("registry.example.com:5000"))
+		Expect(repo).To(Equal("manifests/backend"))
+		Expect(ref).To(Equal("1.0.0"))
+
+		_, repo, ref, err = parseOCIReference("oci://registry.example.com/backend@sha256:0123")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(repo).To(Equal("backend"))
+		Expect(ref).To(Equal("sha256:0123"))
+
+		_, _, ref, err = parseOCIReference("oci://registry.example.com/backend")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ref).To(Equal("latest"))
+
+		_, _, _, err = parseOCIReference("https://registry.example.com/backend")
+		Expect(err).To(HaveOccurred())
+	})
+})
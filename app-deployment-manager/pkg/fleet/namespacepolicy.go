@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	ResourceQuotaFile = "resource-quota.yaml"
+	LimitRangeFile    = "limit-range.yaml"
+
+	// PodSecurityEnforceLabel sets the Pod Security admission level enforced on a namespace
+	PodSecurityEnforceLabel = "pod-security.kubernetes.io/enforce"
+)
+
+// injectNamespacePolicy generates the ResourceQuota and the LimitRange of the
+// app namespace next to the network policies in the kustomize directory and
+// returns the names of the generated files. The Pod Security admission level
+// is set as a label of the namespace created by the app bundle.
+func injectNamespacePolicy(policy *v1beta1.NamespacePolicy, namePrefix string, kustomizePath string,
+	fleetConf *Config) ([]string, error) {
+	if policy == nil {
+		return nil, nil
+	}
+
+	if policy.PodSecurityLevel != "" {
+		fleetConf.NamespaceLabels[PodSecurityEnforceLabel] = policy.PodSecurityLevel
+	}
+
+	files := []string{}
+	if len(policy.ResourceQuota) > 0 {
+		quota := &corev1.ResourceQuota{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ResourceQuota",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namePrefix + "-quota",
+			},
+			Spec: corev1.ResourceQuotaSpec{
+				Hard: policy.ResourceQuota,
+			},
+		}
+		if err := writeNamespacePolicyResource(kustomizePath, quota, ResourceQuotaFile); err != nil {
+			return nil, err
+		}
+		files = append(files, ResourceQuotaFile)
+	}
+
+	if len(policy.DefaultLimits) > 0 || len(policy.DefaultRequests) > 0 || len(policy.MaxLimits) > 0 {
+		limitRange := &corev1.LimitRange{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "LimitRange",
+				APIVersion: "v1",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name: namePrefix + "-limits",
+			},
+			Spec: corev1.LimitRangeSpec{
+				Limits: []corev1.LimitRangeItem{
+					{
+						Type:           corev1.LimitTypeContainer,
+						Default:        policy.DefaultLimits,
+						DefaultRequest: policy.DefaultRequests,
+						Max:            policy.MaxLimits,
+					},
+				},
+			},
+		}
+		if err := writeNamespacePolicyResource(kustomizePath, limitRange, LimitRangeFile); err != nil {
+			return nil, err
+		}
+		files = append(files, LimitRangeFile)
+	}
+
+	return files, nil
+}
+
+func writeNamespacePolicyResource(basedir string, v interface{}, name string) error {
+	data, err := k8syaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+	return utils.WriteFile(basedir, name, data)
+}
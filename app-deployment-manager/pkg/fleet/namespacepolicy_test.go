@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Namespace policies", func() {
+	const namePrefix = "wordpress-0.1.0-1234"
+
+	var (
+		fleetConf     Config
+		kustomizePath string
+	)
+
+	BeforeEach(func() {
+		fleetConf = Config{
+			NamespaceLabels: make(map[string]string),
+		}
+		kustomizePath = filepath.Join(GinkgoT().TempDir(), "kustomize")
+	})
+
+	It("generates nothing without a namespace policy", func() {
+		files, err := injectNamespacePolicy(nil, namePrefix, kustomizePath, &fleetConf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(BeEmpty())
+		Expect(fleetConf.NamespaceLabels).To(BeEmpty())
+		Expect(kustomizePath).ToNot(BeAnExistingFile())
+	})
+
+	It("generates the ResourceQuota and LimitRange and sets the Pod Security level", func() {
+		policy := &v1beta1.NamespacePolicy{
+			ResourceQuota: corev1.ResourceList{
+				"requests.cpu": resource.MustParse("2"),
+				"pods":         resource.MustParse("20"),
+			},
+			DefaultLimits: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("256Mi"),
+			},
+			DefaultRequests: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("100m"),
+			},
+			MaxLimits: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("1"),
+			},
+			PodSecurityLevel: "restricted",
+		}
+
+		files, err := injectNamespacePolicy(policy, namePrefix, kustomizePath, &fleetConf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(Equal([]string{ResourceQuotaFile, LimitRangeFile}))
+		Expect(fleetConf.NamespaceLabels).To(HaveKeyWithValue(PodSecurityEnforceLabel, "restricted"))
+
+		data, err := os.ReadFile(filepath.Join(kustomizePath, ResourceQuotaFile))
+		Expect(err).ToNot(HaveOccurred())
+		quota := &corev1.ResourceQuota{}
+		Expect(k8syaml.Unmarshal(data, quota)).To(Succeed())
+		Expect(quota.Kind).To(Equal("ResourceQuota"))
+		Expect(quota.Name).To(Equal(namePrefix + "-quota"))
+		Expect(quota.Spec.Hard).To(HaveLen(2))
+		Expect(quota.Spec.Hard.Cpu().IsZero()).To(BeTrue())
+		Expect(quota.Spec.Hard.Name("requests.cpu", resource.DecimalSI).String()).To(Equal("2"))
+		Expect(quota.Spec.Hard.Pods().String()).To(Equal("20"))
+
+		data, err = os.ReadFile(filepath.Join(kustomizePath, LimitRangeFile))
+		Expect(err).ToNot(HaveOccurred())
+		limitRange := &corev1.LimitRange{}
+		Expect(k8syaml.Unmarshal(data, limitRange)).To(Succeed())
+		Expect(limitRange.Kind).To(Equal("LimitRange"))
+		Expect(limitRange.Name).To(Equal(namePrefix + "-limits"))
+		Expect(limitRange.Spec.Limits).To(HaveLen(1))
+		limits := limitRange.Spec.Limits[0]
+		Expect(limits.Type).To(Equal(corev1.LimitTypeContainer))
+		Expect(limits.Default.Memory().String()).To(Equal("256Mi"))
+		Expect(limits.DefaultRequest.Cpu().String()).To(Equal("100m"))
+		Expect(limits.Max.Cpu().String()).To(Equal("1"))
+	})
+
+	It("only generates the LimitRange without resource quota", func() {
+		policy := &v1beta1.NamespacePolicy{
+			DefaultLimits: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("500m"),
+			},
+		}
+
+		files, err := injectNamespacePolicy(policy, namePrefix, kustomizePath, &fleetConf)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(files).To(Equal([]string{LimitRangeFile}))
+		Expect(fleetConf.NamespaceLabels).To(BeEmpty())
+		Expect(filepath.Join(kustomizePath, ResourceQuotaFile)).ToNot(BeAnExistingFile())
+	})
+})
@@ -0,0 +1,487 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/vault"
+)
+
+const (
+	SecretValuesDir = "secret-values"
+
+	// SecretValuesKey is the key of the values in the generated Secrets
+	SecretValuesKey = "values.yaml"
+
+	defaultSecretStoreKind = "ClusterSecretStore"
+
+	// sealedSessionKeyBytes is the size of the AES-256 key sealing a value,
+	// as expected by the sealed-secrets controller
+	sealedSessionKeyBytes = 32
+)
+
+type ValuesFrom struct {
+	SecretKeyRef *SecretKeyRef `yaml:"secretKeyRef,omitempty"`
+}
+
+type SecretKeyRef struct {
+	Name      string `yaml:"name"`
+	Namespace string `yaml:"namespace,omitempty"`
+	Key       string `yaml:"key"`
+}
+
+type SealedSecret struct {
+	APIVersion string           `yaml:"apiVersion"`
+	Kind       string           `yaml:"kind"`
+	Metadata   Metadata         `yaml:"metadata"`
+	Spec       SealedSecretSpec `yaml:"spec"`
+}
+
+type SealedSecretSpec struct {
+	EncryptedData map[string]string    `yaml:"encryptedData"`
+	Template      SealedSecretTemplate `yaml:"template"`
+}
+
+type SealedSecretTemplate struct {
+	Metadata Metadata `yaml:"metadata"`
+}
+
+type ExternalSecret struct {
+	APIVersion string             `yaml:"apiVersion"`
+	Kind       string             `yaml:"kind"`
+	Metadata   Metadata           `yaml:"metadata"`
+	Spec       ExternalSecretSpec `yaml:"spec"`
+}
+
+type ExternalSecretSpec struct {
+	RefreshInterval string               `yaml:"refreshInterval,omitempty"`
+	Target          ExternalSecretTarget `yaml:"target"`
+	Data            []ExternalSecretData `yaml:"data"`
+}
+
+type ExternalSecretTarget struct {
+	Name     string                 `yaml:"name"`
+	Template ExternalSecretTemplate `yaml:"template"`
+}
+
+type ExternalSecretTemplate struct {
+	Data map[string]string `yaml:"data"`
+}
+
+type ExternalSecretData struct {
+	SecretKey string                  `yaml:"secretKey"`
+	RemoteRef ExternalSecretRemoteRef `yaml:"remoteRef"`
+	SourceRef ExternalSecretSourceRef `yaml:"sourceRef"`
+}
+
+type ExternalSecretRemoteRef struct {
+	Key      string `yaml:"key"`
+	Property string `yaml:"property,omitempty"`
+}
+
+type ExternalSecretSourceRef struct {
+	StoreRef ExternalSecretStoreRef `yaml:"storeRef"`
+}
+
+type ExternalSecretStoreRef struct {
+	Name string `yaml:"name"`
+	Kind string `yaml:"kind"`
+}
+
+// SecretValuesBundleName returns the name of the bundle delivering the secret
+// override values of an app bundle
+func SecretValuesBundleName(bundleName string) string {
+	return "secret-values-" + bundleName
+}
+
+// readVaultSecretRefs reads the values of the Vault secret references of an
+// app. Their paths are relative to the deployment values of the project, so
+// that a deployment cannot read the secrets of the orchestrator or of another
+// project.
+func readVaultSecretRefs(ctx context.Context, refs []v1beta1.SecretRef, projectID string) (map[string]string, error) {
+	secretServiceEnabled, err := utils.IsSecretServiceEnabled()
+	if err != nil {
+		return nil, err
+	}
+	if !secretServiceEnabled {
+		return nil, errors.New("secret service is disabled, cannot read Vault secret references")
+	}
+	if projectID == "" {
+		return nil, errors.New("project-id not found in deployment labels")
+	}
+
+	vaultManager := vault.NewManager(utils.GetSecretServiceEndpoint(), utils.GetServiceAccount(), utils.GetSecretServiceMount())
+	vaultClient, err := vaultManager.GetVaultClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := vaultManager.Logout(ctx, vaultClient); err != nil {
+			log.Errorf("failed to logout from vault: %v", err)
+		}
+	}()
+
+	values := make(map[string]string)
+	for _, ref := range refs {
+		if ref.Vault == nil {
+			continue
+		}
+		secretPath := path.Join(utils.GetSecretServiceDeploymentValuesPath(), projectID, path.Clean("/"+ref.Vault.Path))
+		value, err := vaultManager.GetSecretValueString(ctx, vaultClient, secretPath, ref.Vault.Key)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read secret reference %s: %w", ref.Key, err)
+		}
+		values[ref.Key] = value
+	}
+	return values, nil
+}
+
+// parameterSecretName returns the name of the secret holding the values of
+// the secret parameters of an app. Deployments created before the name was
+// recorded in the app hold them in the secret named by the same convention.
+func parameterSecretName(d *v1beta1.Deployment, app v1beta1.Application) (string, bool) {
+	if app.ParameterSecretName != "" {
+		return app.ParameterSecretName, false
+	}
+	return fmt.Sprintf("%s-%s-%s-secret", d.Name, app.Name, d.Spec.DeploymentPackageRef.ProfileName), true
+}
+
+// secretParameterKeys returns the keys of the secret parameters of an app,
+// read from the secret holding their values
+func secretParameterKeys(d *v1beta1.Deployment, app v1beta1.Application, kc client.Client) ([]string, error) {
+	secretName, derived := parameterSecretName(d, app)
+
+	secret := &corev1.Secret{}
+	if err := kc.Get(context.Background(), client.ObjectKey{
+		Namespace: d.Namespace,
+		Name:      secretName,
+	}, secret); err != nil {
+		if derived && apierrors.IsNotFound(err) {
+			// The app has no secret parameter
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	values := map[string]interface{}{}
+	if err := json.Unmarshal(secret.Data["values"], &values); err != nil {
+		return nil, fmt.Errorf("invalid secret parameters %s: %w", secretName, err)
+	}
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys, nil
+}
+
+// splitSecretParameters removes the values of the secret parameters from the
+// overriding values and returns them by dotted key
+func splitSecretParameters(overrides string, keys []string) (string, map[string]interface{}, error) {
+	if len(keys) == 0 {
+		return overrides, nil, nil
+	}
+
+	values := map[string]interface{}{}
+	if err := k8syaml.Unmarshal([]byte(overrides), &values); err != nil {
+		return "", nil, fmt.Errorf("cannot remove the secret parameters from invalid override values: %w", err)
+	}
+
+	secrets := make(map[string]interface{}, len(keys))
+	for _, key := range keys {
+		node := values
+		path := strings.Split(key, ".")
+		for _, k := range path[:len(path)-1] {
+			node, _ = node[k].(map[string]interface{})
+		}
+		if value, ok := node[path[len(path)-1]]; ok {
+			secrets[key] = value
+			delete(node, path[len(path)-1])
+		}
+	}
+
+	data, err := k8syaml.Marshal(values)
+	if err != nil {
+		return "", nil, err
+	}
+	return string(data), secrets, nil
+}
+
+// injectSecretValuesToSubDir generates the Secrets holding the secret
+// override values of an app in a separate bundle, which the app bundle
+// depends on and reads its values from. The values of the secret parameters
+// and the values read from Vault are delivered as a SealedSecret and values
+// of an external-secrets store are read on the edge cluster through an
+// ExternalSecret, so that no plain text secret is written to the repository.
+func injectSecretValuesToSubDir(app v1beta1.Application, projectID string, bundleName string,
+	namespace string, fleetPath string, secretParameters map[string]interface{}, fleetConf *Config) error {
+	secretDir := filepath.Join(fleetPath, SecretValuesDir)
+	if err := os.RemoveAll(secretDir); err != nil {
+		return err
+	}
+	if (len(app.SecretRefs) == 0 && len(secretParameters) == 0) || app.HelmApp == nil {
+		return nil
+	}
+
+	var vaultRefs, externalRefs []v1beta1.SecretRef
+	for _, ref := range app.SecretRefs {
+		switch {
+		case ref.Vault != nil:
+			vaultRefs = append(vaultRefs, ref)
+		case ref.External != nil:
+			externalRefs = append(externalRefs, ref)
+		}
+	}
+
+	if len(vaultRefs) > 0 || len(secretParameters) > 0 {
+		values := make(map[string]interface{}, len(secretParameters)+len(vaultRefs))
+		for key, value := range secretParameters {
+			values[key] = value
+		}
+		if len(vaultRefs) > 0 {
+			vaultValues, err := readVaultSecretRefs(context.Background(), vaultRefs, projectID)
+			if err != nil {
+				return err
+			}
+			for key, value := range vaultValues {
+				values[key] = value
+			}
+		}
+		name := bundleName + "-secret-values"
+		sealed, err := newSealedValues(values, name, namespace)
+		if err != nil {
+			return err
+		}
+		if err := WriteResourceConfig(secretDir, sealed, "sealed-values.yaml"); err != nil {
+			return err
+		}
+		fleetConf.Helm.ValuesFrom = append(fleetConf.Helm.ValuesFrom, ValuesFrom{
+			SecretKeyRef: &SecretKeyRef{Name: name, Namespace: namespace, Key: SecretValuesKey},
+		})
+	}
+
+	if len(externalRefs) > 0 {
+		name := bundleName + "-external-values"
+		external, err := newExternalValues(externalRefs, name, namespace)
+		if err != nil {
+			return err
+		}
+		if err := WriteResourceConfig(secretDir, external, "external-values.yaml"); err != nil {
+			return err
+		}
+		fleetConf.Helm.ValuesFrom = append(fleetConf.Helm.ValuesFrom, ValuesFrom{
+			SecretKeyRef: &SecretKeyRef{Name: name, Namespace: namespace, Key: SecretValuesKey},
+		})
+	}
+
+	subFleetConf := Config{
+		Name:             SecretValuesBundleName(bundleName),
+		DefaultNamespace: namespace,
+	}
+	if err := WriteFleetConfig(secretDir, subFleetConf); err != nil {
+		return err
+	}
+
+	// Have main fleet.yaml wait until the values are available
+	fleetConf.DependsOn = append(fleetConf.DependsOn, DependsOnItem{
+		Name: SecretValuesBundleName(bundleName),
+	})
+	return nil
+}
+
+// secretValuesYAML returns the Helm values setting each dotted key to its value
+func secretValuesYAML(values map[string]interface{}) ([]byte, error) {
+	root := map[string]interface{}{}
+	for key, value := range values {
+		node := root
+		keys := strings.Split(key, ".")
+		for _, k := range keys[:len(keys)-1] {
+			child, ok := node[k].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[k] = child
+			}
+			node = child
+		}
+		node[keys[len(keys)-1]] = value
+	}
+	return yaml.Marshal(root)
+}
+
+// newSealedValues returns a SealedSecret holding the values, sealed with the
+// certificate of the sealed-secrets controller of the edge clusters. It uses
+// the strict scope, so that it can only be unsealed with its name and
+// namespace.
+func newSealedValues(values map[string]interface{}, name string, namespace string) (*SealedSecret, error) {
+	cert := utils.GetSealedSecretCert()
+	if cert == "" {
+		return nil, errors.New("sealed secrets certificate is not set, cannot deliver secret values")
+	}
+	pubKey, err := parseSealingCert(cert)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := secretValuesYAML(values)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := sealValue(rand.Reader, pubKey, []byte(namespace+"/"+name), data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SealedSecret{
+		APIVersion: "bitnami.com/v1alpha1",
+		Kind:       "SealedSecret",
+		Metadata: Metadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: SealedSecretSpec{
+			EncryptedData: map[string]string{
+				SecretValuesKey: base64.StdEncoding.EncodeToString(sealed),
+			},
+			Template: SealedSecretTemplate{
+				Metadata: Metadata{
+					Name:      name,
+					Namespace: namespace,
+				},
+			},
+		},
+	}, nil
+}
+
+func parseSealingCert(data string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(data))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, errors.New("invalid sealed secrets certificate")
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sealed secrets certificate: %w", err)
+	}
+	pubKey, ok := cert.PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("sealed secrets certificate has no RSA public key")
+	}
+	return pubKey, nil
+}
+
+// sealValue encrypts a value like the sealed-secrets controller expects it:
+// a random AES-GCM session key encrypted with RSA-OAEP, prefixed by its
+// length, followed by the value encrypted with the session key.
+func sealValue(rnd io.Reader, pubKey *rsa.PublicKey, label []byte, plaintext []byte) ([]byte, error) {
+	sessionKey := make([]byte, sealedSessionKeyBytes)
+	if _, err := io.ReadFull(rnd, sessionKey); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aed, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaCiphertext, err := rsa.EncryptOAEP(sha256.New(), rnd, pubKey, sessionKey, label)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext := make([]byte, 2, 2+len(rsaCiphertext)+len(plaintext)+aed.Overhead())
+	binary.BigEndian.PutUint16(ciphertext, uint16(len(rsaCiphertext))) //nolint:gosec // RSA ciphertext fits in 16 bits
+	ciphertext = append(ciphertext, rsaCiphertext...)
+
+	// The session key is only used once, so the nonce can be zero
+	zeroNonce := make([]byte, aed.NonceSize())
+	return aed.Seal(ciphertext, zeroNonce, plaintext, nil), nil
+}
+
+// newExternalValues returns an ExternalSecret generating the values from the
+// secrets of the external-secrets stores of the edge clusters.
+func newExternalValues(refs []v1beta1.SecretRef, name string, namespace string) (*ExternalSecret, error) {
+	external := &ExternalSecret{
+		APIVersion: "external-secrets.io/v1beta1",
+		Kind:       "ExternalSecret",
+		Metadata: Metadata{
+			Name:      name,
+			Namespace: namespace,
+		},
+		Spec: ExternalSecretSpec{
+			RefreshInterval: "1h",
+			Target: ExternalSecretTarget{
+				Name: name,
+			},
+		},
+	}
+
+	// The values are templated with a placeholder per reference, which is
+	// replaced by the JSON encoded secret so that it is a valid YAML string
+	placeholders := make(map[string]interface{}, len(refs))
+	var replacements []string
+	for i, ref := range refs {
+		secretKey := fmt.Sprintf("ref%d", i)
+		placeholder := fmt.Sprintf("__secret_ref_%d__", i)
+		placeholders[ref.Key] = placeholder
+		replacements = append(replacements, placeholder, fmt.Sprintf("{{ .%s | toJson }}", secretKey))
+
+		storeKind := ref.External.StoreKind
+		if storeKind == "" {
+			storeKind = defaultSecretStoreKind
+		}
+		external.Spec.Data = append(external.Spec.Data, ExternalSecretData{
+			SecretKey: secretKey,
+			RemoteRef: ExternalSecretRemoteRef{
+				Key:      ref.External.Key,
+				Property: ref.External.Property,
+			},
+			SourceRef: ExternalSecretSourceRef{
+				StoreRef: ExternalSecretStoreRef{
+					Name: ref.External.Store,
+					Kind: storeKind,
+				},
+			},
+		})
+	}
+
+	data, err := secretValuesYAML(placeholders)
+	if err != nil {
+		return nil, err
+	}
+	external.Spec.Target.Template.Data = map[string]string{
+		SecretValuesKey: strings.NewReplacer(replacements...).Replace(string(data)),
+	}
+	return external, nil
+}
@@ -0,0 +1,280 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	vaultapi "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/vault"
+)
+
+type mockVaultManager struct {
+	secrets map[string]map[string]string
+}
+
+func (m *mockVaultManager) GetVaultClient(_ context.Context) (*vaultapi.Client, error) {
+	return nil, nil
+}
+
+func (m *mockVaultManager) GetKVSecret(_ context.Context, _ *vaultapi.Client, _ string) (*vaultapi.KVSecret, error) {
+	return nil, nil
+}
+
+func (m *mockVaultManager) GetSecretValueString(_ context.Context, _ *vaultapi.Client, path string, key string) (string, error) {
+	return m.secrets[path][key], nil
+}
+
+func (m *mockVaultManager) Logout(_ context.Context, _ *vaultapi.Client) error {
+	return nil
+}
+
+// unseal decrypts a value sealed for the sealed-secrets controller
+func unseal(key *rsa.PrivateKey, label []byte, ciphertext []byte) ([]byte, error) {
+	rsaLen := int(binary.BigEndian.Uint16(ciphertext))
+	sessionKey, err := rsa.DecryptOAEP(sha256.New(), rand.Reader, key, ciphertext[2:2+rsaLen], label)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(sessionKey)
+	if err != nil {
+		return nil, err
+	}
+	aed, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return aed.Open(nil, make([]byte, aed.NonceSize()), ciphertext[2+rsaLen:], nil)
+}
+
+// setSealingCert sets the certificate of a new sealing key for the test and
+// returns the key
+func setSealingCert() *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "sealed-secret"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+	GinkgoT().Setenv("SEALED_SECRET_TLS_CRT", string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})))
+	return key
+}
+
+// unsealValues returns the values of a SealedSecret generated in a directory
+func unsealValues(key *rsa.PrivateKey, dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "sealed-values.yaml"))
+	Expect(err).ToNot(HaveOccurred())
+	sealed := &SealedSecret{}
+	Expect(yaml.Unmarshal(data, sealed)).To(Succeed())
+
+	ciphertext, err := base64.StdEncoding.DecodeString(sealed.Spec.EncryptedData[SecretValuesKey])
+	Expect(err).ToNot(HaveOccurred())
+	label := sealed.Spec.Template.Metadata.Namespace + "/" + sealed.Spec.Template.Metadata.Name
+	plaintext, err := unseal(key, []byte(label), ciphertext)
+	Expect(err).ToNot(HaveOccurred())
+	return string(plaintext)
+}
+
+var _ = Describe("Secret override values", func() {
+	const (
+		projectID  = "64f42b12-af68-4676-a689-657dd670daab"
+		bundleName = "wordpress-deployment-1234"
+		namespace  = "wordpress"
+	)
+
+	var (
+		app       v1beta1.Application
+		fleetConf Config
+		fleetPath string
+		key       *rsa.PrivateKey
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name:      "wordpress",
+			Namespace: namespace,
+			HelmApp:   &v1beta1.HelmApp{Chart: "wordpress"},
+		}
+		fleetConf = Config{}
+		fleetPath = GinkgoT().TempDir()
+
+		key = setSealingCert()
+		GinkgoT().Setenv("SECRET_SERVICE_ENABLED", "true")
+
+		newManager := vault.NewManager
+		DeferCleanup(func() { vault.NewManager = newManager })
+		vault.NewManager = func(_, _, _ string) vault.Manager {
+			return &mockVaultManager{secrets: map[string]map[string]string{
+				"ma_deployment_values/" + projectID + "/wordpress/db": {"password": "s3cr3t"},
+				"ma_git_service": {"password": "admin"},
+			}}
+		}
+	})
+
+	It("seals the values read from Vault", func() {
+		app.SecretRefs = []v1beta1.SecretRef{{
+			Key:   "mariadb.auth.password",
+			Vault: &v1beta1.VaultSecretRef{Path: "wordpress/db", Key: "password"},
+		}}
+		Expect(injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, nil, &fleetConf)).To(Succeed())
+
+		Expect(fleetConf.DependsOn).To(Equal([]DependsOnItem{{Name: SecretValuesBundleName(bundleName)}}))
+		Expect(fleetConf.Helm.ValuesFrom).To(Equal([]ValuesFrom{{SecretKeyRef: &SecretKeyRef{
+			Name:      bundleName + "-secret-values",
+			Namespace: namespace,
+			Key:       SecretValuesKey,
+		}}}))
+
+		data, err := os.ReadFile(filepath.Join(fleetPath, SecretValuesDir, "sealed-values.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).ToNot(ContainSubstring("s3cr3t"))
+		sealed := &SealedSecret{}
+		Expect(yaml.Unmarshal(data, sealed)).To(Succeed())
+		Expect(sealed.Kind).To(Equal("SealedSecret"))
+		Expect(sealed.Spec.Template.Metadata.Name).To(Equal(bundleName + "-secret-values"))
+
+		ciphertext, err := base64.StdEncoding.DecodeString(sealed.Spec.EncryptedData[SecretValuesKey])
+		Expect(err).ToNot(HaveOccurred())
+		plaintext, err := unseal(key, []byte(namespace+"/"+bundleName+"-secret-values"), ciphertext)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(plaintext)).To(Equal("mariadb:\n  auth:\n    password: s3cr3t\n"))
+
+		data, err = os.ReadFile(filepath.Join(fleetPath, SecretValuesDir, "fleet.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		subFleetConf := Config{}
+		Expect(yaml.Unmarshal(data, &subFleetConf)).To(Succeed())
+		Expect(subFleetConf.Name).To(Equal(SecretValuesBundleName(bundleName)))
+		Expect(subFleetConf.DefaultNamespace).To(Equal(namespace))
+	})
+
+	It("seals the values of the secret parameters with the values read from Vault", func() {
+		app.SecretRefs = []v1beta1.SecretRef{{
+			Key:   "mariadb.auth.password",
+			Vault: &v1beta1.VaultSecretRef{Path: "wordpress/db", Key: "password"},
+		}}
+		params := map[string]interface{}{"wordpress.password": "hunter2", "smtp.port": float64(2525)}
+		Expect(injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, params, &fleetConf)).To(Succeed())
+		Expect(fleetConf.Helm.ValuesFrom).To(HaveLen(1))
+
+		Expect(unsealValues(key, filepath.Join(fleetPath, SecretValuesDir))).To(Equal(
+			"mariadb:\n  auth:\n    password: s3cr3t\nsmtp:\n  port: 2525\nwordpress:\n  password: hunter2\n"))
+	})
+
+	It("removes the secret parameters from the override values", func() {
+		overrides := "db:\n  password: s3cr3t\n  user: admin\nport: 8080\nreplicas: 2\n"
+		contents, secrets, err := splitSecretParameters(overrides, []string{"db.password", "port", "missing.key"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contents).To(Equal("db:\n  user: admin\nreplicas: 2\n"))
+		Expect(secrets).To(Equal(map[string]interface{}{"db.password": "s3cr3t", "port": float64(8080)}))
+
+		contents, secrets, err = splitSecretParameters(overrides, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(contents).To(Equal(overrides))
+		Expect(secrets).To(BeEmpty())
+
+		_, _, err = splitSecretParameters("# WARNING: Values are not valid JSON\n{password: [", []string{"password"})
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("reads Vault secrets within the deployment values of the project", func() {
+		app.SecretRefs = []v1beta1.SecretRef{{
+			Key:   "password",
+			Vault: &v1beta1.VaultSecretRef{Path: "../../ma_git_service", Key: "password"},
+		}}
+		values, err := readVaultSecretRefs(context.Background(), app.SecretRefs, projectID)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(values).To(Equal(map[string]string{"password": ""}))
+
+		_, err = readVaultSecretRefs(context.Background(), app.SecretRefs, "")
+		Expect(err).To(MatchError("project-id not found in deployment labels"))
+	})
+
+	It("fails without sealing certificate", func() {
+		GinkgoT().Setenv("SEALED_SECRET_TLS_CRT", "")
+		app.SecretRefs = []v1beta1.SecretRef{{
+			Key:   "password",
+			Vault: &v1beta1.VaultSecretRef{Path: "wordpress/db", Key: "password"},
+		}}
+
+		err := injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, nil, &fleetConf)
+		Expect(err).To(MatchError("sealed secrets certificate is not set, cannot deliver secret values"))
+		Expect(filepath.Join(fleetPath, SecretValuesDir)).ToNot(BeAnExistingFile())
+	})
+
+	It("generates an ExternalSecret for the values of external stores", func() {
+		app.SecretRefs = []v1beta1.SecretRef{
+			{
+				Key:      "mariadb.auth.password",
+				External: &v1beta1.ExternalSecretRef{Store: "aws", StoreKind: "ClusterSecretStore", Key: "wordpress/db", Property: "password"},
+			},
+			{
+				Key:      "smtp",
+				External: &v1beta1.ExternalSecretRef{Store: "local", StoreKind: "SecretStore", Key: "smtp"},
+			},
+		}
+		Expect(injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, nil, &fleetConf)).To(Succeed())
+		Expect(fleetConf.Helm.ValuesFrom).To(HaveLen(1))
+		Expect(fleetConf.Helm.ValuesFrom[0].SecretKeyRef.Name).To(Equal(bundleName + "-external-values"))
+
+		data, err := os.ReadFile(filepath.Join(fleetPath, SecretValuesDir, "external-values.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		external := &ExternalSecret{}
+		Expect(yaml.Unmarshal(data, external)).To(Succeed())
+		Expect(external.Spec.Target.Name).To(Equal(bundleName + "-external-values"))
+		Expect(external.Spec.Target.Template.Data[SecretValuesKey]).To(Equal(
+			"mariadb:\n  auth:\n    password: {{ .ref0 | toJson }}\nsmtp: {{ .ref1 | toJson }}\n"))
+		Expect(external.Spec.Data).To(Equal([]ExternalSecretData{
+			{
+				SecretKey: "ref0",
+				RemoteRef: ExternalSecretRemoteRef{Key: "wordpress/db", Property: "password"},
+				SourceRef: ExternalSecretSourceRef{StoreRef: ExternalSecretStoreRef{Name: "aws", Kind: "ClusterSecretStore"}},
+			},
+			{
+				SecretKey: "ref1",
+				RemoteRef: ExternalSecretRemoteRef{Key: "smtp"},
+				SourceRef: ExternalSecretSourceRef{StoreRef: ExternalSecretStoreRef{Name: "local", Kind: "SecretStore"}},
+			},
+		}))
+	})
+
+	It("removes the bundle once the references are removed", func() {
+		app.SecretRefs = []v1beta1.SecretRef{{
+			Key:      "password",
+			External: &v1beta1.ExternalSecretRef{Store: "aws", Key: "password"},
+		}}
+		Expect(injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, nil, &fleetConf)).To(Succeed())
+		Expect(filepath.Join(fleetPath, SecretValuesDir, "fleet.yaml")).To(BeAnExistingFile())
+
+		app.SecretRefs = nil
+		fleetConf = Config{}
+		Expect(injectSecretValuesToSubDir(app, projectID, bundleName, namespace, fleetPath, nil, &fleetConf)).To(Succeed())
+		Expect(filepath.Join(fleetPath, SecretValuesDir)).ToNot(BeAnExistingFile())
+		Expect(fleetConf.DependsOn).To(BeEmpty())
+	})
+})
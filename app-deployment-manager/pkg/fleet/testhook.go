@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+)
+
+const (
+	TestHookDir = "test-hooks"
+
+	// TestHookLabel is set on the test hook Jobs with the test name
+	TestHookLabel = "app.edge-orchestrator.intel.com/test-hook"
+
+	defaultTestTimeoutSeconds = 600
+
+	testJobInfix = "-test-"
+)
+
+// TestBundleName returns the name of the bundle running the test hooks of an app bundle
+func TestBundleName(bundleName string) string {
+	return "test-" + bundleName
+}
+
+// TestHookJobName returns the name of the Job running a test hook. The
+// deployment generation is part of the name so that the tests run again
+// after every update.
+func TestHookJobName(bundleName string, testName string, generation int64) string {
+	return fmt.Sprintf("%s-%d%s%s", bundleName, generation, testJobInfix, testName)
+}
+
+// TestHookNameFromJob returns the test name of a test hook Job of the given
+// app bundle, or an empty string if the Job is not a test hook.
+func TestHookNameFromJob(bundleName string, jobName string) string {
+	rest, ok := strings.CutPrefix(jobName, bundleName+"-")
+	if !ok {
+		return ""
+	}
+	gen, testName, ok := strings.Cut(rest, testJobInfix)
+	if !ok {
+		return ""
+	}
+	if _, err := strconv.ParseInt(gen, 10, 64); err != nil {
+		return ""
+	}
+	return testName
+}
+
+// ParseTestJobManifest parses a batch/v1 Job manifest of a test hook
+func ParseTestJobManifest(manifest string) (*batchv1.Job, error) {
+	job := &batchv1.Job{}
+	if err := k8syaml.Unmarshal([]byte(manifest), job); err != nil {
+		return nil, fmt.Errorf("invalid Job manifest: %w", err)
+	}
+	if job.Kind != "Job" || (job.APIVersion != "" && job.APIVersion != "batch/v1") {
+		return nil, fmt.Errorf("invalid Job manifest: expected kind Job of batch/v1, got %s of %s", job.Kind, job.APIVersion)
+	}
+	if len(job.Spec.Template.Spec.Containers) == 0 {
+		return nil, fmt.Errorf("invalid Job manifest: no containers")
+	}
+	return job, nil
+}
+
+// injectTestHooksToSubDir generates a Job per test hook in a separate bundle
+// which depends on the app bundle, so that the tests only run once the app
+// is rolled out and ready.
+func injectTestHooksToSubDir(app v1beta1.Application, depID string, generation int64,
+	bundleName string, namespace string, fleetPath string) error {
+	subFleetConf := Config{
+		Name:             TestBundleName(bundleName),
+		DefaultNamespace: namespace,
+		Labels: DeployLabels{
+			AppName:              app.Name,
+			BundleType:           BundleTypeTest.String(),
+			DeploymentID:         depID,
+			DeploymentGeneration: fmt.Sprint(generation),
+		},
+		DependsOn: []DependsOnItem{
+			{
+				Name: bundleName,
+			},
+		},
+		Diff: &DiffOptions{},
+	}
+
+	testDir := filepath.Join(fleetPath, TestHookDir)
+	for _, test := range app.TestHooks {
+		job, err := newTestHookJob(test, bundleName, namespace, generation)
+		if err != nil {
+			return err
+		}
+
+		data, err := k8syaml.Marshal(job)
+		if err != nil {
+			return err
+		}
+		err = utils.WriteFile(testDir, fmt.Sprintf("test-%s.yaml", test.Name), data)
+		if err != nil {
+			return err
+		}
+
+		// Job selector and pod template labels are generated by Kubernetes
+		subFleetConf.Diff.ComparePatches = append(subFleetConf.Diff.ComparePatches, ComparePatch{
+			Kind:       "Job",
+			APIVersion: "batch/v1",
+			Namespace:  job.Namespace,
+			Name:       job.Name,
+			JSONPointers: []string{
+				"/spec/selector",
+				"/spec/template/metadata/labels",
+			},
+		})
+	}
+
+	// Generate fleet.yaml from Config
+	return WriteFleetConfig(testDir, subFleetConf)
+}
+
+func newTestHookJob(test v1beta1.TestHook, bundleName string, namespace string, generation int64) (*batchv1.Job, error) {
+	var job *batchv1.Job
+	switch {
+	case test.Manifest != "":
+		var err error
+		job, err = ParseTestJobManifest(test.Manifest)
+		if err != nil {
+			return nil, fmt.Errorf("test hook %s: %w", test.Name, err)
+		}
+	case test.Job != nil:
+		job = &batchv1.Job{
+			Spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{
+							{
+								Name:    "test",
+								Image:   test.Job.Image,
+								Command: test.Job.Command,
+								Args:    test.Job.Args,
+							},
+						},
+					},
+				},
+			},
+		}
+	default:
+		return nil, fmt.Errorf("test hook %s has neither a job nor a manifest", test.Name)
+	}
+
+	job.TypeMeta = metav1.TypeMeta{
+		Kind:       "Job",
+		APIVersion: "batch/v1",
+	}
+	job.Name = TestHookJobName(bundleName, test.Name, generation)
+	job.Namespace = namespace
+	if job.Labels == nil {
+		job.Labels = map[string]string{}
+	}
+	job.Labels[TestHookLabel] = test.Name
+	if job.Spec.Template.Labels == nil {
+		job.Spec.Template.Labels = map[string]string{}
+	}
+	job.Spec.Template.Labels[TestHookLabel] = test.Name
+
+	// Settings of the test hook take precedence over the ones of the manifest
+	if test.TimeoutSeconds > 0 || job.Spec.ActiveDeadlineSeconds == nil {
+		timeout := int64(test.TimeoutSeconds)
+		if timeout <= 0 {
+			timeout = defaultTestTimeoutSeconds
+		}
+		job.Spec.ActiveDeadlineSeconds = &timeout
+	}
+	if test.BackoffLimit > 0 || job.Spec.BackoffLimit == nil {
+		backoffLimit := test.BackoffLimit
+		job.Spec.BackoffLimit = &backoffLimit
+	}
+	if job.Spec.Template.Spec.RestartPolicy == "" {
+		job.Spec.Template.Spec.RestartPolicy = corev1.RestartPolicyNever
+	}
+
+	return job, nil
+}
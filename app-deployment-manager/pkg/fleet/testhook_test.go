@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fleet
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	yamlv3 "gopkg.in/yaml.v3"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8syaml "sigs.k8s.io/yaml"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/v1beta1"
+)
+
+var _ = Describe("Test hooks", func() {
+	const (
+		bundleName = "b-0123456789abcdef"
+		depID      = "a563356a-b4df-47bb-b620-aae2d74c5129"
+		namespace  = "apps"
+		manifest   = `apiVersion: batch/v1
+kind: Job
+metadata:
+  name: e2e
+spec:
+  backoffLimit: 2
+  template:
+    spec:
+      restartPolicy: OnFailure
+      containers:
+      - name: e2e
+        image: registry.example.com/wordpress-e2e:1.0
+        args: ["--login"]
+`
+	)
+
+	var (
+		app      v1beta1.Application
+		basePath string
+	)
+
+	BeforeEach(func() {
+		app = v1beta1.Application{
+			Name: "wordpress",
+			TestHooks: []v1beta1.TestHook{
+				{
+					Name: "smoke",
+					Job: &v1beta1.JobProbe{
+						Image:   "busybox:1.36",
+						Command: []string{"true"},
+					},
+				},
+				{
+					Name:           "e2e",
+					Artifact:       "wordpress-e2e",
+					Manifest:       manifest,
+					TimeoutSeconds: 120,
+				},
+			},
+		}
+		basePath = GinkgoT().TempDir()
+	})
+
+	readJob := func(testName string) *batchv1.Job {
+		data, err := os.ReadFile(filepath.Join(basePath, TestHookDir, "test-"+testName+".yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		job := &batchv1.Job{}
+		Expect(k8syaml.Unmarshal(data, job)).To(Succeed())
+		return job
+	}
+
+	It("generates a bundle depending on the app bundle with a Job per test", func() {
+		Expect(injectTestHooksToSubDir(app, depID, 2, bundleName, namespace, basePath)).To(Succeed())
+
+		data, err := os.ReadFile(filepath.Join(basePath, TestHookDir, "fleet.yaml"))
+		Expect(err).ToNot(HaveOccurred())
+		conf := &Config{}
+		Expect(yamlv3.Unmarshal(data, conf)).To(Succeed())
+		Expect(conf.Name).To(Equal("test-" + bundleName))
+		Expect(conf.DefaultNamespace).To(Equal(namespace))
+		Expect(conf.Labels.BundleType).To(Equal(BundleTypeTestString))
+		Expect(conf.Labels.DeploymentGeneration).To(Equal("2"))
+		Expect(conf.DependsOn).To(Equal([]DependsOnItem{{Name: bundleName}}))
+		Expect(conf.Diff.ComparePatches).To(HaveLen(2))
+
+		job := readJob("smoke")
+		Expect(job.Name).To(Equal(bundleName + "-2-test-smoke"))
+		Expect(job.Namespace).To(Equal(namespace))
+		Expect(job.Labels[TestHookLabel]).To(Equal("smoke"))
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(0)))
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(int64(defaultTestTimeoutSeconds)))
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyNever))
+		Expect(job.Spec.Template.Spec.Containers[0].Image).To(Equal("busybox:1.36"))
+
+		job = readJob("e2e")
+		Expect(job.Name).To(Equal(bundleName + "-2-test-e2e"))
+		Expect(job.Namespace).To(Equal(namespace))
+		Expect(*job.Spec.BackoffLimit).To(Equal(int32(2)))
+		Expect(*job.Spec.ActiveDeadlineSeconds).To(Equal(int64(120)))
+		Expect(job.Spec.Template.Spec.RestartPolicy).To(Equal(corev1.RestartPolicyOnFailure))
+		Expect(job.Spec.Template.Labels[TestHookLabel]).To(Equal("e2e"))
+		Expect(job.Spec.Template.Spec.Containers[0].Args).To(Equal([]string{"--login"}))
+	})
+
+	It("fails for a test without a Job", func() {
+		app.TestHooks[0].Job = nil
+		Expect(injectTestHooksToSubDir(app, depID, 1, bundleName, namespace, basePath)).ToNot(Succeed())
+	})
+
+	It("rejects manifests which are not a Job", func() {
+		_, err := ParseTestJobManifest("apiVersion: v1\nkind: Pod\nmetadata:\n  name: e2e\n")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseTestJobManifest("apiVersion: batch/v1\nkind: Job\nspec: {}\n")
+		Expect(err).To(HaveOccurred())
+		_, err = ParseTestJobManifest(manifest)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("maps test Job names back to test names", func() {
+		Expect(TestHookNameFromJob(bundleName, TestHookJobName(bundleName, "e2e", 12))).To(Equal("e2e"))
+		Expect(TestHookNameFromJob(bundleName, HealthProbeJobName(bundleName, "e2e", 12))).To(BeEmpty())
+		Expect(TestHookNameFromJob(bundleName, bundleName+"-x-test-e2e")).To(BeEmpty())
+	})
+})
@@ -12,6 +12,7 @@ import (
 	_http "net/http"
 	"net/url"
 	"os"
+	"sort"
 	"strings"
 	"time"
 
@@ -35,11 +36,19 @@ type Repository interface {
 	ExistsOnRemote() (bool, error)
 	Initialize(basedir string) error
 	Clone(basedir string) error
-	CommitFiles() error
+	CommitFiles(info CommitInfo) error
 	PushToRemote() error
 	Delete() error
 }
 
+// CommitInfo describes the change that caused a commit and is recorded in the commit message
+type CommitInfo struct {
+	DeploymentID string
+	Generation   int64
+	RequestedBy  string
+	Summary      string
+}
+
 type GitClient struct {
 	Server       string
 	User         string
@@ -51,6 +60,7 @@ type GitClient struct {
 	GitProvider  string
 	Repo         *git.Repository
 	RemoteConfig config.RemoteConfig
+	Signer       git.Signer
 }
 
 var log = dazl.GetPackageLogger()
@@ -74,6 +84,10 @@ func getGitClientWithSecretService(repoName, server, gitProvider, gitProxy, gitC
 	if err != nil {
 		return nil, err
 	}
+	signer, err := getCommitSigner(context.Background(), vaultManager, vaultClient)
+	if err != nil {
+		return nil, err
+	}
 
 	remote := getRemoteURL(server, user, repoName, gitProvider)
 	return &GitClient{
@@ -89,6 +103,7 @@ func getGitClientWithSecretService(repoName, server, gitProvider, gitProxy, gitC
 			Name: "origin",
 			URLs: []string{remote},
 		},
+		Signer: signer,
 	}, nil
 }
 
@@ -101,6 +116,9 @@ func getGitClientWithoutSecretService(repoName, server, gitProvider, gitProxy, g
 	if err != nil {
 		return nil, err
 	}
+	if utils.GetGitCommitSigning() != "" {
+		return nil, errors.NewInvalid("secret service is disabled but GIT_COMMIT_SIGNING requires the signing key from secret service")
+	}
 
 	remote := getRemoteURL(server, user, repoName, gitProvider)
 	return &GitClient{
@@ -365,7 +383,7 @@ func (g *GitClient) Clone(basedir string) error {
 }
 
 // Commit any file that has changed to the local Git repository
-func (g *GitClient) CommitFiles() error {
+func (g *GitClient) CommitFiles(info CommitInfo) error {
 	if g.Repo == nil {
 		return errors.NewUnavailable("git repo not yet initialized or cloned")
 	}
@@ -388,12 +406,19 @@ func (g *GitClient) CommitFiles() error {
 		return err
 	}
 
-	_, err = w.Commit("Generated Fleet configs", &git.CommitOptions{
+	// Refresh the status so that it reflects the staged changes
+	status, err = w.Status()
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Commit(commitMessage(info, status), &git.CommitOptions{
 		Author: &object.Signature{
 			Name:  "App Deployment Manager",
 			Email: "adm@app-orch.com",
 			When:  time.Now(),
 		},
+		Signer: g.Signer,
 	})
 	if err != nil {
 		return err
@@ -401,6 +426,41 @@ func (g *GitClient) CommitFiles() error {
 	return nil
 }
 
+// Build the commit message from the change info and the list of changed files.
+// Deployment details are added as git trailers so they can be parsed with "git interpret-trailers".
+func commitMessage(info CommitInfo, status git.Status) string {
+	var msg strings.Builder
+
+	msg.WriteString("Generated Fleet configs")
+	if info.Summary != "" {
+		msg.WriteString(": " + info.Summary)
+	}
+	msg.WriteString("\n\n")
+
+	files := make([]string, 0, len(status))
+	for file := range status {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	msg.WriteString("Changed files:\n")
+	for _, file := range files {
+		fmt.Fprintf(&msg, "  %c %s\n", status[file].Staging, file)
+	}
+
+	msg.WriteString("\n")
+	if info.DeploymentID != "" {
+		fmt.Fprintf(&msg, "Deployment-Id: %s\n", info.DeploymentID)
+	}
+	if info.Generation != 0 {
+		fmt.Fprintf(&msg, "Deployment-Generation: %d\n", info.Generation)
+	}
+	if info.RequestedBy != "" {
+		fmt.Fprintf(&msg, "Requested-By: %s\n", info.RequestedBy)
+	}
+
+	return strings.TrimRight(msg.String(), "\n") + "\n"
+}
+
 // Push the modified local Git repository to the remote server
 func (g *GitClient) PushToRemote() error {
 	if g.Repo == nil {
@@ -16,6 +16,13 @@ const (
 	uid     = "12345"
 )
 
+var commitInfo = CommitInfo{
+	DeploymentID: uid,
+	Generation:   1,
+	RequestedBy:  "sample-user",
+	Summary:      "wordpress 0.1.0 (profile default)",
+}
+
 var _ = Describe("Gitclient", func() {
 
 	Describe("Gitea Gitclient", func() {
@@ -149,7 +156,7 @@ var _ = Describe("Gitclient", func() {
 					Expect(os.RemoveAll(basedir)).Should(Succeed())
 					Expect(client.Initialize(basedir)).Should(Succeed())
 					Expect(basedir).Should(BeADirectory())
-					err = client.CommitFiles()
+					err = client.CommitFiles(commitInfo)
 					Expect(err).Should(BeNil())
 				})
 			})
@@ -160,10 +167,39 @@ var _ = Describe("Gitclient", func() {
 					Expect(os.RemoveAll(basedir)).Should(Succeed())
 					Expect(client.Initialize(basedir)).Should(Succeed())
 					Expect(os.WriteFile(filepath.Join(basedir, "foo.txt"), []byte("foo"), 0600)).Should(Succeed())
-					Expect(client.CommitFiles()).Should(Succeed())
+					Expect(client.CommitFiles(commitInfo)).Should(Succeed())
 					Expect(client.PushToRemote()).ShouldNot(Succeed())
 				})
 			})
+			Context("and commit called with commit info", func() {
+				It("should record the deployment details in the commit message", func() {
+					client, err := NewGitClient(uid)
+					Expect(err).Should(BeNil())
+					Expect(os.RemoveAll(basedir)).Should(Succeed())
+					Expect(client.Initialize(basedir)).Should(Succeed())
+					Expect(os.WriteFile(filepath.Join(basedir, "foo.txt"), []byte("foo"), 0600)).Should(Succeed())
+					Expect(client.CommitFiles(commitInfo)).Should(Succeed())
+
+					repo := client.(*GitClient).Repo
+					head, err := repo.Head()
+					Expect(err).Should(BeNil())
+					commit, err := repo.CommitObject(head.Hash())
+					Expect(err).Should(BeNil())
+					Expect(commit.Message).Should(HavePrefix("Generated Fleet configs: wordpress 0.1.0 (profile default)\n"))
+					Expect(commit.Message).Should(ContainSubstring("  A foo.txt\n"))
+					Expect(commit.Message).Should(ContainSubstring("Deployment-Id: 12345\n"))
+					Expect(commit.Message).Should(ContainSubstring("Deployment-Generation: 1\n"))
+					Expect(commit.Message).Should(ContainSubstring("Requested-By: sample-user\n"))
+				})
+			})
+		})
+		When("commit signing is enabled", func() {
+			It("should fail without secret service", func() {
+				os.Setenv("GIT_COMMIT_SIGNING", "gpg")
+				defer os.Unsetenv("GIT_COMMIT_SIGNING")
+				_, err := NewGitClient(uid)
+				Expect(err).ShouldNot(BeNil())
+			})
 		})
 		When("repo is already initialized", func() {
 			It("should fail to initialize twice", func() {
@@ -178,7 +214,7 @@ var _ = Describe("Gitclient", func() {
 			It("should fail to commit and push", func() {
 				client, err := NewGitClient(uid)
 				Expect(err).Should(BeNil())
-				Expect(client.CommitFiles()).ShouldNot(Succeed())
+				Expect(client.CommitFiles(commitInfo)).ShouldNot(Succeed())
 				Expect(client.PushToRemote()).ShouldNot(Succeed())
 			})
 		})
@@ -316,7 +352,7 @@ var _ = Describe("Gitclient", func() {
 					Expect(os.RemoveAll(basedir)).Should(Succeed())
 					Expect(client.Initialize(basedir)).Should(Succeed())
 					Expect(basedir).Should(BeADirectory())
-					err = client.CommitFiles()
+					err = client.CommitFiles(commitInfo)
 					Expect(err).Should(BeNil())
 				})
 			})
@@ -327,7 +363,7 @@ var _ = Describe("Gitclient", func() {
 					Expect(os.RemoveAll(basedir)).Should(Succeed())
 					Expect(client.Initialize(basedir)).Should(Succeed())
 					Expect(os.WriteFile(filepath.Join(basedir, "foo.txt"), []byte("foo"), 0600)).Should(Succeed())
-					Expect(client.CommitFiles()).Should(Succeed())
+					Expect(client.CommitFiles(commitInfo)).Should(Succeed())
 					Expect(client.PushToRemote()).ShouldNot(Succeed())
 				})
 			})
@@ -345,7 +381,7 @@ var _ = Describe("Gitclient", func() {
 			It("should fail to commit and push", func() {
 				client, err := NewGitClient(uid)
 				Expect(err).Should(BeNil())
-				Expect(client.CommitFiles()).ShouldNot(Succeed())
+				Expect(client.CommitFiles(commitInfo)).ShouldNot(Succeed())
 				Expect(client.PushToRemote()).ShouldNot(Succeed())
 			})
 		})
@@ -0,0 +1,189 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package gitclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	stdErr "errors"
+	"io"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/go-git/go-git/v5"
+	vaultAPI "github.com/hashicorp/vault/api"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/vault"
+)
+
+const (
+	SigningFormatGPG = "gpg"
+	SigningFormatSSH = "ssh"
+
+	// sshsig constants as defined in https://github.com/openssh/openssh-portable/blob/master/PROTOCOL.sshsig
+	sshSigVersion   = 1
+	sshSigNamespace = "git"
+	sshSigHashAlg   = "sha512"
+)
+
+var sshSigMagic = [6]byte{'S', 'S', 'H', 'S', 'I', 'G'}
+
+// getCommitSigner returns the signer configured by GIT_COMMIT_SIGNING, or nil if signing is disabled.
+// The private key and its optional passphrase are read from the secret service.
+func getCommitSigner(ctx context.Context, vaultManager vault.Manager, vaultClient *vaultAPI.Client) (git.Signer, error) {
+	format := utils.GetGitCommitSigning()
+	if format == "" {
+		return nil, nil
+	}
+
+	key, err := vaultManager.GetSecretValueString(ctx, vaultClient, utils.GetSecretServiceGitSigningPath(), utils.GetSecretServiceGitSigningKVKeyKey())
+	if err != nil {
+		return nil, err
+	}
+
+	// Passphrase is optional, the key may be stored unencrypted
+	passphrase, err := vaultManager.GetSecretValueString(ctx, vaultClient, utils.GetSecretServiceGitSigningPath(), utils.GetSecretServiceGitSigningKVKeyPassphrase())
+	if errors.IsNotFound(err) {
+		passphrase = ""
+	} else if err != nil {
+		return nil, err
+	}
+
+	return newCommitSigner(format, key, passphrase)
+}
+
+// newCommitSigner creates a git.Signer for the given format from a PEM/armored private key.
+func newCommitSigner(format string, key string, passphrase string) (git.Signer, error) {
+	switch format {
+	case SigningFormatGPG:
+		return newGPGSigner(key, passphrase)
+	case SigningFormatSSH:
+		return newSSHSigner(key, passphrase)
+	default:
+		return nil, errors.NewInvalid("unsupported commit signing format %s", format)
+	}
+}
+
+type gpgSigner struct {
+	entity *openpgp.Entity
+}
+
+func newGPGSigner(key string, passphrase string) (git.Signer, error) {
+	entities, err := openpgp.ReadArmoredKeyRing(strings.NewReader(key))
+	if err != nil {
+		return nil, errors.NewInvalid("failed to read gpg signing key: %v", err)
+	}
+	if len(entities) == 0 || entities[0].PrivateKey == nil {
+		return nil, errors.NewInvalid("gpg signing key has no private key")
+	}
+
+	entity := entities[0]
+	if entity.PrivateKey.Encrypted {
+		if err := entity.DecryptPrivateKeys([]byte(passphrase)); err != nil {
+			return nil, errors.NewInvalid("failed to decrypt gpg signing key: %v", err)
+		}
+	}
+
+	return &gpgSigner{entity: entity}, nil
+}
+
+// Sign returns an armored detached signature of the message
+func (s *gpgSigner) Sign(message io.Reader) ([]byte, error) {
+	var sig bytes.Buffer
+	if err := openpgp.ArmoredDetachSign(&sig, s.entity, message, nil); err != nil {
+		return nil, err
+	}
+	return sig.Bytes(), nil
+}
+
+type sshSigner struct {
+	signer ssh.Signer
+}
+
+func newSSHSigner(key string, passphrase string) (git.Signer, error) {
+	// The passphrase is only used if the key is encrypted, like for gpg keys
+	signer, err := ssh.ParsePrivateKey([]byte(key))
+	var missing *ssh.PassphraseMissingError
+	if stdErr.As(err, &missing) {
+		signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(passphrase))
+	}
+	if err != nil {
+		return nil, errors.NewInvalid("failed to read ssh signing key: %v", err)
+	}
+
+	return &sshSigner{signer: signer}, nil
+}
+
+// Sign returns an armored SSH signature of the message in the format produced by "ssh-keygen -Y sign"
+func (s *sshSigner) Sign(message io.Reader) ([]byte, error) {
+	h := sha512.New()
+	if _, err := io.Copy(h, message); err != nil {
+		return nil, err
+	}
+
+	signedData := ssh.Marshal(struct {
+		Magic     [6]byte
+		Namespace string
+		Reserved  string
+		HashAlg   string
+		Hash      string
+	}{
+		Magic:     sshSigMagic,
+		Namespace: sshSigNamespace,
+		HashAlg:   sshSigHashAlg,
+		Hash:      string(h.Sum(nil)),
+	})
+
+	var sig *ssh.Signature
+	var err error
+	if algSigner, ok := s.signer.(ssh.AlgorithmSigner); ok && s.signer.PublicKey().Type() == ssh.KeyAlgoRSA {
+		// ssh-rsa (SHA-1) signatures are rejected by git, use rsa-sha2-512 instead
+		sig, err = algSigner.SignWithAlgorithm(rand.Reader, signedData, ssh.KeyAlgoRSASHA512)
+	} else {
+		sig, err = s.signer.Sign(rand.Reader, signedData)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	blob := ssh.Marshal(struct {
+		Magic     [6]byte
+		Version   uint32
+		PublicKey string
+		Namespace string
+		Reserved  string
+		HashAlg   string
+		Signature string
+	}{
+		Magic:     sshSigMagic,
+		Version:   sshSigVersion,
+		PublicKey: string(s.signer.PublicKey().Marshal()),
+		Namespace: sshSigNamespace,
+		HashAlg:   sshSigHashAlg,
+		Signature: string(ssh.Marshal(sig)),
+	})
+
+	return armorSSHSignature(blob), nil
+}
+
+func armorSSHSignature(blob []byte) []byte {
+	encoded := base64.StdEncoding.EncodeToString(blob)
+
+	var out bytes.Buffer
+	out.WriteString("-----BEGIN SSH SIGNATURE-----\n")
+	for len(encoded) > 70 {
+		out.WriteString(encoded[:70] + "\n")
+		encoded = encoded[70:]
+	}
+	out.WriteString(encoded + "\n")
+	out.WriteString("-----END SSH SIGNATURE-----\n")
+	return out.Bytes()
+}
@@ -0,0 +1,239 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+package gitclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
+	"github.com/go-git/go-git/v5/plumbing"
+	vaultAPI "github.com/hashicorp/vault/api"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/open-edge-platform/orch-library/go/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/utils"
+	"github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/pkg/vault"
+)
+
+// signingKeyManager serves the values of the signing secret by KV key, failing
+// the lookup of the other keys with err
+type signingKeyManager struct {
+	vault.Manager
+	values map[string]string
+	err    error
+}
+
+func (m *signingKeyManager) GetSecretValueString(_ context.Context, _ *vaultAPI.Client, _ string, key string) (string, error) {
+	if v, ok := m.values[key]; ok {
+		return v, nil
+	}
+	return "", m.err
+}
+
+var _ = Describe("Commit signer", func() {
+	BeforeEach(func() {
+		os.Setenv("SECRET_SERVICE_ENABLED", "false")
+		os.Setenv("GIT_USER", "foo")
+		os.Setenv("GIT_PASSWORD", "bar")
+		os.Setenv("GIT_SERVER", "https://localhost:12345")
+		os.Setenv("GIT_PROVIDER", "gitea")
+	})
+
+	commitWithSigner := func(format, key string) (string, []byte) {
+		signer, err := newCommitSigner(format, key, "")
+		Expect(err).Should(BeNil())
+
+		client, err := NewGitClient(uid)
+		Expect(err).Should(BeNil())
+		client.(*GitClient).Signer = signer
+
+		Expect(os.RemoveAll(basedir)).Should(Succeed())
+		Expect(client.Initialize(basedir)).Should(Succeed())
+		Expect(os.WriteFile(filepath.Join(basedir, "foo.txt"), []byte("foo"), 0600)).Should(Succeed())
+		Expect(client.CommitFiles(commitInfo)).Should(Succeed())
+
+		repo := client.(*GitClient).Repo
+		head, err := repo.Head()
+		Expect(err).Should(BeNil())
+		commit, err := repo.CommitObject(head.Hash())
+		Expect(err).Should(BeNil())
+
+		encoded := &plumbing.MemoryObject{}
+		Expect(commit.EncodeWithoutSignature(encoded)).Should(Succeed())
+		r, err := encoded.Reader()
+		Expect(err).Should(BeNil())
+		var payload bytes.Buffer
+		_, err = payload.ReadFrom(r)
+		Expect(err).Should(BeNil())
+
+		return commit.PGPSignature, payload.Bytes()
+	}
+
+	When("signing format is unknown", func() {
+		It("should fail", func() {
+			_, err := newCommitSigner("x509", "key", "")
+			Expect(err).ShouldNot(BeNil())
+		})
+	})
+
+	When("signing key is not encrypted", func() {
+		It("should ignore the passphrase for ssh", func() {
+			_, priv, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).Should(BeNil())
+			block, err := ssh.MarshalPrivateKey(priv, "")
+			Expect(err).Should(BeNil())
+
+			_, err = newCommitSigner(SigningFormatSSH, string(pem.EncodeToMemory(block)), "secret")
+			Expect(err).Should(BeNil())
+		})
+	})
+
+	When("signing key is invalid", func() {
+		It("should fail for gpg", func() {
+			_, err := newCommitSigner(SigningFormatGPG, "not a key", "")
+			Expect(err).ShouldNot(BeNil())
+		})
+		It("should fail for ssh", func() {
+			_, err := newCommitSigner(SigningFormatSSH, "not a key", "")
+			Expect(err).ShouldNot(BeNil())
+		})
+	})
+
+	When("reading the signing key from the secret service", func() {
+		var manager *signingKeyManager
+
+		BeforeEach(func() {
+			os.Setenv("GIT_COMMIT_SIGNING", SigningFormatSSH)
+			DeferCleanup(os.Unsetenv, "GIT_COMMIT_SIGNING")
+
+			_, priv, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).Should(BeNil())
+			block, err := ssh.MarshalPrivateKeyWithPassphrase(priv, "", []byte("secret"))
+			Expect(err).Should(BeNil())
+
+			manager = &signingKeyManager{
+				values: map[string]string{
+					utils.GetSecretServiceGitSigningKVKeyKey():        string(pem.EncodeToMemory(block)),
+					utils.GetSecretServiceGitSigningKVKeyPassphrase(): "secret",
+				},
+				err: errors.NewNotFound("not found"),
+			}
+		})
+
+		It("should decrypt the key with the passphrase", func() {
+			signer, err := getCommitSigner(context.Background(), manager, nil)
+			Expect(err).Should(BeNil())
+			Expect(signer).ShouldNot(BeNil())
+		})
+
+		It("should use the key without passphrase when none is stored", func() {
+			_, priv, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).Should(BeNil())
+			block, err := ssh.MarshalPrivateKey(priv, "")
+			Expect(err).Should(BeNil())
+			manager.values = map[string]string{
+				utils.GetSecretServiceGitSigningKVKeyKey(): string(pem.EncodeToMemory(block)),
+			}
+
+			signer, err := getCommitSigner(context.Background(), manager, nil)
+			Expect(err).Should(BeNil())
+			Expect(signer).ShouldNot(BeNil())
+		})
+
+		It("should fail when the passphrase cannot be read", func() {
+			delete(manager.values, utils.GetSecretServiceGitSigningKVKeyPassphrase())
+			manager.err = errors.NewUnavailable("vault unavailable")
+
+			_, err := getCommitSigner(context.Background(), manager, nil)
+			Expect(errors.IsUnavailable(err)).Should(BeTrue())
+		})
+	})
+
+	When("signing with a gpg key", func() {
+		It("should create a verifiable commit", func() {
+			entity, err := openpgp.NewEntity("App Deployment Manager", "", "adm@app-orch.com", nil)
+			Expect(err).Should(BeNil())
+
+			var privKey bytes.Buffer
+			w, err := armor.Encode(&privKey, openpgp.PrivateKeyType, nil)
+			Expect(err).Should(BeNil())
+			Expect(entity.SerializePrivate(w, nil)).Should(Succeed())
+			Expect(w.Close()).Should(Succeed())
+
+			var pubKey bytes.Buffer
+			w, err = armor.Encode(&pubKey, openpgp.PublicKeyType, nil)
+			Expect(err).Should(BeNil())
+			Expect(entity.Serialize(w)).Should(Succeed())
+			Expect(w.Close()).Should(Succeed())
+
+			signature, payload := commitWithSigner(SigningFormatGPG, privKey.String())
+			Expect(signature).Should(HavePrefix("-----BEGIN PGP SIGNATURE-----"))
+
+			keyring, err := openpgp.ReadArmoredKeyRing(&pubKey)
+			Expect(err).Should(BeNil())
+			_, err = openpgp.CheckArmoredDetachedSignature(keyring, bytes.NewReader(payload), strings.NewReader(signature), nil)
+			Expect(err).Should(BeNil())
+		})
+	})
+
+	When("signing with an ssh key", func() {
+		It("should create a verifiable commit", func() {
+			pub, priv, err := ed25519.GenerateKey(rand.Reader)
+			Expect(err).Should(BeNil())
+			block, err := ssh.MarshalPrivateKey(priv, "")
+			Expect(err).Should(BeNil())
+
+			signature, payload := commitWithSigner(SigningFormatSSH, string(pem.EncodeToMemory(block)))
+			Expect(signature).Should(HavePrefix("-----BEGIN SSH SIGNATURE-----\n"))
+			Expect(signature).Should(HaveSuffix("-----END SSH SIGNATURE-----\n"))
+
+			body := strings.TrimPrefix(signature, "-----BEGIN SSH SIGNATURE-----\n")
+			body = strings.TrimSuffix(body, "-----END SSH SIGNATURE-----\n")
+			blob, err := base64.StdEncoding.DecodeString(strings.ReplaceAll(body, "\n", ""))
+			Expect(err).Should(BeNil())
+
+			var sig struct {
+				Magic     [6]byte
+				Version   uint32
+				PublicKey string
+				Namespace string
+				Reserved  string
+				HashAlg   string
+				Signature string
+			}
+			Expect(ssh.Unmarshal(blob, &sig)).Should(Succeed())
+			Expect(sig.Magic).Should(Equal(sshSigMagic))
+			Expect(sig.Namespace).Should(Equal(sshSigNamespace))
+
+			sshPub, err := ssh.NewPublicKey(pub)
+			Expect(err).Should(BeNil())
+			Expect([]byte(sig.PublicKey)).Should(Equal(sshPub.Marshal()))
+
+			var sshSig ssh.Signature
+			Expect(ssh.Unmarshal([]byte(sig.Signature), &sshSig)).Should(Succeed())
+
+			hash := sha512.Sum512(payload)
+			signedData := ssh.Marshal(struct {
+				Magic     [6]byte
+				Namespace string
+				Reserved  string
+				HashAlg   string
+				Hash      string
+			}{sshSigMagic, sshSigNamespace, "", sshSigHashAlg, string(hash[:])})
+			Expect(sshPub.Verify(signedData, &sshSig)).Should(Succeed())
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/open-edge-platform/orch-library/go/pkg/errors"
@@ -33,6 +34,10 @@ const (
 	defaultHarborServiceUsernameKey  = "username"
 	defaultHarborServicePasswordKey  = "password"
 	defaultHarborServiceCertKey      = "cacerts"
+	defaultGitSigningPath            = "ma_git_signing"
+	defaultGitSigningKeyKey          = "signingKey"
+	defaultGitSigningPassphraseKey   = "passphrase"
+	defaultDeploymentValuesPath      = "ma_deployment_values"
 	defaultGitProxy                  = ""
 	defaultGitCaCert                 = ""
 	defaultSecretServiceEndpoint     = "http://vault.orch-platform.svc.cluster.local:8200" // #nosec G101
@@ -49,6 +54,27 @@ const (
 	envKeyGitAccessKey       = "GIT_ACCESSKEY"
 	envKeyGitSecretAccessKey = "GIT_SECRET_ACCESSKEY" // #nosec G101
 	envKeyGitAwsSSHKey       = "GIT_AWSSSHKEY"
+	envKeyGitCommitSigning   = "GIT_COMMIT_SIGNING"
+
+	// number of requests reconciled concurrently by each controller
+	envKeyDeploymentMaxConcurrentReconciles        = "DEPLOYMENT_MAX_CONCURRENT_RECONCILES"
+	envKeyDeploymentClusterMaxConcurrentReconciles = "DEPLOYMENTCLUSTER_MAX_CONCURRENT_RECONCILES"
+	envKeyClusterMaxConcurrentReconciles           = "CLUSTER_MAX_CONCURRENT_RECONCILES"
+	defaultMaxConcurrentReconciles                 = 1
+
+	// image running the http and prometheus health probes on the edge clusters
+	envKeyHealthProbeImage  = "HEALTH_PROBE_IMAGE"
+	defaultHealthProbeImage = "curlimages/curl:8.11.1"
+
+	// image policy enforced on the images of the deployed values
+	envKeyImageDigestPinning           = "IMAGE_DIGEST_PINNING"
+	envKeyImagePolicyAllowedRegistries = "IMAGE_POLICY_ALLOWED_REGISTRIES"
+	envKeyImagePolicyDenyLatestTag     = "IMAGE_POLICY_DENY_LATEST_TAG"
+	envKeyImagePolicyCosignPublicKey   = "IMAGE_POLICY_COSIGN_PUBLIC_KEY"
+
+	// certificate of the sealed-secrets controller of the edge clusters, used
+	// to seal the override values read from the secret service
+	envKeySealedSecretCert = "SEALED_SECRET_TLS_CRT" // #nosec G101
 
 	// for secret service - this is just key to get os environment
 	envKeyServiceAccount                              = "SERVICE_ACCOUNT"                           // #nosec G101
@@ -67,6 +93,10 @@ const (
 	envKeySecretServiceHarborServiceKVKeyUsername     = "SECRET_HARBOR_SERVICE_USERNAME_KVKEY"      // #nosec G101
 	envKeySecretServiceHarborServiceKVKeyPassword     = "SECRET_HARBOR_SERVICE_PASSWORD_KVKEY"      // #nosec G101
 	envKeySecretServiceHarborServiceKVKeyCert         = "SECRET_HARBOR_SERVICE_CERT_KVKEY"          // #nosec G101
+	envKeySecretServiceGitSigningPath                 = "SECRET_GIT_SIGNING_PATH"                   // #nosec G101
+	envKeySecretServiceGitSigningKVKeyKey             = "SECRET_GIT_SIGNING_KEY_KVKEY"              // #nosec G101
+	envKeySecretServiceGitSigningKVKeyPassphrase      = "SECRET_GIT_SIGNING_PASSPHRASE_KVKEY"       // #nosec G101
+	envKeySecretServiceDeploymentValuesPath           = "SECRET_DEPLOYMENT_VALUES_PATH"             // #nosec G101
 
 	envKeyKeycloakServiceEndpoint = "KEYCLOAK_SERVICE_ENDPOINT"
 
@@ -256,6 +286,42 @@ func GetSecretServiceHarborServiceKVKeyCert() string {
 	return region
 }
 
+// GetSecretServiceGitSigningPath returns secret service path of the commit signing key
+func GetSecretServiceGitSigningPath() string {
+	path, ok := os.LookupEnv(envKeySecretServiceGitSigningPath)
+	if !ok {
+		return defaultGitSigningPath
+	}
+	return path
+}
+
+// GetSecretServiceGitSigningKVKeyKey returns KV key for commit signing private key from secret service
+func GetSecretServiceGitSigningKVKeyKey() string {
+	key, ok := os.LookupEnv(envKeySecretServiceGitSigningKVKeyKey)
+	if !ok {
+		return defaultGitSigningKeyKey
+	}
+	return key
+}
+
+// GetSecretServiceGitSigningKVKeyPassphrase returns KV key for commit signing key passphrase from secret service
+func GetSecretServiceGitSigningKVKeyPassphrase() string {
+	key, ok := os.LookupEnv(envKeySecretServiceGitSigningKVKeyPassphrase)
+	if !ok {
+		return defaultGitSigningPassphraseKey
+	}
+	return key
+}
+
+// GetSecretServiceDeploymentValuesPath returns secret service path of the override values referred to by deployments
+func GetSecretServiceDeploymentValuesPath() string {
+	path, ok := os.LookupEnv(envKeySecretServiceDeploymentValuesPath)
+	if !ok {
+		return defaultDeploymentValuesPath
+	}
+	return path
+}
+
 // IsSecretServiceEnabled returns true if SecretService is enabled; otherwise false
 func IsSecretServiceEnabled() (bool, error) {
 	flag, ok := os.LookupEnv(envKeySecretServiceEnabled)
@@ -315,6 +381,51 @@ func GetGitProxy() string {
 	return proxy
 }
 
+// GetGitCommitSigning returns env value for git commit signing format (gpg, ssh or empty if disabled)
+func GetGitCommitSigning() string {
+	return os.Getenv(envKeyGitCommitSigning)
+}
+
+// GetHealthProbeImage returns the image used to run http and prometheus health probes
+func GetHealthProbeImage() string {
+	image, ok := os.LookupEnv(envKeyHealthProbeImage)
+	if !ok || image == "" {
+		return defaultHealthProbeImage
+	}
+	return image
+}
+
+// GetSealedSecretCert returns the PEM certificate used to seal secrets for the edge clusters
+func GetSealedSecretCert() string {
+	return os.Getenv(envKeySealedSecretCert)
+}
+
+// IsImageDigestPinningEnabled returns true if image tags are resolved and pinned to their digest
+func IsImageDigestPinningEnabled() bool {
+	return os.Getenv(envKeyImageDigestPinning) == "true"
+}
+
+// GetImagePolicyAllowedRegistries returns the registries images may be pulled from, all are allowed if empty
+func GetImagePolicyAllowedRegistries() []string {
+	var registries []string
+	for _, r := range strings.Split(os.Getenv(envKeyImagePolicyAllowedRegistries), ",") {
+		if r = strings.TrimSpace(r); r != "" {
+			registries = append(registries, r)
+		}
+	}
+	return registries
+}
+
+// IsImagePolicyDenyLatestTagEnabled returns true if images using the latest tag are rejected
+func IsImagePolicyDenyLatestTagEnabled() bool {
+	return os.Getenv(envKeyImagePolicyDenyLatestTag) == "true"
+}
+
+// GetImagePolicyCosignPublicKey returns the PEM public key images must be signed with, signatures are not verified if empty
+func GetImagePolicyCosignPublicKey() string {
+	return os.Getenv(envKeyImagePolicyCosignPublicKey)
+}
+
 // GetGitRegion returns env value for git region (AWS)
 func GetGitRegion() (string, error) {
 	region, ok := os.LookupEnv(envKeyGitRegion)
@@ -389,6 +500,29 @@ func GetIntegerFromEnv(envvar string, defaultvalue int) (int, string) {
 	return value, message
 }
 
+// GetDeploymentMaxConcurrentReconciles returns the number of deployments reconciled concurrently
+func GetDeploymentMaxConcurrentReconciles() (int, string) {
+	return getMaxConcurrentReconciles(envKeyDeploymentMaxConcurrentReconciles)
+}
+
+// GetDeploymentClusterMaxConcurrentReconciles returns the number of deployment clusters reconciled concurrently
+func GetDeploymentClusterMaxConcurrentReconciles() (int, string) {
+	return getMaxConcurrentReconciles(envKeyDeploymentClusterMaxConcurrentReconciles)
+}
+
+// GetClusterMaxConcurrentReconciles returns the number of clusters reconciled concurrently
+func GetClusterMaxConcurrentReconciles() (int, string) {
+	return getMaxConcurrentReconciles(envKeyClusterMaxConcurrentReconciles)
+}
+
+func getMaxConcurrentReconciles(envvar string) (int, string) {
+	value, message := GetIntegerFromEnv(envvar, defaultMaxConcurrentReconciles)
+	if value < 1 {
+		return defaultMaxConcurrentReconciles, fmt.Sprintf("%s must be positive - using default of %d", envvar, defaultMaxConcurrentReconciles)
+	}
+	return value, message
+}
+
 // GetKeycloakServiceEndpoint returns secret service endpoint
 func GetKeycloakServiceEndpoint() string {
 	endpoint, ok := os.LookupEnv(envKeyKeycloakServiceEndpoint)
@@ -537,4 +537,22 @@ var _ = Describe("Test Utils", func() {
 			Expect(m).To(Equal("parsing TEST_INTEGER_IN_ENV failed - strconv.Atoi: parsing \"Not an integer!\": invalid syntax - using default of 20"))
 		})
 	})
+
+	Describe("Test GetDeploymentMaxConcurrentReconciles", func() {
+		It("successfully got max concurrent reconciles from env vars", func() {
+			os.Setenv(envKeyDeploymentMaxConcurrentReconciles, "4")
+			defer os.Unsetenv(envKeyDeploymentMaxConcurrentReconciles)
+			v, m := GetDeploymentMaxConcurrentReconciles()
+			Expect(v).To(Equal(4))
+			Expect(m).To(BeEmpty())
+		})
+
+		It("got default value when env var is not positive", func() {
+			os.Setenv(envKeyDeploymentMaxConcurrentReconciles, "0")
+			defer os.Unsetenv(envKeyDeploymentMaxConcurrentReconciles)
+			v, m := GetDeploymentMaxConcurrentReconciles()
+			Expect(v).To(Equal(defaultMaxConcurrentReconciles))
+			Expect(m).To(Equal("DEPLOYMENT_MAX_CONCURRENT_RECONCILES must be positive - using default of 1"))
+		})
+	})
 })
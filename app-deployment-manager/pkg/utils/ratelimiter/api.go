@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ratelimiter
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+const (
+	// apiRateLimitPrefix prefixes the environment variables of the API rate
+	// limits, e.g. API_RATE_LIMIT_MUTATE_PROJECT_QPS
+	apiRateLimitPrefix = "API_RATE_LIMIT"
+)
+
+// Limit is a token bucket refilled at QPS tokens per second holding up to
+// Burst tokens, a zero QPS disables the limit
+type Limit struct {
+	QPS   float64
+	Burst int
+}
+
+// APILimits are the limits of a class of RPCs of the API, applied to each
+// active project and to each user
+type APILimits struct {
+	Project Limit
+	User    Limit
+}
+
+// GetAPILimits returns the limits of a class of RPCs of the API set in the
+// environment, the defaults are kept for the variables not set
+func GetAPILimits(class string, defaults APILimits) (APILimits, error) {
+	limits := defaults
+	var err error
+	if limits.Project, err = getLimit(class, "PROJECT", defaults.Project); err != nil {
+		return limits, err
+	}
+	if limits.User, err = getLimit(class, "USER", defaults.User); err != nil {
+		return limits, err
+	}
+	return limits, nil
+}
+
+func getLimit(class string, key string, defaults Limit) (Limit, error) {
+	limit := defaults
+	prefix := strings.Join([]string{apiRateLimitPrefix, strings.ToUpper(class), key}, "_")
+
+	if qps, ok := os.LookupEnv(prefix + "_QPS"); ok && qps != "" {
+		value, err := strconv.ParseFloat(qps, 64)
+		if err != nil || value < 0 {
+			return limit, fmt.Errorf("invalid %s_QPS %q", prefix, qps)
+		}
+		limit.QPS = value
+	}
+
+	if burst, ok := os.LookupEnv(prefix + "_BURST"); ok && burst != "" {
+		value, err := strconv.Atoi(burst)
+		if err != nil || value < 0 {
+			return limit, fmt.Errorf("invalid %s_BURST %q", prefix, burst)
+		}
+		limit.Burst = value
+	}
+	return limit, nil
+}
@@ -6,6 +6,7 @@ package utils
 
 import (
 	"context"
+	"encoding/json"
 	stdErr "errors"
 	"fmt"
 	"math"
@@ -51,6 +52,11 @@ var (
 
 const (
 	appRefFilter = ".metadata.deployment-package-ref"
+
+	kubeConfigSecretKey = "value"
+
+	// clusterClientTimeout bounds each request of the edge cluster clientsets
+	clusterClientTimeout = 30 * time.Second
 )
 
 // CreateClient creates the k8s client
@@ -101,6 +107,21 @@ func LogActivity(ctx context.Context, verb string, thing string, args ...string)
 	utilsLog.Infof("User '%s' %s %s %s", user, verb, thing, strings.Join(args, "/"))
 }
 
+// GetRequestUser returns the name of the user, or the client ID for M2M tokens, that sent the request
+func GetRequestUser(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	if len(md.Get("name")) > 0 {
+		return md.Get("name")[0]
+	}
+	if len(md.Get("client_id")) > 0 {
+		return md.Get("client_id")[0]
+	}
+	return ""
+}
+
 func CreateRestConfig(kubeConfig string) (*rest.Config, error) {
 	var config *rest.Config
 	var err error
@@ -248,6 +269,41 @@ func GetDeploymentGeneration(bd *fleetv1alpha1.BundleDeployment) int64 {
 	return 0
 }
 
+// GetOfflineBundles returns the generations of the deployment exported in
+// offline bundles by cluster ID
+func GetOfflineBundles(d *v1beta1.Deployment) (map[string]int64, error) {
+	exported := map[string]int64{}
+	v, ok := d.Annotations[string(v1beta1.OfflineBundles)]
+	if !ok {
+		return exported, nil
+	}
+	if err := json.Unmarshal([]byte(v), &exported); err != nil {
+		return map[string]int64{}, err
+	}
+	return exported, nil
+}
+
+// GetBundleState returns the state Fleet displays for the BundleDeployment
+func GetBundleState(bd *fleetv1alpha1.BundleDeployment) string {
+	return bd.Status.Display.State
+}
+
+// GetLastUpdateTime returns the latest update time of the BundleDeployment
+// conditions, nil if none is set
+func GetLastUpdateTime(bd *fleetv1alpha1.BundleDeployment) *metav1.Time {
+	var last *metav1.Time
+	for _, c := range bd.Status.Conditions {
+		t, err := time.Parse(time.RFC3339, c.LastUpdateTime)
+		if err != nil {
+			continue
+		}
+		if last == nil || t.After(last.Time) {
+			last = &metav1.Time{Time: t}
+		}
+	}
+	return last
+}
+
 func AppendMessage(orig string, next string) string {
 	if orig == "" {
 		return next
@@ -387,6 +443,29 @@ func GetSecretValue(ctx context.Context, s *kubernetes.Clientset, namespace stri
 	return value, nil
 }
 
+// NewClusterClientset returns a clientset for an edge cluster built from its kubeconfig secret
+func NewClusterClientset(ctx context.Context, c client.Reader, cluster *v1beta1.Cluster) (kubernetes.Interface, error) {
+	if cluster.Spec.KubeConfigSecretName == "" {
+		return nil, fmt.Errorf("cluster %s has no kubeconfig secret", cluster.Name)
+	}
+
+	secret := &v1.Secret{}
+	key := client.ObjectKey{
+		Namespace: cluster.Namespace,
+		Name:      cluster.Spec.KubeConfigSecretName,
+	}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, err
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig(secret.Data[kubeConfigSecretKey])
+	if err != nil {
+		return nil, err
+	}
+	config.Timeout = clusterClientTimeout
+	return kubernetes.NewForConfig(config)
+}
+
 func UpdateStatusCondition(conds []metav1.Condition, condType string, status metav1.ConditionStatus, reason string, err error) []metav1.Condition {
 	condition := metav1.Condition{
 		Type:               condType,
@@ -30,6 +30,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -168,6 +169,43 @@ var _ = Describe("Test Utils", func() {
 		})
 	})
 
+	Describe("Test GetLastUpdateTime", func() {
+		It("Returns nil when no condition has an update time", func() {
+			v := GetLastUpdateTime(&fleetv1alpha1.BundleDeployment{
+				Status: fleetv1alpha1.BundleDeploymentStatus{
+					Conditions: []genericcondition.GenericCondition{
+						{
+							Type:           "Ready",
+							LastUpdateTime: "invalid",
+						},
+					},
+				},
+			})
+			Expect(v).To(BeNil())
+		})
+		It("Returns the latest condition update time", func() {
+			v := GetLastUpdateTime(&fleetv1alpha1.BundleDeployment{
+				Status: fleetv1alpha1.BundleDeploymentStatus{
+					Conditions: []genericcondition.GenericCondition{
+						{
+							Type:           "Ready",
+							LastUpdateTime: "2025-03-01T10:00:00Z",
+						},
+						{
+							Type:           "Deployed",
+							LastUpdateTime: "2025-03-02T10:00:00Z",
+						},
+						{
+							Type: "Monitored",
+						},
+					},
+				},
+			})
+			Expect(v).ToNot(BeNil())
+			Expect(v.Time).To(Equal(time.Date(2025, 3, 2, 10, 0, 0, 0, time.UTC)))
+		})
+	})
+
 	Describe("Test GetMessage", func() {
 		It("Returns no message when BundleDeployment is Ready", func() {
 			v := GetMessage(&fleetv1alpha1.BundleDeployment{
@@ -264,6 +302,36 @@ var _ = Describe("Test Utils", func() {
 		})
 	})
 
+	Describe("Test GetOfflineBundles", func() {
+		It("Returns the generations exported by cluster", func() {
+			v, err := GetOfflineBundles(&v1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						string(v1beta1.OfflineBundles): `{"cluster-a":1,"cluster-b":3}`,
+					},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(Equal(map[string]int64{"cluster-a": 1, "cluster-b": 3}))
+		})
+		It("Returns none when annotation is not present", func() {
+			v, err := GetOfflineBundles(&v1beta1.Deployment{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(v).To(BeEmpty())
+		})
+		It("Returns an error when annotation is invalid", func() {
+			v, err := GetOfflineBundles(&v1beta1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{
+						string(v1beta1.OfflineBundles): "invalid",
+					},
+				},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(v).To(BeEmpty())
+		})
+	})
+
 	Describe("Test UpdateStatusCondition", func() {
 		It("Adds the Status condition when not present", func() {
 			v := UpdateStatusCondition([]metav1.Condition{}, "Ready", metav1.ConditionTrue, "Reason", nil)
@@ -473,6 +541,42 @@ var _ = Describe("Test Utils", func() {
 			Expect(s.Message()).Should(Equal("the server does not allow this method on the requested resource (get secrets test-secretname)"))
 		})
 	})
+
+	Describe("Test NewClusterClientset", func() {
+		It("bounds the requests to the edge cluster", func() {
+			kubeconfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: edge
+  cluster:
+    server: https://edge.example.com:6443
+contexts:
+- name: edge
+  context:
+    cluster: edge
+    user: edge
+current-context: edge
+users:
+- name: edge
+  user:
+    token: edge-token
+`
+			reader := ctrlfake.NewClientBuilder().WithObjects(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "edge-kubeconfig", Namespace: "project"},
+				Data:       map[string][]byte{kubeConfigSecretKey: []byte(kubeconfig)},
+			}).Build()
+			cluster := &v1beta1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "project"},
+				Spec:       v1beta1.ClusterSpec{KubeConfigSecretName: "edge-kubeconfig"},
+			}
+
+			cs, err := NewClusterClientset(context.Background(), reader, cluster)
+			Expect(err).ToNot(HaveOccurred())
+			restClient, ok := cs.CoreV1().RESTClient().(*rest.RESTClient)
+			Expect(ok).To(BeTrue())
+			Expect(restClient.Client.Timeout).To(Equal(clusterClientTimeout))
+		})
+	})
 })
 
 func mockK8Client(tsUrl string) *kubernetes.Clientset {
@@ -545,6 +649,16 @@ func TestLogActivity_Client(t *testing.T) {
 	}
 }
 
+func TestGetRequestUser(t *testing.T) {
+	ctx := metadata.NewIncomingContext(context.TODO(), metadata.Pairs("name", "sample-user", "client_id", "sample-client"))
+	assert.Equal(t, "sample-user", GetRequestUser(ctx))
+
+	ctx = metadata.NewIncomingContext(context.TODO(), metadata.Pairs("client_id", "sample-client"))
+	assert.Equal(t, "sample-client", GetRequestUser(ctx))
+
+	assert.Equal(t, "", GetRequestUser(context.TODO()))
+}
+
 func TestCreateRestConfig(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
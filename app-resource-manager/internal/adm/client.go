@@ -20,7 +20,7 @@ var log = dazl.GetPackageLogger()
 
 //go:generate mockery --name Client --filename adm_client_mock.go --structname MockADMClient
 type Client interface {
-	GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error)
+	GetKubeConfig(ctx context.Context, clusterID string, namespace string) ([]byte, error)
 	GetAppNamespace(ctx context.Context, appID string) (string, error)
 }
 
@@ -49,8 +49,9 @@ func (c *client) GetAppNamespace(ctx context.Context, appID string) (string, err
 	return resp.Namespace, nil
 }
 
-// GetKubeConfig gets kubeconfig based on a given cluster ID
-func (c *client) GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error) {
+// GetKubeConfig gets kubeconfig based on a given cluster ID, with credentials
+// scoped to the given application namespace
+func (c *client) GetKubeConfig(ctx context.Context, clusterID string, namespace string) ([]byte, error) {
 	ctx, cancel, err := addToOutgoingContext(ctx, c.vaultAuthClient, true)
 	if err != nil {
 		log.Warn(err)
@@ -60,6 +61,7 @@ func (c *client) GetKubeConfig(ctx context.Context, clusterID string) ([]byte, e
 
 	request := &clusterapi.GetKubeConfigRequest{
 		ClusterId: clusterID,
+		Namespace: namespace,
 	}
 
 	resp, err := c.clusterServiceClient.GetKubeConfig(ctx, request)
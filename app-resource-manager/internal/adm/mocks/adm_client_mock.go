@@ -41,9 +41,9 @@ func (_m *MockADMClient) GetAppNamespace(ctx context.Context, appID string) (str
 	return r0, r1
 }
 
-// GetKubeConfig provides a mock function with given fields: ctx, clusterID
-func (_m *MockADMClient) GetKubeConfig(ctx context.Context, clusterID string) ([]byte, error) {
-	ret := _m.Called(ctx, clusterID)
+// GetKubeConfig provides a mock function with given fields: ctx, clusterID, namespace
+func (_m *MockADMClient) GetKubeConfig(ctx context.Context, clusterID string, namespace string) ([]byte, error) {
+	ret := _m.Called(ctx, clusterID, namespace)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GetKubeConfig")
@@ -51,19 +51,19 @@ func (_m *MockADMClient) GetKubeConfig(ctx context.Context, clusterID string) ([
 
 	var r0 []byte
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]byte, error)); ok {
-		return rf(ctx, clusterID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]byte, error)); ok {
+		return rf(ctx, clusterID, namespace)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
-		r0 = rf(ctx, clusterID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []byte); ok {
+		r0 = rf(ctx, clusterID, namespace)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]byte)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, clusterID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, clusterID, namespace)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -66,7 +66,7 @@ type serviceProxyURL struct {
 }
 
 func (m *manager) DeletePod(ctx context.Context, clusterID string, namespace string, podName string) error {
-	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, m.configPath)
+	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, namespace)
 	if err != nil {
 		log.Warnw("Failed to create a k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return err
@@ -152,21 +152,21 @@ func (m *manager) GetPodWorkloads(ctx context.Context, appID string, clusterID s
 	log.Infow("Getting Pod Workloads", dazl.String("appID", appID), dazl.String("clusterID", clusterID))
 	podWorkloads := make([]*resourceapiv2.AppWorkload, 0)
 
-	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, m.configPath)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
 	if err != nil {
-		log.Warnw("Failed to create a k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
 		return nil, err
 	}
 
-	dynK8sClient, err := getDynamicK8sClient(ctx, clusterID, m.admClient, m.configPath)
+	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, appNamespace)
 	if err != nil {
-		log.Warnw("Failed to create a dynamic k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
+		log.Warnw("Failed to create a k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return nil, err
 	}
 
-	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	dynK8sClient, err := getDynamicK8sClient(ctx, clusterID, m.admClient, appNamespace)
 	if err != nil {
-		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		log.Warnw("Failed to create a dynamic k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return nil, err
 	}
 
@@ -237,17 +237,17 @@ func (m *manager) GetPodWorkloads(ctx context.Context, appID string, clusterID s
 }
 
 func (m *manager) GetAppEndpointsV2(ctx context.Context, appID string, clusterID string) ([]*resourceapiv2.AppEndpoint, error) {
-	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, m.configPath)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
 	if err != nil {
-		log.Warnw("Failed to create a k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
 		return nil, err
 	}
-	appEndpoints := make([]*resourceapiv2.AppEndpoint, 0)
-	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	k8sClient, err := getK8sClient(ctx, clusterID, m.admClient, appNamespace)
 	if err != nil {
-		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		log.Warnw("Failed to create a k8s client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return nil, err
 	}
+	appEndpoints := make([]*resourceapiv2.AppEndpoint, 0)
 
 	activeProjectID, err := opa.GetActiveProjectID(ctx)
 	if err != nil {
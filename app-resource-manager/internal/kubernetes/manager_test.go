@@ -516,11 +516,11 @@ func (s *KubernetesManagerTestSuite) TestDeletePodWorkload() {
 	assert.NoError(s.T(), err)
 
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return fakeK8sClient, nil
 	}
 	origDynamicK8sClient := getDynamicK8sClient
-	getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (dynamic.Interface, error) {
+	getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (dynamic.Interface, error) {
 		return fakeDynamicK8sClient, nil
 	}
 
@@ -549,8 +549,10 @@ func (s *KubernetesManagerTestSuite) TestDeletePodWorkload() {
 }
 
 func (s *KubernetesManagerTestSuite) TestGetPodWorkloadsClientError() {
+	s.admClientMock.On("GetAppNamespace", s.ctx, testAppID).Return(testNamespace, nil)
+
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return nil, errors.NewInternal("internal error")
 	}
 
@@ -562,8 +564,10 @@ func (s *KubernetesManagerTestSuite) TestGetPodWorkloadsClientError() {
 }
 
 func (s *KubernetesManagerTestSuite) TestGetAppEndpointsClientError() {
+	s.admClientMock.On("GetAppNamespace", s.ctx, testAppID).Return(testNamespace, nil)
+
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return nil, errors.NewInternal("internal error")
 	}
 
@@ -575,8 +579,10 @@ func (s *KubernetesManagerTestSuite) TestGetAppEndpointsClientError() {
 }
 
 func (s *KubernetesManagerTestSuite) TestGetAppEndpointsInvalidAppID() {
+	s.admClientMock.On("GetAppNamespace", s.ctx, "").Return("", errors.NewNotFound("app not found"))
+
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return nil, errors.NewInternal("internal error")
 	}
 
@@ -591,7 +597,7 @@ func (s *KubernetesManagerTestSuite) TestDeletePodError() {
 	fakeK8sClient := fake.NewSimpleClientset()
 
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return fakeK8sClient, nil
 	}
 
@@ -603,7 +609,7 @@ func (s *KubernetesManagerTestSuite) TestDeletePodError() {
 
 func (s *KubernetesManagerTestSuite) TestDeletePodClientError() {
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return nil, errors.NewInternal("internal error")
 	}
 
@@ -641,11 +647,11 @@ func (s *KubernetesManagerTestSuite) TestGetPodWorkloads() {
 	s.admClientMock.On("GetAppNamespace", s.ctx, testAppID).Return(testNamespace, nil)
 
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return fakeK8sClient, nil
 	}
 	origDynamicK8sClient := getDynamicK8sClient
-	getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (dynamic.Interface, error) {
+	getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (dynamic.Interface, error) {
 		return fakeDynamicK8sClient, nil
 	}
 
@@ -686,7 +692,7 @@ func (s *KubernetesManagerTestSuite) TestGetAppEndpoints() {
 	assert.NoError(s.T(), err)
 
 	origGetK8sClient := getK8sClient
-	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, configPath string) (kubernetes.Interface, error) {
+	getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
 		return fakeK8sClient, nil
 	}
 
@@ -705,10 +711,10 @@ func (s *KubernetesManagerTestSuite) TestGetAppEndpoints() {
 
 func (s *KubernetesManagerTestSuite) TestGetK8sClient() {
 
-	s.admClientMock.On("GetKubeConfig", context.Background(), testClusterID).
+	s.admClientMock.On("GetKubeConfig", context.Background(), testClusterID, testNamespace).
 		Return([]byte(testKubeConfig), nil)
 
-	k8sClient, err := getK8sClient(context.Background(), testClusterID, s.admClientMock, s.configFile.Name())
+	k8sClient, err := getK8sClient(context.Background(), testClusterID, s.admClientMock, testNamespace)
 	assert.NoError(s.T(), err)
 	assert.NotNil(s.T(), k8sClient)
 }
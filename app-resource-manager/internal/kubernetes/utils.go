@@ -16,8 +16,8 @@ import (
 	"k8s.io/client-go/tools/clientcmd"
 )
 
-var getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, _ string) (kubernetes.Interface, error) {
-	kubeConfig, err := admClient.GetKubeConfig(ctx, clusterID)
+var getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (kubernetes.Interface, error) {
+	kubeConfig, err := admClient.GetKubeConfig(ctx, clusterID, namespace)
 	if err != nil {
 		log.Warn(err)
 		return nil, err
@@ -45,8 +45,8 @@ var getK8sClient = func(ctx context.Context, clusterID string, admClient adm.Cli
 	return clientSet, nil
 }
 
-var getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, _ string) (dynamic.Interface, error) {
-	kubeConfig, err := admClient.GetKubeConfig(ctx, clusterID)
+var getDynamicK8sClient = func(ctx context.Context, clusterID string, admClient adm.Client, namespace string) (dynamic.Interface, error) {
+	kubeConfig, err := admClient.GetKubeConfig(ctx, clusterID, namespace)
 	if err != nil {
 		log.Warn(err)
 		return nil, err
@@ -81,13 +81,19 @@ type manager struct {
 func (m *manager) GetVMWorkloads(ctx context.Context, appID string, clusterID string) ([]*resourcev2.AppWorkload, error) {
 	results := make([]*resourcev2.AppWorkload, 0)
 
-	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	if err != nil {
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		return nil, err
+	}
+
+	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to get kubevirt client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return nil, err
 	}
 
-	vmList, err := m.getKubevirtVirtualMachineList(ctx, kubevirtClient, appID)
+	vmList, err := m.getKubevirtVirtualMachineList(ctx, kubevirtClient, appID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to list virtual machines", dazl.String("AppID", appID), dazl.Error(err))
 		return nil, err
@@ -120,13 +126,19 @@ func (m *manager) GetVMWorkloads(ctx context.Context, appID string, clusterID st
 }
 
 func (m *manager) StartVM(ctx context.Context, appID string, clusterID string, vmID string) error {
-	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	if err != nil {
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		return err
+	}
+
+	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to get kubevirt client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return err
 	}
 
-	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, vmID)
+	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, appNamespace, vmID)
 	if err != nil {
 		log.Warnw("Failed to get application virtual machine", dazl.String("AppID", appID), dazl.Error(err))
 		return err
@@ -140,13 +152,19 @@ func (m *manager) StartVM(ctx context.Context, appID string, clusterID string, v
 }
 
 func (m *manager) StopVM(ctx context.Context, appID string, clusterID string, vmID string) error {
-	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	if err != nil {
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		return err
+	}
+
+	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to get kubevirt client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return err
 	}
 
-	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, vmID)
+	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, appNamespace, vmID)
 	if err != nil {
 		log.Warnw("Failed to get application virtual machine", dazl.String("AppID", appID), dazl.Error(err))
 		return err
@@ -160,13 +178,19 @@ func (m *manager) StopVM(ctx context.Context, appID string, clusterID string, vm
 }
 
 func (m *manager) RestartVM(ctx context.Context, appID string, clusterID string, vmID string) error {
-	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID)
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	if err != nil {
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		return err
+	}
+
+	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to get kubevirt client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return err
 	}
 
-	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, vmID)
+	vm, err := m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, appNamespace, vmID)
 	if err != nil {
 		log.Warnw("Failed to get application virtual machine", dazl.String("AppID", appID), dazl.Error(err))
 		return err
@@ -187,15 +211,22 @@ func (m *manager) GetVNCAddress(ctx context.Context, appID string, clusterID str
 	}
 
 	// validation
+	// app ID is valid
+	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
+	if err != nil {
+		log.Warnw("Failed to get application namespace", dazl.String("AppID", appID), dazl.Error(err))
+		return "", err
+	}
+
 	// cluster ID is valid
-	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID)
+	kubevirtClient, err := m.getKubevirtClient(ctx, clusterID, appNamespace)
 	if err != nil {
 		log.Warnw("Failed to get kubevirt client", dazl.String("ClusterID", clusterID), dazl.Error(err))
 		return "", err
 	}
 
 	// app ID and VM ID is valid
-	_, err = m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, vmID)
+	_, err = m.getKubevirtVirtualMachine(ctx, kubevirtClient, appID, appNamespace, vmID)
 	if err != nil {
 		log.Warnw("Failed to get application virtual machine", dazl.String("AppID", appID), dazl.Error(err))
 		return "", err
@@ -385,7 +416,17 @@ func (m *manager) GetVNCWebSocketHandler(ctx context.Context, opaClient openpoli
 			log.Debugw("account session counter decreased", dazl.Strings("accounts", accounts), dazl.String("counter", accountSessionCounter.Print()))
 		}()
 
-		kubevirtClient, err := m.getKubevirtClient(outCtx, path.clusterID)
+		appNamespace, err := m.admClient.GetAppNamespace(outCtx, path.appID)
+		if err != nil {
+			msg := fmt.Sprintf("failed to get application namespace, error: %v", err)
+			err := setErrorStatus(w, http.StatusNotFound, msg)
+			if err != nil {
+				log.Errorw("failed to encode error status message", dazl.Error(err))
+			}
+			return
+		}
+
+		kubevirtClient, err := m.getKubevirtClient(outCtx, path.clusterID, appNamespace)
 		if err != nil {
 			msg := fmt.Sprintf("failed to get kubevirt client, error: %v", err)
 			err := setErrorStatus(w, http.StatusNotFound, msg)
@@ -395,7 +436,7 @@ func (m *manager) GetVNCWebSocketHandler(ctx context.Context, opaClient openpoli
 			return
 		}
 
-		vncStream, err := m.getVNCStream(outCtx, kubevirtClient, path.appID, path.vmID)
+		vncStream, err := m.getVNCStream(outCtx, kubevirtClient, path.appID, appNamespace, path.vmID)
 		if err != nil {
 			msg := fmt.Sprintf("failed to get VNC stream, error: %v", err)
 			err := setErrorStatus(w, http.StatusNotFound, msg)
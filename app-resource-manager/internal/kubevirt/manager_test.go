@@ -144,7 +144,7 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVMWorkloads() {
 	}
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
@@ -166,7 +166,8 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVMWorkloads() {
 func (s *KubevirtManagerTestSuite) TestManager_GetVMWorkloads_FailedGetKubevirtClient() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 
 	vm, err := s.mgr.GetVMWorkloads(ctx, testAppID, testClusterID)
 	assert.Nil(s.T(), vm)
@@ -176,7 +177,7 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVMWorkloads_FailedGetKubevirtC
 func (s *KubevirtManagerTestSuite) TestManager_GetVMWorkloads_FailedGetKubevirtVirtualMachineList() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(nil, errors.NewUnknown("test"))
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
@@ -201,7 +202,7 @@ func (s *KubevirtManagerTestSuite) TestManager_StartVM() {
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("Start", ctx, testVMID1, &v1.StartOptions{}).Return(nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
@@ -222,7 +223,8 @@ func (s *KubevirtManagerTestSuite) TestManager_StartVM() {
 func (s *KubevirtManagerTestSuite) TestManager_StartVM_FailedGetKubevirtClient() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 	err := s.mgr.StartVM(ctx, testAppID, testClusterID, testVMID1)
 	assert.Error(s.T(), err)
 }
@@ -234,7 +236,7 @@ func (s *KubevirtManagerTestSuite) TestManager_StartVM_FailedGetKubevirtVirtualM
 		Items: []v1.VirtualMachine{testVirtualMachine1, testVirtualMachine2},
 	}
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
@@ -258,7 +260,7 @@ func (s *KubevirtManagerTestSuite) TestManager_StopVM() {
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	s.mockVirtualMachineInterface.On("Stop", ctx, testVMID1, &v1.StopOptions{}).Return(nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
@@ -280,7 +282,8 @@ func (s *KubevirtManagerTestSuite) TestManager_StopVM() {
 func (s *KubevirtManagerTestSuite) TestManager_StopVM_FailedGetKubevirtClient() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 	err := s.mgr.StopVM(ctx, testAppID, testClusterID, testVMID1)
 	assert.Error(s.T(), err)
 }
@@ -292,7 +295,7 @@ func (s *KubevirtManagerTestSuite) TestManager_StopVM_FailedGetKubevirtVirtualMa
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
@@ -316,7 +319,7 @@ func (s *KubevirtManagerTestSuite) TestManager_RestartVM() {
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	s.mockVirtualMachineInterface.On("Restart", ctx, testVMID1, &v1.RestartOptions{}).Return(nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
@@ -338,7 +341,8 @@ func (s *KubevirtManagerTestSuite) TestManager_RestartVM() {
 func (s *KubevirtManagerTestSuite) TestManager_RestartVM_FailedGetKubevirtClient() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 	err := s.mgr.RestartVM(ctx, testAppID, testClusterID, testVMID1)
 	assert.Error(s.T(), err)
 }
@@ -350,7 +354,7 @@ func (s *KubevirtManagerTestSuite) TestManager_RestartVM_FailedGetKubevirtVirtua
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
@@ -374,7 +378,7 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVNCAddress() {
 	}
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
@@ -406,7 +410,8 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVNCAddress_FailedGetConfigMode
 
 func (s *KubevirtManagerTestSuite) TestManager_GetVNCAddress_FailedGetKubevirtClient() {
 	ctx := context.Background()
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 
 	addr, err := s.mgr.GetVNCAddress(ctx, testAppID, testClusterID, testVMID1)
 	assert.Error(s.T(), err)
@@ -418,7 +423,7 @@ func (s *KubevirtManagerTestSuite) TestManager_GetVNCAddress_FailedGetKubevirtVi
 		Items: []v1.VirtualMachine{testVirtualMachine1, testVirtualMachine2},
 	}
 	ctx := context.Background()
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
@@ -453,7 +458,7 @@ func (s *KubevirtManagerTestSuite) TestVNCWebSocketHandler() {
 
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockStreamInterface.On("Stream", mock.Anything).Return(nil)
 	s.mockVirtualMachineInstanceInterface.On("VNC", testVMID1).Return(s.mockStreamInterface, nil)
@@ -505,7 +510,8 @@ func (s *KubevirtManagerTestSuite) TestVNCWebSocketHandler() {
 func (s *KubevirtManagerTestSuite) TestVNCWebSocketHandler_FailedGetKubevirtClient() {
 	ctx := context.Background()
 
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(""), errors.NewUnknown("test"))
+	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(""), errors.NewUnknown("test"))
 
 	mockIPSessionCounter := countermock.NewMockCounter(s.T())
 	mockIPSessionCounter.On("Increase", mock.AnythingOfType("string")).Return(nil)
@@ -545,7 +551,7 @@ func (s *KubevirtManagerTestSuite) TestVNCWebSocketHandler_FailedToGetVNCStream_
 		Items: []v1.VirtualMachine{testVirtualMachine1, testVirtualMachine2},
 	}
 	ctx := context.Background()
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.admMockClient.On("GetAppNamespace", ctx, testAppID).Return(testNamespace, nil)
 	s.mockKubeVirtClient.On("VirtualMachine", testNamespace).Return(s.mockVirtualMachineInterface)
@@ -596,7 +602,7 @@ func (s *KubevirtManagerTestSuite) TestVNCWebSocketHandler_FailedCallUpgrade() {
 		Items: []v1.VirtualMachine{testVirtualMachine1, testVirtualMachine2},
 	}
 	ctx := context.Background()
-	s.admMockClient.On("GetKubeConfig", ctx, testClusterID).Return([]byte(testKubeConfig), nil)
+	s.admMockClient.On("GetKubeConfig", ctx, testClusterID, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	s.mockVirtualMachineInterface.On("List", ctx, &k8sv1.ListOptions{}).Return(&vmList, nil)
 	s.mockVirtualMachineInstanceInterface.On("VNC", testVMID1).Return(s.mockStreamInterface, nil)
@@ -22,8 +22,8 @@ var (
 	kubeVirtGetKubevirtClientFromRESTConfig = kubecli.GetKubevirtClientFromRESTConfig
 )
 
-func (m *manager) getKubevirtClient(ctx context.Context, clusterID string) (kubecli.KubevirtClient, error) {
-	kubeConfig, err := m.admClient.GetKubeConfig(ctx, clusterID)
+func (m *manager) getKubevirtClient(ctx context.Context, clusterID string, namespace string) (kubecli.KubevirtClient, error) {
+	kubeConfig, err := m.admClient.GetKubeConfig(ctx, clusterID, namespace)
 	if err != nil {
 		log.Warn(err)
 		return nil, err
@@ -12,14 +12,8 @@ import (
 	"kubevirt.io/client-go/kubecli"
 )
 
-func (m *manager) getKubevirtVirtualMachineList(ctx context.Context, client kubecli.KubevirtClient, appID string) ([]*v1.VirtualMachine, error) {
+func (m *manager) getKubevirtVirtualMachineList(ctx context.Context, client kubecli.KubevirtClient, appID string, appNamespace string) ([]*v1.VirtualMachine, error) {
 	results := make([]*v1.VirtualMachine, 0)
-	appNamespace, err := m.admClient.GetAppNamespace(ctx, appID)
-	if err != nil {
-		log.Warn(err)
-		return nil, err
-	}
-
 	vmList, err := client.VirtualMachine(appNamespace).List(ctx, &k8sv1.ListOptions{})
 	if err != nil {
 		log.Warn(err)
@@ -42,8 +36,8 @@ func (m *manager) getKubevirtVirtualMachineList(ctx context.Context, client kube
 	return results, nil
 }
 
-func (m *manager) getKubevirtVirtualMachine(ctx context.Context, client kubecli.KubevirtClient, appID string, vmID string) (*v1.VirtualMachine, error) {
-	vmList, err := m.getKubevirtVirtualMachineList(ctx, client, appID)
+func (m *manager) getKubevirtVirtualMachine(ctx context.Context, client kubecli.KubevirtClient, appID string, appNamespace string, vmID string) (*v1.VirtualMachine, error) {
+	vmList, err := m.getKubevirtVirtualMachineList(ctx, client, appID, appNamespace)
 	if err != nil {
 		return nil, err
 	}
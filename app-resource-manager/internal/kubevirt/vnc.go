@@ -12,9 +12,9 @@ import (
 	"strings"
 )
 
-func (m *manager) getVNCStream(ctx context.Context, client kubecli.KubevirtClient, appID string, vmID string) (kubecli.StreamInterface, error) {
+func (m *manager) getVNCStream(ctx context.Context, client kubecli.KubevirtClient, appID string, appNamespace string, vmID string) (kubecli.StreamInterface, error) {
 	// app ID and VM ID is valid
-	vm, err := m.getKubevirtVirtualMachine(ctx, client, appID, vmID)
+	vm, err := m.getKubevirtVirtualMachine(ctx, client, appID, appNamespace, vmID)
 	if err != nil {
 		return nil, err
 	}
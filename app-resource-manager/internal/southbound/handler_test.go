@@ -35,7 +35,7 @@ func TestNewHandler(t *testing.T) {
 	defer server.Close()
 
 	admMockClient := admmock.NewMockADMClient(t)
-	admMockClient.On("GetKubeConfig", context.Background(), testCluster1).Return([]byte(testKubeConfig), nil)
+	admMockClient.On("GetKubeConfig", context.Background(), testCluster1, testNamespace).Return([]byte(testKubeConfig), nil)
 
 	k8s := kubernetes.NewManager("", admMockClient)
 	kv := kubevirt.NewManager("", admMockClient, false)
@@ -7,6 +7,7 @@ package admclient
 import (
 	"context"
 	"fmt"
+	"math"
 	"os"
 
 	admv1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
@@ -19,15 +20,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
-	authenticationv1 "k8s.io/api/authentication/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 )
 
 type ADMClient interface {
-	GetClusterToken(ctx context.Context, clusterID, namespace, serviceAccount string, expiration *int64) (string, error)
+	GetClusterToken(ctx context.Context, clusterID, namespace string, expiration *int64) (string, error)
 	GetClusterInfraName(ctx context.Context, clusterID, activeProjectID string) (string, error)
 }
 
@@ -37,43 +36,37 @@ type client struct {
 	appDepClient         *clientv1beta1.AppDeploymentClient
 }
 
-func (c *client) GetClusterToken(ctx context.Context, clusterID, namespace, serviceAccount string, expiration *int64) (string, error) {
+// GetClusterToken returns a token of the credentials ADM issues for a cluster,
+// scoped to the given namespace and valid for the given number of seconds
+func (c *client) GetClusterToken(ctx context.Context, clusterID, namespace string, expiration *int64) (string, error) {
 	ctx, cancel, err := getCtxWithToken(ctx, c.vaultAuthClient)
 	if err != nil {
 		return "", err
 	}
 	defer cancel()
 
-	req := &admv1.GetKubeConfigRequest{ClusterId: clusterID}
-
-	resp, err := c.clusterServiceClient.GetKubeConfig(ctx, req)
-	if err != nil {
-		return "", err
+	req := &admv1.GetKubeConfigRequest{
+		ClusterId: clusterID,
+		Namespace: namespace,
+	}
+	if expiration != nil {
+		req.TtlSeconds = int32(min(*expiration, math.MaxInt32))
 	}
 
-	cfg, err := clientcmd.RESTConfigFromKubeConfig(resp.KubeConfigInfo.KubeConfig)
+	resp, err := c.clusterServiceClient.GetKubeConfig(ctx, req)
 	if err != nil {
 		return "", err
 	}
 
-	clientset, err := kubernetes.NewForConfig(cfg)
+	cfg, err := clientcmd.RESTConfigFromKubeConfig(resp.GetKubeConfigInfo().GetKubeConfig())
 	if err != nil {
 		return "", err
 	}
-
-	tokenReq := &authenticationv1.TokenRequest{
-		Spec: authenticationv1.TokenRequestSpec{
-			Audiences:         []string{},
-			ExpirationSeconds: expiration,
-		},
-	}
-	tokenCreated, err := clientset.CoreV1().ServiceAccounts(namespace).
-		CreateToken(ctx, serviceAccount, tokenReq, v1.CreateOptions{})
-	if err != nil || tokenCreated.Status.Token == "" {
-		return "", fmt.Errorf("failed to create token %v", err)
+	if cfg.BearerToken == "" {
+		return "", fmt.Errorf("no token in the kubeconfig of cluster %s", clusterID)
 	}
 
-	return tokenCreated.Status.Token, nil
+	return cfg.BearerToken, nil
 }
 
 func (c *client) GetClusterInfraName(ctx context.Context, clusterID, activeProjectID string) (string, error) {
@@ -0,0 +1,93 @@
+// SPDX-FileCopyrightText: (C) 2025 Intel Corporation
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package admclient
+
+import (
+	"context"
+	"testing"
+
+	admv1 "github.com/open-edge-platform/app-orch-deployment/app-deployment-manager/api/nbi/v2/deployment/v1"
+	"github.com/open-edge-platform/orch-library/go/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+type fakeVaultAuth struct {
+	auth.VaultAuth
+}
+
+func (fakeVaultAuth) GetM2MToken(context.Context) (string, error) {
+	return "m2m-token", nil
+}
+
+func (fakeVaultAuth) Logout(context.Context) error {
+	return nil
+}
+
+// fakeClusterService issues kubeconfigs authenticated by a token named after
+// the requested namespace
+type fakeClusterService struct {
+	admv1.ClusterServiceClient
+	requests []*admv1.GetKubeConfigRequest
+	auth     []string
+	token    string
+}
+
+func (f *fakeClusterService) GetKubeConfig(ctx context.Context, in *admv1.GetKubeConfigRequest, _ ...grpc.CallOption) (*admv1.GetKubeConfigResponse, error) {
+	f.requests = append(f.requests, in)
+	md, _ := metadata.FromOutgoingContext(ctx)
+	f.auth = append(f.auth, md.Get("authorization")...)
+
+	config := clientcmdapi.NewConfig()
+	config.Clusters[in.ClusterId] = &clientcmdapi.Cluster{Server: "https://" + in.ClusterId + ":6443"}
+	config.AuthInfos[in.ClusterId] = &clientcmdapi.AuthInfo{Token: f.token}
+	config.Contexts[in.ClusterId] = &clientcmdapi.Context{Cluster: in.ClusterId, AuthInfo: in.ClusterId, Namespace: in.Namespace}
+	config.CurrentContext = in.ClusterId
+	data, err := clientcmd.Write(*config)
+	if err != nil {
+		return nil, err
+	}
+	return &admv1.GetKubeConfigResponse{KubeConfigInfo: &admv1.KubeConfigInfo{KubeConfig: data}}, nil
+}
+
+func TestGetClusterTokenRequestsNamespaceScope(t *testing.T) {
+	cs := &fakeClusterService{token: "token-wordpress"}
+	c := &client{clusterServiceClient: cs, vaultAuthClient: fakeVaultAuth{}}
+
+	expiration := int64(900)
+	token, err := c.GetClusterToken(context.Background(), "cluster-1", "wordpress", &expiration)
+	require.NoError(t, err)
+	assert.Equal(t, "token-wordpress", token)
+
+	// The credentials scoped to the whole cluster are read-only, the token
+	// must be scoped to the namespace of the proxied service
+	require.Len(t, cs.requests, 1)
+	assert.Equal(t, "cluster-1", cs.requests[0].ClusterId)
+	assert.Equal(t, "wordpress", cs.requests[0].Namespace)
+	assert.Equal(t, int32(900), cs.requests[0].TtlSeconds)
+	assert.Equal(t, []string{"Bearer m2m-token"}, cs.auth)
+}
+
+func TestGetClusterTokenDefaultExpiration(t *testing.T) {
+	cs := &fakeClusterService{token: "token-wordpress"}
+	c := &client{clusterServiceClient: cs, vaultAuthClient: fakeVaultAuth{}}
+
+	_, err := c.GetClusterToken(context.Background(), "cluster-1", "wordpress", nil)
+	require.NoError(t, err)
+	require.Len(t, cs.requests, 1)
+	assert.Zero(t, cs.requests[0].TtlSeconds)
+}
+
+func TestGetClusterTokenWithoutToken(t *testing.T) {
+	cs := &fakeClusterService{}
+	c := &client{clusterServiceClient: cs, vaultAuthClient: fakeVaultAuth{}}
+
+	_, err := c.GetClusterToken(context.Background(), "cluster-1", "wordpress", nil)
+	assert.Error(t, err)
+}
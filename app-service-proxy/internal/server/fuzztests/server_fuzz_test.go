@@ -267,7 +267,7 @@ func FuzzProxyHeaderProto(f *testing.F) {
 
 type mockADMClient struct{}
 
-func (c *mockADMClient) GetClusterToken(ctx context.Context, clusterId, namespace, serviceAccount string, expiration *int64) (string, error) {
+func (c *mockADMClient) GetClusterToken(ctx context.Context, clusterId, namespace string, expiration *int64) (string, error) {
 	return "", nil
 }
 
@@ -258,7 +258,7 @@ var _ = Describe("Server", func() {
 // Mock ADM Client
 type mockADMClient struct{}
 
-func (c *mockADMClient) GetClusterToken(ctx context.Context, clusterId, namespace, serviceAccount string, expiration *int64) (string, error) {
+func (c *mockADMClient) GetClusterToken(ctx context.Context, clusterId, namespace string, expiration *int64) (string, error) {
 	return "", nil
 }
 